@@ -255,10 +255,60 @@ func (TopicAnalysisMethod) EnumDescriptor() ([]byte, []int) {
 	return file_api_ai_v1_ai_proto_rawDescGZIP(), []int{3}
 }
 
+// 话题分析状态枚举
+type TopicAnalysisStatus int32
+
+const (
+	TopicAnalysisStatus_TOPIC_ANALYSIS_STATUS_UNSPECIFIED TopicAnalysisStatus = 0
+	TopicAnalysisStatus_TOPIC_ANALYSIS_STATUS_COMPLETED   TopicAnalysisStatus = 1 // 已完成
+	TopicAnalysisStatus_TOPIC_ANALYSIS_STATUS_PENDING     TopicAnalysisStatus = 2 // 后台分析中，稍后以相同参数重试
+)
+
+// Enum value maps for TopicAnalysisStatus.
+var (
+	TopicAnalysisStatus_name = map[int32]string{
+		0: "TOPIC_ANALYSIS_STATUS_UNSPECIFIED",
+		1: "TOPIC_ANALYSIS_STATUS_COMPLETED",
+		2: "TOPIC_ANALYSIS_STATUS_PENDING",
+	}
+	TopicAnalysisStatus_value = map[string]int32{
+		"TOPIC_ANALYSIS_STATUS_UNSPECIFIED": 0,
+		"TOPIC_ANALYSIS_STATUS_COMPLETED":   1,
+		"TOPIC_ANALYSIS_STATUS_PENDING":     2,
+	}
+)
+
+func (x TopicAnalysisStatus) Enum() *TopicAnalysisStatus {
+	p := new(TopicAnalysisStatus)
+	*p = x
+	return p
+}
+
+func (x TopicAnalysisStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TopicAnalysisStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_ai_v1_ai_proto_enumTypes[4].Descriptor()
+}
+
+func (TopicAnalysisStatus) Type() protoreflect.EnumType {
+	return &file_api_ai_v1_ai_proto_enumTypes[4]
+}
+
+func (x TopicAnalysisStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TopicAnalysisStatus.Descriptor instead.
+func (TopicAnalysisStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_ai_v1_ai_proto_rawDescGZIP(), []int{4}
+}
+
 // 对话统计分析请求
 type GetConversationAnalyticsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                                              // 用户ID，为空则分析全局数据
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                                              // 用户ID，为空时为调用方本人；仅管理员可指定其他用户，为空则分析全局数据
 	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`                                                      // 开始时间
 	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                                                            // 结束时间
 	Granularity   AnalyticsGranularity   `protobuf:"varint,4,opt,name=granularity,proto3,enum=api.ai.v1.AnalyticsGranularity" json:"granularity,omitempty"`                              // 时间粒度
@@ -387,7 +437,7 @@ func (x *GetConversationAnalyticsReply) GetAnalytics() *ConversationAnalytics {
 // 用户使用统计请求
 type GetUserUsageStatsRequest struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
-	UserId               int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                             // 用户ID
+	UserId               int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                             // 用户ID，为空时为调用方本人；仅管理员可指定其他用户
 	StartTime            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`                                     // 开始时间
 	EndTime              *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                                           // 结束时间
 	IncludeCostBreakdown bool                   `protobuf:"varint,4,opt,name=include_cost_breakdown,json=includeCostBreakdown,proto3" json:"include_cost_breakdown,omitempty"` // 是否包含成本明细
@@ -629,7 +679,7 @@ func (x *GetModelPerformanceStatsReply) GetModelStats() []*ModelPerformanceStats
 // 对话趋势分析请求
 type GetConversationTrendsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                                     // 用户ID，为空则分析全局趋势
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                                     // 用户ID，为空时为调用方本人；仅管理员可指定其他用户，为空则分析全局趋势
 	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`                                             // 开始时间
 	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                                                   // 结束时间
 	Granularity   AnalyticsGranularity   `protobuf:"varint,4,opt,name=granularity,proto3,enum=api.ai.v1.AnalyticsGranularity" json:"granularity,omitempty"`                     // 时间粒度
@@ -749,15 +799,17 @@ func (x *GetConversationTrendsReply) GetTrends() []*TrendData {
 
 // 话题分析请求
 type GetTopicAnalysisRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                                              // 用户ID，为空则分析全局话题
-	StartTime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`                                                      // 开始时间
-	EndTime        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                                                            // 结束时间
-	TopTopicsCount int32                  `protobuf:"varint,4,opt,name=top_topics_count,json=topTopicsCount,proto3" json:"top_topics_count,omitempty"`                                    // 返回热门话题数量
-	Method         TopicAnalysisMethod    `protobuf:"varint,5,opt,name=method,proto3,enum=api.ai.v1.TopicAnalysisMethod" json:"method,omitempty"`                                         // 分析方法
-	Filters        map[string]string      `protobuf:"bytes,6,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 过滤条件
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                                                   // 用户ID，为空时为调用方本人；仅管理员可指定其他用户，为空则分析全局话题
+	StartTime        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`                                                           // 开始时间
+	EndTime          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                                                                 // 结束时间
+	TopTopicsCount   int32                  `protobuf:"varint,4,opt,name=top_topics_count,json=topTopicsCount,proto3" json:"top_topics_count,omitempty"`                                         // 返回热门话题数量
+	Method           TopicAnalysisMethod    `protobuf:"varint,5,opt,name=method,proto3,enum=api.ai.v1.TopicAnalysisMethod" json:"method,omitempty"`                                              // 分析方法
+	Filters          map[string]string      `protobuf:"bytes,6,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`      // 过滤条件
+	TrendGranularity AnalyticsGranularity   `protobuf:"varint,7,opt,name=trend_granularity,json=trendGranularity,proto3,enum=api.ai.v1.AnalyticsGranularity" json:"trend_granularity,omitempty"` // 话题趋势粒度，默认按天
+	Refresh          bool                   `protobuf:"varint,8,opt,name=refresh,proto3" json:"refresh,omitempty"`                                                                               // 忽略缓存重新分析
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *GetTopicAnalysisRequest) Reset() {
@@ -832,10 +884,27 @@ func (x *GetTopicAnalysisRequest) GetFilters() map[string]string {
 	return nil
 }
 
+func (x *GetTopicAnalysisRequest) GetTrendGranularity() AnalyticsGranularity {
+	if x != nil {
+		return x.TrendGranularity
+	}
+	return AnalyticsGranularity_ANALYTICS_GRANULARITY_UNSPECIFIED
+}
+
+func (x *GetTopicAnalysisRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
 type GetTopicAnalysisReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Topics        []*TopicInsight        `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`             // 话题洞察
-	Distribution  *TopicDistribution     `protobuf:"bytes,2,opt,name=distribution,proto3" json:"distribution,omitempty"` // 话题分布
+	Topics        []*TopicInsight        `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`                                     // 话题洞察
+	Distribution  *TopicDistribution     `protobuf:"bytes,2,opt,name=distribution,proto3" json:"distribution,omitempty"`                         // 话题分布
+	Status        TopicAnalysisStatus    `protobuf:"varint,3,opt,name=status,proto3,enum=api.ai.v1.TopicAnalysisStatus" json:"status,omitempty"` // 分析状态，后台分析未完成时为PENDING
+	JobId         string                 `protobuf:"bytes,4,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                          // 后台分析任务ID
+	GeneratedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`        // 结果生成时间
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -884,6 +953,27 @@ func (x *GetTopicAnalysisReply) GetDistribution() *TopicDistribution {
 	return nil
 }
 
+func (x *GetTopicAnalysisReply) GetStatus() TopicAnalysisStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TopicAnalysisStatus_TOPIC_ANALYSIS_STATUS_UNSPECIFIED
+}
+
+func (x *GetTopicAnalysisReply) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *GetTopicAnalysisReply) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
 // 系统总览请求
 type GetSystemOverviewRequest struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
@@ -1419,17 +1509,19 @@ func (x *TrendData) GetTrendDirection() string {
 
 // 话题洞察
 type TopicInsight struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	TopicName              string                 `protobuf:"bytes,1,opt,name=topic_name,json=topicName,proto3" json:"topic_name,omitempty"`                                        // 话题名称
-	Keywords               []string               `protobuf:"bytes,2,rep,name=keywords,proto3" json:"keywords,omitempty"`                                                           // 关键词
-	MessageCount           int64                  `protobuf:"varint,3,opt,name=message_count,json=messageCount,proto3" json:"message_count,omitempty"`                              // 消息数量
-	RelevanceScore         float64                `protobuf:"fixed64,4,opt,name=relevance_score,json=relevanceScore,proto3" json:"relevance_score,omitempty"`                       // 相关度分数
-	SentimentScore         float64                `protobuf:"fixed64,5,opt,name=sentiment_score,json=sentimentScore,proto3" json:"sentiment_score,omitempty"`                       // 情感分数
-	RepresentativeMessages []string               `protobuf:"bytes,6,rep,name=representative_messages,json=representativeMessages,proto3" json:"representative_messages,omitempty"` // 代表性消息
-	FirstSeen              *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`                                        // 首次出现时间
-	LastSeen               *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`                                           // 最后出现时间
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	state                         protoimpl.MessageState `protogen:"open.v1"`
+	TopicName                     string                 `protobuf:"bytes,1,opt,name=topic_name,json=topicName,proto3" json:"topic_name,omitempty"`                                                                       // 话题名称
+	Keywords                      []string               `protobuf:"bytes,2,rep,name=keywords,proto3" json:"keywords,omitempty"`                                                                                          // 关键词
+	MessageCount                  int64                  `protobuf:"varint,3,opt,name=message_count,json=messageCount,proto3" json:"message_count,omitempty"`                                                             // 消息数量
+	RelevanceScore                float64                `protobuf:"fixed64,4,opt,name=relevance_score,json=relevanceScore,proto3" json:"relevance_score,omitempty"`                                                      // 相关度分数
+	SentimentScore                float64                `protobuf:"fixed64,5,opt,name=sentiment_score,json=sentimentScore,proto3" json:"sentiment_score,omitempty"`                                                      // 情感分数
+	RepresentativeMessages        []string               `protobuf:"bytes,6,rep,name=representative_messages,json=representativeMessages,proto3" json:"representative_messages,omitempty"`                                // 代表性消息
+	FirstSeen                     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`                                                                       // 首次出现时间
+	LastSeen                      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`                                                                          // 最后出现时间
+	RepresentativeConversationIds []int64                `protobuf:"varint,9,rep,packed,name=representative_conversation_ids,json=representativeConversationIds,proto3" json:"representative_conversation_ids,omitempty"` // 代表性对话ID
+	Trend                         []*TimeSeriesPoint     `protobuf:"bytes,10,rep,name=trend,proto3" json:"trend,omitempty"`                                                                                               // 话题消息量趋势
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
 }
 
 func (x *TopicInsight) Reset() {
@@ -1518,6 +1610,20 @@ func (x *TopicInsight) GetLastSeen() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *TopicInsight) GetRepresentativeConversationIds() []int64 {
+	if x != nil {
+		return x.RepresentativeConversationIds
+	}
+	return nil
+}
+
+func (x *TopicInsight) GetTrend() []*TimeSeriesPoint {
+	if x != nil {
+		return x.Trend
+	}
+	return nil
+}
+
 // 话题分布
 type TopicDistribution struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
@@ -2481,7 +2587,7 @@ const file_api_ai_v1_ai_proto_rawDesc = "" +
 	"\vgranularity\x18\x04 \x01(\x0e2\x1f.api.ai.v1.AnalyticsGranularityR\vgranularity\x12;\n" +
 	"\rtrend_metrics\x18\x05 \x03(\x0e2\x16.api.ai.v1.TrendMetricR\ftrendMetrics\"J\n" +
 	"\x1aGetConversationTrendsReply\x12,\n" +
-	"\x06trends\x18\x01 \x03(\v2\x14.api.ai.v1.TrendDataR\x06trends\"\x8d\x03\n" +
+	"\x06trends\x18\x01 \x03(\v2\x14.api.ai.v1.TrendDataR\x06trends\"\xf5\x03\n" +
 	"\x17GetTopicAnalysisRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x129\n" +
 	"\n" +
@@ -2489,13 +2595,18 @@ const file_api_ai_v1_ai_proto_rawDesc = "" +
 	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12(\n" +
 	"\x10top_topics_count\x18\x04 \x01(\x05R\x0etopTopicsCount\x126\n" +
 	"\x06method\x18\x05 \x01(\x0e2\x1e.api.ai.v1.TopicAnalysisMethodR\x06method\x12I\n" +
-	"\afilters\x18\x06 \x03(\v2/.api.ai.v1.GetTopicAnalysisRequest.FiltersEntryR\afilters\x1a:\n" +
+	"\afilters\x18\x06 \x03(\v2/.api.ai.v1.GetTopicAnalysisRequest.FiltersEntryR\afilters\x12L\n" +
+	"\x11trend_granularity\x18\a \x01(\x0e2\x1f.api.ai.v1.AnalyticsGranularityR\x10trendGranularity\x12\x18\n" +
+	"\arefresh\x18\b \x01(\bR\arefresh\x1a:\n" +
 	"\fFiltersEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8a\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x98\x02\n" +
 	"\x15GetTopicAnalysisReply\x12/\n" +
 	"\x06topics\x18\x01 \x03(\v2\x17.api.ai.v1.TopicInsightR\x06topics\x12@\n" +
-	"\fdistribution\x18\x02 \x01(\v2\x1c.api.ai.v1.TopicDistributionR\fdistribution\"\xf8\x01\n" +
+	"\fdistribution\x18\x02 \x01(\v2\x1c.api.ai.v1.TopicDistributionR\fdistribution\x126\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1e.api.ai.v1.TopicAnalysisStatusR\x06status\x12\x15\n" +
+	"\x06job_id\x18\x04 \x01(\tR\x05jobId\x12=\n" +
+	"\fgenerated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\"\xf8\x01\n" +
 	"\x18GetSystemOverviewRequest\x129\n" +
 	"\n" +
 	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
@@ -2565,7 +2676,7 @@ const file_api_ai_v1_ai_proto_rawDesc = "" +
 	"dataPoints\x12\x1f\n" +
 	"\vgrowth_rate\x18\x03 \x01(\x01R\n" +
 	"growthRate\x12'\n" +
-	"\x0ftrend_direction\x18\x04 \x01(\tR\x0etrendDirection\"\xed\x02\n" +
+	"\x0ftrend_direction\x18\x04 \x01(\tR\x0etrendDirection\"\xe7\x03\n" +
 	"\fTopicInsight\x12\x1d\n" +
 	"\n" +
 	"topic_name\x18\x01 \x01(\tR\ttopicName\x12\x1a\n" +
@@ -2576,7 +2687,10 @@ const file_api_ai_v1_ai_proto_rawDesc = "" +
 	"\x17representative_messages\x18\x06 \x03(\tR\x16representativeMessages\x129\n" +
 	"\n" +
 	"first_seen\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tfirstSeen\x127\n" +
-	"\tlast_seen\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\blastSeen\"\xa1\x02\n" +
+	"\tlast_seen\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\blastSeen\x12F\n" +
+	"\x1frepresentative_conversation_ids\x18\t \x03(\x03R\x1drepresentativeConversationIds\x120\n" +
+	"\x05trend\x18\n" +
+	" \x03(\v2\x1a.api.ai.v1.TimeSeriesPointR\x05trend\"\xa1\x02\n" +
 	"\x11TopicDistribution\x12_\n" +
 	"\x11topic_percentages\x18\x01 \x03(\v22.api.ai.v1.TopicDistribution.TopicPercentagesEntryR\x10topicPercentages\x122\n" +
 	"\x15topic_diversity_index\x18\x02 \x01(\x01R\x13topicDiversityIndex\x122\n" +
@@ -2699,7 +2813,11 @@ const file_api_ai_v1_ai_proto_rawDesc = "" +
 	"\x1dTOPIC_ANALYSIS_METHOD_KEYWORD\x10\x01\x12\x1d\n" +
 	"\x19TOPIC_ANALYSIS_METHOD_LDA\x10\x02\x12$\n" +
 	" TOPIC_ANALYSIS_METHOD_CLUSTERING\x10\x03\x12\"\n" +
-	"\x1eTOPIC_ANALYSIS_METHOD_SEMANTIC\x10\x042\xe5\x04\n" +
+	"\x1eTOPIC_ANALYSIS_METHOD_SEMANTIC\x10\x04*\x84\x01\n" +
+	"\x13TopicAnalysisStatus\x12%\n" +
+	"!TOPIC_ANALYSIS_STATUS_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fTOPIC_ANALYSIS_STATUS_COMPLETED\x10\x01\x12!\n" +
+	"\x1dTOPIC_ANALYSIS_STATUS_PENDING\x10\x022\xe5\x04\n" +
 	"\x02Ai\x12p\n" +
 	"\x18GetConversationAnalytics\x12*.api.ai.v1.GetConversationAnalyticsRequest\x1a(.api.ai.v1.GetConversationAnalyticsReply\x12[\n" +
 	"\x11GetUserUsageStats\x12#.api.ai.v1.GetUserUsageStatsRequest\x1a!.api.ai.v1.GetUserUsageStatsReply\x12p\n" +
@@ -2721,127 +2839,132 @@ func file_api_ai_v1_ai_proto_rawDescGZIP() []byte {
 	return file_api_ai_v1_ai_proto_rawDescData
 }
 
-var file_api_ai_v1_ai_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_api_ai_v1_ai_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
 var file_api_ai_v1_ai_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_api_ai_v1_ai_proto_goTypes = []any{
 	(AnalyticsGranularity)(0),               // 0: api.ai.v1.AnalyticsGranularity
 	(AnalyticsMetric)(0),                    // 1: api.ai.v1.AnalyticsMetric
 	(TrendMetric)(0),                        // 2: api.ai.v1.TrendMetric
 	(TopicAnalysisMethod)(0),                // 3: api.ai.v1.TopicAnalysisMethod
-	(*GetConversationAnalyticsRequest)(nil), // 4: api.ai.v1.GetConversationAnalyticsRequest
-	(*GetConversationAnalyticsReply)(nil),   // 5: api.ai.v1.GetConversationAnalyticsReply
-	(*GetUserUsageStatsRequest)(nil),        // 6: api.ai.v1.GetUserUsageStatsRequest
-	(*GetUserUsageStatsReply)(nil),          // 7: api.ai.v1.GetUserUsageStatsReply
-	(*GetModelPerformanceStatsRequest)(nil), // 8: api.ai.v1.GetModelPerformanceStatsRequest
-	(*GetModelPerformanceStatsReply)(nil),   // 9: api.ai.v1.GetModelPerformanceStatsReply
-	(*GetConversationTrendsRequest)(nil),    // 10: api.ai.v1.GetConversationTrendsRequest
-	(*GetConversationTrendsReply)(nil),      // 11: api.ai.v1.GetConversationTrendsReply
-	(*GetTopicAnalysisRequest)(nil),         // 12: api.ai.v1.GetTopicAnalysisRequest
-	(*GetTopicAnalysisReply)(nil),           // 13: api.ai.v1.GetTopicAnalysisReply
-	(*GetSystemOverviewRequest)(nil),        // 14: api.ai.v1.GetSystemOverviewRequest
-	(*GetSystemOverviewReply)(nil),          // 15: api.ai.v1.GetSystemOverviewReply
-	(*ConversationAnalytics)(nil),           // 16: api.ai.v1.ConversationAnalytics
-	(*UserUsageStats)(nil),                  // 17: api.ai.v1.UserUsageStats
-	(*ModelPerformanceStats)(nil),           // 18: api.ai.v1.ModelPerformanceStats
-	(*TrendData)(nil),                       // 19: api.ai.v1.TrendData
-	(*TopicInsight)(nil),                    // 20: api.ai.v1.TopicInsight
-	(*TopicDistribution)(nil),               // 21: api.ai.v1.TopicDistribution
-	(*SystemOverviewStats)(nil),             // 22: api.ai.v1.SystemOverviewStats
-	(*TimeSeriesPoint)(nil),                 // 23: api.ai.v1.TimeSeriesPoint
-	(*UserEngagementMetrics)(nil),           // 24: api.ai.v1.UserEngagementMetrics
-	(*ModelUsageStats)(nil),                 // 25: api.ai.v1.ModelUsageStats
-	(*UsageTimePoint)(nil),                  // 26: api.ai.v1.UsageTimePoint
-	(*UserBehaviorProfile)(nil),             // 27: api.ai.v1.UserBehaviorProfile
-	(*PerformanceTimePoint)(nil),            // 28: api.ai.v1.PerformanceTimePoint
-	(*ModelCapabilityMetrics)(nil),          // 29: api.ai.v1.ModelCapabilityMetrics
-	(*SystemHealthMetrics)(nil),             // 30: api.ai.v1.SystemHealthMetrics
-	(*ResourceUsageStats)(nil),              // 31: api.ai.v1.ResourceUsageStats
-	(*ServiceStats)(nil),                    // 32: api.ai.v1.ServiceStats
-	nil,                                     // 33: api.ai.v1.GetConversationAnalyticsRequest.FiltersEntry
-	nil,                                     // 34: api.ai.v1.GetTopicAnalysisRequest.FiltersEntry
-	nil,                                     // 35: api.ai.v1.ConversationAnalytics.ModelUsageDistributionEntry
-	nil,                                     // 36: api.ai.v1.ConversationAnalytics.TimeDistributionEntry
-	nil,                                     // 37: api.ai.v1.UserUsageStats.ModelUsageEntry
-	nil,                                     // 38: api.ai.v1.UserUsageStats.CostBreakdownEntry
-	nil,                                     // 39: api.ai.v1.ModelPerformanceStats.ErrorDistributionEntry
-	nil,                                     // 40: api.ai.v1.TopicDistribution.TopicPercentagesEntry
-	nil,                                     // 41: api.ai.v1.SystemOverviewStats.ServiceStatsEntry
-	nil,                                     // 42: api.ai.v1.TimeSeriesPoint.DimensionsEntry
-	nil,                                     // 43: api.ai.v1.UserBehaviorProfile.FeatureUsageEntry
-	nil,                                     // 44: api.ai.v1.ModelCapabilityMetrics.TaskSpecificScoresEntry
-	(*timestamppb.Timestamp)(nil),           // 45: google.protobuf.Timestamp
+	(TopicAnalysisStatus)(0),                // 4: api.ai.v1.TopicAnalysisStatus
+	(*GetConversationAnalyticsRequest)(nil), // 5: api.ai.v1.GetConversationAnalyticsRequest
+	(*GetConversationAnalyticsReply)(nil),   // 6: api.ai.v1.GetConversationAnalyticsReply
+	(*GetUserUsageStatsRequest)(nil),        // 7: api.ai.v1.GetUserUsageStatsRequest
+	(*GetUserUsageStatsReply)(nil),          // 8: api.ai.v1.GetUserUsageStatsReply
+	(*GetModelPerformanceStatsRequest)(nil), // 9: api.ai.v1.GetModelPerformanceStatsRequest
+	(*GetModelPerformanceStatsReply)(nil),   // 10: api.ai.v1.GetModelPerformanceStatsReply
+	(*GetConversationTrendsRequest)(nil),    // 11: api.ai.v1.GetConversationTrendsRequest
+	(*GetConversationTrendsReply)(nil),      // 12: api.ai.v1.GetConversationTrendsReply
+	(*GetTopicAnalysisRequest)(nil),         // 13: api.ai.v1.GetTopicAnalysisRequest
+	(*GetTopicAnalysisReply)(nil),           // 14: api.ai.v1.GetTopicAnalysisReply
+	(*GetSystemOverviewRequest)(nil),        // 15: api.ai.v1.GetSystemOverviewRequest
+	(*GetSystemOverviewReply)(nil),          // 16: api.ai.v1.GetSystemOverviewReply
+	(*ConversationAnalytics)(nil),           // 17: api.ai.v1.ConversationAnalytics
+	(*UserUsageStats)(nil),                  // 18: api.ai.v1.UserUsageStats
+	(*ModelPerformanceStats)(nil),           // 19: api.ai.v1.ModelPerformanceStats
+	(*TrendData)(nil),                       // 20: api.ai.v1.TrendData
+	(*TopicInsight)(nil),                    // 21: api.ai.v1.TopicInsight
+	(*TopicDistribution)(nil),               // 22: api.ai.v1.TopicDistribution
+	(*SystemOverviewStats)(nil),             // 23: api.ai.v1.SystemOverviewStats
+	(*TimeSeriesPoint)(nil),                 // 24: api.ai.v1.TimeSeriesPoint
+	(*UserEngagementMetrics)(nil),           // 25: api.ai.v1.UserEngagementMetrics
+	(*ModelUsageStats)(nil),                 // 26: api.ai.v1.ModelUsageStats
+	(*UsageTimePoint)(nil),                  // 27: api.ai.v1.UsageTimePoint
+	(*UserBehaviorProfile)(nil),             // 28: api.ai.v1.UserBehaviorProfile
+	(*PerformanceTimePoint)(nil),            // 29: api.ai.v1.PerformanceTimePoint
+	(*ModelCapabilityMetrics)(nil),          // 30: api.ai.v1.ModelCapabilityMetrics
+	(*SystemHealthMetrics)(nil),             // 31: api.ai.v1.SystemHealthMetrics
+	(*ResourceUsageStats)(nil),              // 32: api.ai.v1.ResourceUsageStats
+	(*ServiceStats)(nil),                    // 33: api.ai.v1.ServiceStats
+	nil,                                     // 34: api.ai.v1.GetConversationAnalyticsRequest.FiltersEntry
+	nil,                                     // 35: api.ai.v1.GetTopicAnalysisRequest.FiltersEntry
+	nil,                                     // 36: api.ai.v1.ConversationAnalytics.ModelUsageDistributionEntry
+	nil,                                     // 37: api.ai.v1.ConversationAnalytics.TimeDistributionEntry
+	nil,                                     // 38: api.ai.v1.UserUsageStats.ModelUsageEntry
+	nil,                                     // 39: api.ai.v1.UserUsageStats.CostBreakdownEntry
+	nil,                                     // 40: api.ai.v1.ModelPerformanceStats.ErrorDistributionEntry
+	nil,                                     // 41: api.ai.v1.TopicDistribution.TopicPercentagesEntry
+	nil,                                     // 42: api.ai.v1.SystemOverviewStats.ServiceStatsEntry
+	nil,                                     // 43: api.ai.v1.TimeSeriesPoint.DimensionsEntry
+	nil,                                     // 44: api.ai.v1.UserBehaviorProfile.FeatureUsageEntry
+	nil,                                     // 45: api.ai.v1.ModelCapabilityMetrics.TaskSpecificScoresEntry
+	(*timestamppb.Timestamp)(nil),           // 46: google.protobuf.Timestamp
 }
 var file_api_ai_v1_ai_proto_depIdxs = []int32{
-	45, // 0: api.ai.v1.GetConversationAnalyticsRequest.start_time:type_name -> google.protobuf.Timestamp
-	45, // 1: api.ai.v1.GetConversationAnalyticsRequest.end_time:type_name -> google.protobuf.Timestamp
+	46, // 0: api.ai.v1.GetConversationAnalyticsRequest.start_time:type_name -> google.protobuf.Timestamp
+	46, // 1: api.ai.v1.GetConversationAnalyticsRequest.end_time:type_name -> google.protobuf.Timestamp
 	0,  // 2: api.ai.v1.GetConversationAnalyticsRequest.granularity:type_name -> api.ai.v1.AnalyticsGranularity
 	1,  // 3: api.ai.v1.GetConversationAnalyticsRequest.metrics:type_name -> api.ai.v1.AnalyticsMetric
-	33, // 4: api.ai.v1.GetConversationAnalyticsRequest.filters:type_name -> api.ai.v1.GetConversationAnalyticsRequest.FiltersEntry
-	16, // 5: api.ai.v1.GetConversationAnalyticsReply.analytics:type_name -> api.ai.v1.ConversationAnalytics
-	45, // 6: api.ai.v1.GetUserUsageStatsRequest.start_time:type_name -> google.protobuf.Timestamp
-	45, // 7: api.ai.v1.GetUserUsageStatsRequest.end_time:type_name -> google.protobuf.Timestamp
-	17, // 8: api.ai.v1.GetUserUsageStatsReply.usage_stats:type_name -> api.ai.v1.UserUsageStats
-	45, // 9: api.ai.v1.GetModelPerformanceStatsRequest.start_time:type_name -> google.protobuf.Timestamp
-	45, // 10: api.ai.v1.GetModelPerformanceStatsRequest.end_time:type_name -> google.protobuf.Timestamp
-	18, // 11: api.ai.v1.GetModelPerformanceStatsReply.model_stats:type_name -> api.ai.v1.ModelPerformanceStats
-	45, // 12: api.ai.v1.GetConversationTrendsRequest.start_time:type_name -> google.protobuf.Timestamp
-	45, // 13: api.ai.v1.GetConversationTrendsRequest.end_time:type_name -> google.protobuf.Timestamp
+	34, // 4: api.ai.v1.GetConversationAnalyticsRequest.filters:type_name -> api.ai.v1.GetConversationAnalyticsRequest.FiltersEntry
+	17, // 5: api.ai.v1.GetConversationAnalyticsReply.analytics:type_name -> api.ai.v1.ConversationAnalytics
+	46, // 6: api.ai.v1.GetUserUsageStatsRequest.start_time:type_name -> google.protobuf.Timestamp
+	46, // 7: api.ai.v1.GetUserUsageStatsRequest.end_time:type_name -> google.protobuf.Timestamp
+	18, // 8: api.ai.v1.GetUserUsageStatsReply.usage_stats:type_name -> api.ai.v1.UserUsageStats
+	46, // 9: api.ai.v1.GetModelPerformanceStatsRequest.start_time:type_name -> google.protobuf.Timestamp
+	46, // 10: api.ai.v1.GetModelPerformanceStatsRequest.end_time:type_name -> google.protobuf.Timestamp
+	19, // 11: api.ai.v1.GetModelPerformanceStatsReply.model_stats:type_name -> api.ai.v1.ModelPerformanceStats
+	46, // 12: api.ai.v1.GetConversationTrendsRequest.start_time:type_name -> google.protobuf.Timestamp
+	46, // 13: api.ai.v1.GetConversationTrendsRequest.end_time:type_name -> google.protobuf.Timestamp
 	0,  // 14: api.ai.v1.GetConversationTrendsRequest.granularity:type_name -> api.ai.v1.AnalyticsGranularity
 	2,  // 15: api.ai.v1.GetConversationTrendsRequest.trend_metrics:type_name -> api.ai.v1.TrendMetric
-	19, // 16: api.ai.v1.GetConversationTrendsReply.trends:type_name -> api.ai.v1.TrendData
-	45, // 17: api.ai.v1.GetTopicAnalysisRequest.start_time:type_name -> google.protobuf.Timestamp
-	45, // 18: api.ai.v1.GetTopicAnalysisRequest.end_time:type_name -> google.protobuf.Timestamp
+	20, // 16: api.ai.v1.GetConversationTrendsReply.trends:type_name -> api.ai.v1.TrendData
+	46, // 17: api.ai.v1.GetTopicAnalysisRequest.start_time:type_name -> google.protobuf.Timestamp
+	46, // 18: api.ai.v1.GetTopicAnalysisRequest.end_time:type_name -> google.protobuf.Timestamp
 	3,  // 19: api.ai.v1.GetTopicAnalysisRequest.method:type_name -> api.ai.v1.TopicAnalysisMethod
-	34, // 20: api.ai.v1.GetTopicAnalysisRequest.filters:type_name -> api.ai.v1.GetTopicAnalysisRequest.FiltersEntry
-	20, // 21: api.ai.v1.GetTopicAnalysisReply.topics:type_name -> api.ai.v1.TopicInsight
-	21, // 22: api.ai.v1.GetTopicAnalysisReply.distribution:type_name -> api.ai.v1.TopicDistribution
-	45, // 23: api.ai.v1.GetSystemOverviewRequest.start_time:type_name -> google.protobuf.Timestamp
-	45, // 24: api.ai.v1.GetSystemOverviewRequest.end_time:type_name -> google.protobuf.Timestamp
-	22, // 25: api.ai.v1.GetSystemOverviewReply.overview:type_name -> api.ai.v1.SystemOverviewStats
-	35, // 26: api.ai.v1.ConversationAnalytics.model_usage_distribution:type_name -> api.ai.v1.ConversationAnalytics.ModelUsageDistributionEntry
-	36, // 27: api.ai.v1.ConversationAnalytics.time_distribution:type_name -> api.ai.v1.ConversationAnalytics.TimeDistributionEntry
-	23, // 28: api.ai.v1.ConversationAnalytics.time_series_data:type_name -> api.ai.v1.TimeSeriesPoint
-	24, // 29: api.ai.v1.ConversationAnalytics.engagement:type_name -> api.ai.v1.UserEngagementMetrics
-	37, // 30: api.ai.v1.UserUsageStats.model_usage:type_name -> api.ai.v1.UserUsageStats.ModelUsageEntry
-	38, // 31: api.ai.v1.UserUsageStats.cost_breakdown:type_name -> api.ai.v1.UserUsageStats.CostBreakdownEntry
-	26, // 32: api.ai.v1.UserUsageStats.usage_timeline:type_name -> api.ai.v1.UsageTimePoint
-	27, // 33: api.ai.v1.UserUsageStats.behavior_profile:type_name -> api.ai.v1.UserBehaviorProfile
-	39, // 34: api.ai.v1.ModelPerformanceStats.error_distribution:type_name -> api.ai.v1.ModelPerformanceStats.ErrorDistributionEntry
-	28, // 35: api.ai.v1.ModelPerformanceStats.performance_timeline:type_name -> api.ai.v1.PerformanceTimePoint
-	29, // 36: api.ai.v1.ModelPerformanceStats.capabilities:type_name -> api.ai.v1.ModelCapabilityMetrics
-	2,  // 37: api.ai.v1.TrendData.metric:type_name -> api.ai.v1.TrendMetric
-	23, // 38: api.ai.v1.TrendData.data_points:type_name -> api.ai.v1.TimeSeriesPoint
-	45, // 39: api.ai.v1.TopicInsight.first_seen:type_name -> google.protobuf.Timestamp
-	45, // 40: api.ai.v1.TopicInsight.last_seen:type_name -> google.protobuf.Timestamp
-	40, // 41: api.ai.v1.TopicDistribution.topic_percentages:type_name -> api.ai.v1.TopicDistribution.TopicPercentagesEntry
-	30, // 42: api.ai.v1.SystemOverviewStats.health:type_name -> api.ai.v1.SystemHealthMetrics
-	31, // 43: api.ai.v1.SystemOverviewStats.resource_usage:type_name -> api.ai.v1.ResourceUsageStats
-	41, // 44: api.ai.v1.SystemOverviewStats.service_stats:type_name -> api.ai.v1.SystemOverviewStats.ServiceStatsEntry
-	45, // 45: api.ai.v1.TimeSeriesPoint.timestamp:type_name -> google.protobuf.Timestamp
-	42, // 46: api.ai.v1.TimeSeriesPoint.dimensions:type_name -> api.ai.v1.TimeSeriesPoint.DimensionsEntry
-	45, // 47: api.ai.v1.UsageTimePoint.timestamp:type_name -> google.protobuf.Timestamp
-	43, // 48: api.ai.v1.UserBehaviorProfile.feature_usage:type_name -> api.ai.v1.UserBehaviorProfile.FeatureUsageEntry
-	45, // 49: api.ai.v1.PerformanceTimePoint.timestamp:type_name -> google.protobuf.Timestamp
-	44, // 50: api.ai.v1.ModelCapabilityMetrics.task_specific_scores:type_name -> api.ai.v1.ModelCapabilityMetrics.TaskSpecificScoresEntry
-	25, // 51: api.ai.v1.UserUsageStats.ModelUsageEntry.value:type_name -> api.ai.v1.ModelUsageStats
-	32, // 52: api.ai.v1.SystemOverviewStats.ServiceStatsEntry.value:type_name -> api.ai.v1.ServiceStats
-	4,  // 53: api.ai.v1.Ai.GetConversationAnalytics:input_type -> api.ai.v1.GetConversationAnalyticsRequest
-	6,  // 54: api.ai.v1.Ai.GetUserUsageStats:input_type -> api.ai.v1.GetUserUsageStatsRequest
-	8,  // 55: api.ai.v1.Ai.GetModelPerformanceStats:input_type -> api.ai.v1.GetModelPerformanceStatsRequest
-	10, // 56: api.ai.v1.Ai.GetConversationTrends:input_type -> api.ai.v1.GetConversationTrendsRequest
-	12, // 57: api.ai.v1.Ai.GetTopicAnalysis:input_type -> api.ai.v1.GetTopicAnalysisRequest
-	14, // 58: api.ai.v1.Ai.GetSystemOverview:input_type -> api.ai.v1.GetSystemOverviewRequest
-	5,  // 59: api.ai.v1.Ai.GetConversationAnalytics:output_type -> api.ai.v1.GetConversationAnalyticsReply
-	7,  // 60: api.ai.v1.Ai.GetUserUsageStats:output_type -> api.ai.v1.GetUserUsageStatsReply
-	9,  // 61: api.ai.v1.Ai.GetModelPerformanceStats:output_type -> api.ai.v1.GetModelPerformanceStatsReply
-	11, // 62: api.ai.v1.Ai.GetConversationTrends:output_type -> api.ai.v1.GetConversationTrendsReply
-	13, // 63: api.ai.v1.Ai.GetTopicAnalysis:output_type -> api.ai.v1.GetTopicAnalysisReply
-	15, // 64: api.ai.v1.Ai.GetSystemOverview:output_type -> api.ai.v1.GetSystemOverviewReply
-	59, // [59:65] is the sub-list for method output_type
-	53, // [53:59] is the sub-list for method input_type
-	53, // [53:53] is the sub-list for extension type_name
-	53, // [53:53] is the sub-list for extension extendee
-	0,  // [0:53] is the sub-list for field type_name
+	35, // 20: api.ai.v1.GetTopicAnalysisRequest.filters:type_name -> api.ai.v1.GetTopicAnalysisRequest.FiltersEntry
+	0,  // 21: api.ai.v1.GetTopicAnalysisRequest.trend_granularity:type_name -> api.ai.v1.AnalyticsGranularity
+	21, // 22: api.ai.v1.GetTopicAnalysisReply.topics:type_name -> api.ai.v1.TopicInsight
+	22, // 23: api.ai.v1.GetTopicAnalysisReply.distribution:type_name -> api.ai.v1.TopicDistribution
+	4,  // 24: api.ai.v1.GetTopicAnalysisReply.status:type_name -> api.ai.v1.TopicAnalysisStatus
+	46, // 25: api.ai.v1.GetTopicAnalysisReply.generated_at:type_name -> google.protobuf.Timestamp
+	46, // 26: api.ai.v1.GetSystemOverviewRequest.start_time:type_name -> google.protobuf.Timestamp
+	46, // 27: api.ai.v1.GetSystemOverviewRequest.end_time:type_name -> google.protobuf.Timestamp
+	23, // 28: api.ai.v1.GetSystemOverviewReply.overview:type_name -> api.ai.v1.SystemOverviewStats
+	36, // 29: api.ai.v1.ConversationAnalytics.model_usage_distribution:type_name -> api.ai.v1.ConversationAnalytics.ModelUsageDistributionEntry
+	37, // 30: api.ai.v1.ConversationAnalytics.time_distribution:type_name -> api.ai.v1.ConversationAnalytics.TimeDistributionEntry
+	24, // 31: api.ai.v1.ConversationAnalytics.time_series_data:type_name -> api.ai.v1.TimeSeriesPoint
+	25, // 32: api.ai.v1.ConversationAnalytics.engagement:type_name -> api.ai.v1.UserEngagementMetrics
+	38, // 33: api.ai.v1.UserUsageStats.model_usage:type_name -> api.ai.v1.UserUsageStats.ModelUsageEntry
+	39, // 34: api.ai.v1.UserUsageStats.cost_breakdown:type_name -> api.ai.v1.UserUsageStats.CostBreakdownEntry
+	27, // 35: api.ai.v1.UserUsageStats.usage_timeline:type_name -> api.ai.v1.UsageTimePoint
+	28, // 36: api.ai.v1.UserUsageStats.behavior_profile:type_name -> api.ai.v1.UserBehaviorProfile
+	40, // 37: api.ai.v1.ModelPerformanceStats.error_distribution:type_name -> api.ai.v1.ModelPerformanceStats.ErrorDistributionEntry
+	29, // 38: api.ai.v1.ModelPerformanceStats.performance_timeline:type_name -> api.ai.v1.PerformanceTimePoint
+	30, // 39: api.ai.v1.ModelPerformanceStats.capabilities:type_name -> api.ai.v1.ModelCapabilityMetrics
+	2,  // 40: api.ai.v1.TrendData.metric:type_name -> api.ai.v1.TrendMetric
+	24, // 41: api.ai.v1.TrendData.data_points:type_name -> api.ai.v1.TimeSeriesPoint
+	46, // 42: api.ai.v1.TopicInsight.first_seen:type_name -> google.protobuf.Timestamp
+	46, // 43: api.ai.v1.TopicInsight.last_seen:type_name -> google.protobuf.Timestamp
+	24, // 44: api.ai.v1.TopicInsight.trend:type_name -> api.ai.v1.TimeSeriesPoint
+	41, // 45: api.ai.v1.TopicDistribution.topic_percentages:type_name -> api.ai.v1.TopicDistribution.TopicPercentagesEntry
+	31, // 46: api.ai.v1.SystemOverviewStats.health:type_name -> api.ai.v1.SystemHealthMetrics
+	32, // 47: api.ai.v1.SystemOverviewStats.resource_usage:type_name -> api.ai.v1.ResourceUsageStats
+	42, // 48: api.ai.v1.SystemOverviewStats.service_stats:type_name -> api.ai.v1.SystemOverviewStats.ServiceStatsEntry
+	46, // 49: api.ai.v1.TimeSeriesPoint.timestamp:type_name -> google.protobuf.Timestamp
+	43, // 50: api.ai.v1.TimeSeriesPoint.dimensions:type_name -> api.ai.v1.TimeSeriesPoint.DimensionsEntry
+	46, // 51: api.ai.v1.UsageTimePoint.timestamp:type_name -> google.protobuf.Timestamp
+	44, // 52: api.ai.v1.UserBehaviorProfile.feature_usage:type_name -> api.ai.v1.UserBehaviorProfile.FeatureUsageEntry
+	46, // 53: api.ai.v1.PerformanceTimePoint.timestamp:type_name -> google.protobuf.Timestamp
+	45, // 54: api.ai.v1.ModelCapabilityMetrics.task_specific_scores:type_name -> api.ai.v1.ModelCapabilityMetrics.TaskSpecificScoresEntry
+	26, // 55: api.ai.v1.UserUsageStats.ModelUsageEntry.value:type_name -> api.ai.v1.ModelUsageStats
+	33, // 56: api.ai.v1.SystemOverviewStats.ServiceStatsEntry.value:type_name -> api.ai.v1.ServiceStats
+	5,  // 57: api.ai.v1.Ai.GetConversationAnalytics:input_type -> api.ai.v1.GetConversationAnalyticsRequest
+	7,  // 58: api.ai.v1.Ai.GetUserUsageStats:input_type -> api.ai.v1.GetUserUsageStatsRequest
+	9,  // 59: api.ai.v1.Ai.GetModelPerformanceStats:input_type -> api.ai.v1.GetModelPerformanceStatsRequest
+	11, // 60: api.ai.v1.Ai.GetConversationTrends:input_type -> api.ai.v1.GetConversationTrendsRequest
+	13, // 61: api.ai.v1.Ai.GetTopicAnalysis:input_type -> api.ai.v1.GetTopicAnalysisRequest
+	15, // 62: api.ai.v1.Ai.GetSystemOverview:input_type -> api.ai.v1.GetSystemOverviewRequest
+	6,  // 63: api.ai.v1.Ai.GetConversationAnalytics:output_type -> api.ai.v1.GetConversationAnalyticsReply
+	8,  // 64: api.ai.v1.Ai.GetUserUsageStats:output_type -> api.ai.v1.GetUserUsageStatsReply
+	10, // 65: api.ai.v1.Ai.GetModelPerformanceStats:output_type -> api.ai.v1.GetModelPerformanceStatsReply
+	12, // 66: api.ai.v1.Ai.GetConversationTrends:output_type -> api.ai.v1.GetConversationTrendsReply
+	14, // 67: api.ai.v1.Ai.GetTopicAnalysis:output_type -> api.ai.v1.GetTopicAnalysisReply
+	16, // 68: api.ai.v1.Ai.GetSystemOverview:output_type -> api.ai.v1.GetSystemOverviewReply
+	63, // [63:69] is the sub-list for method output_type
+	57, // [57:63] is the sub-list for method input_type
+	57, // [57:57] is the sub-list for extension type_name
+	57, // [57:57] is the sub-list for extension extendee
+	0,  // [0:57] is the sub-list for field type_name
 }
 
 func init() { file_api_ai_v1_ai_proto_init() }
@@ -2854,7 +2977,7 @@ func file_api_ai_v1_ai_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_ai_v1_ai_proto_rawDesc), len(file_api_ai_v1_ai_proto_rawDesc)),
-			NumEnums:      4,
+			NumEnums:      5,
 			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   1,
@@ -40,7 +40,7 @@ type AiClient interface {
 	// 分析用户的AI使用情况，包括token消耗、成本统计、时间分布等
 	GetUserUsageStats(ctx context.Context, in *GetUserUsageStatsRequest, opts ...grpc.CallOption) (*GetUserUsageStatsReply, error)
 	// GetModelPerformanceStats 获取模型性能统计
-	// 分析不同模型的使用情况和性能指标
+	// 分析不同模型的使用情况和性能指标，仅管理员可调用
 	GetModelPerformanceStats(ctx context.Context, in *GetModelPerformanceStatsRequest, opts ...grpc.CallOption) (*GetModelPerformanceStatsReply, error)
 	// GetConversationTrends 获取对话趋势分析
 	// 提供时间序列的对话趋势分析数据
@@ -49,7 +49,7 @@ type AiClient interface {
 	// 分析对话中的主要话题和内容分布
 	GetTopicAnalysis(ctx context.Context, in *GetTopicAnalysisRequest, opts ...grpc.CallOption) (*GetTopicAnalysisReply, error)
 	// GetSystemOverview 获取系统总览统计
-	// 提供整个AI系统的总体使用情况和健康状况
+	// 提供整个AI系统的总体使用情况和健康状况，仅管理员可调用
 	GetSystemOverview(ctx context.Context, in *GetSystemOverviewRequest, opts ...grpc.CallOption) (*GetSystemOverviewReply, error)
 }
 
@@ -134,7 +134,7 @@ type AiServer interface {
 	// 分析用户的AI使用情况，包括token消耗、成本统计、时间分布等
 	GetUserUsageStats(context.Context, *GetUserUsageStatsRequest) (*GetUserUsageStatsReply, error)
 	// GetModelPerformanceStats 获取模型性能统计
-	// 分析不同模型的使用情况和性能指标
+	// 分析不同模型的使用情况和性能指标，仅管理员可调用
 	GetModelPerformanceStats(context.Context, *GetModelPerformanceStatsRequest) (*GetModelPerformanceStatsReply, error)
 	// GetConversationTrends 获取对话趋势分析
 	// 提供时间序列的对话趋势分析数据
@@ -143,7 +143,7 @@ type AiServer interface {
 	// 分析对话中的主要话题和内容分布
 	GetTopicAnalysis(context.Context, *GetTopicAnalysisRequest) (*GetTopicAnalysisReply, error)
 	// GetSystemOverview 获取系统总览统计
-	// 提供整个AI系统的总体使用情况和健康状况
+	// 提供整个AI系统的总体使用情况和健康状况，仅管理员可调用
 	GetSystemOverview(context.Context, *GetSystemOverviewRequest) (*GetSystemOverviewReply, error)
 	mustEmbedUnimplementedAiServer()
 }
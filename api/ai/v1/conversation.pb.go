@@ -385,13 +385,14 @@ type ConversationInfo struct {
 	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                                                    // 更新时间
 	LastActiveAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_active_at,json=lastActiveAt,proto3" json:"last_active_at,omitempty"`                                        // 最后活跃时间
 	// 新增字段
-	Memory           *ConversationMemory  `protobuf:"bytes,11,opt,name=memory,proto3" json:"memory,omitempty"`                                               // 对话记忆
-	Context          *ConversationContext `protobuf:"bytes,12,opt,name=context,proto3" json:"context,omitempty"`                                             // 对话上下文
-	Stats            *ConversationStats   `protobuf:"bytes,13,opt,name=stats,proto3" json:"stats,omitempty"`                                                 // 统计信息
-	Description      string               `protobuf:"bytes,14,opt,name=description,proto3" json:"description,omitempty"`                                     // 对话描述
-	Tags             []string             `protobuf:"bytes,15,rep,name=tags,proto3" json:"tags,omitempty"`                                                   // 标签
-	Priority         int32                `protobuf:"varint,16,opt,name=priority,proto3" json:"priority,omitempty"`                                          // 优先级
-	AutoArchiveAfter *durationpb.Duration `protobuf:"bytes,17,opt,name=auto_archive_after,json=autoArchiveAfter,proto3" json:"auto_archive_after,omitempty"` // 自动归档时间
+	Memory           *ConversationMemory  `protobuf:"bytes,11,opt,name=memory,proto3" json:"memory,omitempty"`                                                     // 对话记忆
+	Context          *ConversationContext `protobuf:"bytes,12,opt,name=context,proto3" json:"context,omitempty"`                                                   // 对话上下文
+	Stats            *ConversationStats   `protobuf:"bytes,13,opt,name=stats,proto3" json:"stats,omitempty"`                                                       // 统计信息
+	Description      string               `protobuf:"bytes,14,opt,name=description,proto3" json:"description,omitempty"`                                           // 对话描述
+	Tags             []string             `protobuf:"bytes,15,rep,name=tags,proto3" json:"tags,omitempty"`                                                         // 标签
+	Priority         int32                `protobuf:"varint,16,opt,name=priority,proto3" json:"priority,omitempty"`                                                // 优先级
+	AutoArchiveAfter *durationpb.Duration `protobuf:"bytes,17,opt,name=auto_archive_after,json=autoArchiveAfter,proto3" json:"auto_archive_after,omitempty"`       // 自动归档时间
+	AccessRole       ShareRole            `protobuf:"varint,18,opt,name=access_role,json=accessRole,proto3,enum=api.ai.v1.ShareRole" json:"access_role,omitempty"` // 调用者对该对话的权限
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -545,6 +546,13 @@ func (x *ConversationInfo) GetAutoArchiveAfter() *durationpb.Duration {
 	return nil
 }
 
+func (x *ConversationInfo) GetAccessRole() ShareRole {
+	if x != nil {
+		return x.AccessRole
+	}
+	return ShareRole_SHARE_ROLE_UNSPECIFIED
+}
+
 // 对话记忆管理
 type ConversationMemory struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
@@ -1765,7 +1773,7 @@ func (*DeleteConversationReply) Descriptor() ([]byte, []int) {
 // 列出对话
 type ListConversationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                     // 用户ID
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                     // 用户ID(可选，须为调用方本人)
 	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                                       // 页码
 	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`               // 页面大小
 	Keyword       string                 `protobuf:"bytes,4,opt,name=keyword,proto3" json:"keyword,omitempty"`                                  // 搜索关键词(可选)
@@ -1773,6 +1781,7 @@ type ListConversationsRequest struct {
 	Tags          []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`                                        // 标签过滤(可选)
 	SortBy        string                 `protobuf:"bytes,7,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`                      // 排序字段
 	SortDesc      bool                   `protobuf:"varint,8,opt,name=sort_desc,json=sortDesc,proto3" json:"sort_desc,omitempty"`               // 是否降序
+	OwnedOnly     bool                   `protobuf:"varint,9,opt,name=owned_only,json=ownedOnly,proto3" json:"owned_only,omitempty"`            // 仅返回自己创建的对话，默认包含共享给我的
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1863,6 +1872,13 @@ func (x *ListConversationsRequest) GetSortDesc() bool {
 	return false
 }
 
+func (x *ListConversationsRequest) GetOwnedOnly() bool {
+	if x != nil {
+		return x.OwnedOnly
+	}
+	return false
+}
+
 type ListConversationsReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Conversations []*ConversationInfo    `protobuf:"bytes,1,rep,name=conversations,proto3" json:"conversations,omitempty"`        // 对话列表
@@ -3631,11 +3647,686 @@ func (x *ImportConversationReply) GetWarnings() []string {
 	return nil
 }
 
+// 对话公开快照
+type ConversationSnapshot struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Token          string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                                          // 访问令牌
+	ConversationId int64                  `protobuf:"varint,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // 源对话ID
+	OwnerId        int64                  `protobuf:"varint,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`                      // 发布者ID
+	Title          string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`                                          // 快照标题
+	ModelName      string                 `protobuf:"bytes,5,opt,name=model_name,json=modelName,proto3" json:"model_name,omitempty"`                 // 使用的模型名称
+	Messages       []*Message             `protobuf:"bytes,6,rep,name=messages,proto3" json:"messages,omitempty"`                                    // 冻结的消息列表
+	ViewCount      int64                  `protobuf:"varint,7,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`                // 访问次数
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                 // 过期时间(可选)
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                 // 发布时间
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConversationSnapshot) Reset() {
+	*x = ConversationSnapshot{}
+	mi := &file_api_ai_v1_conversation_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationSnapshot) ProtoMessage() {}
+
+func (x *ConversationSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_conversation_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationSnapshot.ProtoReflect.Descriptor instead.
+func (*ConversationSnapshot) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_conversation_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ConversationSnapshot) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ConversationSnapshot) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *ConversationSnapshot) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *ConversationSnapshot) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ConversationSnapshot) GetModelName() string {
+	if x != nil {
+		return x.ModelName
+	}
+	return ""
+}
+
+func (x *ConversationSnapshot) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *ConversationSnapshot) GetViewCount() int64 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+func (x *ConversationSnapshot) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ConversationSnapshot) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// 共享对话
+type ShareConversationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // 对话ID
+	GranteeUserId  int64                  `protobuf:"varint,3,opt,name=grantee_user_id,json=granteeUserId,proto3" json:"grantee_user_id,omitempty"`  // 被授权用户ID
+	Role           ShareRole              `protobuf:"varint,4,opt,name=role,proto3,enum=api.ai.v1.ShareRole" json:"role,omitempty"`                  // 授权角色
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                 // 过期时间(可选)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ShareConversationRequest) Reset() {
+	*x = ShareConversationRequest{}
+	mi := &file_api_ai_v1_conversation_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareConversationRequest) ProtoMessage() {}
+
+func (x *ShareConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_conversation_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareConversationRequest.ProtoReflect.Descriptor instead.
+func (*ShareConversationRequest) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_conversation_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ShareConversationRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *ShareConversationRequest) GetGranteeUserId() int64 {
+	if x != nil {
+		return x.GranteeUserId
+	}
+	return 0
+}
+
+func (x *ShareConversationRequest) GetRole() ShareRole {
+	if x != nil {
+		return x.Role
+	}
+	return ShareRole_SHARE_ROLE_UNSPECIFIED
+}
+
+func (x *ShareConversationRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type ShareConversationReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grant         *ShareGrant            `protobuf:"bytes,1,opt,name=grant,proto3" json:"grant,omitempty"` // 授权记录
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareConversationReply) Reset() {
+	*x = ShareConversationReply{}
+	mi := &file_api_ai_v1_conversation_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareConversationReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareConversationReply) ProtoMessage() {}
+
+func (x *ShareConversationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_conversation_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareConversationReply.ProtoReflect.Descriptor instead.
+func (*ShareConversationReply) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_conversation_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ShareConversationReply) GetGrant() *ShareGrant {
+	if x != nil {
+		return x.Grant
+	}
+	return nil
+}
+
+// 撤销对话共享
+type RevokeConversationShareRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // 对话ID
+	GranteeUserId  int64                  `protobuf:"varint,3,opt,name=grantee_user_id,json=granteeUserId,proto3" json:"grantee_user_id,omitempty"`  // 被撤销的用户ID
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RevokeConversationShareRequest) Reset() {
+	*x = RevokeConversationShareRequest{}
+	mi := &file_api_ai_v1_conversation_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeConversationShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeConversationShareRequest) ProtoMessage() {}
+
+func (x *RevokeConversationShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_conversation_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeConversationShareRequest.ProtoReflect.Descriptor instead.
+func (*RevokeConversationShareRequest) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_conversation_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RevokeConversationShareRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *RevokeConversationShareRequest) GetGranteeUserId() int64 {
+	if x != nil {
+		return x.GranteeUserId
+	}
+	return 0
+}
+
+type RevokeConversationShareReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeConversationShareReply) Reset() {
+	*x = RevokeConversationShareReply{}
+	mi := &file_api_ai_v1_conversation_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeConversationShareReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeConversationShareReply) ProtoMessage() {}
+
+func (x *RevokeConversationShareReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_conversation_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeConversationShareReply.ProtoReflect.Descriptor instead.
+func (*RevokeConversationShareReply) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_conversation_proto_rawDescGZIP(), []int{53}
+}
+
+// 获取对话共享列表
+type ListConversationSharesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // 对话ID
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListConversationSharesRequest) Reset() {
+	*x = ListConversationSharesRequest{}
+	mi := &file_api_ai_v1_conversation_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConversationSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConversationSharesRequest) ProtoMessage() {}
+
+func (x *ListConversationSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_conversation_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConversationSharesRequest.ProtoReflect.Descriptor instead.
+func (*ListConversationSharesRequest) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_conversation_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListConversationSharesRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+type ListConversationSharesReply struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Grants        []*ShareGrant           `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants,omitempty"`       // 授权列表
+	Snapshots     []*ConversationSnapshot `protobuf:"bytes,2,rep,name=snapshots,proto3" json:"snapshots,omitempty"` // 已发布的快照(不含消息内容)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConversationSharesReply) Reset() {
+	*x = ListConversationSharesReply{}
+	mi := &file_api_ai_v1_conversation_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConversationSharesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConversationSharesReply) ProtoMessage() {}
+
+func (x *ListConversationSharesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_conversation_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConversationSharesReply.ProtoReflect.Descriptor instead.
+func (*ListConversationSharesReply) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_conversation_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListConversationSharesReply) GetGrants() []*ShareGrant {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
+func (x *ListConversationSharesReply) GetSnapshots() []*ConversationSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+// 发布对话公开快照
+type PublishConversationSnapshotRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // 对话ID
+	ExpiresIn      *durationpb.Duration   `protobuf:"bytes,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`                 // 有效期(可选，不填则长期有效)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PublishConversationSnapshotRequest) Reset() {
+	*x = PublishConversationSnapshotRequest{}
+	mi := &file_api_ai_v1_conversation_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishConversationSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishConversationSnapshotRequest) ProtoMessage() {}
+
+func (x *PublishConversationSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_conversation_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishConversationSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*PublishConversationSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_conversation_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PublishConversationSnapshotRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *PublishConversationSnapshotRequest) GetExpiresIn() *durationpb.Duration {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return nil
+}
+
+type PublishConversationSnapshotReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshot      *ConversationSnapshot  `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"` // 快照信息(不含消息内容)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishConversationSnapshotReply) Reset() {
+	*x = PublishConversationSnapshotReply{}
+	mi := &file_api_ai_v1_conversation_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishConversationSnapshotReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishConversationSnapshotReply) ProtoMessage() {}
+
+func (x *PublishConversationSnapshotReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_conversation_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishConversationSnapshotReply.ProtoReflect.Descriptor instead.
+func (*PublishConversationSnapshotReply) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_conversation_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *PublishConversationSnapshotReply) GetSnapshot() *ConversationSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+// 撤销对话公开快照
+type RevokeConversationSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // 访问令牌
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeConversationSnapshotRequest) Reset() {
+	*x = RevokeConversationSnapshotRequest{}
+	mi := &file_api_ai_v1_conversation_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeConversationSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeConversationSnapshotRequest) ProtoMessage() {}
+
+func (x *RevokeConversationSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_conversation_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeConversationSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*RevokeConversationSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_conversation_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RevokeConversationSnapshotRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type RevokeConversationSnapshotReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeConversationSnapshotReply) Reset() {
+	*x = RevokeConversationSnapshotReply{}
+	mi := &file_api_ai_v1_conversation_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeConversationSnapshotReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeConversationSnapshotReply) ProtoMessage() {}
+
+func (x *RevokeConversationSnapshotReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_conversation_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeConversationSnapshotReply.ProtoReflect.Descriptor instead.
+func (*RevokeConversationSnapshotReply) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_conversation_proto_rawDescGZIP(), []int{59}
+}
+
+// 获取对话公开快照
+type GetConversationSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // 访问令牌
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConversationSnapshotRequest) Reset() {
+	*x = GetConversationSnapshotRequest{}
+	mi := &file_api_ai_v1_conversation_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationSnapshotRequest) ProtoMessage() {}
+
+func (x *GetConversationSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_conversation_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*GetConversationSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_conversation_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetConversationSnapshotRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetConversationSnapshotReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshot      *ConversationSnapshot  `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"` // 快照内容
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConversationSnapshotReply) Reset() {
+	*x = GetConversationSnapshotReply{}
+	mi := &file_api_ai_v1_conversation_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationSnapshotReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationSnapshotReply) ProtoMessage() {}
+
+func (x *GetConversationSnapshotReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_conversation_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationSnapshotReply.ProtoReflect.Descriptor instead.
+func (*GetConversationSnapshotReply) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_conversation_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetConversationSnapshotReply) GetSnapshot() *ConversationSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
 var File_api_ai_v1_conversation_proto protoreflect.FileDescriptor
 
 const file_api_ai_v1_conversation_proto_rawDesc = "" +
 	"\n" +
-	"\x1capi/ai/v1/conversation.proto\x12\tapi.ai.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\"\xc0\x06\n" +
+	"\x1capi/ai/v1/conversation.proto\x12\tapi.ai.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x15api/ai/v1/share.proto\"\xf7\x06\n" +
 	"\x10ConversationInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x14\n" +
@@ -3657,7 +4348,9 @@ const file_api_ai_v1_conversation_proto_rawDesc = "" +
 	"\vdescription\x18\x0e \x01(\tR\vdescription\x12\x12\n" +
 	"\x04tags\x18\x0f \x03(\tR\x04tags\x12\x1a\n" +
 	"\bpriority\x18\x10 \x01(\x05R\bpriority\x12G\n" +
-	"\x12auto_archive_after\x18\x11 \x01(\v2\x19.google.protobuf.DurationR\x10autoArchiveAfter\x1a9\n" +
+	"\x12auto_archive_after\x18\x11 \x01(\v2\x19.google.protobuf.DurationR\x10autoArchiveAfter\x125\n" +
+	"\vaccess_role\x18\x12 \x01(\x0e2\x14.api.ai.v1.ShareRoleR\n" +
+	"accessRole\x1a9\n" +
 	"\vConfigEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xff\x02\n" +
@@ -3787,7 +4480,7 @@ const file_api_ai_v1_conversation_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\vhard_delete\x18\x02 \x01(\bR\n" +
 	"hardDelete\"\x19\n" +
-	"\x17DeleteConversationReply\"\xff\x01\n" +
+	"\x17DeleteConversationReply\"\x9e\x02\n" +
 	"\x18ListConversationsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
@@ -3796,7 +4489,9 @@ const file_api_ai_v1_conversation_proto_rawDesc = "" +
 	"\x06status\x18\x05 \x01(\x0e2\x1d.api.ai.v1.ConversationStatusR\x06status\x12\x12\n" +
 	"\x04tags\x18\x06 \x03(\tR\x04tags\x12\x17\n" +
 	"\asort_by\x18\a \x01(\tR\x06sortBy\x12\x1b\n" +
-	"\tsort_desc\x18\b \x01(\bR\bsortDesc\"\xa2\x01\n" +
+	"\tsort_desc\x18\b \x01(\bR\bsortDesc\x12\x1d\n" +
+	"\n" +
+	"owned_only\x18\t \x01(\bR\townedOnly\"\xa2\x01\n" +
 	"\x16ListConversationsReply\x12A\n" +
 	"\rconversations\x18\x01 \x03(\v2\x1b.api.ai.v1.ConversationInfoR\rconversations\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
@@ -3920,7 +4615,51 @@ const file_api_ai_v1_conversation_proto_rawDesc = "" +
 	"\rconversations\x18\x01 \x03(\v2\x1b.api.ai.v1.ConversationInfoR\rconversations\x12%\n" +
 	"\x0eimported_count\x18\x02 \x01(\x05R\rimportedCount\x12#\n" +
 	"\rskipped_count\x18\x03 \x01(\x05R\fskippedCount\x12\x1a\n" +
-	"\bwarnings\x18\x04 \x03(\tR\bwarnings*\xbc\x01\n" +
+	"\bwarnings\x18\x04 \x03(\tR\bwarnings\"\xea\x02\n" +
+	"\x14ConversationSnapshot\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\x03R\x0econversationId\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\x03R\aownerId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"model_name\x18\x05 \x01(\tR\tmodelName\x12.\n" +
+	"\bmessages\x18\x06 \x03(\v2\x12.api.ai.v1.MessageR\bmessages\x12\x1d\n" +
+	"\n" +
+	"view_count\x18\a \x01(\x03R\tviewCount\x129\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd6\x01\n" +
+	"\x18ShareConversationRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12&\n" +
+	"\x0fgrantee_user_id\x18\x03 \x01(\x03R\rgranteeUserId\x12(\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x14.api.ai.v1.ShareRoleR\x04role\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAtJ\x04\b\x02\x10\x03\"E\n" +
+	"\x16ShareConversationReply\x12+\n" +
+	"\x05grant\x18\x01 \x01(\v2\x15.api.ai.v1.ShareGrantR\x05grant\"w\n" +
+	"\x1eRevokeConversationShareRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12&\n" +
+	"\x0fgrantee_user_id\x18\x03 \x01(\x03R\rgranteeUserIdJ\x04\b\x02\x10\x03\"\x1e\n" +
+	"\x1cRevokeConversationShareReply\"N\n" +
+	"\x1dListConversationSharesRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationIdJ\x04\b\x02\x10\x03\"\x8b\x01\n" +
+	"\x1bListConversationSharesReply\x12-\n" +
+	"\x06grants\x18\x01 \x03(\v2\x15.api.ai.v1.ShareGrantR\x06grants\x12=\n" +
+	"\tsnapshots\x18\x02 \x03(\v2\x1f.api.ai.v1.ConversationSnapshotR\tsnapshots\"\x8d\x01\n" +
+	"\"PublishConversationSnapshotRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x128\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\texpiresInJ\x04\b\x02\x10\x03\"_\n" +
+	" PublishConversationSnapshotReply\x12;\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x1f.api.ai.v1.ConversationSnapshotR\bsnapshot\"?\n" +
+	"!RevokeConversationSnapshotRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05tokenJ\x04\b\x02\x10\x03\"!\n" +
+	"\x1fRevokeConversationSnapshotReply\"6\n" +
+	"\x1eGetConversationSnapshotRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"[\n" +
+	"\x1cGetConversationSnapshotReply\x12;\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x1f.api.ai.v1.ConversationSnapshotR\bsnapshot*\xbc\x01\n" +
 	"\x12ConversationStatus\x12#\n" +
 	"\x1fCONVERSATION_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
 	"\x1aCONVERSATION_STATUS_ACTIVE\x10\x01\x12 \n" +
@@ -3960,7 +4699,7 @@ const file_api_ai_v1_conversation_proto_rawDesc = "" +
 	"\x12EXPORT_FORMAT_JSON\x10\x01\x12\x1a\n" +
 	"\x16EXPORT_FORMAT_MARKDOWN\x10\x02\x12\x15\n" +
 	"\x11EXPORT_FORMAT_PDF\x10\x03\x12\x16\n" +
-	"\x12EXPORT_FORMAT_DOCX\x10\x042\xf9\x0f\n" +
+	"\x12EXPORT_FORMAT_DOCX\x10\x042\x93\x15\n" +
 	"\fConversation\x12^\n" +
 	"\x12CreateConversation\x12$.api.ai.v1.CreateConversationRequest\x1a\".api.ai.v1.CreateConversationReply\x12U\n" +
 	"\x0fGetConversation\x12!.api.ai.v1.GetConversationRequest\x1a\x1f.api.ai.v1.GetConversationReply\x12^\n" +
@@ -3982,7 +4721,13 @@ const file_api_ai_v1_conversation_proto_rawDesc = "" +
 	"\x15GetConversationMemory\x12'.api.ai.v1.GetConversationMemoryRequest\x1a%.api.ai.v1.GetConversationMemoryReply\x12d\n" +
 	"\x14GetConversationStats\x12&.api.ai.v1.GetConversationStatsRequest\x1a$.api.ai.v1.GetConversationStatsReply\x12^\n" +
 	"\x12ExportConversation\x12$.api.ai.v1.ExportConversationRequest\x1a\".api.ai.v1.ExportConversationReply\x12^\n" +
-	"\x12ImportConversation\x12$.api.ai.v1.ImportConversationRequest\x1a\".api.ai.v1.ImportConversationReplyBO\n" +
+	"\x12ImportConversation\x12$.api.ai.v1.ImportConversationRequest\x1a\".api.ai.v1.ImportConversationReply\x12[\n" +
+	"\x11ShareConversation\x12#.api.ai.v1.ShareConversationRequest\x1a!.api.ai.v1.ShareConversationReply\x12m\n" +
+	"\x17RevokeConversationShare\x12).api.ai.v1.RevokeConversationShareRequest\x1a'.api.ai.v1.RevokeConversationShareReply\x12j\n" +
+	"\x16ListConversationShares\x12(.api.ai.v1.ListConversationSharesRequest\x1a&.api.ai.v1.ListConversationSharesReply\x12y\n" +
+	"\x1bPublishConversationSnapshot\x12-.api.ai.v1.PublishConversationSnapshotRequest\x1a+.api.ai.v1.PublishConversationSnapshotReply\x12v\n" +
+	"\x1aRevokeConversationSnapshot\x12,.api.ai.v1.RevokeConversationSnapshotRequest\x1a*.api.ai.v1.RevokeConversationSnapshotReply\x12m\n" +
+	"\x17GetConversationSnapshot\x12).api.ai.v1.GetConversationSnapshotRequest\x1a'.api.ai.v1.GetConversationSnapshotReplyBO\n" +
 	"\x1ecom.oldwei.universal.api.ai.v1B\x13ConversationProtoV1P\x01Z\x16universal/api/ai/v1;v1b\x06proto3"
 
 var (
@@ -3998,180 +4743,219 @@ func file_api_ai_v1_conversation_proto_rawDescGZIP() []byte {
 }
 
 var file_api_ai_v1_conversation_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_api_ai_v1_conversation_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
+var file_api_ai_v1_conversation_proto_msgTypes = make([]protoimpl.MessageInfo, 72)
 var file_api_ai_v1_conversation_proto_goTypes = []any{
-	(ConversationStatus)(0),                  // 0: api.ai.v1.ConversationStatus
-	(MessageRole)(0),                         // 1: api.ai.v1.MessageRole
-	(MessageStatus)(0),                       // 2: api.ai.v1.MessageStatus
-	(AttachmentType)(0),                      // 3: api.ai.v1.AttachmentType
-	(ToolCallStatus)(0),                      // 4: api.ai.v1.ToolCallStatus
-	(ExportFormat)(0),                        // 5: api.ai.v1.ExportFormat
-	(*ConversationInfo)(nil),                 // 6: api.ai.v1.ConversationInfo
-	(*ConversationMemory)(nil),               // 7: api.ai.v1.ConversationMemory
-	(*ConversationContext)(nil),              // 8: api.ai.v1.ConversationContext
-	(*ConversationStats)(nil),                // 9: api.ai.v1.ConversationStats
-	(*Message)(nil),                          // 10: api.ai.v1.Message
-	(*MessageAttachment)(nil),                // 11: api.ai.v1.MessageAttachment
-	(*MessageMetrics)(nil),                   // 12: api.ai.v1.MessageMetrics
-	(*ToolCall)(nil),                         // 13: api.ai.v1.ToolCall
-	(*CreateConversationRequest)(nil),        // 14: api.ai.v1.CreateConversationRequest
-	(*CreateConversationReply)(nil),          // 15: api.ai.v1.CreateConversationReply
-	(*GetConversationRequest)(nil),           // 16: api.ai.v1.GetConversationRequest
-	(*GetConversationReply)(nil),             // 17: api.ai.v1.GetConversationReply
-	(*UpdateConversationRequest)(nil),        // 18: api.ai.v1.UpdateConversationRequest
-	(*UpdateConversationReply)(nil),          // 19: api.ai.v1.UpdateConversationReply
-	(*DeleteConversationRequest)(nil),        // 20: api.ai.v1.DeleteConversationRequest
-	(*DeleteConversationReply)(nil),          // 21: api.ai.v1.DeleteConversationReply
-	(*ListConversationsRequest)(nil),         // 22: api.ai.v1.ListConversationsRequest
-	(*ListConversationsReply)(nil),           // 23: api.ai.v1.ListConversationsReply
-	(*ArchiveConversationRequest)(nil),       // 24: api.ai.v1.ArchiveConversationRequest
-	(*ArchiveConversationReply)(nil),         // 25: api.ai.v1.ArchiveConversationReply
-	(*RestoreConversationRequest)(nil),       // 26: api.ai.v1.RestoreConversationRequest
-	(*RestoreConversationReply)(nil),         // 27: api.ai.v1.RestoreConversationReply
-	(*SendMessageRequest)(nil),               // 28: api.ai.v1.SendMessageRequest
-	(*SendMessageReply)(nil),                 // 29: api.ai.v1.SendMessageReply
-	(*SendMessageStreamReply)(nil),           // 30: api.ai.v1.SendMessageStreamReply
-	(*GetMessagesRequest)(nil),               // 31: api.ai.v1.GetMessagesRequest
-	(*GetMessagesReply)(nil),                 // 32: api.ai.v1.GetMessagesReply
-	(*DeleteMessageRequest)(nil),             // 33: api.ai.v1.DeleteMessageRequest
-	(*DeleteMessageReply)(nil),               // 34: api.ai.v1.DeleteMessageReply
-	(*RegenerateMessageRequest)(nil),         // 35: api.ai.v1.RegenerateMessageRequest
-	(*RegenerateMessageReply)(nil),           // 36: api.ai.v1.RegenerateMessageReply
-	(*GetConversationContextRequest)(nil),    // 37: api.ai.v1.GetConversationContextRequest
-	(*GetConversationContextReply)(nil),      // 38: api.ai.v1.GetConversationContextReply
-	(*UpdateConversationContextRequest)(nil), // 39: api.ai.v1.UpdateConversationContextRequest
-	(*UpdateConversationContextReply)(nil),   // 40: api.ai.v1.UpdateConversationContextReply
-	(*SummarizeConversationRequest)(nil),     // 41: api.ai.v1.SummarizeConversationRequest
-	(*SummarizeConversationReply)(nil),       // 42: api.ai.v1.SummarizeConversationReply
-	(*ClearConversationHistoryRequest)(nil),  // 43: api.ai.v1.ClearConversationHistoryRequest
-	(*ClearConversationHistoryReply)(nil),    // 44: api.ai.v1.ClearConversationHistoryReply
-	(*SetConversationMemoryRequest)(nil),     // 45: api.ai.v1.SetConversationMemoryRequest
-	(*SetConversationMemoryReply)(nil),       // 46: api.ai.v1.SetConversationMemoryReply
-	(*GetConversationMemoryRequest)(nil),     // 47: api.ai.v1.GetConversationMemoryRequest
-	(*GetConversationMemoryReply)(nil),       // 48: api.ai.v1.GetConversationMemoryReply
-	(*GetConversationStatsRequest)(nil),      // 49: api.ai.v1.GetConversationStatsRequest
-	(*GetConversationStatsReply)(nil),        // 50: api.ai.v1.GetConversationStatsReply
-	(*ExportConversationRequest)(nil),        // 51: api.ai.v1.ExportConversationRequest
-	(*ExportConversationReply)(nil),          // 52: api.ai.v1.ExportConversationReply
-	(*ImportConversationRequest)(nil),        // 53: api.ai.v1.ImportConversationRequest
-	(*ImportConversationReply)(nil),          // 54: api.ai.v1.ImportConversationReply
-	nil,                                      // 55: api.ai.v1.ConversationInfo.ConfigEntry
-	nil,                                      // 56: api.ai.v1.ConversationMemory.UserPreferencesEntry
-	nil,                                      // 57: api.ai.v1.Message.MetadataEntry
-	nil,                                      // 58: api.ai.v1.MessageAttachment.MetadataEntry
-	nil,                                      // 59: api.ai.v1.ToolCall.MetadataEntry
-	nil,                                      // 60: api.ai.v1.CreateConversationRequest.ConfigEntry
-	nil,                                      // 61: api.ai.v1.UpdateConversationRequest.ConfigEntry
-	nil,                                      // 62: api.ai.v1.SendMessageRequest.OptionsEntry
-	nil,                                      // 63: api.ai.v1.RegenerateMessageRequest.OptionsEntry
-	nil,                                      // 64: api.ai.v1.GetConversationStatsReply.CostBreakdownEntry
-	(*timestamppb.Timestamp)(nil),            // 65: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),              // 66: google.protobuf.Duration
+	(ConversationStatus)(0),                    // 0: api.ai.v1.ConversationStatus
+	(MessageRole)(0),                           // 1: api.ai.v1.MessageRole
+	(MessageStatus)(0),                         // 2: api.ai.v1.MessageStatus
+	(AttachmentType)(0),                        // 3: api.ai.v1.AttachmentType
+	(ToolCallStatus)(0),                        // 4: api.ai.v1.ToolCallStatus
+	(ExportFormat)(0),                          // 5: api.ai.v1.ExportFormat
+	(*ConversationInfo)(nil),                   // 6: api.ai.v1.ConversationInfo
+	(*ConversationMemory)(nil),                 // 7: api.ai.v1.ConversationMemory
+	(*ConversationContext)(nil),                // 8: api.ai.v1.ConversationContext
+	(*ConversationStats)(nil),                  // 9: api.ai.v1.ConversationStats
+	(*Message)(nil),                            // 10: api.ai.v1.Message
+	(*MessageAttachment)(nil),                  // 11: api.ai.v1.MessageAttachment
+	(*MessageMetrics)(nil),                     // 12: api.ai.v1.MessageMetrics
+	(*ToolCall)(nil),                           // 13: api.ai.v1.ToolCall
+	(*CreateConversationRequest)(nil),          // 14: api.ai.v1.CreateConversationRequest
+	(*CreateConversationReply)(nil),            // 15: api.ai.v1.CreateConversationReply
+	(*GetConversationRequest)(nil),             // 16: api.ai.v1.GetConversationRequest
+	(*GetConversationReply)(nil),               // 17: api.ai.v1.GetConversationReply
+	(*UpdateConversationRequest)(nil),          // 18: api.ai.v1.UpdateConversationRequest
+	(*UpdateConversationReply)(nil),            // 19: api.ai.v1.UpdateConversationReply
+	(*DeleteConversationRequest)(nil),          // 20: api.ai.v1.DeleteConversationRequest
+	(*DeleteConversationReply)(nil),            // 21: api.ai.v1.DeleteConversationReply
+	(*ListConversationsRequest)(nil),           // 22: api.ai.v1.ListConversationsRequest
+	(*ListConversationsReply)(nil),             // 23: api.ai.v1.ListConversationsReply
+	(*ArchiveConversationRequest)(nil),         // 24: api.ai.v1.ArchiveConversationRequest
+	(*ArchiveConversationReply)(nil),           // 25: api.ai.v1.ArchiveConversationReply
+	(*RestoreConversationRequest)(nil),         // 26: api.ai.v1.RestoreConversationRequest
+	(*RestoreConversationReply)(nil),           // 27: api.ai.v1.RestoreConversationReply
+	(*SendMessageRequest)(nil),                 // 28: api.ai.v1.SendMessageRequest
+	(*SendMessageReply)(nil),                   // 29: api.ai.v1.SendMessageReply
+	(*SendMessageStreamReply)(nil),             // 30: api.ai.v1.SendMessageStreamReply
+	(*GetMessagesRequest)(nil),                 // 31: api.ai.v1.GetMessagesRequest
+	(*GetMessagesReply)(nil),                   // 32: api.ai.v1.GetMessagesReply
+	(*DeleteMessageRequest)(nil),               // 33: api.ai.v1.DeleteMessageRequest
+	(*DeleteMessageReply)(nil),                 // 34: api.ai.v1.DeleteMessageReply
+	(*RegenerateMessageRequest)(nil),           // 35: api.ai.v1.RegenerateMessageRequest
+	(*RegenerateMessageReply)(nil),             // 36: api.ai.v1.RegenerateMessageReply
+	(*GetConversationContextRequest)(nil),      // 37: api.ai.v1.GetConversationContextRequest
+	(*GetConversationContextReply)(nil),        // 38: api.ai.v1.GetConversationContextReply
+	(*UpdateConversationContextRequest)(nil),   // 39: api.ai.v1.UpdateConversationContextRequest
+	(*UpdateConversationContextReply)(nil),     // 40: api.ai.v1.UpdateConversationContextReply
+	(*SummarizeConversationRequest)(nil),       // 41: api.ai.v1.SummarizeConversationRequest
+	(*SummarizeConversationReply)(nil),         // 42: api.ai.v1.SummarizeConversationReply
+	(*ClearConversationHistoryRequest)(nil),    // 43: api.ai.v1.ClearConversationHistoryRequest
+	(*ClearConversationHistoryReply)(nil),      // 44: api.ai.v1.ClearConversationHistoryReply
+	(*SetConversationMemoryRequest)(nil),       // 45: api.ai.v1.SetConversationMemoryRequest
+	(*SetConversationMemoryReply)(nil),         // 46: api.ai.v1.SetConversationMemoryReply
+	(*GetConversationMemoryRequest)(nil),       // 47: api.ai.v1.GetConversationMemoryRequest
+	(*GetConversationMemoryReply)(nil),         // 48: api.ai.v1.GetConversationMemoryReply
+	(*GetConversationStatsRequest)(nil),        // 49: api.ai.v1.GetConversationStatsRequest
+	(*GetConversationStatsReply)(nil),          // 50: api.ai.v1.GetConversationStatsReply
+	(*ExportConversationRequest)(nil),          // 51: api.ai.v1.ExportConversationRequest
+	(*ExportConversationReply)(nil),            // 52: api.ai.v1.ExportConversationReply
+	(*ImportConversationRequest)(nil),          // 53: api.ai.v1.ImportConversationRequest
+	(*ImportConversationReply)(nil),            // 54: api.ai.v1.ImportConversationReply
+	(*ConversationSnapshot)(nil),               // 55: api.ai.v1.ConversationSnapshot
+	(*ShareConversationRequest)(nil),           // 56: api.ai.v1.ShareConversationRequest
+	(*ShareConversationReply)(nil),             // 57: api.ai.v1.ShareConversationReply
+	(*RevokeConversationShareRequest)(nil),     // 58: api.ai.v1.RevokeConversationShareRequest
+	(*RevokeConversationShareReply)(nil),       // 59: api.ai.v1.RevokeConversationShareReply
+	(*ListConversationSharesRequest)(nil),      // 60: api.ai.v1.ListConversationSharesRequest
+	(*ListConversationSharesReply)(nil),        // 61: api.ai.v1.ListConversationSharesReply
+	(*PublishConversationSnapshotRequest)(nil), // 62: api.ai.v1.PublishConversationSnapshotRequest
+	(*PublishConversationSnapshotReply)(nil),   // 63: api.ai.v1.PublishConversationSnapshotReply
+	(*RevokeConversationSnapshotRequest)(nil),  // 64: api.ai.v1.RevokeConversationSnapshotRequest
+	(*RevokeConversationSnapshotReply)(nil),    // 65: api.ai.v1.RevokeConversationSnapshotReply
+	(*GetConversationSnapshotRequest)(nil),     // 66: api.ai.v1.GetConversationSnapshotRequest
+	(*GetConversationSnapshotReply)(nil),       // 67: api.ai.v1.GetConversationSnapshotReply
+	nil,                                        // 68: api.ai.v1.ConversationInfo.ConfigEntry
+	nil,                                        // 69: api.ai.v1.ConversationMemory.UserPreferencesEntry
+	nil,                                        // 70: api.ai.v1.Message.MetadataEntry
+	nil,                                        // 71: api.ai.v1.MessageAttachment.MetadataEntry
+	nil,                                        // 72: api.ai.v1.ToolCall.MetadataEntry
+	nil,                                        // 73: api.ai.v1.CreateConversationRequest.ConfigEntry
+	nil,                                        // 74: api.ai.v1.UpdateConversationRequest.ConfigEntry
+	nil,                                        // 75: api.ai.v1.SendMessageRequest.OptionsEntry
+	nil,                                        // 76: api.ai.v1.RegenerateMessageRequest.OptionsEntry
+	nil,                                        // 77: api.ai.v1.GetConversationStatsReply.CostBreakdownEntry
+	(*timestamppb.Timestamp)(nil),              // 78: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),                // 79: google.protobuf.Duration
+	(ShareRole)(0),                             // 80: api.ai.v1.ShareRole
+	(*ShareGrant)(nil),                         // 81: api.ai.v1.ShareGrant
 }
 var file_api_ai_v1_conversation_proto_depIdxs = []int32{
-	55, // 0: api.ai.v1.ConversationInfo.config:type_name -> api.ai.v1.ConversationInfo.ConfigEntry
+	68, // 0: api.ai.v1.ConversationInfo.config:type_name -> api.ai.v1.ConversationInfo.ConfigEntry
 	0,  // 1: api.ai.v1.ConversationInfo.status:type_name -> api.ai.v1.ConversationStatus
-	65, // 2: api.ai.v1.ConversationInfo.created_at:type_name -> google.protobuf.Timestamp
-	65, // 3: api.ai.v1.ConversationInfo.updated_at:type_name -> google.protobuf.Timestamp
-	65, // 4: api.ai.v1.ConversationInfo.last_active_at:type_name -> google.protobuf.Timestamp
+	78, // 2: api.ai.v1.ConversationInfo.created_at:type_name -> google.protobuf.Timestamp
+	78, // 3: api.ai.v1.ConversationInfo.updated_at:type_name -> google.protobuf.Timestamp
+	78, // 4: api.ai.v1.ConversationInfo.last_active_at:type_name -> google.protobuf.Timestamp
 	7,  // 5: api.ai.v1.ConversationInfo.memory:type_name -> api.ai.v1.ConversationMemory
 	8,  // 6: api.ai.v1.ConversationInfo.context:type_name -> api.ai.v1.ConversationContext
 	9,  // 7: api.ai.v1.ConversationInfo.stats:type_name -> api.ai.v1.ConversationStats
-	66, // 8: api.ai.v1.ConversationInfo.auto_archive_after:type_name -> google.protobuf.Duration
-	56, // 9: api.ai.v1.ConversationMemory.user_preferences:type_name -> api.ai.v1.ConversationMemory.UserPreferencesEntry
-	65, // 10: api.ai.v1.ConversationMemory.last_updated:type_name -> google.protobuf.Timestamp
-	10, // 11: api.ai.v1.ConversationContext.recent_messages:type_name -> api.ai.v1.Message
-	66, // 12: api.ai.v1.ConversationStats.total_duration:type_name -> google.protobuf.Duration
-	65, // 13: api.ai.v1.ConversationStats.last_message_at:type_name -> google.protobuf.Timestamp
-	1,  // 14: api.ai.v1.Message.role:type_name -> api.ai.v1.MessageRole
-	13, // 15: api.ai.v1.Message.tool_calls:type_name -> api.ai.v1.ToolCall
-	57, // 16: api.ai.v1.Message.metadata:type_name -> api.ai.v1.Message.MetadataEntry
-	65, // 17: api.ai.v1.Message.created_at:type_name -> google.protobuf.Timestamp
-	2,  // 18: api.ai.v1.Message.status:type_name -> api.ai.v1.MessageStatus
-	11, // 19: api.ai.v1.Message.attachments:type_name -> api.ai.v1.MessageAttachment
-	12, // 20: api.ai.v1.Message.metrics:type_name -> api.ai.v1.MessageMetrics
-	65, // 21: api.ai.v1.Message.edited_at:type_name -> google.protobuf.Timestamp
-	3,  // 22: api.ai.v1.MessageAttachment.type:type_name -> api.ai.v1.AttachmentType
-	58, // 23: api.ai.v1.MessageAttachment.metadata:type_name -> api.ai.v1.MessageAttachment.MetadataEntry
-	4,  // 24: api.ai.v1.ToolCall.status:type_name -> api.ai.v1.ToolCallStatus
-	65, // 25: api.ai.v1.ToolCall.created_at:type_name -> google.protobuf.Timestamp
-	66, // 26: api.ai.v1.ToolCall.execution_time:type_name -> google.protobuf.Duration
-	59, // 27: api.ai.v1.ToolCall.metadata:type_name -> api.ai.v1.ToolCall.MetadataEntry
-	60, // 28: api.ai.v1.CreateConversationRequest.config:type_name -> api.ai.v1.CreateConversationRequest.ConfigEntry
-	7,  // 29: api.ai.v1.CreateConversationRequest.initial_memory:type_name -> api.ai.v1.ConversationMemory
-	6,  // 30: api.ai.v1.CreateConversationReply.conversation:type_name -> api.ai.v1.ConversationInfo
-	6,  // 31: api.ai.v1.GetConversationReply.conversation:type_name -> api.ai.v1.ConversationInfo
-	61, // 32: api.ai.v1.UpdateConversationRequest.config:type_name -> api.ai.v1.UpdateConversationRequest.ConfigEntry
-	6,  // 33: api.ai.v1.UpdateConversationReply.conversation:type_name -> api.ai.v1.ConversationInfo
-	0,  // 34: api.ai.v1.ListConversationsRequest.status:type_name -> api.ai.v1.ConversationStatus
-	6,  // 35: api.ai.v1.ListConversationsReply.conversations:type_name -> api.ai.v1.ConversationInfo
-	6,  // 36: api.ai.v1.RestoreConversationReply.conversation:type_name -> api.ai.v1.ConversationInfo
-	11, // 37: api.ai.v1.SendMessageRequest.attachments:type_name -> api.ai.v1.MessageAttachment
-	62, // 38: api.ai.v1.SendMessageRequest.options:type_name -> api.ai.v1.SendMessageRequest.OptionsEntry
-	10, // 39: api.ai.v1.SendMessageReply.user_message:type_name -> api.ai.v1.Message
-	10, // 40: api.ai.v1.SendMessageReply.assistant_message:type_name -> api.ai.v1.Message
-	10, // 41: api.ai.v1.SendMessageStreamReply.final_message:type_name -> api.ai.v1.Message
-	1,  // 42: api.ai.v1.GetMessagesRequest.role_filter:type_name -> api.ai.v1.MessageRole
-	2,  // 43: api.ai.v1.GetMessagesRequest.status_filter:type_name -> api.ai.v1.MessageStatus
-	10, // 44: api.ai.v1.GetMessagesReply.messages:type_name -> api.ai.v1.Message
-	63, // 45: api.ai.v1.RegenerateMessageRequest.options:type_name -> api.ai.v1.RegenerateMessageRequest.OptionsEntry
-	10, // 46: api.ai.v1.RegenerateMessageReply.new_message:type_name -> api.ai.v1.Message
-	8,  // 47: api.ai.v1.GetConversationContextReply.context:type_name -> api.ai.v1.ConversationContext
-	8,  // 48: api.ai.v1.UpdateConversationContextRequest.context:type_name -> api.ai.v1.ConversationContext
-	7,  // 49: api.ai.v1.SetConversationMemoryRequest.memory:type_name -> api.ai.v1.ConversationMemory
-	7,  // 50: api.ai.v1.GetConversationMemoryReply.memory:type_name -> api.ai.v1.ConversationMemory
-	9,  // 51: api.ai.v1.GetConversationStatsReply.stats:type_name -> api.ai.v1.ConversationStats
-	64, // 52: api.ai.v1.GetConversationStatsReply.cost_breakdown:type_name -> api.ai.v1.GetConversationStatsReply.CostBreakdownEntry
-	5,  // 53: api.ai.v1.ExportConversationRequest.format:type_name -> api.ai.v1.ExportFormat
-	5,  // 54: api.ai.v1.ImportConversationRequest.format:type_name -> api.ai.v1.ExportFormat
-	6,  // 55: api.ai.v1.ImportConversationReply.conversations:type_name -> api.ai.v1.ConversationInfo
-	14, // 56: api.ai.v1.Conversation.CreateConversation:input_type -> api.ai.v1.CreateConversationRequest
-	16, // 57: api.ai.v1.Conversation.GetConversation:input_type -> api.ai.v1.GetConversationRequest
-	18, // 58: api.ai.v1.Conversation.UpdateConversation:input_type -> api.ai.v1.UpdateConversationRequest
-	20, // 59: api.ai.v1.Conversation.DeleteConversation:input_type -> api.ai.v1.DeleteConversationRequest
-	22, // 60: api.ai.v1.Conversation.ListConversations:input_type -> api.ai.v1.ListConversationsRequest
-	24, // 61: api.ai.v1.Conversation.ArchiveConversation:input_type -> api.ai.v1.ArchiveConversationRequest
-	26, // 62: api.ai.v1.Conversation.RestoreConversation:input_type -> api.ai.v1.RestoreConversationRequest
-	28, // 63: api.ai.v1.Conversation.SendMessage:input_type -> api.ai.v1.SendMessageRequest
-	28, // 64: api.ai.v1.Conversation.SendStreamMessage:input_type -> api.ai.v1.SendMessageRequest
-	31, // 65: api.ai.v1.Conversation.GetMessages:input_type -> api.ai.v1.GetMessagesRequest
-	33, // 66: api.ai.v1.Conversation.DeleteMessage:input_type -> api.ai.v1.DeleteMessageRequest
-	35, // 67: api.ai.v1.Conversation.RegenerateMessage:input_type -> api.ai.v1.RegenerateMessageRequest
-	37, // 68: api.ai.v1.Conversation.GetConversationContext:input_type -> api.ai.v1.GetConversationContextRequest
-	39, // 69: api.ai.v1.Conversation.UpdateConversationContext:input_type -> api.ai.v1.UpdateConversationContextRequest
-	41, // 70: api.ai.v1.Conversation.SummarizeConversation:input_type -> api.ai.v1.SummarizeConversationRequest
-	43, // 71: api.ai.v1.Conversation.ClearConversationHistory:input_type -> api.ai.v1.ClearConversationHistoryRequest
-	45, // 72: api.ai.v1.Conversation.SetConversationMemory:input_type -> api.ai.v1.SetConversationMemoryRequest
-	47, // 73: api.ai.v1.Conversation.GetConversationMemory:input_type -> api.ai.v1.GetConversationMemoryRequest
-	49, // 74: api.ai.v1.Conversation.GetConversationStats:input_type -> api.ai.v1.GetConversationStatsRequest
-	51, // 75: api.ai.v1.Conversation.ExportConversation:input_type -> api.ai.v1.ExportConversationRequest
-	53, // 76: api.ai.v1.Conversation.ImportConversation:input_type -> api.ai.v1.ImportConversationRequest
-	15, // 77: api.ai.v1.Conversation.CreateConversation:output_type -> api.ai.v1.CreateConversationReply
-	17, // 78: api.ai.v1.Conversation.GetConversation:output_type -> api.ai.v1.GetConversationReply
-	19, // 79: api.ai.v1.Conversation.UpdateConversation:output_type -> api.ai.v1.UpdateConversationReply
-	21, // 80: api.ai.v1.Conversation.DeleteConversation:output_type -> api.ai.v1.DeleteConversationReply
-	23, // 81: api.ai.v1.Conversation.ListConversations:output_type -> api.ai.v1.ListConversationsReply
-	25, // 82: api.ai.v1.Conversation.ArchiveConversation:output_type -> api.ai.v1.ArchiveConversationReply
-	27, // 83: api.ai.v1.Conversation.RestoreConversation:output_type -> api.ai.v1.RestoreConversationReply
-	29, // 84: api.ai.v1.Conversation.SendMessage:output_type -> api.ai.v1.SendMessageReply
-	30, // 85: api.ai.v1.Conversation.SendStreamMessage:output_type -> api.ai.v1.SendMessageStreamReply
-	32, // 86: api.ai.v1.Conversation.GetMessages:output_type -> api.ai.v1.GetMessagesReply
-	34, // 87: api.ai.v1.Conversation.DeleteMessage:output_type -> api.ai.v1.DeleteMessageReply
-	36, // 88: api.ai.v1.Conversation.RegenerateMessage:output_type -> api.ai.v1.RegenerateMessageReply
-	38, // 89: api.ai.v1.Conversation.GetConversationContext:output_type -> api.ai.v1.GetConversationContextReply
-	40, // 90: api.ai.v1.Conversation.UpdateConversationContext:output_type -> api.ai.v1.UpdateConversationContextReply
-	42, // 91: api.ai.v1.Conversation.SummarizeConversation:output_type -> api.ai.v1.SummarizeConversationReply
-	44, // 92: api.ai.v1.Conversation.ClearConversationHistory:output_type -> api.ai.v1.ClearConversationHistoryReply
-	46, // 93: api.ai.v1.Conversation.SetConversationMemory:output_type -> api.ai.v1.SetConversationMemoryReply
-	48, // 94: api.ai.v1.Conversation.GetConversationMemory:output_type -> api.ai.v1.GetConversationMemoryReply
-	50, // 95: api.ai.v1.Conversation.GetConversationStats:output_type -> api.ai.v1.GetConversationStatsReply
-	52, // 96: api.ai.v1.Conversation.ExportConversation:output_type -> api.ai.v1.ExportConversationReply
-	54, // 97: api.ai.v1.Conversation.ImportConversation:output_type -> api.ai.v1.ImportConversationReply
-	77, // [77:98] is the sub-list for method output_type
-	56, // [56:77] is the sub-list for method input_type
-	56, // [56:56] is the sub-list for extension type_name
-	56, // [56:56] is the sub-list for extension extendee
-	0,  // [0:56] is the sub-list for field type_name
+	79, // 8: api.ai.v1.ConversationInfo.auto_archive_after:type_name -> google.protobuf.Duration
+	80, // 9: api.ai.v1.ConversationInfo.access_role:type_name -> api.ai.v1.ShareRole
+	69, // 10: api.ai.v1.ConversationMemory.user_preferences:type_name -> api.ai.v1.ConversationMemory.UserPreferencesEntry
+	78, // 11: api.ai.v1.ConversationMemory.last_updated:type_name -> google.protobuf.Timestamp
+	10, // 12: api.ai.v1.ConversationContext.recent_messages:type_name -> api.ai.v1.Message
+	79, // 13: api.ai.v1.ConversationStats.total_duration:type_name -> google.protobuf.Duration
+	78, // 14: api.ai.v1.ConversationStats.last_message_at:type_name -> google.protobuf.Timestamp
+	1,  // 15: api.ai.v1.Message.role:type_name -> api.ai.v1.MessageRole
+	13, // 16: api.ai.v1.Message.tool_calls:type_name -> api.ai.v1.ToolCall
+	70, // 17: api.ai.v1.Message.metadata:type_name -> api.ai.v1.Message.MetadataEntry
+	78, // 18: api.ai.v1.Message.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 19: api.ai.v1.Message.status:type_name -> api.ai.v1.MessageStatus
+	11, // 20: api.ai.v1.Message.attachments:type_name -> api.ai.v1.MessageAttachment
+	12, // 21: api.ai.v1.Message.metrics:type_name -> api.ai.v1.MessageMetrics
+	78, // 22: api.ai.v1.Message.edited_at:type_name -> google.protobuf.Timestamp
+	3,  // 23: api.ai.v1.MessageAttachment.type:type_name -> api.ai.v1.AttachmentType
+	71, // 24: api.ai.v1.MessageAttachment.metadata:type_name -> api.ai.v1.MessageAttachment.MetadataEntry
+	4,  // 25: api.ai.v1.ToolCall.status:type_name -> api.ai.v1.ToolCallStatus
+	78, // 26: api.ai.v1.ToolCall.created_at:type_name -> google.protobuf.Timestamp
+	79, // 27: api.ai.v1.ToolCall.execution_time:type_name -> google.protobuf.Duration
+	72, // 28: api.ai.v1.ToolCall.metadata:type_name -> api.ai.v1.ToolCall.MetadataEntry
+	73, // 29: api.ai.v1.CreateConversationRequest.config:type_name -> api.ai.v1.CreateConversationRequest.ConfigEntry
+	7,  // 30: api.ai.v1.CreateConversationRequest.initial_memory:type_name -> api.ai.v1.ConversationMemory
+	6,  // 31: api.ai.v1.CreateConversationReply.conversation:type_name -> api.ai.v1.ConversationInfo
+	6,  // 32: api.ai.v1.GetConversationReply.conversation:type_name -> api.ai.v1.ConversationInfo
+	74, // 33: api.ai.v1.UpdateConversationRequest.config:type_name -> api.ai.v1.UpdateConversationRequest.ConfigEntry
+	6,  // 34: api.ai.v1.UpdateConversationReply.conversation:type_name -> api.ai.v1.ConversationInfo
+	0,  // 35: api.ai.v1.ListConversationsRequest.status:type_name -> api.ai.v1.ConversationStatus
+	6,  // 36: api.ai.v1.ListConversationsReply.conversations:type_name -> api.ai.v1.ConversationInfo
+	6,  // 37: api.ai.v1.RestoreConversationReply.conversation:type_name -> api.ai.v1.ConversationInfo
+	11, // 38: api.ai.v1.SendMessageRequest.attachments:type_name -> api.ai.v1.MessageAttachment
+	75, // 39: api.ai.v1.SendMessageRequest.options:type_name -> api.ai.v1.SendMessageRequest.OptionsEntry
+	10, // 40: api.ai.v1.SendMessageReply.user_message:type_name -> api.ai.v1.Message
+	10, // 41: api.ai.v1.SendMessageReply.assistant_message:type_name -> api.ai.v1.Message
+	10, // 42: api.ai.v1.SendMessageStreamReply.final_message:type_name -> api.ai.v1.Message
+	1,  // 43: api.ai.v1.GetMessagesRequest.role_filter:type_name -> api.ai.v1.MessageRole
+	2,  // 44: api.ai.v1.GetMessagesRequest.status_filter:type_name -> api.ai.v1.MessageStatus
+	10, // 45: api.ai.v1.GetMessagesReply.messages:type_name -> api.ai.v1.Message
+	76, // 46: api.ai.v1.RegenerateMessageRequest.options:type_name -> api.ai.v1.RegenerateMessageRequest.OptionsEntry
+	10, // 47: api.ai.v1.RegenerateMessageReply.new_message:type_name -> api.ai.v1.Message
+	8,  // 48: api.ai.v1.GetConversationContextReply.context:type_name -> api.ai.v1.ConversationContext
+	8,  // 49: api.ai.v1.UpdateConversationContextRequest.context:type_name -> api.ai.v1.ConversationContext
+	7,  // 50: api.ai.v1.SetConversationMemoryRequest.memory:type_name -> api.ai.v1.ConversationMemory
+	7,  // 51: api.ai.v1.GetConversationMemoryReply.memory:type_name -> api.ai.v1.ConversationMemory
+	9,  // 52: api.ai.v1.GetConversationStatsReply.stats:type_name -> api.ai.v1.ConversationStats
+	77, // 53: api.ai.v1.GetConversationStatsReply.cost_breakdown:type_name -> api.ai.v1.GetConversationStatsReply.CostBreakdownEntry
+	5,  // 54: api.ai.v1.ExportConversationRequest.format:type_name -> api.ai.v1.ExportFormat
+	5,  // 55: api.ai.v1.ImportConversationRequest.format:type_name -> api.ai.v1.ExportFormat
+	6,  // 56: api.ai.v1.ImportConversationReply.conversations:type_name -> api.ai.v1.ConversationInfo
+	10, // 57: api.ai.v1.ConversationSnapshot.messages:type_name -> api.ai.v1.Message
+	78, // 58: api.ai.v1.ConversationSnapshot.expires_at:type_name -> google.protobuf.Timestamp
+	78, // 59: api.ai.v1.ConversationSnapshot.created_at:type_name -> google.protobuf.Timestamp
+	80, // 60: api.ai.v1.ShareConversationRequest.role:type_name -> api.ai.v1.ShareRole
+	78, // 61: api.ai.v1.ShareConversationRequest.expires_at:type_name -> google.protobuf.Timestamp
+	81, // 62: api.ai.v1.ShareConversationReply.grant:type_name -> api.ai.v1.ShareGrant
+	81, // 63: api.ai.v1.ListConversationSharesReply.grants:type_name -> api.ai.v1.ShareGrant
+	55, // 64: api.ai.v1.ListConversationSharesReply.snapshots:type_name -> api.ai.v1.ConversationSnapshot
+	79, // 65: api.ai.v1.PublishConversationSnapshotRequest.expires_in:type_name -> google.protobuf.Duration
+	55, // 66: api.ai.v1.PublishConversationSnapshotReply.snapshot:type_name -> api.ai.v1.ConversationSnapshot
+	55, // 67: api.ai.v1.GetConversationSnapshotReply.snapshot:type_name -> api.ai.v1.ConversationSnapshot
+	14, // 68: api.ai.v1.Conversation.CreateConversation:input_type -> api.ai.v1.CreateConversationRequest
+	16, // 69: api.ai.v1.Conversation.GetConversation:input_type -> api.ai.v1.GetConversationRequest
+	18, // 70: api.ai.v1.Conversation.UpdateConversation:input_type -> api.ai.v1.UpdateConversationRequest
+	20, // 71: api.ai.v1.Conversation.DeleteConversation:input_type -> api.ai.v1.DeleteConversationRequest
+	22, // 72: api.ai.v1.Conversation.ListConversations:input_type -> api.ai.v1.ListConversationsRequest
+	24, // 73: api.ai.v1.Conversation.ArchiveConversation:input_type -> api.ai.v1.ArchiveConversationRequest
+	26, // 74: api.ai.v1.Conversation.RestoreConversation:input_type -> api.ai.v1.RestoreConversationRequest
+	28, // 75: api.ai.v1.Conversation.SendMessage:input_type -> api.ai.v1.SendMessageRequest
+	28, // 76: api.ai.v1.Conversation.SendStreamMessage:input_type -> api.ai.v1.SendMessageRequest
+	31, // 77: api.ai.v1.Conversation.GetMessages:input_type -> api.ai.v1.GetMessagesRequest
+	33, // 78: api.ai.v1.Conversation.DeleteMessage:input_type -> api.ai.v1.DeleteMessageRequest
+	35, // 79: api.ai.v1.Conversation.RegenerateMessage:input_type -> api.ai.v1.RegenerateMessageRequest
+	37, // 80: api.ai.v1.Conversation.GetConversationContext:input_type -> api.ai.v1.GetConversationContextRequest
+	39, // 81: api.ai.v1.Conversation.UpdateConversationContext:input_type -> api.ai.v1.UpdateConversationContextRequest
+	41, // 82: api.ai.v1.Conversation.SummarizeConversation:input_type -> api.ai.v1.SummarizeConversationRequest
+	43, // 83: api.ai.v1.Conversation.ClearConversationHistory:input_type -> api.ai.v1.ClearConversationHistoryRequest
+	45, // 84: api.ai.v1.Conversation.SetConversationMemory:input_type -> api.ai.v1.SetConversationMemoryRequest
+	47, // 85: api.ai.v1.Conversation.GetConversationMemory:input_type -> api.ai.v1.GetConversationMemoryRequest
+	49, // 86: api.ai.v1.Conversation.GetConversationStats:input_type -> api.ai.v1.GetConversationStatsRequest
+	51, // 87: api.ai.v1.Conversation.ExportConversation:input_type -> api.ai.v1.ExportConversationRequest
+	53, // 88: api.ai.v1.Conversation.ImportConversation:input_type -> api.ai.v1.ImportConversationRequest
+	56, // 89: api.ai.v1.Conversation.ShareConversation:input_type -> api.ai.v1.ShareConversationRequest
+	58, // 90: api.ai.v1.Conversation.RevokeConversationShare:input_type -> api.ai.v1.RevokeConversationShareRequest
+	60, // 91: api.ai.v1.Conversation.ListConversationShares:input_type -> api.ai.v1.ListConversationSharesRequest
+	62, // 92: api.ai.v1.Conversation.PublishConversationSnapshot:input_type -> api.ai.v1.PublishConversationSnapshotRequest
+	64, // 93: api.ai.v1.Conversation.RevokeConversationSnapshot:input_type -> api.ai.v1.RevokeConversationSnapshotRequest
+	66, // 94: api.ai.v1.Conversation.GetConversationSnapshot:input_type -> api.ai.v1.GetConversationSnapshotRequest
+	15, // 95: api.ai.v1.Conversation.CreateConversation:output_type -> api.ai.v1.CreateConversationReply
+	17, // 96: api.ai.v1.Conversation.GetConversation:output_type -> api.ai.v1.GetConversationReply
+	19, // 97: api.ai.v1.Conversation.UpdateConversation:output_type -> api.ai.v1.UpdateConversationReply
+	21, // 98: api.ai.v1.Conversation.DeleteConversation:output_type -> api.ai.v1.DeleteConversationReply
+	23, // 99: api.ai.v1.Conversation.ListConversations:output_type -> api.ai.v1.ListConversationsReply
+	25, // 100: api.ai.v1.Conversation.ArchiveConversation:output_type -> api.ai.v1.ArchiveConversationReply
+	27, // 101: api.ai.v1.Conversation.RestoreConversation:output_type -> api.ai.v1.RestoreConversationReply
+	29, // 102: api.ai.v1.Conversation.SendMessage:output_type -> api.ai.v1.SendMessageReply
+	30, // 103: api.ai.v1.Conversation.SendStreamMessage:output_type -> api.ai.v1.SendMessageStreamReply
+	32, // 104: api.ai.v1.Conversation.GetMessages:output_type -> api.ai.v1.GetMessagesReply
+	34, // 105: api.ai.v1.Conversation.DeleteMessage:output_type -> api.ai.v1.DeleteMessageReply
+	36, // 106: api.ai.v1.Conversation.RegenerateMessage:output_type -> api.ai.v1.RegenerateMessageReply
+	38, // 107: api.ai.v1.Conversation.GetConversationContext:output_type -> api.ai.v1.GetConversationContextReply
+	40, // 108: api.ai.v1.Conversation.UpdateConversationContext:output_type -> api.ai.v1.UpdateConversationContextReply
+	42, // 109: api.ai.v1.Conversation.SummarizeConversation:output_type -> api.ai.v1.SummarizeConversationReply
+	44, // 110: api.ai.v1.Conversation.ClearConversationHistory:output_type -> api.ai.v1.ClearConversationHistoryReply
+	46, // 111: api.ai.v1.Conversation.SetConversationMemory:output_type -> api.ai.v1.SetConversationMemoryReply
+	48, // 112: api.ai.v1.Conversation.GetConversationMemory:output_type -> api.ai.v1.GetConversationMemoryReply
+	50, // 113: api.ai.v1.Conversation.GetConversationStats:output_type -> api.ai.v1.GetConversationStatsReply
+	52, // 114: api.ai.v1.Conversation.ExportConversation:output_type -> api.ai.v1.ExportConversationReply
+	54, // 115: api.ai.v1.Conversation.ImportConversation:output_type -> api.ai.v1.ImportConversationReply
+	57, // 116: api.ai.v1.Conversation.ShareConversation:output_type -> api.ai.v1.ShareConversationReply
+	59, // 117: api.ai.v1.Conversation.RevokeConversationShare:output_type -> api.ai.v1.RevokeConversationShareReply
+	61, // 118: api.ai.v1.Conversation.ListConversationShares:output_type -> api.ai.v1.ListConversationSharesReply
+	63, // 119: api.ai.v1.Conversation.PublishConversationSnapshot:output_type -> api.ai.v1.PublishConversationSnapshotReply
+	65, // 120: api.ai.v1.Conversation.RevokeConversationSnapshot:output_type -> api.ai.v1.RevokeConversationSnapshotReply
+	67, // 121: api.ai.v1.Conversation.GetConversationSnapshot:output_type -> api.ai.v1.GetConversationSnapshotReply
+	95, // [95:122] is the sub-list for method output_type
+	68, // [68:95] is the sub-list for method input_type
+	68, // [68:68] is the sub-list for extension type_name
+	68, // [68:68] is the sub-list for extension extendee
+	0,  // [0:68] is the sub-list for field type_name
 }
 
 func init() { file_api_ai_v1_conversation_proto_init() }
@@ -4179,13 +4963,14 @@ func file_api_ai_v1_conversation_proto_init() {
 	if File_api_ai_v1_conversation_proto != nil {
 		return
 	}
+	file_api_ai_v1_share_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_ai_v1_conversation_proto_rawDesc), len(file_api_ai_v1_conversation_proto_rawDesc)),
 			NumEnums:      6,
-			NumMessages:   59,
+			NumMessages:   72,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,27 +19,33 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Conversation_CreateConversation_FullMethodName        = "/api.ai.v1.Conversation/CreateConversation"
-	Conversation_GetConversation_FullMethodName           = "/api.ai.v1.Conversation/GetConversation"
-	Conversation_UpdateConversation_FullMethodName        = "/api.ai.v1.Conversation/UpdateConversation"
-	Conversation_DeleteConversation_FullMethodName        = "/api.ai.v1.Conversation/DeleteConversation"
-	Conversation_ListConversations_FullMethodName         = "/api.ai.v1.Conversation/ListConversations"
-	Conversation_ArchiveConversation_FullMethodName       = "/api.ai.v1.Conversation/ArchiveConversation"
-	Conversation_RestoreConversation_FullMethodName       = "/api.ai.v1.Conversation/RestoreConversation"
-	Conversation_SendMessage_FullMethodName               = "/api.ai.v1.Conversation/SendMessage"
-	Conversation_SendStreamMessage_FullMethodName         = "/api.ai.v1.Conversation/SendStreamMessage"
-	Conversation_GetMessages_FullMethodName               = "/api.ai.v1.Conversation/GetMessages"
-	Conversation_DeleteMessage_FullMethodName             = "/api.ai.v1.Conversation/DeleteMessage"
-	Conversation_RegenerateMessage_FullMethodName         = "/api.ai.v1.Conversation/RegenerateMessage"
-	Conversation_GetConversationContext_FullMethodName    = "/api.ai.v1.Conversation/GetConversationContext"
-	Conversation_UpdateConversationContext_FullMethodName = "/api.ai.v1.Conversation/UpdateConversationContext"
-	Conversation_SummarizeConversation_FullMethodName     = "/api.ai.v1.Conversation/SummarizeConversation"
-	Conversation_ClearConversationHistory_FullMethodName  = "/api.ai.v1.Conversation/ClearConversationHistory"
-	Conversation_SetConversationMemory_FullMethodName     = "/api.ai.v1.Conversation/SetConversationMemory"
-	Conversation_GetConversationMemory_FullMethodName     = "/api.ai.v1.Conversation/GetConversationMemory"
-	Conversation_GetConversationStats_FullMethodName      = "/api.ai.v1.Conversation/GetConversationStats"
-	Conversation_ExportConversation_FullMethodName        = "/api.ai.v1.Conversation/ExportConversation"
-	Conversation_ImportConversation_FullMethodName        = "/api.ai.v1.Conversation/ImportConversation"
+	Conversation_CreateConversation_FullMethodName          = "/api.ai.v1.Conversation/CreateConversation"
+	Conversation_GetConversation_FullMethodName             = "/api.ai.v1.Conversation/GetConversation"
+	Conversation_UpdateConversation_FullMethodName          = "/api.ai.v1.Conversation/UpdateConversation"
+	Conversation_DeleteConversation_FullMethodName          = "/api.ai.v1.Conversation/DeleteConversation"
+	Conversation_ListConversations_FullMethodName           = "/api.ai.v1.Conversation/ListConversations"
+	Conversation_ArchiveConversation_FullMethodName         = "/api.ai.v1.Conversation/ArchiveConversation"
+	Conversation_RestoreConversation_FullMethodName         = "/api.ai.v1.Conversation/RestoreConversation"
+	Conversation_SendMessage_FullMethodName                 = "/api.ai.v1.Conversation/SendMessage"
+	Conversation_SendStreamMessage_FullMethodName           = "/api.ai.v1.Conversation/SendStreamMessage"
+	Conversation_GetMessages_FullMethodName                 = "/api.ai.v1.Conversation/GetMessages"
+	Conversation_DeleteMessage_FullMethodName               = "/api.ai.v1.Conversation/DeleteMessage"
+	Conversation_RegenerateMessage_FullMethodName           = "/api.ai.v1.Conversation/RegenerateMessage"
+	Conversation_GetConversationContext_FullMethodName      = "/api.ai.v1.Conversation/GetConversationContext"
+	Conversation_UpdateConversationContext_FullMethodName   = "/api.ai.v1.Conversation/UpdateConversationContext"
+	Conversation_SummarizeConversation_FullMethodName       = "/api.ai.v1.Conversation/SummarizeConversation"
+	Conversation_ClearConversationHistory_FullMethodName    = "/api.ai.v1.Conversation/ClearConversationHistory"
+	Conversation_SetConversationMemory_FullMethodName       = "/api.ai.v1.Conversation/SetConversationMemory"
+	Conversation_GetConversationMemory_FullMethodName       = "/api.ai.v1.Conversation/GetConversationMemory"
+	Conversation_GetConversationStats_FullMethodName        = "/api.ai.v1.Conversation/GetConversationStats"
+	Conversation_ExportConversation_FullMethodName          = "/api.ai.v1.Conversation/ExportConversation"
+	Conversation_ImportConversation_FullMethodName          = "/api.ai.v1.Conversation/ImportConversation"
+	Conversation_ShareConversation_FullMethodName           = "/api.ai.v1.Conversation/ShareConversation"
+	Conversation_RevokeConversationShare_FullMethodName     = "/api.ai.v1.Conversation/RevokeConversationShare"
+	Conversation_ListConversationShares_FullMethodName      = "/api.ai.v1.Conversation/ListConversationShares"
+	Conversation_PublishConversationSnapshot_FullMethodName = "/api.ai.v1.Conversation/PublishConversationSnapshot"
+	Conversation_RevokeConversationSnapshot_FullMethodName  = "/api.ai.v1.Conversation/RevokeConversationSnapshot"
+	Conversation_GetConversationSnapshot_FullMethodName     = "/api.ai.v1.Conversation/GetConversationSnapshot"
 )
 
 // ConversationClient is the client API for Conversation service.
@@ -111,6 +117,24 @@ type ConversationClient interface {
 	// ImportConversation 导入对话数据
 	// 从导出的数据中导入对话
 	ImportConversation(ctx context.Context, in *ImportConversationRequest, opts ...grpc.CallOption) (*ImportConversationReply, error)
+	// ShareConversation 共享对话
+	// 将对话以只读、编辑或管理员角色授权给其他用户，重复授权会更新角色
+	ShareConversation(ctx context.Context, in *ShareConversationRequest, opts ...grpc.CallOption) (*ShareConversationReply, error)
+	// RevokeConversationShare 撤销对话共享
+	// 撤销指定用户对对话的访问权限，被授权用户也可以主动退出
+	RevokeConversationShare(ctx context.Context, in *RevokeConversationShareRequest, opts ...grpc.CallOption) (*RevokeConversationShareReply, error)
+	// ListConversationShares 获取对话的共享列表
+	// 返回对话当前的授权记录和已发布的公开快照
+	ListConversationShares(ctx context.Context, in *ListConversationSharesRequest, opts ...grpc.CallOption) (*ListConversationSharesReply, error)
+	// PublishConversationSnapshot 发布对话公开快照
+	// 冻结当前对话内容并生成不可猜测的访问令牌，任何持有链接的人都可只读访问
+	PublishConversationSnapshot(ctx context.Context, in *PublishConversationSnapshotRequest, opts ...grpc.CallOption) (*PublishConversationSnapshotReply, error)
+	// RevokeConversationSnapshot 撤销对话公开快照
+	// 使已发布的快照链接立即失效
+	RevokeConversationSnapshot(ctx context.Context, in *RevokeConversationSnapshotRequest, opts ...grpc.CallOption) (*RevokeConversationSnapshotReply, error)
+	// GetConversationSnapshot 通过令牌获取对话公开快照
+	// 匿名访问接口，不要求调用者身份
+	GetConversationSnapshot(ctx context.Context, in *GetConversationSnapshotRequest, opts ...grpc.CallOption) (*GetConversationSnapshotReply, error)
 }
 
 type conversationClient struct {
@@ -340,6 +364,66 @@ func (c *conversationClient) ImportConversation(ctx context.Context, in *ImportC
 	return out, nil
 }
 
+func (c *conversationClient) ShareConversation(ctx context.Context, in *ShareConversationRequest, opts ...grpc.CallOption) (*ShareConversationReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareConversationReply)
+	err := c.cc.Invoke(ctx, Conversation_ShareConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) RevokeConversationShare(ctx context.Context, in *RevokeConversationShareRequest, opts ...grpc.CallOption) (*RevokeConversationShareReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeConversationShareReply)
+	err := c.cc.Invoke(ctx, Conversation_RevokeConversationShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) ListConversationShares(ctx context.Context, in *ListConversationSharesRequest, opts ...grpc.CallOption) (*ListConversationSharesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConversationSharesReply)
+	err := c.cc.Invoke(ctx, Conversation_ListConversationShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) PublishConversationSnapshot(ctx context.Context, in *PublishConversationSnapshotRequest, opts ...grpc.CallOption) (*PublishConversationSnapshotReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishConversationSnapshotReply)
+	err := c.cc.Invoke(ctx, Conversation_PublishConversationSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) RevokeConversationSnapshot(ctx context.Context, in *RevokeConversationSnapshotRequest, opts ...grpc.CallOption) (*RevokeConversationSnapshotReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeConversationSnapshotReply)
+	err := c.cc.Invoke(ctx, Conversation_RevokeConversationSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) GetConversationSnapshot(ctx context.Context, in *GetConversationSnapshotRequest, opts ...grpc.CallOption) (*GetConversationSnapshotReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConversationSnapshotReply)
+	err := c.cc.Invoke(ctx, Conversation_GetConversationSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ConversationServer is the server API for Conversation service.
 // All implementations must embed UnimplementedConversationServer
 // for forward compatibility.
@@ -409,6 +493,24 @@ type ConversationServer interface {
 	// ImportConversation 导入对话数据
 	// 从导出的数据中导入对话
 	ImportConversation(context.Context, *ImportConversationRequest) (*ImportConversationReply, error)
+	// ShareConversation 共享对话
+	// 将对话以只读、编辑或管理员角色授权给其他用户，重复授权会更新角色
+	ShareConversation(context.Context, *ShareConversationRequest) (*ShareConversationReply, error)
+	// RevokeConversationShare 撤销对话共享
+	// 撤销指定用户对对话的访问权限，被授权用户也可以主动退出
+	RevokeConversationShare(context.Context, *RevokeConversationShareRequest) (*RevokeConversationShareReply, error)
+	// ListConversationShares 获取对话的共享列表
+	// 返回对话当前的授权记录和已发布的公开快照
+	ListConversationShares(context.Context, *ListConversationSharesRequest) (*ListConversationSharesReply, error)
+	// PublishConversationSnapshot 发布对话公开快照
+	// 冻结当前对话内容并生成不可猜测的访问令牌，任何持有链接的人都可只读访问
+	PublishConversationSnapshot(context.Context, *PublishConversationSnapshotRequest) (*PublishConversationSnapshotReply, error)
+	// RevokeConversationSnapshot 撤销对话公开快照
+	// 使已发布的快照链接立即失效
+	RevokeConversationSnapshot(context.Context, *RevokeConversationSnapshotRequest) (*RevokeConversationSnapshotReply, error)
+	// GetConversationSnapshot 通过令牌获取对话公开快照
+	// 匿名访问接口，不要求调用者身份
+	GetConversationSnapshot(context.Context, *GetConversationSnapshotRequest) (*GetConversationSnapshotReply, error)
 	mustEmbedUnimplementedConversationServer()
 }
 
@@ -482,6 +584,24 @@ func (UnimplementedConversationServer) ExportConversation(context.Context, *Expo
 func (UnimplementedConversationServer) ImportConversation(context.Context, *ImportConversationRequest) (*ImportConversationReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportConversation not implemented")
 }
+func (UnimplementedConversationServer) ShareConversation(context.Context, *ShareConversationRequest) (*ShareConversationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareConversation not implemented")
+}
+func (UnimplementedConversationServer) RevokeConversationShare(context.Context, *RevokeConversationShareRequest) (*RevokeConversationShareReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeConversationShare not implemented")
+}
+func (UnimplementedConversationServer) ListConversationShares(context.Context, *ListConversationSharesRequest) (*ListConversationSharesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConversationShares not implemented")
+}
+func (UnimplementedConversationServer) PublishConversationSnapshot(context.Context, *PublishConversationSnapshotRequest) (*PublishConversationSnapshotReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishConversationSnapshot not implemented")
+}
+func (UnimplementedConversationServer) RevokeConversationSnapshot(context.Context, *RevokeConversationSnapshotRequest) (*RevokeConversationSnapshotReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeConversationSnapshot not implemented")
+}
+func (UnimplementedConversationServer) GetConversationSnapshot(context.Context, *GetConversationSnapshotRequest) (*GetConversationSnapshotReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversationSnapshot not implemented")
+}
 func (UnimplementedConversationServer) mustEmbedUnimplementedConversationServer() {}
 func (UnimplementedConversationServer) testEmbeddedByValue()                      {}
 
@@ -874,6 +994,114 @@ func _Conversation_ImportConversation_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Conversation_ShareConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).ShareConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_ShareConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).ShareConversation(ctx, req.(*ShareConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_RevokeConversationShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeConversationShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).RevokeConversationShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_RevokeConversationShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).RevokeConversationShare(ctx, req.(*RevokeConversationShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_ListConversationShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConversationSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).ListConversationShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_ListConversationShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).ListConversationShares(ctx, req.(*ListConversationSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_PublishConversationSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishConversationSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).PublishConversationSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_PublishConversationSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).PublishConversationSnapshot(ctx, req.(*PublishConversationSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_RevokeConversationSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeConversationSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).RevokeConversationSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_RevokeConversationSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).RevokeConversationSnapshot(ctx, req.(*RevokeConversationSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_GetConversationSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversationSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).GetConversationSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_GetConversationSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).GetConversationSnapshot(ctx, req.(*GetConversationSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Conversation_ServiceDesc is the grpc.ServiceDesc for Conversation service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -961,6 +1189,30 @@ var Conversation_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ImportConversation",
 			Handler:    _Conversation_ImportConversation_Handler,
 		},
+		{
+			MethodName: "ShareConversation",
+			Handler:    _Conversation_ShareConversation_Handler,
+		},
+		{
+			MethodName: "RevokeConversationShare",
+			Handler:    _Conversation_RevokeConversationShare_Handler,
+		},
+		{
+			MethodName: "ListConversationShares",
+			Handler:    _Conversation_ListConversationShares_Handler,
+		},
+		{
+			MethodName: "PublishConversationSnapshot",
+			Handler:    _Conversation_PublishConversationSnapshot_Handler,
+		},
+		{
+			MethodName: "RevokeConversationSnapshot",
+			Handler:    _Conversation_RevokeConversationSnapshot_Handler,
+		},
+		{
+			MethodName: "GetConversationSnapshot",
+			Handler:    _Conversation_GetConversationSnapshot_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -264,6 +264,58 @@ func (ChunkType) EnumDescriptor() ([]byte, []int) {
 	return file_api_ai_v1_knowledge_proto_rawDescGZIP(), []int{3}
 }
 
+type DuplicatePolicy int32
+
+const (
+	DuplicatePolicy_DUPLICATE_POLICY_UNSPECIFIED DuplicatePolicy = 0 // 同 REJECT
+	DuplicatePolicy_DUPLICATE_POLICY_REJECT      DuplicatePolicy = 1 // 知识库中已有相同内容时拒绝上传
+	DuplicatePolicy_DUPLICATE_POLICY_SKIP        DuplicatePolicy = 2 // 知识库中已有相同内容时返回已有文档
+	DuplicatePolicy_DUPLICATE_POLICY_NEW_VERSION DuplicatePolicy = 3 // 作为同名文档的新版本更新，旧版本保留在历史中
+)
+
+// Enum value maps for DuplicatePolicy.
+var (
+	DuplicatePolicy_name = map[int32]string{
+		0: "DUPLICATE_POLICY_UNSPECIFIED",
+		1: "DUPLICATE_POLICY_REJECT",
+		2: "DUPLICATE_POLICY_SKIP",
+		3: "DUPLICATE_POLICY_NEW_VERSION",
+	}
+	DuplicatePolicy_value = map[string]int32{
+		"DUPLICATE_POLICY_UNSPECIFIED": 0,
+		"DUPLICATE_POLICY_REJECT":      1,
+		"DUPLICATE_POLICY_SKIP":        2,
+		"DUPLICATE_POLICY_NEW_VERSION": 3,
+	}
+)
+
+func (x DuplicatePolicy) Enum() *DuplicatePolicy {
+	p := new(DuplicatePolicy)
+	*p = x
+	return p
+}
+
+func (x DuplicatePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DuplicatePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_ai_v1_knowledge_proto_enumTypes[4].Descriptor()
+}
+
+func (DuplicatePolicy) Type() protoreflect.EnumType {
+	return &file_api_ai_v1_knowledge_proto_enumTypes[4]
+}
+
+func (x DuplicatePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DuplicatePolicy.Descriptor instead.
+func (DuplicatePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_api_ai_v1_knowledge_proto_rawDescGZIP(), []int{4}
+}
+
 type SearchMode int32
 
 const (
@@ -303,11 +355,11 @@ func (x SearchMode) String() string {
 }
 
 func (SearchMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_ai_v1_knowledge_proto_enumTypes[4].Descriptor()
+	return file_api_ai_v1_knowledge_proto_enumTypes[5].Descriptor()
 }
 
 func (SearchMode) Type() protoreflect.EnumType {
-	return &file_api_ai_v1_knowledge_proto_enumTypes[4]
+	return &file_api_ai_v1_knowledge_proto_enumTypes[5]
 }
 
 func (x SearchMode) Number() protoreflect.EnumNumber {
@@ -316,7 +368,7 @@ func (x SearchMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SearchMode.Descriptor instead.
 func (SearchMode) EnumDescriptor() ([]byte, []int) {
-	return file_api_ai_v1_knowledge_proto_rawDescGZIP(), []int{4}
+	return file_api_ai_v1_knowledge_proto_rawDescGZIP(), []int{5}
 }
 
 type AnalysisType int32
@@ -358,11 +410,11 @@ func (x AnalysisType) String() string {
 }
 
 func (AnalysisType) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_ai_v1_knowledge_proto_enumTypes[5].Descriptor()
+	return file_api_ai_v1_knowledge_proto_enumTypes[6].Descriptor()
 }
 
 func (AnalysisType) Type() protoreflect.EnumType {
-	return &file_api_ai_v1_knowledge_proto_enumTypes[5]
+	return &file_api_ai_v1_knowledge_proto_enumTypes[6]
 }
 
 func (x AnalysisType) Number() protoreflect.EnumNumber {
@@ -371,7 +423,7 @@ func (x AnalysisType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AnalysisType.Descriptor instead.
 func (AnalysisType) EnumDescriptor() ([]byte, []int) {
-	return file_api_ai_v1_knowledge_proto_rawDescGZIP(), []int{5}
+	return file_api_ai_v1_knowledge_proto_rawDescGZIP(), []int{6}
 }
 
 // 知识库定义 - 增强版
@@ -397,6 +449,7 @@ type KnowledgeBase struct {
 	MaxFileSize        int64                  `protobuf:"varint,17,opt,name=max_file_size,json=maxFileSize,proto3" json:"max_file_size,omitempty"`                     // 最大文件大小
 	AutoProcess        bool                   `protobuf:"varint,18,opt,name=auto_process,json=autoProcess,proto3" json:"auto_process,omitempty"`                       // 自动处理新文档
 	LastIndexedAt      *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=last_indexed_at,json=lastIndexedAt,proto3" json:"last_indexed_at,omitempty"`                // 最后索引时间
+	AccessRole         ShareRole              `protobuf:"varint,20,opt,name=access_role,json=accessRole,proto3,enum=api.ai.v1.ShareRole" json:"access_role,omitempty"` // 调用者对该知识库的权限
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -564,6 +617,13 @@ func (x *KnowledgeBase) GetLastIndexedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *KnowledgeBase) GetAccessRole() ShareRole {
+	if x != nil {
+		return x.AccessRole
+	}
+	return ShareRole_SHARE_ROLE_UNSPECIFIED
+}
+
 // 知识库配置
 type KnowledgeBaseConfig struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
@@ -1790,7 +1850,7 @@ func (x *GetKnowledgeBaseReply) GetKnowledgeBase() *KnowledgeBase {
 // 列出知识库
 type ListKnowledgeBasesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                      // 用户ID
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                      // 用户ID(可选，须为调用方本人)
 	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                                        // 页码
 	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                // 页面大小
 	Keyword       string                 `protobuf:"bytes,4,opt,name=keyword,proto3" json:"keyword,omitempty"`                                   // 搜索关键词(可选)
@@ -1798,6 +1858,7 @@ type ListKnowledgeBasesRequest struct {
 	Tags          []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`                                         // 标签过滤(可选)
 	SortBy        string                 `protobuf:"bytes,7,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`                       // 排序字段
 	SortDesc      bool                   `protobuf:"varint,8,opt,name=sort_desc,json=sortDesc,proto3" json:"sort_desc,omitempty"`                // 是否降序
+	OwnedOnly     bool                   `protobuf:"varint,9,opt,name=owned_only,json=ownedOnly,proto3" json:"owned_only,omitempty"`             // 仅返回自己创建的知识库，默认包含共享给我的
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1888,6 +1949,13 @@ func (x *ListKnowledgeBasesRequest) GetSortDesc() bool {
 	return false
 }
 
+func (x *ListKnowledgeBasesRequest) GetOwnedOnly() bool {
+	if x != nil {
+		return x.OwnedOnly
+	}
+	return false
+}
+
 type ListKnowledgeBasesReply struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	KnowledgeBases []*KnowledgeBase       `protobuf:"bytes,1,rep,name=knowledge_bases,json=knowledgeBases,proto3" json:"knowledge_bases,omitempty"` // 知识库列表
@@ -1959,13 +2027,14 @@ func (x *ListKnowledgeBasesReply) GetPageSize() int32 {
 // 上传文档
 type UploadDocumentRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
-	KnowledgeBaseId int64                  `protobuf:"varint,1,opt,name=knowledge_base_id,json=knowledgeBaseId,proto3" json:"knowledge_base_id,omitempty"` // 知识库ID
-	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                 // 文档名称
-	Content         []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                                           // 文档内容
-	MimeType        string                 `protobuf:"bytes,4,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`                         // 文件类型
-	Metadata        *DocumentMetadata      `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`                                         // 文档元数据(可选)
-	Tags            []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`                                                 // 标签(可选)
-	AutoProcess     bool                   `protobuf:"varint,7,opt,name=auto_process,json=autoProcess,proto3" json:"auto_process,omitempty"`               // 是否自动处理
+	KnowledgeBaseId int64                  `protobuf:"varint,1,opt,name=knowledge_base_id,json=knowledgeBaseId,proto3" json:"knowledge_base_id,omitempty"`                              // 知识库ID
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                                              // 文档名称
+	Content         []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                                                                        // 文档内容
+	MimeType        string                 `protobuf:"bytes,4,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`                                                      // 文件类型
+	Metadata        *DocumentMetadata      `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`                                                                      // 文档元数据(可选)
+	Tags            []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`                                                                              // 标签(可选)
+	AutoProcess     bool                   `protobuf:"varint,7,opt,name=auto_process,json=autoProcess,proto3" json:"auto_process,omitempty"`                                            // 是否自动处理
+	DuplicatePolicy DuplicatePolicy        `protobuf:"varint,8,opt,name=duplicate_policy,json=duplicatePolicy,proto3,enum=api.ai.v1.DuplicatePolicy" json:"duplicate_policy,omitempty"` // 内容重复时的处理方式
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -2049,9 +2118,17 @@ func (x *UploadDocumentRequest) GetAutoProcess() bool {
 	return false
 }
 
+func (x *UploadDocumentRequest) GetDuplicatePolicy() DuplicatePolicy {
+	if x != nil {
+		return x.DuplicatePolicy
+	}
+	return DuplicatePolicy_DUPLICATE_POLICY_UNSPECIFIED
+}
+
 type UploadDocumentReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Document      *Document              `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"` // 上传的文档
+	Document      *Document              `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`    // 上传的文档
+	Duplicate     bool                   `protobuf:"varint,2,opt,name=duplicate,proto3" json:"duplicate,omitempty"` // 内容与已有文档相同，返回的是已有文档
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2093,6 +2170,13 @@ func (x *UploadDocumentReply) GetDocument() *Document {
 	return nil
 }
 
+func (x *UploadDocumentReply) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
 // 批量上传文档
 type BatchUploadDocumentsRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
@@ -2300,15 +2384,16 @@ func (x *BatchUploadDocumentsReply) GetProgress() float64 {
 
 // 更新文档
 type UpdateDocumentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`               // 文档ID
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`            // 文档名称(可选)
-	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`      // 文档内容(可选)
-	Metadata      *DocumentMetadata      `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`    // 文档元数据(可选)
-	Tags          []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`            // 标签(可选)
-	Reprocess     bool                   `protobuf:"varint,6,opt,name=reprocess,proto3" json:"reprocess,omitempty"` // 是否重新处理
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                          // 文档ID
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                       // 文档名称(可选)
+	Content           []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                                                 // 文档内容(可选)
+	Metadata          *DocumentMetadata      `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`                                               // 文档元数据(可选)
+	Tags              []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`                                                       // 标签(可选)
+	Reprocess         bool                   `protobuf:"varint,6,opt,name=reprocess,proto3" json:"reprocess,omitempty"`                                            // 是否重新处理
+	RollbackToVersion int32                  `protobuf:"varint,7,opt,name=rollback_to_version,json=rollbackToVersion,proto3" json:"rollback_to_version,omitempty"` // 回滚到指定历史版本(可选)，设置时忽略 content
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *UpdateDocumentRequest) Reset() {
@@ -2383,11 +2468,21 @@ func (x *UpdateDocumentRequest) GetReprocess() bool {
 	return false
 }
 
+func (x *UpdateDocumentRequest) GetRollbackToVersion() int32 {
+	if x != nil {
+		return x.RollbackToVersion
+	}
+	return 0
+}
+
 type UpdateDocumentReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Document      *Document              `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"` // 更新后的文档
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Document        *Document              `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`                                       // 更新后的文档
+	ChunksAdded     int32                  `protobuf:"varint,2,opt,name=chunks_added,json=chunksAdded,proto3" json:"chunks_added,omitempty"`             // 新增并向量化的知识块数
+	ChunksRemoved   int32                  `protobuf:"varint,3,opt,name=chunks_removed,json=chunksRemoved,proto3" json:"chunks_removed,omitempty"`       // 删除的知识块数
+	ChunksUnchanged int32                  `protobuf:"varint,4,opt,name=chunks_unchanged,json=chunksUnchanged,proto3" json:"chunks_unchanged,omitempty"` // 内容未变化、沿用原向量的知识块数
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UpdateDocumentReply) Reset() {
@@ -2427,6 +2522,27 @@ func (x *UpdateDocumentReply) GetDocument() *Document {
 	return nil
 }
 
+func (x *UpdateDocumentReply) GetChunksAdded() int32 {
+	if x != nil {
+		return x.ChunksAdded
+	}
+	return 0
+}
+
+func (x *UpdateDocumentReply) GetChunksRemoved() int32 {
+	if x != nil {
+		return x.ChunksRemoved
+	}
+	return 0
+}
+
+func (x *UpdateDocumentReply) GetChunksUnchanged() int32 {
+	if x != nil {
+		return x.ChunksUnchanged
+	}
+	return 0
+}
+
 // 删除文档
 type DeleteDocumentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -3969,7 +4085,7 @@ func (x *ListKnowledgeChunksReply) GetPageSize() int32 {
 type ReindexKnowledgeBaseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                         // 知识库ID
-	ForceReindex  bool                   `protobuf:"varint,2,opt,name=force_reindex,json=forceReindex,proto3" json:"force_reindex,omitempty"` // 是否强制重新索引
+	ForceReindex  bool                   `protobuf:"varint,2,opt,name=force_reindex,json=forceReindex,proto3" json:"force_reindex,omitempty"` // 是否强制重新索引，知识库正在索引中时取代原任务
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -4356,11 +4472,302 @@ func (x *AnalysisResult) GetVisualizationData() []byte {
 	return nil
 }
 
+// 共享知识库
+type ShareKnowledgeBaseRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	KnowledgeBaseId int64                  `protobuf:"varint,1,opt,name=knowledge_base_id,json=knowledgeBaseId,proto3" json:"knowledge_base_id,omitempty"` // 知识库ID
+	GranteeUserId   int64                  `protobuf:"varint,3,opt,name=grantee_user_id,json=granteeUserId,proto3" json:"grantee_user_id,omitempty"`       // 被授权用户ID
+	Role            ShareRole              `protobuf:"varint,4,opt,name=role,proto3,enum=api.ai.v1.ShareRole" json:"role,omitempty"`                       // 授权角色
+	ExpiresAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                      // 过期时间(可选)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ShareKnowledgeBaseRequest) Reset() {
+	*x = ShareKnowledgeBaseRequest{}
+	mi := &file_api_ai_v1_knowledge_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareKnowledgeBaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareKnowledgeBaseRequest) ProtoMessage() {}
+
+func (x *ShareKnowledgeBaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_knowledge_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareKnowledgeBaseRequest.ProtoReflect.Descriptor instead.
+func (*ShareKnowledgeBaseRequest) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_knowledge_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ShareKnowledgeBaseRequest) GetKnowledgeBaseId() int64 {
+	if x != nil {
+		return x.KnowledgeBaseId
+	}
+	return 0
+}
+
+func (x *ShareKnowledgeBaseRequest) GetGranteeUserId() int64 {
+	if x != nil {
+		return x.GranteeUserId
+	}
+	return 0
+}
+
+func (x *ShareKnowledgeBaseRequest) GetRole() ShareRole {
+	if x != nil {
+		return x.Role
+	}
+	return ShareRole_SHARE_ROLE_UNSPECIFIED
+}
+
+func (x *ShareKnowledgeBaseRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type ShareKnowledgeBaseReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grant         *ShareGrant            `protobuf:"bytes,1,opt,name=grant,proto3" json:"grant,omitempty"` // 授权记录
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareKnowledgeBaseReply) Reset() {
+	*x = ShareKnowledgeBaseReply{}
+	mi := &file_api_ai_v1_knowledge_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareKnowledgeBaseReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareKnowledgeBaseReply) ProtoMessage() {}
+
+func (x *ShareKnowledgeBaseReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_knowledge_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareKnowledgeBaseReply.ProtoReflect.Descriptor instead.
+func (*ShareKnowledgeBaseReply) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_knowledge_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ShareKnowledgeBaseReply) GetGrant() *ShareGrant {
+	if x != nil {
+		return x.Grant
+	}
+	return nil
+}
+
+// 撤销知识库共享
+type RevokeKnowledgeBaseShareRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	KnowledgeBaseId int64                  `protobuf:"varint,1,opt,name=knowledge_base_id,json=knowledgeBaseId,proto3" json:"knowledge_base_id,omitempty"` // 知识库ID
+	GranteeUserId   int64                  `protobuf:"varint,3,opt,name=grantee_user_id,json=granteeUserId,proto3" json:"grantee_user_id,omitempty"`       // 被撤销的用户ID
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RevokeKnowledgeBaseShareRequest) Reset() {
+	*x = RevokeKnowledgeBaseShareRequest{}
+	mi := &file_api_ai_v1_knowledge_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeKnowledgeBaseShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeKnowledgeBaseShareRequest) ProtoMessage() {}
+
+func (x *RevokeKnowledgeBaseShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_knowledge_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeKnowledgeBaseShareRequest.ProtoReflect.Descriptor instead.
+func (*RevokeKnowledgeBaseShareRequest) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_knowledge_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RevokeKnowledgeBaseShareRequest) GetKnowledgeBaseId() int64 {
+	if x != nil {
+		return x.KnowledgeBaseId
+	}
+	return 0
+}
+
+func (x *RevokeKnowledgeBaseShareRequest) GetGranteeUserId() int64 {
+	if x != nil {
+		return x.GranteeUserId
+	}
+	return 0
+}
+
+type RevokeKnowledgeBaseShareReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeKnowledgeBaseShareReply) Reset() {
+	*x = RevokeKnowledgeBaseShareReply{}
+	mi := &file_api_ai_v1_knowledge_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeKnowledgeBaseShareReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeKnowledgeBaseShareReply) ProtoMessage() {}
+
+func (x *RevokeKnowledgeBaseShareReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_knowledge_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeKnowledgeBaseShareReply.ProtoReflect.Descriptor instead.
+func (*RevokeKnowledgeBaseShareReply) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_knowledge_proto_rawDescGZIP(), []int{57}
+}
+
+// 获取知识库共享列表
+type ListKnowledgeBaseSharesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	KnowledgeBaseId int64                  `protobuf:"varint,1,opt,name=knowledge_base_id,json=knowledgeBaseId,proto3" json:"knowledge_base_id,omitempty"` // 知识库ID
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListKnowledgeBaseSharesRequest) Reset() {
+	*x = ListKnowledgeBaseSharesRequest{}
+	mi := &file_api_ai_v1_knowledge_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKnowledgeBaseSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKnowledgeBaseSharesRequest) ProtoMessage() {}
+
+func (x *ListKnowledgeBaseSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_knowledge_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKnowledgeBaseSharesRequest.ProtoReflect.Descriptor instead.
+func (*ListKnowledgeBaseSharesRequest) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_knowledge_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListKnowledgeBaseSharesRequest) GetKnowledgeBaseId() int64 {
+	if x != nil {
+		return x.KnowledgeBaseId
+	}
+	return 0
+}
+
+type ListKnowledgeBaseSharesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grants        []*ShareGrant          `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants,omitempty"` // 授权列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKnowledgeBaseSharesReply) Reset() {
+	*x = ListKnowledgeBaseSharesReply{}
+	mi := &file_api_ai_v1_knowledge_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKnowledgeBaseSharesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKnowledgeBaseSharesReply) ProtoMessage() {}
+
+func (x *ListKnowledgeBaseSharesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_knowledge_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKnowledgeBaseSharesReply.ProtoReflect.Descriptor instead.
+func (*ListKnowledgeBaseSharesReply) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_knowledge_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListKnowledgeBaseSharesReply) GetGrants() []*ShareGrant {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
 var File_api_ai_v1_knowledge_proto protoreflect.FileDescriptor
 
 const file_api_ai_v1_knowledge_proto_rawDesc = "" +
 	"\n" +
-	"\x19api/ai/v1/knowledge.proto\x12\tapi.ai.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfd\x05\n" +
+	"\x19api/ai/v1/knowledge.proto\x12\tapi.ai.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x15api/ai/v1/share.proto\"\xb4\x06\n" +
 	"\rKnowledgeBase\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
@@ -4384,7 +4791,9 @@ const file_api_ai_v1_knowledge_proto_rawDesc = "" +
 	"\x14supported_file_types\x18\x10 \x03(\tR\x12supportedFileTypes\x12\"\n" +
 	"\rmax_file_size\x18\x11 \x01(\x03R\vmaxFileSize\x12!\n" +
 	"\fauto_process\x18\x12 \x01(\bR\vautoProcess\x12B\n" +
-	"\x0flast_indexed_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\rlastIndexedAt\"\xff\x04\n" +
+	"\x0flast_indexed_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\rlastIndexedAt\x125\n" +
+	"\vaccess_role\x18\x14 \x01(\x0e2\x14.api.ai.v1.ShareRoleR\n" +
+	"accessRole\"\xff\x04\n" +
 	"\x13KnowledgeBaseConfig\x12'\n" +
 	"\x0fembedding_model\x18\x01 \x01(\tR\x0eembeddingModel\x12/\n" +
 	"\x13embedding_dimension\x18\x02 \x01(\x05R\x12embeddingDimension\x12\x1d\n" +
@@ -4526,7 +4935,7 @@ const file_api_ai_v1_knowledge_proto_rawDesc = "" +
 	"\rinclude_stats\x18\x02 \x01(\bR\fincludeStats\x12%\n" +
 	"\x0einclude_config\x18\x03 \x01(\bR\rincludeConfig\"X\n" +
 	"\x15GetKnowledgeBaseReply\x12?\n" +
-	"\x0eknowledge_base\x18\x01 \x01(\v2\x18.api.ai.v1.KnowledgeBaseR\rknowledgeBase\"\x81\x02\n" +
+	"\x0eknowledge_base\x18\x01 \x01(\v2\x18.api.ai.v1.KnowledgeBaseR\rknowledgeBase\"\xa0\x02\n" +
 	"\x19ListKnowledgeBasesRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
@@ -4535,12 +4944,14 @@ const file_api_ai_v1_knowledge_proto_rawDesc = "" +
 	"\x06status\x18\x05 \x01(\x0e2\x1e.api.ai.v1.KnowledgeBaseStatusR\x06status\x12\x12\n" +
 	"\x04tags\x18\x06 \x03(\tR\x04tags\x12\x17\n" +
 	"\asort_by\x18\a \x01(\tR\x06sortBy\x12\x1b\n" +
-	"\tsort_desc\x18\b \x01(\bR\bsortDesc\"\xa3\x01\n" +
+	"\tsort_desc\x18\b \x01(\bR\bsortDesc\x12\x1d\n" +
+	"\n" +
+	"owned_only\x18\t \x01(\bR\townedOnly\"\xa3\x01\n" +
 	"\x17ListKnowledgeBasesReply\x12A\n" +
 	"\x0fknowledge_bases\x18\x01 \x03(\v2\x18.api.ai.v1.KnowledgeBaseR\x0eknowledgeBases\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xfe\x01\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xc5\x02\n" +
 	"\x15UploadDocumentRequest\x12*\n" +
 	"\x11knowledge_base_id\x18\x01 \x01(\x03R\x0fknowledgeBaseId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
@@ -4548,9 +4959,11 @@ const file_api_ai_v1_knowledge_proto_rawDesc = "" +
 	"\tmime_type\x18\x04 \x01(\tR\bmimeType\x127\n" +
 	"\bmetadata\x18\x05 \x01(\v2\x1b.api.ai.v1.DocumentMetadataR\bmetadata\x12\x12\n" +
 	"\x04tags\x18\x06 \x03(\tR\x04tags\x12!\n" +
-	"\fauto_process\x18\a \x01(\bR\vautoProcess\"F\n" +
+	"\fauto_process\x18\a \x01(\bR\vautoProcess\x12E\n" +
+	"\x10duplicate_policy\x18\b \x01(\x0e2\x1a.api.ai.v1.DuplicatePolicyR\x0fduplicatePolicy\"d\n" +
 	"\x13UploadDocumentReply\x12/\n" +
-	"\bdocument\x18\x01 \x01(\v2\x13.api.ai.v1.DocumentR\bdocument\"\x82\x01\n" +
+	"\bdocument\x18\x01 \x01(\v2\x13.api.ai.v1.DocumentR\bdocument\x12\x1c\n" +
+	"\tduplicate\x18\x02 \x01(\bR\tduplicate\"\x82\x01\n" +
 	"\x1bBatchUploadDocumentsRequest\x12*\n" +
 	"\x11knowledge_base_id\x18\x01 \x01(\x03R\x0fknowledgeBaseId\x127\n" +
 	"\tdocuments\x18\x02 \x03(\v2\x19.api.ai.v1.DocumentUploadR\tdocuments\"\xa8\x01\n" +
@@ -4565,16 +4978,20 @@ const file_api_ai_v1_knowledge_proto_rawDesc = "" +
 	"\rsuccess_count\x18\x02 \x01(\x05R\fsuccessCount\x12!\n" +
 	"\ffailed_count\x18\x03 \x01(\x05R\vfailedCount\x12\x16\n" +
 	"\x06errors\x18\x04 \x03(\tR\x06errors\x12\x1a\n" +
-	"\bprogress\x18\x05 \x01(\x01R\bprogress\"\xc0\x01\n" +
+	"\bprogress\x18\x05 \x01(\x01R\bprogress\"\xf0\x01\n" +
 	"\x15UpdateDocumentRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
 	"\acontent\x18\x03 \x01(\fR\acontent\x127\n" +
 	"\bmetadata\x18\x04 \x01(\v2\x1b.api.ai.v1.DocumentMetadataR\bmetadata\x12\x12\n" +
 	"\x04tags\x18\x05 \x03(\tR\x04tags\x12\x1c\n" +
-	"\treprocess\x18\x06 \x01(\bR\treprocess\"F\n" +
+	"\treprocess\x18\x06 \x01(\bR\treprocess\x12.\n" +
+	"\x13rollback_to_version\x18\a \x01(\x05R\x11rollbackToVersion\"\xbb\x01\n" +
 	"\x13UpdateDocumentReply\x12/\n" +
-	"\bdocument\x18\x01 \x01(\v2\x13.api.ai.v1.DocumentR\bdocument\"[\n" +
+	"\bdocument\x18\x01 \x01(\v2\x13.api.ai.v1.DocumentR\bdocument\x12!\n" +
+	"\fchunks_added\x18\x02 \x01(\x05R\vchunksAdded\x12%\n" +
+	"\x0echunks_removed\x18\x03 \x01(\x05R\rchunksRemoved\x12)\n" +
+	"\x10chunks_unchanged\x18\x04 \x01(\x05R\x0fchunksUnchanged\"[\n" +
 	"\x15DeleteDocumentRequest\x12!\n" +
 	"\fdocument_ids\x18\x01 \x03(\x03R\vdocumentIds\x12\x1f\n" +
 	"\vhard_delete\x18\x02 \x01(\bR\n" +
@@ -4736,7 +5153,23 @@ const file_api_ai_v1_knowledge_proto_rawDesc = "" +
 	"\x12visualization_data\x18\x06 \x01(\fR\x11visualizationData\x1a:\n" +
 	"\fMetricsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01*\xea\x01\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\xda\x01\n" +
+	"\x19ShareKnowledgeBaseRequest\x12*\n" +
+	"\x11knowledge_base_id\x18\x01 \x01(\x03R\x0fknowledgeBaseId\x12&\n" +
+	"\x0fgrantee_user_id\x18\x03 \x01(\x03R\rgranteeUserId\x12(\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x14.api.ai.v1.ShareRoleR\x04role\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAtJ\x04\b\x02\x10\x03\"F\n" +
+	"\x17ShareKnowledgeBaseReply\x12+\n" +
+	"\x05grant\x18\x01 \x01(\v2\x15.api.ai.v1.ShareGrantR\x05grant\"{\n" +
+	"\x1fRevokeKnowledgeBaseShareRequest\x12*\n" +
+	"\x11knowledge_base_id\x18\x01 \x01(\x03R\x0fknowledgeBaseId\x12&\n" +
+	"\x0fgrantee_user_id\x18\x03 \x01(\x03R\rgranteeUserIdJ\x04\b\x02\x10\x03\"\x1f\n" +
+	"\x1dRevokeKnowledgeBaseShareReply\"R\n" +
+	"\x1eListKnowledgeBaseSharesRequest\x12*\n" +
+	"\x11knowledge_base_id\x18\x01 \x01(\x03R\x0fknowledgeBaseIdJ\x04\b\x02\x10\x03\"M\n" +
+	"\x1cListKnowledgeBaseSharesReply\x12-\n" +
+	"\x06grants\x18\x01 \x03(\v2\x15.api.ai.v1.ShareGrantR\x06grants*\xea\x01\n" +
 	"\x13KnowledgeBaseStatus\x12%\n" +
 	"!KNOWLEDGE_BASE_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
 	"\x1cKNOWLEDGE_BASE_STATUS_ACTIVE\x10\x01\x12\"\n" +
@@ -4766,7 +5199,12 @@ const file_api_ai_v1_knowledge_proto_rawDesc = "" +
 	"\x14CHUNK_TYPE_PARAGRAPH\x10\x03\x12\x13\n" +
 	"\x0fCHUNK_TYPE_LIST\x10\x04\x12\x14\n" +
 	"\x10CHUNK_TYPE_TABLE\x10\x05\x12\x13\n" +
-	"\x0fCHUNK_TYPE_CODE\x10\x06*\x8b\x01\n" +
+	"\x0fCHUNK_TYPE_CODE\x10\x06*\x8d\x01\n" +
+	"\x0fDuplicatePolicy\x12 \n" +
+	"\x1cDUPLICATE_POLICY_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17DUPLICATE_POLICY_REJECT\x10\x01\x12\x19\n" +
+	"\x15DUPLICATE_POLICY_SKIP\x10\x02\x12 \n" +
+	"\x1cDUPLICATE_POLICY_NEW_VERSION\x10\x03*\x8b\x01\n" +
 	"\n" +
 	"SearchMode\x12\x1b\n" +
 	"\x17SEARCH_MODE_UNSPECIFIED\x10\x00\x12\x18\n" +
@@ -4779,7 +5217,7 @@ const file_api_ai_v1_knowledge_proto_rawDesc = "" +
 	"\"ANALYSIS_TYPE_CONTENT_DISTRIBUTION\x10\x01\x12 \n" +
 	"\x1cANALYSIS_TYPE_TOPIC_MODELING\x10\x02\x12%\n" +
 	"!ANALYSIS_TYPE_SIMILARITY_ANALYSIS\x10\x03\x12$\n" +
-	" ANALYSIS_TYPE_QUALITY_ASSESSMENT\x10\x042\x9b\x0f\n" +
+	" ANALYSIS_TYPE_QUALITY_ASSESSMENT\x10\x042\xdc\x11\n" +
 	"\tKnowledge\x12a\n" +
 	"\x13CreateKnowledgeBase\x12%.api.ai.v1.CreateKnowledgeBaseRequest\x1a#.api.ai.v1.CreateKnowledgeBaseReply\x12a\n" +
 	"\x13UpdateKnowledgeBase\x12%.api.ai.v1.UpdateKnowledgeBaseRequest\x1a#.api.ai.v1.UpdateKnowledgeBaseReply\x12a\n" +
@@ -4801,7 +5239,10 @@ const file_api_ai_v1_knowledge_proto_rawDesc = "" +
 	"\x13ListKnowledgeChunks\x12%.api.ai.v1.ListKnowledgeChunksRequest\x1a#.api.ai.v1.ListKnowledgeChunksReply\x12d\n" +
 	"\x14ReindexKnowledgeBase\x12&.api.ai.v1.ReindexKnowledgeBaseRequest\x1a$.api.ai.v1.ReindexKnowledgeBaseReply\x12g\n" +
 	"\x15GetKnowledgeBaseStats\x12'.api.ai.v1.GetKnowledgeBaseStatsRequest\x1a%.api.ai.v1.GetKnowledgeBaseStatsReply\x12d\n" +
-	"\x14AnalyzeKnowledgeBase\x12&.api.ai.v1.AnalyzeKnowledgeBaseRequest\x1a$.api.ai.v1.AnalyzeKnowledgeBaseReplyBL\n" +
+	"\x14AnalyzeKnowledgeBase\x12&.api.ai.v1.AnalyzeKnowledgeBaseRequest\x1a$.api.ai.v1.AnalyzeKnowledgeBaseReply\x12^\n" +
+	"\x12ShareKnowledgeBase\x12$.api.ai.v1.ShareKnowledgeBaseRequest\x1a\".api.ai.v1.ShareKnowledgeBaseReply\x12p\n" +
+	"\x18RevokeKnowledgeBaseShare\x12*.api.ai.v1.RevokeKnowledgeBaseShareRequest\x1a(.api.ai.v1.RevokeKnowledgeBaseShareReply\x12m\n" +
+	"\x17ListKnowledgeBaseShares\x12).api.ai.v1.ListKnowledgeBaseSharesRequest\x1a'.api.ai.v1.ListKnowledgeBaseSharesReplyBL\n" +
 	"\x1ecom.oldwei.universal.api.ai.v1B\x10KnowledgeProtoV1P\x01Z\x16universal/api/ai/v1;v1b\x06proto3"
 
 var (
@@ -4816,199 +5257,220 @@ func file_api_ai_v1_knowledge_proto_rawDescGZIP() []byte {
 	return file_api_ai_v1_knowledge_proto_rawDescData
 }
 
-var file_api_ai_v1_knowledge_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_api_ai_v1_knowledge_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
+var file_api_ai_v1_knowledge_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_api_ai_v1_knowledge_proto_msgTypes = make([]protoimpl.MessageInfo, 73)
 var file_api_ai_v1_knowledge_proto_goTypes = []any{
-	(KnowledgeBaseStatus)(0),             // 0: api.ai.v1.KnowledgeBaseStatus
-	(ChunkingStrategy)(0),                // 1: api.ai.v1.ChunkingStrategy
-	(DocumentStatus)(0),                  // 2: api.ai.v1.DocumentStatus
-	(ChunkType)(0),                       // 3: api.ai.v1.ChunkType
-	(SearchMode)(0),                      // 4: api.ai.v1.SearchMode
-	(AnalysisType)(0),                    // 5: api.ai.v1.AnalysisType
-	(*KnowledgeBase)(nil),                // 6: api.ai.v1.KnowledgeBase
-	(*KnowledgeBaseConfig)(nil),          // 7: api.ai.v1.KnowledgeBaseConfig
-	(*KnowledgeBaseStats)(nil),           // 8: api.ai.v1.KnowledgeBaseStats
-	(*Document)(nil),                     // 9: api.ai.v1.Document
-	(*DocumentMetadata)(nil),             // 10: api.ai.v1.DocumentMetadata
-	(*KnowledgeChunk)(nil),               // 11: api.ai.v1.KnowledgeChunk
-	(*CreateKnowledgeBaseRequest)(nil),   // 12: api.ai.v1.CreateKnowledgeBaseRequest
-	(*CreateKnowledgeBaseReply)(nil),     // 13: api.ai.v1.CreateKnowledgeBaseReply
-	(*UpdateKnowledgeBaseRequest)(nil),   // 14: api.ai.v1.UpdateKnowledgeBaseRequest
-	(*UpdateKnowledgeBaseReply)(nil),     // 15: api.ai.v1.UpdateKnowledgeBaseReply
-	(*DeleteKnowledgeBaseRequest)(nil),   // 16: api.ai.v1.DeleteKnowledgeBaseRequest
-	(*DeleteKnowledgeBaseReply)(nil),     // 17: api.ai.v1.DeleteKnowledgeBaseReply
-	(*GetKnowledgeBaseRequest)(nil),      // 18: api.ai.v1.GetKnowledgeBaseRequest
-	(*GetKnowledgeBaseReply)(nil),        // 19: api.ai.v1.GetKnowledgeBaseReply
-	(*ListKnowledgeBasesRequest)(nil),    // 20: api.ai.v1.ListKnowledgeBasesRequest
-	(*ListKnowledgeBasesReply)(nil),      // 21: api.ai.v1.ListKnowledgeBasesReply
-	(*UploadDocumentRequest)(nil),        // 22: api.ai.v1.UploadDocumentRequest
-	(*UploadDocumentReply)(nil),          // 23: api.ai.v1.UploadDocumentReply
-	(*BatchUploadDocumentsRequest)(nil),  // 24: api.ai.v1.BatchUploadDocumentsRequest
-	(*DocumentUpload)(nil),               // 25: api.ai.v1.DocumentUpload
-	(*BatchUploadDocumentsReply)(nil),    // 26: api.ai.v1.BatchUploadDocumentsReply
-	(*UpdateDocumentRequest)(nil),        // 27: api.ai.v1.UpdateDocumentRequest
-	(*UpdateDocumentReply)(nil),          // 28: api.ai.v1.UpdateDocumentReply
-	(*DeleteDocumentRequest)(nil),        // 29: api.ai.v1.DeleteDocumentRequest
-	(*DeleteDocumentReply)(nil),          // 30: api.ai.v1.DeleteDocumentReply
-	(*GetDocumentRequest)(nil),           // 31: api.ai.v1.GetDocumentRequest
-	(*GetDocumentReply)(nil),             // 32: api.ai.v1.GetDocumentReply
-	(*ListDocumentsRequest)(nil),         // 33: api.ai.v1.ListDocumentsRequest
-	(*ListDocumentsReply)(nil),           // 34: api.ai.v1.ListDocumentsReply
-	(*ProcessDocumentRequest)(nil),       // 35: api.ai.v1.ProcessDocumentRequest
-	(*ProcessDocumentReply)(nil),         // 36: api.ai.v1.ProcessDocumentReply
-	(*SearchKnowledgeRequest)(nil),       // 37: api.ai.v1.SearchKnowledgeRequest
-	(*SearchKnowledgeReply)(nil),         // 38: api.ai.v1.SearchKnowledgeReply
-	(*HybridSearchRequest)(nil),          // 39: api.ai.v1.HybridSearchRequest
-	(*HybridSearchReply)(nil),            // 40: api.ai.v1.HybridSearchReply
-	(*HybridSearchResult)(nil),           // 41: api.ai.v1.HybridSearchResult
-	(*AdvancedSearchRequest)(nil),        // 42: api.ai.v1.AdvancedSearchRequest
-	(*SearchQuery)(nil),                  // 43: api.ai.v1.SearchQuery
-	(*DateRange)(nil),                    // 44: api.ai.v1.DateRange
-	(*SearchSort)(nil),                   // 45: api.ai.v1.SearchSort
-	(*AdvancedSearchReply)(nil),          // 46: api.ai.v1.AdvancedSearchReply
-	(*GetKnowledgeChunkRequest)(nil),     // 47: api.ai.v1.GetKnowledgeChunkRequest
-	(*GetKnowledgeChunkReply)(nil),       // 48: api.ai.v1.GetKnowledgeChunkReply
-	(*UpdateKnowledgeChunkRequest)(nil),  // 49: api.ai.v1.UpdateKnowledgeChunkRequest
-	(*UpdateKnowledgeChunkReply)(nil),    // 50: api.ai.v1.UpdateKnowledgeChunkReply
-	(*ListKnowledgeChunksRequest)(nil),   // 51: api.ai.v1.ListKnowledgeChunksRequest
-	(*ListKnowledgeChunksReply)(nil),     // 52: api.ai.v1.ListKnowledgeChunksReply
-	(*ReindexKnowledgeBaseRequest)(nil),  // 53: api.ai.v1.ReindexKnowledgeBaseRequest
-	(*ReindexKnowledgeBaseReply)(nil),    // 54: api.ai.v1.ReindexKnowledgeBaseReply
-	(*GetKnowledgeBaseStatsRequest)(nil), // 55: api.ai.v1.GetKnowledgeBaseStatsRequest
-	(*GetKnowledgeBaseStatsReply)(nil),   // 56: api.ai.v1.GetKnowledgeBaseStatsReply
-	(*AnalyzeKnowledgeBaseRequest)(nil),  // 57: api.ai.v1.AnalyzeKnowledgeBaseRequest
-	(*AnalyzeKnowledgeBaseReply)(nil),    // 58: api.ai.v1.AnalyzeKnowledgeBaseReply
-	(*AnalysisResult)(nil),               // 59: api.ai.v1.AnalysisResult
-	nil,                                  // 60: api.ai.v1.KnowledgeBaseConfig.AdvancedOptionsEntry
-	nil,                                  // 61: api.ai.v1.KnowledgeBaseStats.FileTypeDistributionEntry
-	nil,                                  // 62: api.ai.v1.KnowledgeBaseStats.LanguageDistributionEntry
-	nil,                                  // 63: api.ai.v1.DocumentMetadata.CustomFieldsEntry
-	nil,                                  // 64: api.ai.v1.KnowledgeChunk.MetadataEntry
-	nil,                                  // 65: api.ai.v1.ProcessDocumentRequest.OptionsEntry
-	nil,                                  // 66: api.ai.v1.SearchKnowledgeRequest.FiltersEntry
-	nil,                                  // 67: api.ai.v1.HybridSearchRequest.FiltersEntry
-	nil,                                  // 68: api.ai.v1.AdvancedSearchRequest.FiltersEntry
-	nil,                                  // 69: api.ai.v1.AdvancedSearchReply.FacetsEntry
-	nil,                                  // 70: api.ai.v1.UpdateKnowledgeChunkRequest.MetadataEntry
-	nil,                                  // 71: api.ai.v1.GetKnowledgeBaseStatsReply.PerformanceMetricsEntry
-	nil,                                  // 72: api.ai.v1.AnalysisResult.MetricsEntry
-	(*timestamppb.Timestamp)(nil),        // 73: google.protobuf.Timestamp
+	(KnowledgeBaseStatus)(0),                // 0: api.ai.v1.KnowledgeBaseStatus
+	(ChunkingStrategy)(0),                   // 1: api.ai.v1.ChunkingStrategy
+	(DocumentStatus)(0),                     // 2: api.ai.v1.DocumentStatus
+	(ChunkType)(0),                          // 3: api.ai.v1.ChunkType
+	(DuplicatePolicy)(0),                    // 4: api.ai.v1.DuplicatePolicy
+	(SearchMode)(0),                         // 5: api.ai.v1.SearchMode
+	(AnalysisType)(0),                       // 6: api.ai.v1.AnalysisType
+	(*KnowledgeBase)(nil),                   // 7: api.ai.v1.KnowledgeBase
+	(*KnowledgeBaseConfig)(nil),             // 8: api.ai.v1.KnowledgeBaseConfig
+	(*KnowledgeBaseStats)(nil),              // 9: api.ai.v1.KnowledgeBaseStats
+	(*Document)(nil),                        // 10: api.ai.v1.Document
+	(*DocumentMetadata)(nil),                // 11: api.ai.v1.DocumentMetadata
+	(*KnowledgeChunk)(nil),                  // 12: api.ai.v1.KnowledgeChunk
+	(*CreateKnowledgeBaseRequest)(nil),      // 13: api.ai.v1.CreateKnowledgeBaseRequest
+	(*CreateKnowledgeBaseReply)(nil),        // 14: api.ai.v1.CreateKnowledgeBaseReply
+	(*UpdateKnowledgeBaseRequest)(nil),      // 15: api.ai.v1.UpdateKnowledgeBaseRequest
+	(*UpdateKnowledgeBaseReply)(nil),        // 16: api.ai.v1.UpdateKnowledgeBaseReply
+	(*DeleteKnowledgeBaseRequest)(nil),      // 17: api.ai.v1.DeleteKnowledgeBaseRequest
+	(*DeleteKnowledgeBaseReply)(nil),        // 18: api.ai.v1.DeleteKnowledgeBaseReply
+	(*GetKnowledgeBaseRequest)(nil),         // 19: api.ai.v1.GetKnowledgeBaseRequest
+	(*GetKnowledgeBaseReply)(nil),           // 20: api.ai.v1.GetKnowledgeBaseReply
+	(*ListKnowledgeBasesRequest)(nil),       // 21: api.ai.v1.ListKnowledgeBasesRequest
+	(*ListKnowledgeBasesReply)(nil),         // 22: api.ai.v1.ListKnowledgeBasesReply
+	(*UploadDocumentRequest)(nil),           // 23: api.ai.v1.UploadDocumentRequest
+	(*UploadDocumentReply)(nil),             // 24: api.ai.v1.UploadDocumentReply
+	(*BatchUploadDocumentsRequest)(nil),     // 25: api.ai.v1.BatchUploadDocumentsRequest
+	(*DocumentUpload)(nil),                  // 26: api.ai.v1.DocumentUpload
+	(*BatchUploadDocumentsReply)(nil),       // 27: api.ai.v1.BatchUploadDocumentsReply
+	(*UpdateDocumentRequest)(nil),           // 28: api.ai.v1.UpdateDocumentRequest
+	(*UpdateDocumentReply)(nil),             // 29: api.ai.v1.UpdateDocumentReply
+	(*DeleteDocumentRequest)(nil),           // 30: api.ai.v1.DeleteDocumentRequest
+	(*DeleteDocumentReply)(nil),             // 31: api.ai.v1.DeleteDocumentReply
+	(*GetDocumentRequest)(nil),              // 32: api.ai.v1.GetDocumentRequest
+	(*GetDocumentReply)(nil),                // 33: api.ai.v1.GetDocumentReply
+	(*ListDocumentsRequest)(nil),            // 34: api.ai.v1.ListDocumentsRequest
+	(*ListDocumentsReply)(nil),              // 35: api.ai.v1.ListDocumentsReply
+	(*ProcessDocumentRequest)(nil),          // 36: api.ai.v1.ProcessDocumentRequest
+	(*ProcessDocumentReply)(nil),            // 37: api.ai.v1.ProcessDocumentReply
+	(*SearchKnowledgeRequest)(nil),          // 38: api.ai.v1.SearchKnowledgeRequest
+	(*SearchKnowledgeReply)(nil),            // 39: api.ai.v1.SearchKnowledgeReply
+	(*HybridSearchRequest)(nil),             // 40: api.ai.v1.HybridSearchRequest
+	(*HybridSearchReply)(nil),               // 41: api.ai.v1.HybridSearchReply
+	(*HybridSearchResult)(nil),              // 42: api.ai.v1.HybridSearchResult
+	(*AdvancedSearchRequest)(nil),           // 43: api.ai.v1.AdvancedSearchRequest
+	(*SearchQuery)(nil),                     // 44: api.ai.v1.SearchQuery
+	(*DateRange)(nil),                       // 45: api.ai.v1.DateRange
+	(*SearchSort)(nil),                      // 46: api.ai.v1.SearchSort
+	(*AdvancedSearchReply)(nil),             // 47: api.ai.v1.AdvancedSearchReply
+	(*GetKnowledgeChunkRequest)(nil),        // 48: api.ai.v1.GetKnowledgeChunkRequest
+	(*GetKnowledgeChunkReply)(nil),          // 49: api.ai.v1.GetKnowledgeChunkReply
+	(*UpdateKnowledgeChunkRequest)(nil),     // 50: api.ai.v1.UpdateKnowledgeChunkRequest
+	(*UpdateKnowledgeChunkReply)(nil),       // 51: api.ai.v1.UpdateKnowledgeChunkReply
+	(*ListKnowledgeChunksRequest)(nil),      // 52: api.ai.v1.ListKnowledgeChunksRequest
+	(*ListKnowledgeChunksReply)(nil),        // 53: api.ai.v1.ListKnowledgeChunksReply
+	(*ReindexKnowledgeBaseRequest)(nil),     // 54: api.ai.v1.ReindexKnowledgeBaseRequest
+	(*ReindexKnowledgeBaseReply)(nil),       // 55: api.ai.v1.ReindexKnowledgeBaseReply
+	(*GetKnowledgeBaseStatsRequest)(nil),    // 56: api.ai.v1.GetKnowledgeBaseStatsRequest
+	(*GetKnowledgeBaseStatsReply)(nil),      // 57: api.ai.v1.GetKnowledgeBaseStatsReply
+	(*AnalyzeKnowledgeBaseRequest)(nil),     // 58: api.ai.v1.AnalyzeKnowledgeBaseRequest
+	(*AnalyzeKnowledgeBaseReply)(nil),       // 59: api.ai.v1.AnalyzeKnowledgeBaseReply
+	(*AnalysisResult)(nil),                  // 60: api.ai.v1.AnalysisResult
+	(*ShareKnowledgeBaseRequest)(nil),       // 61: api.ai.v1.ShareKnowledgeBaseRequest
+	(*ShareKnowledgeBaseReply)(nil),         // 62: api.ai.v1.ShareKnowledgeBaseReply
+	(*RevokeKnowledgeBaseShareRequest)(nil), // 63: api.ai.v1.RevokeKnowledgeBaseShareRequest
+	(*RevokeKnowledgeBaseShareReply)(nil),   // 64: api.ai.v1.RevokeKnowledgeBaseShareReply
+	(*ListKnowledgeBaseSharesRequest)(nil),  // 65: api.ai.v1.ListKnowledgeBaseSharesRequest
+	(*ListKnowledgeBaseSharesReply)(nil),    // 66: api.ai.v1.ListKnowledgeBaseSharesReply
+	nil,                                     // 67: api.ai.v1.KnowledgeBaseConfig.AdvancedOptionsEntry
+	nil,                                     // 68: api.ai.v1.KnowledgeBaseStats.FileTypeDistributionEntry
+	nil,                                     // 69: api.ai.v1.KnowledgeBaseStats.LanguageDistributionEntry
+	nil,                                     // 70: api.ai.v1.DocumentMetadata.CustomFieldsEntry
+	nil,                                     // 71: api.ai.v1.KnowledgeChunk.MetadataEntry
+	nil,                                     // 72: api.ai.v1.ProcessDocumentRequest.OptionsEntry
+	nil,                                     // 73: api.ai.v1.SearchKnowledgeRequest.FiltersEntry
+	nil,                                     // 74: api.ai.v1.HybridSearchRequest.FiltersEntry
+	nil,                                     // 75: api.ai.v1.AdvancedSearchRequest.FiltersEntry
+	nil,                                     // 76: api.ai.v1.AdvancedSearchReply.FacetsEntry
+	nil,                                     // 77: api.ai.v1.UpdateKnowledgeChunkRequest.MetadataEntry
+	nil,                                     // 78: api.ai.v1.GetKnowledgeBaseStatsReply.PerformanceMetricsEntry
+	nil,                                     // 79: api.ai.v1.AnalysisResult.MetricsEntry
+	(*timestamppb.Timestamp)(nil),           // 80: google.protobuf.Timestamp
+	(ShareRole)(0),                          // 81: api.ai.v1.ShareRole
+	(*ShareGrant)(nil),                      // 82: api.ai.v1.ShareGrant
 }
 var file_api_ai_v1_knowledge_proto_depIdxs = []int32{
 	0,  // 0: api.ai.v1.KnowledgeBase.status:type_name -> api.ai.v1.KnowledgeBaseStatus
-	73, // 1: api.ai.v1.KnowledgeBase.created_at:type_name -> google.protobuf.Timestamp
-	73, // 2: api.ai.v1.KnowledgeBase.updated_at:type_name -> google.protobuf.Timestamp
-	7,  // 3: api.ai.v1.KnowledgeBase.config:type_name -> api.ai.v1.KnowledgeBaseConfig
-	8,  // 4: api.ai.v1.KnowledgeBase.stats:type_name -> api.ai.v1.KnowledgeBaseStats
-	73, // 5: api.ai.v1.KnowledgeBase.last_indexed_at:type_name -> google.protobuf.Timestamp
-	1,  // 6: api.ai.v1.KnowledgeBaseConfig.chunking_strategy:type_name -> api.ai.v1.ChunkingStrategy
-	60, // 7: api.ai.v1.KnowledgeBaseConfig.advanced_options:type_name -> api.ai.v1.KnowledgeBaseConfig.AdvancedOptionsEntry
-	61, // 8: api.ai.v1.KnowledgeBaseStats.file_type_distribution:type_name -> api.ai.v1.KnowledgeBaseStats.FileTypeDistributionEntry
-	62, // 9: api.ai.v1.KnowledgeBaseStats.language_distribution:type_name -> api.ai.v1.KnowledgeBaseStats.LanguageDistributionEntry
-	73, // 10: api.ai.v1.KnowledgeBaseStats.last_updated:type_name -> google.protobuf.Timestamp
-	2,  // 11: api.ai.v1.Document.status:type_name -> api.ai.v1.DocumentStatus
-	73, // 12: api.ai.v1.Document.created_at:type_name -> google.protobuf.Timestamp
-	73, // 13: api.ai.v1.Document.updated_at:type_name -> google.protobuf.Timestamp
-	10, // 14: api.ai.v1.Document.metadata:type_name -> api.ai.v1.DocumentMetadata
-	73, // 15: api.ai.v1.Document.last_processed_at:type_name -> google.protobuf.Timestamp
-	73, // 16: api.ai.v1.DocumentMetadata.created_date:type_name -> google.protobuf.Timestamp
-	73, // 17: api.ai.v1.DocumentMetadata.modified_date:type_name -> google.protobuf.Timestamp
-	63, // 18: api.ai.v1.DocumentMetadata.custom_fields:type_name -> api.ai.v1.DocumentMetadata.CustomFieldsEntry
-	64, // 19: api.ai.v1.KnowledgeChunk.metadata:type_name -> api.ai.v1.KnowledgeChunk.MetadataEntry
-	3,  // 20: api.ai.v1.KnowledgeChunk.chunk_type:type_name -> api.ai.v1.ChunkType
-	73, // 21: api.ai.v1.KnowledgeChunk.created_at:type_name -> google.protobuf.Timestamp
-	7,  // 22: api.ai.v1.CreateKnowledgeBaseRequest.config:type_name -> api.ai.v1.KnowledgeBaseConfig
-	6,  // 23: api.ai.v1.CreateKnowledgeBaseReply.knowledge_base:type_name -> api.ai.v1.KnowledgeBase
-	7,  // 24: api.ai.v1.UpdateKnowledgeBaseRequest.config:type_name -> api.ai.v1.KnowledgeBaseConfig
-	6,  // 25: api.ai.v1.UpdateKnowledgeBaseReply.knowledge_base:type_name -> api.ai.v1.KnowledgeBase
-	6,  // 26: api.ai.v1.GetKnowledgeBaseReply.knowledge_base:type_name -> api.ai.v1.KnowledgeBase
-	0,  // 27: api.ai.v1.ListKnowledgeBasesRequest.status:type_name -> api.ai.v1.KnowledgeBaseStatus
-	6,  // 28: api.ai.v1.ListKnowledgeBasesReply.knowledge_bases:type_name -> api.ai.v1.KnowledgeBase
-	10, // 29: api.ai.v1.UploadDocumentRequest.metadata:type_name -> api.ai.v1.DocumentMetadata
-	9,  // 30: api.ai.v1.UploadDocumentReply.document:type_name -> api.ai.v1.Document
-	25, // 31: api.ai.v1.BatchUploadDocumentsRequest.documents:type_name -> api.ai.v1.DocumentUpload
-	10, // 32: api.ai.v1.DocumentUpload.metadata:type_name -> api.ai.v1.DocumentMetadata
-	9,  // 33: api.ai.v1.BatchUploadDocumentsReply.documents:type_name -> api.ai.v1.Document
-	10, // 34: api.ai.v1.UpdateDocumentRequest.metadata:type_name -> api.ai.v1.DocumentMetadata
-	9,  // 35: api.ai.v1.UpdateDocumentReply.document:type_name -> api.ai.v1.Document
-	9,  // 36: api.ai.v1.GetDocumentReply.document:type_name -> api.ai.v1.Document
-	11, // 37: api.ai.v1.GetDocumentReply.chunks:type_name -> api.ai.v1.KnowledgeChunk
-	2,  // 38: api.ai.v1.ListDocumentsRequest.status:type_name -> api.ai.v1.DocumentStatus
-	9,  // 39: api.ai.v1.ListDocumentsReply.documents:type_name -> api.ai.v1.Document
-	65, // 40: api.ai.v1.ProcessDocumentRequest.options:type_name -> api.ai.v1.ProcessDocumentRequest.OptionsEntry
-	2,  // 41: api.ai.v1.ProcessDocumentReply.status:type_name -> api.ai.v1.DocumentStatus
-	66, // 42: api.ai.v1.SearchKnowledgeRequest.filters:type_name -> api.ai.v1.SearchKnowledgeRequest.FiltersEntry
-	11, // 43: api.ai.v1.SearchKnowledgeReply.chunks:type_name -> api.ai.v1.KnowledgeChunk
-	67, // 44: api.ai.v1.HybridSearchRequest.filters:type_name -> api.ai.v1.HybridSearchRequest.FiltersEntry
-	41, // 45: api.ai.v1.HybridSearchReply.results:type_name -> api.ai.v1.HybridSearchResult
-	11, // 46: api.ai.v1.HybridSearchResult.chunk:type_name -> api.ai.v1.KnowledgeChunk
-	43, // 47: api.ai.v1.AdvancedSearchRequest.query:type_name -> api.ai.v1.SearchQuery
-	45, // 48: api.ai.v1.AdvancedSearchRequest.sort:type_name -> api.ai.v1.SearchSort
-	68, // 49: api.ai.v1.AdvancedSearchRequest.filters:type_name -> api.ai.v1.AdvancedSearchRequest.FiltersEntry
-	4,  // 50: api.ai.v1.SearchQuery.mode:type_name -> api.ai.v1.SearchMode
-	44, // 51: api.ai.v1.SearchQuery.date_range:type_name -> api.ai.v1.DateRange
-	73, // 52: api.ai.v1.DateRange.start:type_name -> google.protobuf.Timestamp
-	73, // 53: api.ai.v1.DateRange.end:type_name -> google.protobuf.Timestamp
-	11, // 54: api.ai.v1.AdvancedSearchReply.chunks:type_name -> api.ai.v1.KnowledgeChunk
-	69, // 55: api.ai.v1.AdvancedSearchReply.facets:type_name -> api.ai.v1.AdvancedSearchReply.FacetsEntry
-	11, // 56: api.ai.v1.GetKnowledgeChunkReply.chunk:type_name -> api.ai.v1.KnowledgeChunk
-	70, // 57: api.ai.v1.UpdateKnowledgeChunkRequest.metadata:type_name -> api.ai.v1.UpdateKnowledgeChunkRequest.MetadataEntry
-	11, // 58: api.ai.v1.UpdateKnowledgeChunkReply.chunk:type_name -> api.ai.v1.KnowledgeChunk
-	3,  // 59: api.ai.v1.ListKnowledgeChunksRequest.chunk_type:type_name -> api.ai.v1.ChunkType
-	11, // 60: api.ai.v1.ListKnowledgeChunksReply.chunks:type_name -> api.ai.v1.KnowledgeChunk
-	8,  // 61: api.ai.v1.GetKnowledgeBaseStatsReply.stats:type_name -> api.ai.v1.KnowledgeBaseStats
-	71, // 62: api.ai.v1.GetKnowledgeBaseStatsReply.performance_metrics:type_name -> api.ai.v1.GetKnowledgeBaseStatsReply.PerformanceMetricsEntry
-	5,  // 63: api.ai.v1.AnalyzeKnowledgeBaseRequest.analysis_types:type_name -> api.ai.v1.AnalysisType
-	59, // 64: api.ai.v1.AnalyzeKnowledgeBaseReply.results:type_name -> api.ai.v1.AnalysisResult
-	5,  // 65: api.ai.v1.AnalysisResult.type:type_name -> api.ai.v1.AnalysisType
-	72, // 66: api.ai.v1.AnalysisResult.metrics:type_name -> api.ai.v1.AnalysisResult.MetricsEntry
-	12, // 67: api.ai.v1.Knowledge.CreateKnowledgeBase:input_type -> api.ai.v1.CreateKnowledgeBaseRequest
-	14, // 68: api.ai.v1.Knowledge.UpdateKnowledgeBase:input_type -> api.ai.v1.UpdateKnowledgeBaseRequest
-	16, // 69: api.ai.v1.Knowledge.DeleteKnowledgeBase:input_type -> api.ai.v1.DeleteKnowledgeBaseRequest
-	20, // 70: api.ai.v1.Knowledge.ListKnowledgeBases:input_type -> api.ai.v1.ListKnowledgeBasesRequest
-	18, // 71: api.ai.v1.Knowledge.GetKnowledgeBase:input_type -> api.ai.v1.GetKnowledgeBaseRequest
-	22, // 72: api.ai.v1.Knowledge.UploadDocument:input_type -> api.ai.v1.UploadDocumentRequest
-	24, // 73: api.ai.v1.Knowledge.BatchUploadDocuments:input_type -> api.ai.v1.BatchUploadDocumentsRequest
-	27, // 74: api.ai.v1.Knowledge.UpdateDocument:input_type -> api.ai.v1.UpdateDocumentRequest
-	29, // 75: api.ai.v1.Knowledge.DeleteDocument:input_type -> api.ai.v1.DeleteDocumentRequest
-	33, // 76: api.ai.v1.Knowledge.ListDocuments:input_type -> api.ai.v1.ListDocumentsRequest
-	31, // 77: api.ai.v1.Knowledge.GetDocument:input_type -> api.ai.v1.GetDocumentRequest
-	35, // 78: api.ai.v1.Knowledge.ProcessDocument:input_type -> api.ai.v1.ProcessDocumentRequest
-	37, // 79: api.ai.v1.Knowledge.SearchKnowledge:input_type -> api.ai.v1.SearchKnowledgeRequest
-	39, // 80: api.ai.v1.Knowledge.HybridSearch:input_type -> api.ai.v1.HybridSearchRequest
-	42, // 81: api.ai.v1.Knowledge.AdvancedSearch:input_type -> api.ai.v1.AdvancedSearchRequest
-	47, // 82: api.ai.v1.Knowledge.GetKnowledgeChunk:input_type -> api.ai.v1.GetKnowledgeChunkRequest
-	49, // 83: api.ai.v1.Knowledge.UpdateKnowledgeChunk:input_type -> api.ai.v1.UpdateKnowledgeChunkRequest
-	51, // 84: api.ai.v1.Knowledge.ListKnowledgeChunks:input_type -> api.ai.v1.ListKnowledgeChunksRequest
-	53, // 85: api.ai.v1.Knowledge.ReindexKnowledgeBase:input_type -> api.ai.v1.ReindexKnowledgeBaseRequest
-	55, // 86: api.ai.v1.Knowledge.GetKnowledgeBaseStats:input_type -> api.ai.v1.GetKnowledgeBaseStatsRequest
-	57, // 87: api.ai.v1.Knowledge.AnalyzeKnowledgeBase:input_type -> api.ai.v1.AnalyzeKnowledgeBaseRequest
-	13, // 88: api.ai.v1.Knowledge.CreateKnowledgeBase:output_type -> api.ai.v1.CreateKnowledgeBaseReply
-	15, // 89: api.ai.v1.Knowledge.UpdateKnowledgeBase:output_type -> api.ai.v1.UpdateKnowledgeBaseReply
-	17, // 90: api.ai.v1.Knowledge.DeleteKnowledgeBase:output_type -> api.ai.v1.DeleteKnowledgeBaseReply
-	21, // 91: api.ai.v1.Knowledge.ListKnowledgeBases:output_type -> api.ai.v1.ListKnowledgeBasesReply
-	19, // 92: api.ai.v1.Knowledge.GetKnowledgeBase:output_type -> api.ai.v1.GetKnowledgeBaseReply
-	23, // 93: api.ai.v1.Knowledge.UploadDocument:output_type -> api.ai.v1.UploadDocumentReply
-	26, // 94: api.ai.v1.Knowledge.BatchUploadDocuments:output_type -> api.ai.v1.BatchUploadDocumentsReply
-	28, // 95: api.ai.v1.Knowledge.UpdateDocument:output_type -> api.ai.v1.UpdateDocumentReply
-	30, // 96: api.ai.v1.Knowledge.DeleteDocument:output_type -> api.ai.v1.DeleteDocumentReply
-	34, // 97: api.ai.v1.Knowledge.ListDocuments:output_type -> api.ai.v1.ListDocumentsReply
-	32, // 98: api.ai.v1.Knowledge.GetDocument:output_type -> api.ai.v1.GetDocumentReply
-	36, // 99: api.ai.v1.Knowledge.ProcessDocument:output_type -> api.ai.v1.ProcessDocumentReply
-	38, // 100: api.ai.v1.Knowledge.SearchKnowledge:output_type -> api.ai.v1.SearchKnowledgeReply
-	40, // 101: api.ai.v1.Knowledge.HybridSearch:output_type -> api.ai.v1.HybridSearchReply
-	46, // 102: api.ai.v1.Knowledge.AdvancedSearch:output_type -> api.ai.v1.AdvancedSearchReply
-	48, // 103: api.ai.v1.Knowledge.GetKnowledgeChunk:output_type -> api.ai.v1.GetKnowledgeChunkReply
-	50, // 104: api.ai.v1.Knowledge.UpdateKnowledgeChunk:output_type -> api.ai.v1.UpdateKnowledgeChunkReply
-	52, // 105: api.ai.v1.Knowledge.ListKnowledgeChunks:output_type -> api.ai.v1.ListKnowledgeChunksReply
-	54, // 106: api.ai.v1.Knowledge.ReindexKnowledgeBase:output_type -> api.ai.v1.ReindexKnowledgeBaseReply
-	56, // 107: api.ai.v1.Knowledge.GetKnowledgeBaseStats:output_type -> api.ai.v1.GetKnowledgeBaseStatsReply
-	58, // 108: api.ai.v1.Knowledge.AnalyzeKnowledgeBase:output_type -> api.ai.v1.AnalyzeKnowledgeBaseReply
-	88, // [88:109] is the sub-list for method output_type
-	67, // [67:88] is the sub-list for method input_type
-	67, // [67:67] is the sub-list for extension type_name
-	67, // [67:67] is the sub-list for extension extendee
-	0,  // [0:67] is the sub-list for field type_name
+	80, // 1: api.ai.v1.KnowledgeBase.created_at:type_name -> google.protobuf.Timestamp
+	80, // 2: api.ai.v1.KnowledgeBase.updated_at:type_name -> google.protobuf.Timestamp
+	8,  // 3: api.ai.v1.KnowledgeBase.config:type_name -> api.ai.v1.KnowledgeBaseConfig
+	9,  // 4: api.ai.v1.KnowledgeBase.stats:type_name -> api.ai.v1.KnowledgeBaseStats
+	80, // 5: api.ai.v1.KnowledgeBase.last_indexed_at:type_name -> google.protobuf.Timestamp
+	81, // 6: api.ai.v1.KnowledgeBase.access_role:type_name -> api.ai.v1.ShareRole
+	1,  // 7: api.ai.v1.KnowledgeBaseConfig.chunking_strategy:type_name -> api.ai.v1.ChunkingStrategy
+	67, // 8: api.ai.v1.KnowledgeBaseConfig.advanced_options:type_name -> api.ai.v1.KnowledgeBaseConfig.AdvancedOptionsEntry
+	68, // 9: api.ai.v1.KnowledgeBaseStats.file_type_distribution:type_name -> api.ai.v1.KnowledgeBaseStats.FileTypeDistributionEntry
+	69, // 10: api.ai.v1.KnowledgeBaseStats.language_distribution:type_name -> api.ai.v1.KnowledgeBaseStats.LanguageDistributionEntry
+	80, // 11: api.ai.v1.KnowledgeBaseStats.last_updated:type_name -> google.protobuf.Timestamp
+	2,  // 12: api.ai.v1.Document.status:type_name -> api.ai.v1.DocumentStatus
+	80, // 13: api.ai.v1.Document.created_at:type_name -> google.protobuf.Timestamp
+	80, // 14: api.ai.v1.Document.updated_at:type_name -> google.protobuf.Timestamp
+	11, // 15: api.ai.v1.Document.metadata:type_name -> api.ai.v1.DocumentMetadata
+	80, // 16: api.ai.v1.Document.last_processed_at:type_name -> google.protobuf.Timestamp
+	80, // 17: api.ai.v1.DocumentMetadata.created_date:type_name -> google.protobuf.Timestamp
+	80, // 18: api.ai.v1.DocumentMetadata.modified_date:type_name -> google.protobuf.Timestamp
+	70, // 19: api.ai.v1.DocumentMetadata.custom_fields:type_name -> api.ai.v1.DocumentMetadata.CustomFieldsEntry
+	71, // 20: api.ai.v1.KnowledgeChunk.metadata:type_name -> api.ai.v1.KnowledgeChunk.MetadataEntry
+	3,  // 21: api.ai.v1.KnowledgeChunk.chunk_type:type_name -> api.ai.v1.ChunkType
+	80, // 22: api.ai.v1.KnowledgeChunk.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 23: api.ai.v1.CreateKnowledgeBaseRequest.config:type_name -> api.ai.v1.KnowledgeBaseConfig
+	7,  // 24: api.ai.v1.CreateKnowledgeBaseReply.knowledge_base:type_name -> api.ai.v1.KnowledgeBase
+	8,  // 25: api.ai.v1.UpdateKnowledgeBaseRequest.config:type_name -> api.ai.v1.KnowledgeBaseConfig
+	7,  // 26: api.ai.v1.UpdateKnowledgeBaseReply.knowledge_base:type_name -> api.ai.v1.KnowledgeBase
+	7,  // 27: api.ai.v1.GetKnowledgeBaseReply.knowledge_base:type_name -> api.ai.v1.KnowledgeBase
+	0,  // 28: api.ai.v1.ListKnowledgeBasesRequest.status:type_name -> api.ai.v1.KnowledgeBaseStatus
+	7,  // 29: api.ai.v1.ListKnowledgeBasesReply.knowledge_bases:type_name -> api.ai.v1.KnowledgeBase
+	11, // 30: api.ai.v1.UploadDocumentRequest.metadata:type_name -> api.ai.v1.DocumentMetadata
+	4,  // 31: api.ai.v1.UploadDocumentRequest.duplicate_policy:type_name -> api.ai.v1.DuplicatePolicy
+	10, // 32: api.ai.v1.UploadDocumentReply.document:type_name -> api.ai.v1.Document
+	26, // 33: api.ai.v1.BatchUploadDocumentsRequest.documents:type_name -> api.ai.v1.DocumentUpload
+	11, // 34: api.ai.v1.DocumentUpload.metadata:type_name -> api.ai.v1.DocumentMetadata
+	10, // 35: api.ai.v1.BatchUploadDocumentsReply.documents:type_name -> api.ai.v1.Document
+	11, // 36: api.ai.v1.UpdateDocumentRequest.metadata:type_name -> api.ai.v1.DocumentMetadata
+	10, // 37: api.ai.v1.UpdateDocumentReply.document:type_name -> api.ai.v1.Document
+	10, // 38: api.ai.v1.GetDocumentReply.document:type_name -> api.ai.v1.Document
+	12, // 39: api.ai.v1.GetDocumentReply.chunks:type_name -> api.ai.v1.KnowledgeChunk
+	2,  // 40: api.ai.v1.ListDocumentsRequest.status:type_name -> api.ai.v1.DocumentStatus
+	10, // 41: api.ai.v1.ListDocumentsReply.documents:type_name -> api.ai.v1.Document
+	72, // 42: api.ai.v1.ProcessDocumentRequest.options:type_name -> api.ai.v1.ProcessDocumentRequest.OptionsEntry
+	2,  // 43: api.ai.v1.ProcessDocumentReply.status:type_name -> api.ai.v1.DocumentStatus
+	73, // 44: api.ai.v1.SearchKnowledgeRequest.filters:type_name -> api.ai.v1.SearchKnowledgeRequest.FiltersEntry
+	12, // 45: api.ai.v1.SearchKnowledgeReply.chunks:type_name -> api.ai.v1.KnowledgeChunk
+	74, // 46: api.ai.v1.HybridSearchRequest.filters:type_name -> api.ai.v1.HybridSearchRequest.FiltersEntry
+	42, // 47: api.ai.v1.HybridSearchReply.results:type_name -> api.ai.v1.HybridSearchResult
+	12, // 48: api.ai.v1.HybridSearchResult.chunk:type_name -> api.ai.v1.KnowledgeChunk
+	44, // 49: api.ai.v1.AdvancedSearchRequest.query:type_name -> api.ai.v1.SearchQuery
+	46, // 50: api.ai.v1.AdvancedSearchRequest.sort:type_name -> api.ai.v1.SearchSort
+	75, // 51: api.ai.v1.AdvancedSearchRequest.filters:type_name -> api.ai.v1.AdvancedSearchRequest.FiltersEntry
+	5,  // 52: api.ai.v1.SearchQuery.mode:type_name -> api.ai.v1.SearchMode
+	45, // 53: api.ai.v1.SearchQuery.date_range:type_name -> api.ai.v1.DateRange
+	80, // 54: api.ai.v1.DateRange.start:type_name -> google.protobuf.Timestamp
+	80, // 55: api.ai.v1.DateRange.end:type_name -> google.protobuf.Timestamp
+	12, // 56: api.ai.v1.AdvancedSearchReply.chunks:type_name -> api.ai.v1.KnowledgeChunk
+	76, // 57: api.ai.v1.AdvancedSearchReply.facets:type_name -> api.ai.v1.AdvancedSearchReply.FacetsEntry
+	12, // 58: api.ai.v1.GetKnowledgeChunkReply.chunk:type_name -> api.ai.v1.KnowledgeChunk
+	77, // 59: api.ai.v1.UpdateKnowledgeChunkRequest.metadata:type_name -> api.ai.v1.UpdateKnowledgeChunkRequest.MetadataEntry
+	12, // 60: api.ai.v1.UpdateKnowledgeChunkReply.chunk:type_name -> api.ai.v1.KnowledgeChunk
+	3,  // 61: api.ai.v1.ListKnowledgeChunksRequest.chunk_type:type_name -> api.ai.v1.ChunkType
+	12, // 62: api.ai.v1.ListKnowledgeChunksReply.chunks:type_name -> api.ai.v1.KnowledgeChunk
+	9,  // 63: api.ai.v1.GetKnowledgeBaseStatsReply.stats:type_name -> api.ai.v1.KnowledgeBaseStats
+	78, // 64: api.ai.v1.GetKnowledgeBaseStatsReply.performance_metrics:type_name -> api.ai.v1.GetKnowledgeBaseStatsReply.PerformanceMetricsEntry
+	6,  // 65: api.ai.v1.AnalyzeKnowledgeBaseRequest.analysis_types:type_name -> api.ai.v1.AnalysisType
+	60, // 66: api.ai.v1.AnalyzeKnowledgeBaseReply.results:type_name -> api.ai.v1.AnalysisResult
+	6,  // 67: api.ai.v1.AnalysisResult.type:type_name -> api.ai.v1.AnalysisType
+	79, // 68: api.ai.v1.AnalysisResult.metrics:type_name -> api.ai.v1.AnalysisResult.MetricsEntry
+	81, // 69: api.ai.v1.ShareKnowledgeBaseRequest.role:type_name -> api.ai.v1.ShareRole
+	80, // 70: api.ai.v1.ShareKnowledgeBaseRequest.expires_at:type_name -> google.protobuf.Timestamp
+	82, // 71: api.ai.v1.ShareKnowledgeBaseReply.grant:type_name -> api.ai.v1.ShareGrant
+	82, // 72: api.ai.v1.ListKnowledgeBaseSharesReply.grants:type_name -> api.ai.v1.ShareGrant
+	13, // 73: api.ai.v1.Knowledge.CreateKnowledgeBase:input_type -> api.ai.v1.CreateKnowledgeBaseRequest
+	15, // 74: api.ai.v1.Knowledge.UpdateKnowledgeBase:input_type -> api.ai.v1.UpdateKnowledgeBaseRequest
+	17, // 75: api.ai.v1.Knowledge.DeleteKnowledgeBase:input_type -> api.ai.v1.DeleteKnowledgeBaseRequest
+	21, // 76: api.ai.v1.Knowledge.ListKnowledgeBases:input_type -> api.ai.v1.ListKnowledgeBasesRequest
+	19, // 77: api.ai.v1.Knowledge.GetKnowledgeBase:input_type -> api.ai.v1.GetKnowledgeBaseRequest
+	23, // 78: api.ai.v1.Knowledge.UploadDocument:input_type -> api.ai.v1.UploadDocumentRequest
+	25, // 79: api.ai.v1.Knowledge.BatchUploadDocuments:input_type -> api.ai.v1.BatchUploadDocumentsRequest
+	28, // 80: api.ai.v1.Knowledge.UpdateDocument:input_type -> api.ai.v1.UpdateDocumentRequest
+	30, // 81: api.ai.v1.Knowledge.DeleteDocument:input_type -> api.ai.v1.DeleteDocumentRequest
+	34, // 82: api.ai.v1.Knowledge.ListDocuments:input_type -> api.ai.v1.ListDocumentsRequest
+	32, // 83: api.ai.v1.Knowledge.GetDocument:input_type -> api.ai.v1.GetDocumentRequest
+	36, // 84: api.ai.v1.Knowledge.ProcessDocument:input_type -> api.ai.v1.ProcessDocumentRequest
+	38, // 85: api.ai.v1.Knowledge.SearchKnowledge:input_type -> api.ai.v1.SearchKnowledgeRequest
+	40, // 86: api.ai.v1.Knowledge.HybridSearch:input_type -> api.ai.v1.HybridSearchRequest
+	43, // 87: api.ai.v1.Knowledge.AdvancedSearch:input_type -> api.ai.v1.AdvancedSearchRequest
+	48, // 88: api.ai.v1.Knowledge.GetKnowledgeChunk:input_type -> api.ai.v1.GetKnowledgeChunkRequest
+	50, // 89: api.ai.v1.Knowledge.UpdateKnowledgeChunk:input_type -> api.ai.v1.UpdateKnowledgeChunkRequest
+	52, // 90: api.ai.v1.Knowledge.ListKnowledgeChunks:input_type -> api.ai.v1.ListKnowledgeChunksRequest
+	54, // 91: api.ai.v1.Knowledge.ReindexKnowledgeBase:input_type -> api.ai.v1.ReindexKnowledgeBaseRequest
+	56, // 92: api.ai.v1.Knowledge.GetKnowledgeBaseStats:input_type -> api.ai.v1.GetKnowledgeBaseStatsRequest
+	58, // 93: api.ai.v1.Knowledge.AnalyzeKnowledgeBase:input_type -> api.ai.v1.AnalyzeKnowledgeBaseRequest
+	61, // 94: api.ai.v1.Knowledge.ShareKnowledgeBase:input_type -> api.ai.v1.ShareKnowledgeBaseRequest
+	63, // 95: api.ai.v1.Knowledge.RevokeKnowledgeBaseShare:input_type -> api.ai.v1.RevokeKnowledgeBaseShareRequest
+	65, // 96: api.ai.v1.Knowledge.ListKnowledgeBaseShares:input_type -> api.ai.v1.ListKnowledgeBaseSharesRequest
+	14, // 97: api.ai.v1.Knowledge.CreateKnowledgeBase:output_type -> api.ai.v1.CreateKnowledgeBaseReply
+	16, // 98: api.ai.v1.Knowledge.UpdateKnowledgeBase:output_type -> api.ai.v1.UpdateKnowledgeBaseReply
+	18, // 99: api.ai.v1.Knowledge.DeleteKnowledgeBase:output_type -> api.ai.v1.DeleteKnowledgeBaseReply
+	22, // 100: api.ai.v1.Knowledge.ListKnowledgeBases:output_type -> api.ai.v1.ListKnowledgeBasesReply
+	20, // 101: api.ai.v1.Knowledge.GetKnowledgeBase:output_type -> api.ai.v1.GetKnowledgeBaseReply
+	24, // 102: api.ai.v1.Knowledge.UploadDocument:output_type -> api.ai.v1.UploadDocumentReply
+	27, // 103: api.ai.v1.Knowledge.BatchUploadDocuments:output_type -> api.ai.v1.BatchUploadDocumentsReply
+	29, // 104: api.ai.v1.Knowledge.UpdateDocument:output_type -> api.ai.v1.UpdateDocumentReply
+	31, // 105: api.ai.v1.Knowledge.DeleteDocument:output_type -> api.ai.v1.DeleteDocumentReply
+	35, // 106: api.ai.v1.Knowledge.ListDocuments:output_type -> api.ai.v1.ListDocumentsReply
+	33, // 107: api.ai.v1.Knowledge.GetDocument:output_type -> api.ai.v1.GetDocumentReply
+	37, // 108: api.ai.v1.Knowledge.ProcessDocument:output_type -> api.ai.v1.ProcessDocumentReply
+	39, // 109: api.ai.v1.Knowledge.SearchKnowledge:output_type -> api.ai.v1.SearchKnowledgeReply
+	41, // 110: api.ai.v1.Knowledge.HybridSearch:output_type -> api.ai.v1.HybridSearchReply
+	47, // 111: api.ai.v1.Knowledge.AdvancedSearch:output_type -> api.ai.v1.AdvancedSearchReply
+	49, // 112: api.ai.v1.Knowledge.GetKnowledgeChunk:output_type -> api.ai.v1.GetKnowledgeChunkReply
+	51, // 113: api.ai.v1.Knowledge.UpdateKnowledgeChunk:output_type -> api.ai.v1.UpdateKnowledgeChunkReply
+	53, // 114: api.ai.v1.Knowledge.ListKnowledgeChunks:output_type -> api.ai.v1.ListKnowledgeChunksReply
+	55, // 115: api.ai.v1.Knowledge.ReindexKnowledgeBase:output_type -> api.ai.v1.ReindexKnowledgeBaseReply
+	57, // 116: api.ai.v1.Knowledge.GetKnowledgeBaseStats:output_type -> api.ai.v1.GetKnowledgeBaseStatsReply
+	59, // 117: api.ai.v1.Knowledge.AnalyzeKnowledgeBase:output_type -> api.ai.v1.AnalyzeKnowledgeBaseReply
+	62, // 118: api.ai.v1.Knowledge.ShareKnowledgeBase:output_type -> api.ai.v1.ShareKnowledgeBaseReply
+	64, // 119: api.ai.v1.Knowledge.RevokeKnowledgeBaseShare:output_type -> api.ai.v1.RevokeKnowledgeBaseShareReply
+	66, // 120: api.ai.v1.Knowledge.ListKnowledgeBaseShares:output_type -> api.ai.v1.ListKnowledgeBaseSharesReply
+	97, // [97:121] is the sub-list for method output_type
+	73, // [73:97] is the sub-list for method input_type
+	73, // [73:73] is the sub-list for extension type_name
+	73, // [73:73] is the sub-list for extension extendee
+	0,  // [0:73] is the sub-list for field type_name
 }
 
 func init() { file_api_ai_v1_knowledge_proto_init() }
@@ -5016,13 +5478,14 @@ func file_api_ai_v1_knowledge_proto_init() {
 	if File_api_ai_v1_knowledge_proto != nil {
 		return
 	}
+	file_api_ai_v1_share_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_ai_v1_knowledge_proto_rawDesc), len(file_api_ai_v1_knowledge_proto_rawDesc)),
-			NumEnums:      6,
-			NumMessages:   67,
+			NumEnums:      7,
+			NumMessages:   73,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
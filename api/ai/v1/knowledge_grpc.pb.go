@@ -19,27 +19,30 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Knowledge_CreateKnowledgeBase_FullMethodName   = "/api.ai.v1.Knowledge/CreateKnowledgeBase"
-	Knowledge_UpdateKnowledgeBase_FullMethodName   = "/api.ai.v1.Knowledge/UpdateKnowledgeBase"
-	Knowledge_DeleteKnowledgeBase_FullMethodName   = "/api.ai.v1.Knowledge/DeleteKnowledgeBase"
-	Knowledge_ListKnowledgeBases_FullMethodName    = "/api.ai.v1.Knowledge/ListKnowledgeBases"
-	Knowledge_GetKnowledgeBase_FullMethodName      = "/api.ai.v1.Knowledge/GetKnowledgeBase"
-	Knowledge_UploadDocument_FullMethodName        = "/api.ai.v1.Knowledge/UploadDocument"
-	Knowledge_BatchUploadDocuments_FullMethodName  = "/api.ai.v1.Knowledge/BatchUploadDocuments"
-	Knowledge_UpdateDocument_FullMethodName        = "/api.ai.v1.Knowledge/UpdateDocument"
-	Knowledge_DeleteDocument_FullMethodName        = "/api.ai.v1.Knowledge/DeleteDocument"
-	Knowledge_ListDocuments_FullMethodName         = "/api.ai.v1.Knowledge/ListDocuments"
-	Knowledge_GetDocument_FullMethodName           = "/api.ai.v1.Knowledge/GetDocument"
-	Knowledge_ProcessDocument_FullMethodName       = "/api.ai.v1.Knowledge/ProcessDocument"
-	Knowledge_SearchKnowledge_FullMethodName       = "/api.ai.v1.Knowledge/SearchKnowledge"
-	Knowledge_HybridSearch_FullMethodName          = "/api.ai.v1.Knowledge/HybridSearch"
-	Knowledge_AdvancedSearch_FullMethodName        = "/api.ai.v1.Knowledge/AdvancedSearch"
-	Knowledge_GetKnowledgeChunk_FullMethodName     = "/api.ai.v1.Knowledge/GetKnowledgeChunk"
-	Knowledge_UpdateKnowledgeChunk_FullMethodName  = "/api.ai.v1.Knowledge/UpdateKnowledgeChunk"
-	Knowledge_ListKnowledgeChunks_FullMethodName   = "/api.ai.v1.Knowledge/ListKnowledgeChunks"
-	Knowledge_ReindexKnowledgeBase_FullMethodName  = "/api.ai.v1.Knowledge/ReindexKnowledgeBase"
-	Knowledge_GetKnowledgeBaseStats_FullMethodName = "/api.ai.v1.Knowledge/GetKnowledgeBaseStats"
-	Knowledge_AnalyzeKnowledgeBase_FullMethodName  = "/api.ai.v1.Knowledge/AnalyzeKnowledgeBase"
+	Knowledge_CreateKnowledgeBase_FullMethodName      = "/api.ai.v1.Knowledge/CreateKnowledgeBase"
+	Knowledge_UpdateKnowledgeBase_FullMethodName      = "/api.ai.v1.Knowledge/UpdateKnowledgeBase"
+	Knowledge_DeleteKnowledgeBase_FullMethodName      = "/api.ai.v1.Knowledge/DeleteKnowledgeBase"
+	Knowledge_ListKnowledgeBases_FullMethodName       = "/api.ai.v1.Knowledge/ListKnowledgeBases"
+	Knowledge_GetKnowledgeBase_FullMethodName         = "/api.ai.v1.Knowledge/GetKnowledgeBase"
+	Knowledge_UploadDocument_FullMethodName           = "/api.ai.v1.Knowledge/UploadDocument"
+	Knowledge_BatchUploadDocuments_FullMethodName     = "/api.ai.v1.Knowledge/BatchUploadDocuments"
+	Knowledge_UpdateDocument_FullMethodName           = "/api.ai.v1.Knowledge/UpdateDocument"
+	Knowledge_DeleteDocument_FullMethodName           = "/api.ai.v1.Knowledge/DeleteDocument"
+	Knowledge_ListDocuments_FullMethodName            = "/api.ai.v1.Knowledge/ListDocuments"
+	Knowledge_GetDocument_FullMethodName              = "/api.ai.v1.Knowledge/GetDocument"
+	Knowledge_ProcessDocument_FullMethodName          = "/api.ai.v1.Knowledge/ProcessDocument"
+	Knowledge_SearchKnowledge_FullMethodName          = "/api.ai.v1.Knowledge/SearchKnowledge"
+	Knowledge_HybridSearch_FullMethodName             = "/api.ai.v1.Knowledge/HybridSearch"
+	Knowledge_AdvancedSearch_FullMethodName           = "/api.ai.v1.Knowledge/AdvancedSearch"
+	Knowledge_GetKnowledgeChunk_FullMethodName        = "/api.ai.v1.Knowledge/GetKnowledgeChunk"
+	Knowledge_UpdateKnowledgeChunk_FullMethodName     = "/api.ai.v1.Knowledge/UpdateKnowledgeChunk"
+	Knowledge_ListKnowledgeChunks_FullMethodName      = "/api.ai.v1.Knowledge/ListKnowledgeChunks"
+	Knowledge_ReindexKnowledgeBase_FullMethodName     = "/api.ai.v1.Knowledge/ReindexKnowledgeBase"
+	Knowledge_GetKnowledgeBaseStats_FullMethodName    = "/api.ai.v1.Knowledge/GetKnowledgeBaseStats"
+	Knowledge_AnalyzeKnowledgeBase_FullMethodName     = "/api.ai.v1.Knowledge/AnalyzeKnowledgeBase"
+	Knowledge_ShareKnowledgeBase_FullMethodName       = "/api.ai.v1.Knowledge/ShareKnowledgeBase"
+	Knowledge_RevokeKnowledgeBaseShare_FullMethodName = "/api.ai.v1.Knowledge/RevokeKnowledgeBaseShare"
+	Knowledge_ListKnowledgeBaseShares_FullMethodName  = "/api.ai.v1.Knowledge/ListKnowledgeBaseShares"
 )
 
 // KnowledgeClient is the client API for Knowledge service.
@@ -103,7 +106,8 @@ type KnowledgeClient interface {
 	// 获取指定文档的所有知识块
 	ListKnowledgeChunks(ctx context.Context, in *ListKnowledgeChunksRequest, opts ...grpc.CallOption) (*ListKnowledgeChunksReply, error)
 	// ReindexKnowledgeBase 重新索引知识库
-	// 重新对知识库中的所有文档进行向量化处理
+	// 重新对知识库中的所有文档进行向量化处理。任务在服务进程内运行，服务重启后中断的任务不会恢复，
+	// 知识库会停留在索引中状态，须以 force_reindex 重新发起，原任务随之取消
 	ReindexKnowledgeBase(ctx context.Context, in *ReindexKnowledgeBaseRequest, opts ...grpc.CallOption) (*ReindexKnowledgeBaseReply, error)
 	// GetKnowledgeBaseStats 获取知识库统计信息
 	// 返回知识库的详细统计数据
@@ -111,6 +115,15 @@ type KnowledgeClient interface {
 	// AnalyzeKnowledgeBase 分析知识库内容
 	// 分析知识库的内容分布、主题等
 	AnalyzeKnowledgeBase(ctx context.Context, in *AnalyzeKnowledgeBaseRequest, opts ...grpc.CallOption) (*AnalyzeKnowledgeBaseReply, error)
+	// ShareKnowledgeBase 共享知识库
+	// 将知识库以只读、编辑或管理员角色授权给其他用户，重复授权会更新角色
+	ShareKnowledgeBase(ctx context.Context, in *ShareKnowledgeBaseRequest, opts ...grpc.CallOption) (*ShareKnowledgeBaseReply, error)
+	// RevokeKnowledgeBaseShare 撤销知识库共享
+	// 撤销指定用户对知识库的访问权限，被授权用户也可以主动退出
+	RevokeKnowledgeBaseShare(ctx context.Context, in *RevokeKnowledgeBaseShareRequest, opts ...grpc.CallOption) (*RevokeKnowledgeBaseShareReply, error)
+	// ListKnowledgeBaseShares 获取知识库的共享列表
+	// 返回知识库当前所有有效的授权记录
+	ListKnowledgeBaseShares(ctx context.Context, in *ListKnowledgeBaseSharesRequest, opts ...grpc.CallOption) (*ListKnowledgeBaseSharesReply, error)
 }
 
 type knowledgeClient struct {
@@ -334,6 +347,36 @@ func (c *knowledgeClient) AnalyzeKnowledgeBase(ctx context.Context, in *AnalyzeK
 	return out, nil
 }
 
+func (c *knowledgeClient) ShareKnowledgeBase(ctx context.Context, in *ShareKnowledgeBaseRequest, opts ...grpc.CallOption) (*ShareKnowledgeBaseReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareKnowledgeBaseReply)
+	err := c.cc.Invoke(ctx, Knowledge_ShareKnowledgeBase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeClient) RevokeKnowledgeBaseShare(ctx context.Context, in *RevokeKnowledgeBaseShareRequest, opts ...grpc.CallOption) (*RevokeKnowledgeBaseShareReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeKnowledgeBaseShareReply)
+	err := c.cc.Invoke(ctx, Knowledge_RevokeKnowledgeBaseShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeClient) ListKnowledgeBaseShares(ctx context.Context, in *ListKnowledgeBaseSharesRequest, opts ...grpc.CallOption) (*ListKnowledgeBaseSharesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListKnowledgeBaseSharesReply)
+	err := c.cc.Invoke(ctx, Knowledge_ListKnowledgeBaseShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KnowledgeServer is the server API for Knowledge service.
 // All implementations must embed UnimplementedKnowledgeServer
 // for forward compatibility.
@@ -395,7 +438,8 @@ type KnowledgeServer interface {
 	// 获取指定文档的所有知识块
 	ListKnowledgeChunks(context.Context, *ListKnowledgeChunksRequest) (*ListKnowledgeChunksReply, error)
 	// ReindexKnowledgeBase 重新索引知识库
-	// 重新对知识库中的所有文档进行向量化处理
+	// 重新对知识库中的所有文档进行向量化处理。任务在服务进程内运行，服务重启后中断的任务不会恢复，
+	// 知识库会停留在索引中状态，须以 force_reindex 重新发起，原任务随之取消
 	ReindexKnowledgeBase(context.Context, *ReindexKnowledgeBaseRequest) (*ReindexKnowledgeBaseReply, error)
 	// GetKnowledgeBaseStats 获取知识库统计信息
 	// 返回知识库的详细统计数据
@@ -403,6 +447,15 @@ type KnowledgeServer interface {
 	// AnalyzeKnowledgeBase 分析知识库内容
 	// 分析知识库的内容分布、主题等
 	AnalyzeKnowledgeBase(context.Context, *AnalyzeKnowledgeBaseRequest) (*AnalyzeKnowledgeBaseReply, error)
+	// ShareKnowledgeBase 共享知识库
+	// 将知识库以只读、编辑或管理员角色授权给其他用户，重复授权会更新角色
+	ShareKnowledgeBase(context.Context, *ShareKnowledgeBaseRequest) (*ShareKnowledgeBaseReply, error)
+	// RevokeKnowledgeBaseShare 撤销知识库共享
+	// 撤销指定用户对知识库的访问权限，被授权用户也可以主动退出
+	RevokeKnowledgeBaseShare(context.Context, *RevokeKnowledgeBaseShareRequest) (*RevokeKnowledgeBaseShareReply, error)
+	// ListKnowledgeBaseShares 获取知识库的共享列表
+	// 返回知识库当前所有有效的授权记录
+	ListKnowledgeBaseShares(context.Context, *ListKnowledgeBaseSharesRequest) (*ListKnowledgeBaseSharesReply, error)
 	mustEmbedUnimplementedKnowledgeServer()
 }
 
@@ -476,6 +529,15 @@ func (UnimplementedKnowledgeServer) GetKnowledgeBaseStats(context.Context, *GetK
 func (UnimplementedKnowledgeServer) AnalyzeKnowledgeBase(context.Context, *AnalyzeKnowledgeBaseRequest) (*AnalyzeKnowledgeBaseReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnalyzeKnowledgeBase not implemented")
 }
+func (UnimplementedKnowledgeServer) ShareKnowledgeBase(context.Context, *ShareKnowledgeBaseRequest) (*ShareKnowledgeBaseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareKnowledgeBase not implemented")
+}
+func (UnimplementedKnowledgeServer) RevokeKnowledgeBaseShare(context.Context, *RevokeKnowledgeBaseShareRequest) (*RevokeKnowledgeBaseShareReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeKnowledgeBaseShare not implemented")
+}
+func (UnimplementedKnowledgeServer) ListKnowledgeBaseShares(context.Context, *ListKnowledgeBaseSharesRequest) (*ListKnowledgeBaseSharesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKnowledgeBaseShares not implemented")
+}
 func (UnimplementedKnowledgeServer) mustEmbedUnimplementedKnowledgeServer() {}
 func (UnimplementedKnowledgeServer) testEmbeddedByValue()                   {}
 
@@ -864,6 +926,60 @@ func _Knowledge_AnalyzeKnowledgeBase_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Knowledge_ShareKnowledgeBase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareKnowledgeBaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeServer).ShareKnowledgeBase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Knowledge_ShareKnowledgeBase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeServer).ShareKnowledgeBase(ctx, req.(*ShareKnowledgeBaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Knowledge_RevokeKnowledgeBaseShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeKnowledgeBaseShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeServer).RevokeKnowledgeBaseShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Knowledge_RevokeKnowledgeBaseShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeServer).RevokeKnowledgeBaseShare(ctx, req.(*RevokeKnowledgeBaseShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Knowledge_ListKnowledgeBaseShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKnowledgeBaseSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeServer).ListKnowledgeBaseShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Knowledge_ListKnowledgeBaseShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeServer).ListKnowledgeBaseShares(ctx, req.(*ListKnowledgeBaseSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Knowledge_ServiceDesc is the grpc.ServiceDesc for Knowledge service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -951,6 +1067,18 @@ var Knowledge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AnalyzeKnowledgeBase",
 			Handler:    _Knowledge_AnalyzeKnowledgeBase_Handler,
 		},
+		{
+			MethodName: "ShareKnowledgeBase",
+			Handler:    _Knowledge_ShareKnowledgeBase_Handler,
+		},
+		{
+			MethodName: "RevokeKnowledgeBaseShare",
+			Handler:    _Knowledge_RevokeKnowledgeBaseShare_Handler,
+		},
+		{
+			MethodName: "ListKnowledgeBaseShares",
+			Handler:    _Knowledge_ListKnowledgeBaseShares_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
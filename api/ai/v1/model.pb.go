@@ -147,6 +147,107 @@ func (x *Provider) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+// 用户或工作空间自有的提供商凭证
+type ProviderCredential struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                      // 凭证ID
+	ProviderId    int64                  `protobuf:"varint,2,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`    // 提供商ID
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // 所属用户ID，工作空间凭证为0
+	WorkspaceId   int64                  `protobuf:"varint,4,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"` // 所属工作空间ID，个人凭证为0
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`                                   // 凭证名称
+	ApiKey        string                 `protobuf:"bytes,6,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`                 // API密钥(脱敏)
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`        // 创建时间
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`        // 更新时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderCredential) Reset() {
+	*x = ProviderCredential{}
+	mi := &file_api_ai_v1_model_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderCredential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderCredential) ProtoMessage() {}
+
+func (x *ProviderCredential) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_model_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderCredential.ProtoReflect.Descriptor instead.
+func (*ProviderCredential) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProviderCredential) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProviderCredential) GetProviderId() int64 {
+	if x != nil {
+		return x.ProviderId
+	}
+	return 0
+}
+
+func (x *ProviderCredential) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ProviderCredential) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *ProviderCredential) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProviderCredential) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *ProviderCredential) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ProviderCredential) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
 // AI模型信息
 type ModelInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -169,7 +270,7 @@ type ModelInfo struct {
 
 func (x *ModelInfo) Reset() {
 	*x = ModelInfo{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[1]
+	mi := &file_api_ai_v1_model_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -181,7 +282,7 @@ func (x *ModelInfo) String() string {
 func (*ModelInfo) ProtoMessage() {}
 
 func (x *ModelInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[1]
+	mi := &file_api_ai_v1_model_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -194,7 +295,7 @@ func (x *ModelInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModelInfo.ProtoReflect.Descriptor instead.
 func (*ModelInfo) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{1}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ModelInfo) GetId() int64 {
@@ -305,7 +406,7 @@ type ModelCapabilities struct {
 
 func (x *ModelCapabilities) Reset() {
 	*x = ModelCapabilities{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[2]
+	mi := &file_api_ai_v1_model_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -317,7 +418,7 @@ func (x *ModelCapabilities) String() string {
 func (*ModelCapabilities) ProtoMessage() {}
 
 func (x *ModelCapabilities) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[2]
+	mi := &file_api_ai_v1_model_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -330,7 +431,7 @@ func (x *ModelCapabilities) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModelCapabilities.ProtoReflect.Descriptor instead.
 func (*ModelCapabilities) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{2}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ModelCapabilities) GetSupportsChat() bool {
@@ -406,7 +507,7 @@ type ModelLimits struct {
 
 func (x *ModelLimits) Reset() {
 	*x = ModelLimits{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[3]
+	mi := &file_api_ai_v1_model_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -418,7 +519,7 @@ func (x *ModelLimits) String() string {
 func (*ModelLimits) ProtoMessage() {}
 
 func (x *ModelLimits) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[3]
+	mi := &file_api_ai_v1_model_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -431,7 +532,7 @@ func (x *ModelLimits) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModelLimits.ProtoReflect.Descriptor instead.
 func (*ModelLimits) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{3}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ModelLimits) GetMaxTokens() int32 {
@@ -492,18 +593,20 @@ func (x *ModelLimits) GetMaxTokensPerMinute() int32 {
 
 // 定价信息
 type ModelPricing struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	InputPricePerToken  float64                `protobuf:"fixed64,1,opt,name=input_price_per_token,json=inputPricePerToken,proto3" json:"input_price_per_token,omitempty"`    // 输入token单价
-	OutputPricePerToken float64                `protobuf:"fixed64,2,opt,name=output_price_per_token,json=outputPricePerToken,proto3" json:"output_price_per_token,omitempty"` // 输出token单价
-	Currency            string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`                                                        // 货币单位
-	BatchDiscount       float64                `protobuf:"fixed64,4,opt,name=batch_discount,json=batchDiscount,proto3" json:"batch_discount,omitempty"`                       // 批量折扣
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	InputPricePerToken       float64                `protobuf:"fixed64,1,opt,name=input_price_per_token,json=inputPricePerToken,proto3" json:"input_price_per_token,omitempty"`                     // 输入token单价
+	OutputPricePerToken      float64                `protobuf:"fixed64,2,opt,name=output_price_per_token,json=outputPricePerToken,proto3" json:"output_price_per_token,omitempty"`                  // 输出token单价
+	Currency                 string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`                                                                         // 货币单位
+	BatchDiscount            float64                `protobuf:"fixed64,4,opt,name=batch_discount,json=batchDiscount,proto3" json:"batch_discount,omitempty"`                                        // 批量折扣
+	CachedInputPricePerToken float64                `protobuf:"fixed64,5,opt,name=cached_input_price_per_token,json=cachedInputPricePerToken,proto3" json:"cached_input_price_per_token,omitempty"` // 命中缓存的输入token单价，0表示按输入单价计费
+	PricePerRequest          float64                `protobuf:"fixed64,6,opt,name=price_per_request,json=pricePerRequest,proto3" json:"price_per_request,omitempty"`                                // 每次请求的固定费用
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
 func (x *ModelPricing) Reset() {
 	*x = ModelPricing{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[4]
+	mi := &file_api_ai_v1_model_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -515,7 +618,7 @@ func (x *ModelPricing) String() string {
 func (*ModelPricing) ProtoMessage() {}
 
 func (x *ModelPricing) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[4]
+	mi := &file_api_ai_v1_model_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -528,7 +631,7 @@ func (x *ModelPricing) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModelPricing.ProtoReflect.Descriptor instead.
 func (*ModelPricing) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{4}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ModelPricing) GetInputPricePerToken() float64 {
@@ -559,6 +662,20 @@ func (x *ModelPricing) GetBatchDiscount() float64 {
 	return 0
 }
 
+func (x *ModelPricing) GetCachedInputPricePerToken() float64 {
+	if x != nil {
+		return x.CachedInputPricePerToken
+	}
+	return 0
+}
+
+func (x *ModelPricing) GetPricePerRequest() float64 {
+	if x != nil {
+		return x.PricePerRequest
+	}
+	return 0
+}
+
 // 用户配额信息
 type UserQuota struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
@@ -580,7 +697,7 @@ type UserQuota struct {
 
 func (x *UserQuota) Reset() {
 	*x = UserQuota{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[5]
+	mi := &file_api_ai_v1_model_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -592,7 +709,7 @@ func (x *UserQuota) String() string {
 func (*UserQuota) ProtoMessage() {}
 
 func (x *UserQuota) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[5]
+	mi := &file_api_ai_v1_model_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -605,7 +722,7 @@ func (x *UserQuota) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserQuota.ProtoReflect.Descriptor instead.
 func (*UserQuota) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{5}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UserQuota) GetUserId() int64 {
@@ -712,7 +829,7 @@ type UsageStats struct {
 
 func (x *UsageStats) Reset() {
 	*x = UsageStats{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[6]
+	mi := &file_api_ai_v1_model_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -724,7 +841,7 @@ func (x *UsageStats) String() string {
 func (*UsageStats) ProtoMessage() {}
 
 func (x *UsageStats) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[6]
+	mi := &file_api_ai_v1_model_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -737,7 +854,7 @@ func (x *UsageStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsageStats.ProtoReflect.Descriptor instead.
 func (*UsageStats) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{6}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UsageStats) GetUserId() int64 {
@@ -837,7 +954,7 @@ type RateLimitConfig struct {
 
 func (x *RateLimitConfig) Reset() {
 	*x = RateLimitConfig{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[7]
+	mi := &file_api_ai_v1_model_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -849,7 +966,7 @@ func (x *RateLimitConfig) String() string {
 func (*RateLimitConfig) ProtoMessage() {}
 
 func (x *RateLimitConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[7]
+	mi := &file_api_ai_v1_model_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -862,7 +979,7 @@ func (x *RateLimitConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RateLimitConfig.ProtoReflect.Descriptor instead.
 func (*RateLimitConfig) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{7}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *RateLimitConfig) GetId() int64 {
@@ -953,13 +1070,16 @@ type ModelHealth struct {
 	FailedRequests int64                  `protobuf:"varint,6,opt,name=failed_requests,json=failedRequests,proto3" json:"failed_requests,omitempty"` // 失败请求数
 	ErrorMessage   string                 `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`        // 错误信息
 	LastCheck      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_check,json=lastCheck,proto3" json:"last_check,omitempty"`                 // 最后检查时间
+	LatencyP50     float64                `protobuf:"fixed64,9,opt,name=latency_p50,json=latencyP50,proto3" json:"latency_p50,omitempty"`            // 延迟P50(毫秒)
+	LatencyP95     float64                `protobuf:"fixed64,10,opt,name=latency_p95,json=latencyP95,proto3" json:"latency_p95,omitempty"`           // 延迟P95(毫秒)
+	LatencyP99     float64                `protobuf:"fixed64,11,opt,name=latency_p99,json=latencyP99,proto3" json:"latency_p99,omitempty"`           // 延迟P99(毫秒)
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ModelHealth) Reset() {
 	*x = ModelHealth{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[8]
+	mi := &file_api_ai_v1_model_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -971,7 +1091,7 @@ func (x *ModelHealth) String() string {
 func (*ModelHealth) ProtoMessage() {}
 
 func (x *ModelHealth) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[8]
+	mi := &file_api_ai_v1_model_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -984,7 +1104,7 @@ func (x *ModelHealth) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModelHealth.ProtoReflect.Descriptor instead.
 func (*ModelHealth) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{8}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ModelHealth) GetModelId() int64 {
@@ -1043,6 +1163,27 @@ func (x *ModelHealth) GetLastCheck() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ModelHealth) GetLatencyP50() float64 {
+	if x != nil {
+		return x.LatencyP50
+	}
+	return 0
+}
+
+func (x *ModelHealth) GetLatencyP95() float64 {
+	if x != nil {
+		return x.LatencyP95
+	}
+	return 0
+}
+
+func (x *ModelHealth) GetLatencyP99() float64 {
+	if x != nil {
+		return x.LatencyP99
+	}
+	return 0
+}
+
 // 创建提供商
 type CreateProviderRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -1059,7 +1200,7 @@ type CreateProviderRequest struct {
 
 func (x *CreateProviderRequest) Reset() {
 	*x = CreateProviderRequest{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[9]
+	mi := &file_api_ai_v1_model_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1071,7 +1212,7 @@ func (x *CreateProviderRequest) String() string {
 func (*CreateProviderRequest) ProtoMessage() {}
 
 func (x *CreateProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[9]
+	mi := &file_api_ai_v1_model_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1084,7 +1225,7 @@ func (x *CreateProviderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProviderRequest.ProtoReflect.Descriptor instead.
 func (*CreateProviderRequest) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{9}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CreateProviderRequest) GetName() string {
@@ -1145,7 +1286,7 @@ type CreateProviderReply struct {
 
 func (x *CreateProviderReply) Reset() {
 	*x = CreateProviderReply{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[10]
+	mi := &file_api_ai_v1_model_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1157,7 +1298,7 @@ func (x *CreateProviderReply) String() string {
 func (*CreateProviderReply) ProtoMessage() {}
 
 func (x *CreateProviderReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[10]
+	mi := &file_api_ai_v1_model_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1170,7 +1311,7 @@ func (x *CreateProviderReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProviderReply.ProtoReflect.Descriptor instead.
 func (*CreateProviderReply) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{10}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CreateProviderReply) GetProvider() *Provider {
@@ -1197,7 +1338,7 @@ type UpdateProviderRequest struct {
 
 func (x *UpdateProviderRequest) Reset() {
 	*x = UpdateProviderRequest{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[11]
+	mi := &file_api_ai_v1_model_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1209,7 +1350,7 @@ func (x *UpdateProviderRequest) String() string {
 func (*UpdateProviderRequest) ProtoMessage() {}
 
 func (x *UpdateProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[11]
+	mi := &file_api_ai_v1_model_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1222,7 +1363,7 @@ func (x *UpdateProviderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProviderRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProviderRequest) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{11}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateProviderRequest) GetId() int64 {
@@ -1290,7 +1431,7 @@ type UpdateProviderReply struct {
 
 func (x *UpdateProviderReply) Reset() {
 	*x = UpdateProviderReply{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[12]
+	mi := &file_api_ai_v1_model_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1302,7 +1443,7 @@ func (x *UpdateProviderReply) String() string {
 func (*UpdateProviderReply) ProtoMessage() {}
 
 func (x *UpdateProviderReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[12]
+	mi := &file_api_ai_v1_model_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1315,7 +1456,7 @@ func (x *UpdateProviderReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProviderReply.ProtoReflect.Descriptor instead.
 func (*UpdateProviderReply) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{12}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdateProviderReply) GetProvider() *Provider {
@@ -1335,7 +1476,7 @@ type DeleteProviderRequest struct {
 
 func (x *DeleteProviderRequest) Reset() {
 	*x = DeleteProviderRequest{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[13]
+	mi := &file_api_ai_v1_model_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1347,7 +1488,7 @@ func (x *DeleteProviderRequest) String() string {
 func (*DeleteProviderRequest) ProtoMessage() {}
 
 func (x *DeleteProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[13]
+	mi := &file_api_ai_v1_model_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1360,7 +1501,7 @@ func (x *DeleteProviderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProviderRequest.ProtoReflect.Descriptor instead.
 func (*DeleteProviderRequest) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{13}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DeleteProviderRequest) GetId() int64 {
@@ -1378,7 +1519,7 @@ type DeleteProviderReply struct {
 
 func (x *DeleteProviderReply) Reset() {
 	*x = DeleteProviderReply{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[14]
+	mi := &file_api_ai_v1_model_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1390,7 +1531,7 @@ func (x *DeleteProviderReply) String() string {
 func (*DeleteProviderReply) ProtoMessage() {}
 
 func (x *DeleteProviderReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[14]
+	mi := &file_api_ai_v1_model_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1403,7 +1544,7 @@ func (x *DeleteProviderReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProviderReply.ProtoReflect.Descriptor instead.
 func (*DeleteProviderReply) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{14}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{15}
 }
 
 // 列出提供商
@@ -1418,7 +1559,7 @@ type ListProvidersRequest struct {
 
 func (x *ListProvidersRequest) Reset() {
 	*x = ListProvidersRequest{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[15]
+	mi := &file_api_ai_v1_model_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1430,7 +1571,7 @@ func (x *ListProvidersRequest) String() string {
 func (*ListProvidersRequest) ProtoMessage() {}
 
 func (x *ListProvidersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[15]
+	mi := &file_api_ai_v1_model_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1443,53 +1584,528 @@ func (x *ListProvidersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProvidersRequest.ProtoReflect.Descriptor instead.
 func (*ListProvidersRequest) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{15}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListProvidersRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ListProvidersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListProvidersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListProvidersReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Providers     []*Provider            `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"` // 提供商列表
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`        // 总数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProvidersReply) Reset() {
+	*x = ListProvidersReply{}
+	mi := &file_api_ai_v1_model_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProvidersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProvidersReply) ProtoMessage() {}
+
+func (x *ListProvidersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_model_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProvidersReply.ProtoReflect.Descriptor instead.
+func (*ListProvidersReply) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListProvidersReply) GetProviders() []*Provider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+func (x *ListProvidersReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 测试提供商
+type TestProviderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                      // 提供商ID
+	ApiKey        string                 `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"` // 测试用API密钥(可选)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestProviderRequest) Reset() {
+	*x = TestProviderRequest{}
+	mi := &file_api_ai_v1_model_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestProviderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestProviderRequest) ProtoMessage() {}
+
+func (x *TestProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_model_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestProviderRequest.ProtoReflect.Descriptor instead.
+func (*TestProviderRequest) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TestProviderRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TestProviderRequest) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+type TestProviderReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsAvailable   bool                   `protobuf:"varint,1,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`     // 是否可用
+	ResponseTime  float64                `protobuf:"fixed64,2,opt,name=response_time,json=responseTime,proto3" json:"response_time,omitempty"` // 响应时间(毫秒)
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`   // 错误信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestProviderReply) Reset() {
+	*x = TestProviderReply{}
+	mi := &file_api_ai_v1_model_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestProviderReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestProviderReply) ProtoMessage() {}
+
+func (x *TestProviderReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_model_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestProviderReply.ProtoReflect.Descriptor instead.
+func (*TestProviderReply) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TestProviderReply) GetIsAvailable() bool {
+	if x != nil {
+		return x.IsAvailable
+	}
+	return false
+}
+
+func (x *TestProviderReply) GetResponseTime() float64 {
+	if x != nil {
+		return x.ResponseTime
+	}
+	return 0
+}
+
+func (x *TestProviderReply) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// 同步提供商模型
+type SyncProviderModelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderId    int64                  `protobuf:"varint,1,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"` // 提供商ID
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`             // 只计算变更，不写入
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncProviderModelsRequest) Reset() {
+	*x = SyncProviderModelsRequest{}
+	mi := &file_api_ai_v1_model_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncProviderModelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncProviderModelsRequest) ProtoMessage() {}
+
+func (x *SyncProviderModelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_model_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncProviderModelsRequest.ProtoReflect.Descriptor instead.
+func (*SyncProviderModelsRequest) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SyncProviderModelsRequest) GetProviderId() int64 {
+	if x != nil {
+		return x.ProviderId
+	}
+	return 0
+}
+
+func (x *SyncProviderModelsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type SyncProviderModelsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Added         []string               `protobuf:"bytes,1,rep,name=added,proto3" json:"added,omitempty"`          // 新增的模型
+	Restored      []string               `protobuf:"bytes,2,rep,name=restored,proto3" json:"restored,omitempty"`    // 重新出现并恢复可用的模型
+	Removed       []string               `protobuf:"bytes,3,rep,name=removed,proto3" json:"removed,omitempty"`      // 提供商已下线、标记为不可用的模型
+	Conflicts     []string               `protobuf:"bytes,4,rep,name=conflicts,proto3" json:"conflicts,omitempty"`  // 名称已被其他提供商的模型占用而跳过的模型
+	Unchanged     int32                  `protobuf:"varint,5,opt,name=unchanged,proto3" json:"unchanged,omitempty"` // 未变化的模型数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncProviderModelsReply) Reset() {
+	*x = SyncProviderModelsReply{}
+	mi := &file_api_ai_v1_model_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncProviderModelsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncProviderModelsReply) ProtoMessage() {}
+
+func (x *SyncProviderModelsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_model_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncProviderModelsReply.ProtoReflect.Descriptor instead.
+func (*SyncProviderModelsReply) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SyncProviderModelsReply) GetAdded() []string {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *SyncProviderModelsReply) GetRestored() []string {
+	if x != nil {
+		return x.Restored
+	}
+	return nil
+}
+
+func (x *SyncProviderModelsReply) GetRemoved() []string {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *SyncProviderModelsReply) GetConflicts() []string {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *SyncProviderModelsReply) GetUnchanged() int32 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+// 保存自有凭证
+type SetProviderCredentialRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // 操作用户ID
+	WorkspaceId   int64                  `protobuf:"varint,2,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"` // 工作空间ID，非0时保存为工作空间凭证，需要管理员权限
+	ProviderId    int64                  `protobuf:"varint,3,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`    // 提供商ID
+	ApiKey        string                 `protobuf:"bytes,4,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`                 // API密钥
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`                                   // 凭证名称
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProviderCredentialRequest) Reset() {
+	*x = SetProviderCredentialRequest{}
+	mi := &file_api_ai_v1_model_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProviderCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProviderCredentialRequest) ProtoMessage() {}
+
+func (x *SetProviderCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_model_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProviderCredentialRequest.ProtoReflect.Descriptor instead.
+func (*SetProviderCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetProviderCredentialRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetProviderCredentialRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *SetProviderCredentialRequest) GetProviderId() int64 {
+	if x != nil {
+		return x.ProviderId
+	}
+	return 0
+}
+
+func (x *SetProviderCredentialRequest) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *SetProviderCredentialRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SetProviderCredentialReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Credential    *ProviderCredential    `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"` // 保存后的凭证
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProviderCredentialReply) Reset() {
+	*x = SetProviderCredentialReply{}
+	mi := &file_api_ai_v1_model_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProviderCredentialReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProviderCredentialReply) ProtoMessage() {}
+
+func (x *SetProviderCredentialReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_model_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProviderCredentialReply.ProtoReflect.Descriptor instead.
+func (*SetProviderCredentialReply) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetProviderCredentialReply) GetCredential() *ProviderCredential {
+	if x != nil {
+		return x.Credential
+	}
+	return nil
+}
+
+// 删除自有凭证
+type DeleteProviderCredentialRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // 操作用户ID
+	WorkspaceId   int64                  `protobuf:"varint,2,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"` // 工作空间ID，非0时删除工作空间凭证，需要管理员权限
+	ProviderId    int64                  `protobuf:"varint,3,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`    // 提供商ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProviderCredentialRequest) Reset() {
+	*x = DeleteProviderCredentialRequest{}
+	mi := &file_api_ai_v1_model_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProviderCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProviderCredentialRequest) ProtoMessage() {}
+
+func (x *DeleteProviderCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_model_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProviderCredentialRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProviderCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ListProvidersRequest) GetStatus() int32 {
+func (x *DeleteProviderCredentialRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Status
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *ListProvidersRequest) GetPage() int32 {
+func (x *DeleteProviderCredentialRequest) GetWorkspaceId() int64 {
 	if x != nil {
-		return x.Page
+		return x.WorkspaceId
 	}
 	return 0
 }
 
-func (x *ListProvidersRequest) GetPageSize() int32 {
+func (x *DeleteProviderCredentialRequest) GetProviderId() int64 {
 	if x != nil {
-		return x.PageSize
+		return x.ProviderId
 	}
 	return 0
 }
 
-type ListProvidersReply struct {
+type DeleteProviderCredentialReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Providers     []*Provider            `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"` // 提供商列表
-	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`        // 总数
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProvidersReply) Reset() {
-	*x = ListProvidersReply{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[16]
+func (x *DeleteProviderCredentialReply) Reset() {
+	*x = DeleteProviderCredentialReply{}
+	mi := &file_api_ai_v1_model_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProvidersReply) String() string {
+func (x *DeleteProviderCredentialReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProvidersReply) ProtoMessage() {}
+func (*DeleteProviderCredentialReply) ProtoMessage() {}
 
-func (x *ListProvidersReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[16]
+func (x *DeleteProviderCredentialReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_model_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1500,49 +2116,42 @@ func (x *ListProvidersReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProvidersReply.ProtoReflect.Descriptor instead.
-func (*ListProvidersReply) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *ListProvidersReply) GetProviders() []*Provider {
-	if x != nil {
-		return x.Providers
-	}
-	return nil
+// Deprecated: Use DeleteProviderCredentialReply.ProtoReflect.Descriptor instead.
+func (*DeleteProviderCredentialReply) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ListProvidersReply) GetTotal() int64 {
+func (x *DeleteProviderCredentialReply) GetSuccess() bool {
 	if x != nil {
-		return x.Total
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-// 测试提供商
-type TestProviderRequest struct {
+// 获取自有凭证列表
+type ListProviderCredentialsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                      // 提供商ID
-	ApiKey        string                 `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"` // 测试用API密钥(可选)
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // 用户ID
+	WorkspaceId   int64                  `protobuf:"varint,2,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"` // 工作空间ID，非0时返回工作空间凭证
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TestProviderRequest) Reset() {
-	*x = TestProviderRequest{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[17]
+func (x *ListProviderCredentialsRequest) Reset() {
+	*x = ListProviderCredentialsRequest{}
+	mi := &file_api_ai_v1_model_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TestProviderRequest) String() string {
+func (x *ListProviderCredentialsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestProviderRequest) ProtoMessage() {}
+func (*ListProviderCredentialsRequest) ProtoMessage() {}
 
-func (x *TestProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[17]
+func (x *ListProviderCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_model_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1553,49 +2162,47 @@ func (x *TestProviderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestProviderRequest.ProtoReflect.Descriptor instead.
-func (*TestProviderRequest) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ListProviderCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*ListProviderCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *TestProviderRequest) GetId() int64 {
+func (x *ListProviderCredentialsRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *TestProviderRequest) GetApiKey() string {
+func (x *ListProviderCredentialsRequest) GetWorkspaceId() int64 {
 	if x != nil {
-		return x.ApiKey
+		return x.WorkspaceId
 	}
-	return ""
+	return 0
 }
 
-type TestProviderReply struct {
+type ListProviderCredentialsReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsAvailable   bool                   `protobuf:"varint,1,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`     // 是否可用
-	ResponseTime  float64                `protobuf:"fixed64,2,opt,name=response_time,json=responseTime,proto3" json:"response_time,omitempty"` // 响应时间(毫秒)
-	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`   // 错误信息
+	Credentials   []*ProviderCredential  `protobuf:"bytes,1,rep,name=credentials,proto3" json:"credentials,omitempty"` // 凭证列表
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TestProviderReply) Reset() {
-	*x = TestProviderReply{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[18]
+func (x *ListProviderCredentialsReply) Reset() {
+	*x = ListProviderCredentialsReply{}
+	mi := &file_api_ai_v1_model_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TestProviderReply) String() string {
+func (x *ListProviderCredentialsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestProviderReply) ProtoMessage() {}
+func (*ListProviderCredentialsReply) ProtoMessage() {}
 
-func (x *TestProviderReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[18]
+func (x *ListProviderCredentialsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ai_v1_model_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1606,30 +2213,16 @@ func (x *TestProviderReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestProviderReply.ProtoReflect.Descriptor instead.
-func (*TestProviderReply) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *TestProviderReply) GetIsAvailable() bool {
-	if x != nil {
-		return x.IsAvailable
-	}
-	return false
-}
-
-func (x *TestProviderReply) GetResponseTime() float64 {
-	if x != nil {
-		return x.ResponseTime
-	}
-	return 0
+// Deprecated: Use ListProviderCredentialsReply.ProtoReflect.Descriptor instead.
+func (*ListProviderCredentialsReply) Descriptor() ([]byte, []int) {
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *TestProviderReply) GetErrorMessage() string {
+func (x *ListProviderCredentialsReply) GetCredentials() []*ProviderCredential {
 	if x != nil {
-		return x.ErrorMessage
+		return x.Credentials
 	}
-	return ""
+	return nil
 }
 
 // 创建模型
@@ -1650,7 +2243,7 @@ type CreateModelRequest struct {
 
 func (x *CreateModelRequest) Reset() {
 	*x = CreateModelRequest{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[19]
+	mi := &file_api_ai_v1_model_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1662,7 +2255,7 @@ func (x *CreateModelRequest) String() string {
 func (*CreateModelRequest) ProtoMessage() {}
 
 func (x *CreateModelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[19]
+	mi := &file_api_ai_v1_model_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1675,7 +2268,7 @@ func (x *CreateModelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateModelRequest.ProtoReflect.Descriptor instead.
 func (*CreateModelRequest) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{19}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *CreateModelRequest) GetProviderId() int64 {
@@ -1750,7 +2343,7 @@ type CreateModelReply struct {
 
 func (x *CreateModelReply) Reset() {
 	*x = CreateModelReply{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[20]
+	mi := &file_api_ai_v1_model_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1762,7 +2355,7 @@ func (x *CreateModelReply) String() string {
 func (*CreateModelReply) ProtoMessage() {}
 
 func (x *CreateModelReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[20]
+	mi := &file_api_ai_v1_model_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1775,7 +2368,7 @@ func (x *CreateModelReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateModelReply.ProtoReflect.Descriptor instead.
 func (*CreateModelReply) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{20}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *CreateModelReply) GetModel() *ModelInfo {
@@ -1802,7 +2395,7 @@ type UpdateModelRequest struct {
 
 func (x *UpdateModelRequest) Reset() {
 	*x = UpdateModelRequest{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[21]
+	mi := &file_api_ai_v1_model_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1814,7 +2407,7 @@ func (x *UpdateModelRequest) String() string {
 func (*UpdateModelRequest) ProtoMessage() {}
 
 func (x *UpdateModelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[21]
+	mi := &file_api_ai_v1_model_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1827,7 +2420,7 @@ func (x *UpdateModelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateModelRequest.ProtoReflect.Descriptor instead.
 func (*UpdateModelRequest) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{21}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *UpdateModelRequest) GetId() int64 {
@@ -1895,7 +2488,7 @@ type UpdateModelReply struct {
 
 func (x *UpdateModelReply) Reset() {
 	*x = UpdateModelReply{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[22]
+	mi := &file_api_ai_v1_model_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1907,7 +2500,7 @@ func (x *UpdateModelReply) String() string {
 func (*UpdateModelReply) ProtoMessage() {}
 
 func (x *UpdateModelReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[22]
+	mi := &file_api_ai_v1_model_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1920,7 +2513,7 @@ func (x *UpdateModelReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateModelReply.ProtoReflect.Descriptor instead.
 func (*UpdateModelReply) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{22}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *UpdateModelReply) GetModel() *ModelInfo {
@@ -1940,7 +2533,7 @@ type DeleteModelRequest struct {
 
 func (x *DeleteModelRequest) Reset() {
 	*x = DeleteModelRequest{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[23]
+	mi := &file_api_ai_v1_model_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1952,7 +2545,7 @@ func (x *DeleteModelRequest) String() string {
 func (*DeleteModelRequest) ProtoMessage() {}
 
 func (x *DeleteModelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[23]
+	mi := &file_api_ai_v1_model_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1965,7 +2558,7 @@ func (x *DeleteModelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteModelRequest.ProtoReflect.Descriptor instead.
 func (*DeleteModelRequest) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{23}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *DeleteModelRequest) GetId() int64 {
@@ -1983,7 +2576,7 @@ type DeleteModelReply struct {
 
 func (x *DeleteModelReply) Reset() {
 	*x = DeleteModelReply{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[24]
+	mi := &file_api_ai_v1_model_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1995,7 +2588,7 @@ func (x *DeleteModelReply) String() string {
 func (*DeleteModelReply) ProtoMessage() {}
 
 func (x *DeleteModelReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[24]
+	mi := &file_api_ai_v1_model_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2008,7 +2601,7 @@ func (x *DeleteModelReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteModelReply.ProtoReflect.Descriptor instead.
 func (*DeleteModelReply) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{24}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{33}
 }
 
 // 列出模型
@@ -2026,7 +2619,7 @@ type ListModelsRequest struct {
 
 func (x *ListModelsRequest) Reset() {
 	*x = ListModelsRequest{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[25]
+	mi := &file_api_ai_v1_model_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2038,7 +2631,7 @@ func (x *ListModelsRequest) String() string {
 func (*ListModelsRequest) ProtoMessage() {}
 
 func (x *ListModelsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[25]
+	mi := &file_api_ai_v1_model_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2051,7 +2644,7 @@ func (x *ListModelsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListModelsRequest.ProtoReflect.Descriptor instead.
 func (*ListModelsRequest) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{25}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ListModelsRequest) GetProviderId() int64 {
@@ -2106,7 +2699,7 @@ type ListModelsReply struct {
 
 func (x *ListModelsReply) Reset() {
 	*x = ListModelsReply{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[26]
+	mi := &file_api_ai_v1_model_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2118,7 +2711,7 @@ func (x *ListModelsReply) String() string {
 func (*ListModelsReply) ProtoMessage() {}
 
 func (x *ListModelsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[26]
+	mi := &file_api_ai_v1_model_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2131,7 +2724,7 @@ func (x *ListModelsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListModelsReply.ProtoReflect.Descriptor instead.
 func (*ListModelsReply) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{26}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ListModelsReply) GetModels() []*ModelInfo {
@@ -2158,7 +2751,7 @@ type GetModelRequest struct {
 
 func (x *GetModelRequest) Reset() {
 	*x = GetModelRequest{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[27]
+	mi := &file_api_ai_v1_model_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2170,7 +2763,7 @@ func (x *GetModelRequest) String() string {
 func (*GetModelRequest) ProtoMessage() {}
 
 func (x *GetModelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[27]
+	mi := &file_api_ai_v1_model_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2183,7 +2776,7 @@ func (x *GetModelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetModelRequest.ProtoReflect.Descriptor instead.
 func (*GetModelRequest) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{27}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetModelRequest) GetId() int64 {
@@ -2202,7 +2795,7 @@ type GetModelReply struct {
 
 func (x *GetModelReply) Reset() {
 	*x = GetModelReply{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[28]
+	mi := &file_api_ai_v1_model_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2214,7 +2807,7 @@ func (x *GetModelReply) String() string {
 func (*GetModelReply) ProtoMessage() {}
 
 func (x *GetModelReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[28]
+	mi := &file_api_ai_v1_model_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2227,7 +2820,7 @@ func (x *GetModelReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetModelReply.ProtoReflect.Descriptor instead.
 func (*GetModelReply) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{28}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *GetModelReply) GetModel() *ModelInfo {
@@ -2248,7 +2841,7 @@ type SwitchModelRequest struct {
 
 func (x *SwitchModelRequest) Reset() {
 	*x = SwitchModelRequest{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[29]
+	mi := &file_api_ai_v1_model_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2260,7 +2853,7 @@ func (x *SwitchModelRequest) String() string {
 func (*SwitchModelRequest) ProtoMessage() {}
 
 func (x *SwitchModelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[29]
+	mi := &file_api_ai_v1_model_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2273,7 +2866,7 @@ func (x *SwitchModelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SwitchModelRequest.ProtoReflect.Descriptor instead.
 func (*SwitchModelRequest) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{29}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *SwitchModelRequest) GetUserId() int64 {
@@ -2299,7 +2892,7 @@ type SwitchModelReply struct {
 
 func (x *SwitchModelReply) Reset() {
 	*x = SwitchModelReply{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[30]
+	mi := &file_api_ai_v1_model_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2311,7 +2904,7 @@ func (x *SwitchModelReply) String() string {
 func (*SwitchModelReply) ProtoMessage() {}
 
 func (x *SwitchModelReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[30]
+	mi := &file_api_ai_v1_model_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2324,7 +2917,7 @@ func (x *SwitchModelReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SwitchModelReply.ProtoReflect.Descriptor instead.
 func (*SwitchModelReply) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{30}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *SwitchModelReply) GetSuccess() bool {
@@ -2345,7 +2938,7 @@ type GetUserQuotaRequest struct {
 
 func (x *GetUserQuotaRequest) Reset() {
 	*x = GetUserQuotaRequest{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[31]
+	mi := &file_api_ai_v1_model_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2357,7 +2950,7 @@ func (x *GetUserQuotaRequest) String() string {
 func (*GetUserQuotaRequest) ProtoMessage() {}
 
 func (x *GetUserQuotaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[31]
+	mi := &file_api_ai_v1_model_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2370,7 +2963,7 @@ func (x *GetUserQuotaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserQuotaRequest.ProtoReflect.Descriptor instead.
 func (*GetUserQuotaRequest) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{31}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetUserQuotaRequest) GetUserId() int64 {
@@ -2396,7 +2989,7 @@ type GetUserQuotaReply struct {
 
 func (x *GetUserQuotaReply) Reset() {
 	*x = GetUserQuotaReply{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[32]
+	mi := &file_api_ai_v1_model_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2408,7 +3001,7 @@ func (x *GetUserQuotaReply) String() string {
 func (*GetUserQuotaReply) ProtoMessage() {}
 
 func (x *GetUserQuotaReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[32]
+	mi := &file_api_ai_v1_model_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2421,7 +3014,7 @@ func (x *GetUserQuotaReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserQuotaReply.ProtoReflect.Descriptor instead.
 func (*GetUserQuotaReply) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{32}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *GetUserQuotaReply) GetQuotas() []*UserQuota {
@@ -2446,7 +3039,7 @@ type UpdateUserQuotaRequest struct {
 
 func (x *UpdateUserQuotaRequest) Reset() {
 	*x = UpdateUserQuotaRequest{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[33]
+	mi := &file_api_ai_v1_model_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2458,7 +3051,7 @@ func (x *UpdateUserQuotaRequest) String() string {
 func (*UpdateUserQuotaRequest) ProtoMessage() {}
 
 func (x *UpdateUserQuotaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[33]
+	mi := &file_api_ai_v1_model_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2471,7 +3064,7 @@ func (x *UpdateUserQuotaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserQuotaRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserQuotaRequest) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{33}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *UpdateUserQuotaRequest) GetUserId() int64 {
@@ -2525,7 +3118,7 @@ type UpdateUserQuotaReply struct {
 
 func (x *UpdateUserQuotaReply) Reset() {
 	*x = UpdateUserQuotaReply{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[34]
+	mi := &file_api_ai_v1_model_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2537,7 +3130,7 @@ func (x *UpdateUserQuotaReply) String() string {
 func (*UpdateUserQuotaReply) ProtoMessage() {}
 
 func (x *UpdateUserQuotaReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[34]
+	mi := &file_api_ai_v1_model_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2550,7 +3143,7 @@ func (x *UpdateUserQuotaReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserQuotaReply.ProtoReflect.Descriptor instead.
 func (*UpdateUserQuotaReply) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{34}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *UpdateUserQuotaReply) GetQuota() *UserQuota {
@@ -2574,7 +3167,7 @@ type GetUsageStatsRequest struct {
 
 func (x *GetUsageStatsRequest) Reset() {
 	*x = GetUsageStatsRequest{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[35]
+	mi := &file_api_ai_v1_model_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2586,7 +3179,7 @@ func (x *GetUsageStatsRequest) String() string {
 func (*GetUsageStatsRequest) ProtoMessage() {}
 
 func (x *GetUsageStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[35]
+	mi := &file_api_ai_v1_model_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2599,7 +3192,7 @@ func (x *GetUsageStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUsageStatsRequest.ProtoReflect.Descriptor instead.
 func (*GetUsageStatsRequest) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{35}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *GetUsageStatsRequest) GetUserId() int64 {
@@ -2649,7 +3242,7 @@ type GetUsageStatsReply struct {
 
 func (x *GetUsageStatsReply) Reset() {
 	*x = GetUsageStatsReply{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[36]
+	mi := &file_api_ai_v1_model_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2661,7 +3254,7 @@ func (x *GetUsageStatsReply) String() string {
 func (*GetUsageStatsReply) ProtoMessage() {}
 
 func (x *GetUsageStatsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[36]
+	mi := &file_api_ai_v1_model_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2674,7 +3267,7 @@ func (x *GetUsageStatsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUsageStatsReply.ProtoReflect.Descriptor instead.
 func (*GetUsageStatsReply) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{36}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *GetUsageStatsReply) GetStats() []*UsageStats {
@@ -2717,7 +3310,7 @@ type ResetUsageRequest struct {
 
 func (x *ResetUsageRequest) Reset() {
 	*x = ResetUsageRequest{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[37]
+	mi := &file_api_ai_v1_model_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2729,7 +3322,7 @@ func (x *ResetUsageRequest) String() string {
 func (*ResetUsageRequest) ProtoMessage() {}
 
 func (x *ResetUsageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[37]
+	mi := &file_api_ai_v1_model_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2742,7 +3335,7 @@ func (x *ResetUsageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetUsageRequest.ProtoReflect.Descriptor instead.
 func (*ResetUsageRequest) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{37}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *ResetUsageRequest) GetUserId() int64 {
@@ -2776,7 +3369,7 @@ type ResetUsageReply struct {
 
 func (x *ResetUsageReply) Reset() {
 	*x = ResetUsageReply{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[38]
+	mi := &file_api_ai_v1_model_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2788,7 +3381,7 @@ func (x *ResetUsageReply) String() string {
 func (*ResetUsageReply) ProtoMessage() {}
 
 func (x *ResetUsageReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[38]
+	mi := &file_api_ai_v1_model_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2801,7 +3394,7 @@ func (x *ResetUsageReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetUsageReply.ProtoReflect.Descriptor instead.
 func (*ResetUsageReply) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{38}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *ResetUsageReply) GetSuccess() bool {
@@ -2830,7 +3423,7 @@ type CheckRateLimitRequest struct {
 
 func (x *CheckRateLimitRequest) Reset() {
 	*x = CheckRateLimitRequest{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[39]
+	mi := &file_api_ai_v1_model_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2842,7 +3435,7 @@ func (x *CheckRateLimitRequest) String() string {
 func (*CheckRateLimitRequest) ProtoMessage() {}
 
 func (x *CheckRateLimitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[39]
+	mi := &file_api_ai_v1_model_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2855,7 +3448,7 @@ func (x *CheckRateLimitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckRateLimitRequest.ProtoReflect.Descriptor instead.
 func (*CheckRateLimitRequest) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{39}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *CheckRateLimitRequest) GetUserId() int64 {
@@ -2892,7 +3485,7 @@ type CheckRateLimitReply struct {
 
 func (x *CheckRateLimitReply) Reset() {
 	*x = CheckRateLimitReply{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[40]
+	mi := &file_api_ai_v1_model_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2904,7 +3497,7 @@ func (x *CheckRateLimitReply) String() string {
 func (*CheckRateLimitReply) ProtoMessage() {}
 
 func (x *CheckRateLimitReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[40]
+	mi := &file_api_ai_v1_model_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2917,7 +3510,7 @@ func (x *CheckRateLimitReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckRateLimitReply.ProtoReflect.Descriptor instead.
 func (*CheckRateLimitReply) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{40}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *CheckRateLimitReply) GetAllowed() bool {
@@ -2966,7 +3559,7 @@ type GetRateLimitConfigRequest struct {
 
 func (x *GetRateLimitConfigRequest) Reset() {
 	*x = GetRateLimitConfigRequest{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[41]
+	mi := &file_api_ai_v1_model_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2978,7 +3571,7 @@ func (x *GetRateLimitConfigRequest) String() string {
 func (*GetRateLimitConfigRequest) ProtoMessage() {}
 
 func (x *GetRateLimitConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[41]
+	mi := &file_api_ai_v1_model_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2991,7 +3584,7 @@ func (x *GetRateLimitConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRateLimitConfigRequest.ProtoReflect.Descriptor instead.
 func (*GetRateLimitConfigRequest) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{41}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *GetRateLimitConfigRequest) GetModelId() int64 {
@@ -3017,7 +3610,7 @@ type GetRateLimitConfigReply struct {
 
 func (x *GetRateLimitConfigReply) Reset() {
 	*x = GetRateLimitConfigReply{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[42]
+	mi := &file_api_ai_v1_model_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3029,7 +3622,7 @@ func (x *GetRateLimitConfigReply) String() string {
 func (*GetRateLimitConfigReply) ProtoMessage() {}
 
 func (x *GetRateLimitConfigReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[42]
+	mi := &file_api_ai_v1_model_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3042,7 +3635,7 @@ func (x *GetRateLimitConfigReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRateLimitConfigReply.ProtoReflect.Descriptor instead.
 func (*GetRateLimitConfigReply) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{42}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *GetRateLimitConfigReply) GetConfigs() []*RateLimitConfig {
@@ -3070,7 +3663,7 @@ type UpdateRateLimitConfigRequest struct {
 
 func (x *UpdateRateLimitConfigRequest) Reset() {
 	*x = UpdateRateLimitConfigRequest{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[43]
+	mi := &file_api_ai_v1_model_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3082,7 +3675,7 @@ func (x *UpdateRateLimitConfigRequest) String() string {
 func (*UpdateRateLimitConfigRequest) ProtoMessage() {}
 
 func (x *UpdateRateLimitConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[43]
+	mi := &file_api_ai_v1_model_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3095,7 +3688,7 @@ func (x *UpdateRateLimitConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRateLimitConfigRequest.ProtoReflect.Descriptor instead.
 func (*UpdateRateLimitConfigRequest) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{43}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *UpdateRateLimitConfigRequest) GetId() int64 {
@@ -3170,7 +3763,7 @@ type UpdateRateLimitConfigReply struct {
 
 func (x *UpdateRateLimitConfigReply) Reset() {
 	*x = UpdateRateLimitConfigReply{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[44]
+	mi := &file_api_ai_v1_model_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3182,7 +3775,7 @@ func (x *UpdateRateLimitConfigReply) String() string {
 func (*UpdateRateLimitConfigReply) ProtoMessage() {}
 
 func (x *UpdateRateLimitConfigReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[44]
+	mi := &file_api_ai_v1_model_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3195,7 +3788,7 @@ func (x *UpdateRateLimitConfigReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRateLimitConfigReply.ProtoReflect.Descriptor instead.
 func (*UpdateRateLimitConfigReply) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{44}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *UpdateRateLimitConfigReply) GetConfig() *RateLimitConfig {
@@ -3215,7 +3808,7 @@ type HealthCheckRequest struct {
 
 func (x *HealthCheckRequest) Reset() {
 	*x = HealthCheckRequest{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[45]
+	mi := &file_api_ai_v1_model_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3227,7 +3820,7 @@ func (x *HealthCheckRequest) String() string {
 func (*HealthCheckRequest) ProtoMessage() {}
 
 func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[45]
+	mi := &file_api_ai_v1_model_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3240,7 +3833,7 @@ func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
 func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{45}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *HealthCheckRequest) GetModelId() int64 {
@@ -3259,7 +3852,7 @@ type HealthCheckReply struct {
 
 func (x *HealthCheckReply) Reset() {
 	*x = HealthCheckReply{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[46]
+	mi := &file_api_ai_v1_model_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3271,7 +3864,7 @@ func (x *HealthCheckReply) String() string {
 func (*HealthCheckReply) ProtoMessage() {}
 
 func (x *HealthCheckReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[46]
+	mi := &file_api_ai_v1_model_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3284,7 +3877,7 @@ func (x *HealthCheckReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthCheckReply.ProtoReflect.Descriptor instead.
 func (*HealthCheckReply) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{46}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *HealthCheckReply) GetHealthStatus() []*ModelHealth {
@@ -3306,7 +3899,7 @@ type GetModelMetricsRequest struct {
 
 func (x *GetModelMetricsRequest) Reset() {
 	*x = GetModelMetricsRequest{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[47]
+	mi := &file_api_ai_v1_model_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3318,7 +3911,7 @@ func (x *GetModelMetricsRequest) String() string {
 func (*GetModelMetricsRequest) ProtoMessage() {}
 
 func (x *GetModelMetricsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[47]
+	mi := &file_api_ai_v1_model_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3331,7 +3924,7 @@ func (x *GetModelMetricsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetModelMetricsRequest.ProtoReflect.Descriptor instead.
 func (*GetModelMetricsRequest) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{47}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *GetModelMetricsRequest) GetModelId() int64 {
@@ -3369,7 +3962,7 @@ type GetModelMetricsReply struct {
 
 func (x *GetModelMetricsReply) Reset() {
 	*x = GetModelMetricsReply{}
-	mi := &file_api_ai_v1_model_proto_msgTypes[48]
+	mi := &file_api_ai_v1_model_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3381,7 +3974,7 @@ func (x *GetModelMetricsReply) String() string {
 func (*GetModelMetricsReply) ProtoMessage() {}
 
 func (x *GetModelMetricsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ai_v1_model_proto_msgTypes[48]
+	mi := &file_api_ai_v1_model_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3394,7 +3987,7 @@ func (x *GetModelMetricsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetModelMetricsReply.ProtoReflect.Descriptor instead.
 func (*GetModelMetricsReply) Descriptor() ([]byte, []int) {
-	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{48}
+	return file_api_ai_v1_model_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *GetModelMetricsReply) GetModelId() int64 {
@@ -3465,7 +4058,19 @@ const file_api_ai_v1_model_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
 	"\vConfigEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf4\x04\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa4\x02\n" +
+	"\x12ProviderCredential\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vprovider_id\x18\x02 \x01(\x03R\n" +
+	"providerId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12!\n" +
+	"\fworkspace_id\x18\x04 \x01(\x03R\vworkspaceId\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x17\n" +
+	"\aapi_key\x18\x06 \x01(\tR\x06apiKey\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xf4\x04\n" +
 	"\tModelInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\vprovider_id\x18\x02 \x01(\x03R\n" +
@@ -3505,12 +4110,14 @@ const file_api_ai_v1_model_proto_rawDesc = "" +
 	"\x0fmax_temperature\x18\x05 \x01(\x01R\x0emaxTemperature\x12'\n" +
 	"\x0fmin_temperature\x18\x06 \x01(\x01R\x0eminTemperature\x125\n" +
 	"\x17max_requests_per_minute\x18\a \x01(\x05R\x14maxRequestsPerMinute\x121\n" +
-	"\x15max_tokens_per_minute\x18\b \x01(\x05R\x12maxTokensPerMinute\"\xb9\x01\n" +
+	"\x15max_tokens_per_minute\x18\b \x01(\x05R\x12maxTokensPerMinute\"\xa5\x02\n" +
 	"\fModelPricing\x121\n" +
 	"\x15input_price_per_token\x18\x01 \x01(\x01R\x12inputPricePerToken\x123\n" +
 	"\x16output_price_per_token\x18\x02 \x01(\x01R\x13outputPricePerToken\x12\x1a\n" +
 	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12%\n" +
-	"\x0ebatch_discount\x18\x04 \x01(\x01R\rbatchDiscount\"\xc7\x04\n" +
+	"\x0ebatch_discount\x18\x04 \x01(\x01R\rbatchDiscount\x12>\n" +
+	"\x1ccached_input_price_per_token\x18\x05 \x01(\x01R\x18cachedInputPricePerToken\x12*\n" +
+	"\x11price_per_request\x18\x06 \x01(\x01R\x0fpricePerRequest\"\xc7\x04\n" +
 	"\tUserQuota\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
 	"\bmodel_id\x18\x02 \x01(\x03R\amodelId\x12*\n" +
@@ -3556,7 +4163,7 @@ const file_api_ai_v1_model_proto_rawDesc = "" +
 	"created_at\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xbf\x02\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa2\x03\n" +
 	"\vModelHealth\x12\x19\n" +
 	"\bmodel_id\x18\x01 \x01(\x03R\amodelId\x12\x1d\n" +
 	"\n" +
@@ -3567,7 +4174,14 @@ const file_api_ai_v1_model_proto_rawDesc = "" +
 	"\x0ffailed_requests\x18\x06 \x01(\x03R\x0efailedRequests\x12#\n" +
 	"\rerror_message\x18\a \x01(\tR\ferrorMessage\x129\n" +
 	"\n" +
-	"last_check\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tlastCheck\"\xdd\x03\n" +
+	"last_check\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tlastCheck\x12\x1f\n" +
+	"\vlatency_p50\x18\t \x01(\x01R\n" +
+	"latencyP50\x12\x1f\n" +
+	"\vlatency_p95\x18\n" +
+	" \x01(\x01R\n" +
+	"latencyP95\x12\x1f\n" +
+	"\vlatency_p99\x18\v \x01(\x01R\n" +
+	"latencyP99\"\xdd\x03\n" +
 	"\x15CreateProviderRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12 \n" +
@@ -3619,7 +4233,40 @@ const file_api_ai_v1_model_proto_rawDesc = "" +
 	"\x11TestProviderReply\x12!\n" +
 	"\fis_available\x18\x01 \x01(\bR\visAvailable\x12#\n" +
 	"\rresponse_time\x18\x02 \x01(\x01R\fresponseTime\x12#\n" +
-	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"\xe8\x03\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"U\n" +
+	"\x19SyncProviderModelsRequest\x12\x1f\n" +
+	"\vprovider_id\x18\x01 \x01(\x03R\n" +
+	"providerId\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"\xa1\x01\n" +
+	"\x17SyncProviderModelsReply\x12\x14\n" +
+	"\x05added\x18\x01 \x03(\tR\x05added\x12\x1a\n" +
+	"\brestored\x18\x02 \x03(\tR\brestored\x12\x18\n" +
+	"\aremoved\x18\x03 \x03(\tR\aremoved\x12\x1c\n" +
+	"\tconflicts\x18\x04 \x03(\tR\tconflicts\x12\x1c\n" +
+	"\tunchanged\x18\x05 \x01(\x05R\tunchanged\"\xa8\x01\n" +
+	"\x1cSetProviderCredentialRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12!\n" +
+	"\fworkspace_id\x18\x02 \x01(\x03R\vworkspaceId\x12\x1f\n" +
+	"\vprovider_id\x18\x03 \x01(\x03R\n" +
+	"providerId\x12\x17\n" +
+	"\aapi_key\x18\x04 \x01(\tR\x06apiKey\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\"[\n" +
+	"\x1aSetProviderCredentialReply\x12=\n" +
+	"\n" +
+	"credential\x18\x01 \x01(\v2\x1d.api.ai.v1.ProviderCredentialR\n" +
+	"credential\"~\n" +
+	"\x1fDeleteProviderCredentialRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12!\n" +
+	"\fworkspace_id\x18\x02 \x01(\x03R\vworkspaceId\x12\x1f\n" +
+	"\vprovider_id\x18\x03 \x01(\x03R\n" +
+	"providerId\"9\n" +
+	"\x1dDeleteProviderCredentialReply\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\\\n" +
+	"\x1eListProviderCredentialsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12!\n" +
+	"\fworkspace_id\x18\x02 \x01(\x03R\vworkspaceId\"_\n" +
+	"\x1cListProviderCredentialsReply\x12?\n" +
+	"\vcredentials\x18\x01 \x03(\v2\x1d.api.ai.v1.ProviderCredentialR\vcredentials\"\xe8\x03\n" +
 	"\x12CreateModelRequest\x12\x1f\n" +
 	"\vprovider_id\x18\x01 \x01(\x03R\n" +
 	"providerId\x12\x12\n" +
@@ -3755,13 +4402,17 @@ const file_api_ai_v1_model_proto_rawDesc = "" +
 	"\verror_count\x18\x05 \x01(\x03R\n" +
 	"errorCount\x12\x1d\n" +
 	"\n" +
-	"top_errors\x18\x06 \x03(\tR\ttopErrors2\xd5\f\n" +
+	"top_errors\x18\x06 \x03(\tR\ttopErrors2\xff\x0f\n" +
 	"\x05Model\x12R\n" +
 	"\x0eCreateProvider\x12 .api.ai.v1.CreateProviderRequest\x1a\x1e.api.ai.v1.CreateProviderReply\x12R\n" +
 	"\x0eUpdateProvider\x12 .api.ai.v1.UpdateProviderRequest\x1a\x1e.api.ai.v1.UpdateProviderReply\x12R\n" +
 	"\x0eDeleteProvider\x12 .api.ai.v1.DeleteProviderRequest\x1a\x1e.api.ai.v1.DeleteProviderReply\x12O\n" +
 	"\rListProviders\x12\x1f.api.ai.v1.ListProvidersRequest\x1a\x1d.api.ai.v1.ListProvidersReply\x12L\n" +
-	"\fTestProvider\x12\x1e.api.ai.v1.TestProviderRequest\x1a\x1c.api.ai.v1.TestProviderReply\x12I\n" +
+	"\fTestProvider\x12\x1e.api.ai.v1.TestProviderRequest\x1a\x1c.api.ai.v1.TestProviderReply\x12^\n" +
+	"\x12SyncProviderModels\x12$.api.ai.v1.SyncProviderModelsRequest\x1a\".api.ai.v1.SyncProviderModelsReply\x12g\n" +
+	"\x15SetProviderCredential\x12'.api.ai.v1.SetProviderCredentialRequest\x1a%.api.ai.v1.SetProviderCredentialReply\x12p\n" +
+	"\x18DeleteProviderCredential\x12*.api.ai.v1.DeleteProviderCredentialRequest\x1a(.api.ai.v1.DeleteProviderCredentialReply\x12m\n" +
+	"\x17ListProviderCredentials\x12).api.ai.v1.ListProviderCredentialsRequest\x1a'.api.ai.v1.ListProviderCredentialsReply\x12I\n" +
 	"\vCreateModel\x12\x1d.api.ai.v1.CreateModelRequest\x1a\x1b.api.ai.v1.CreateModelReply\x12I\n" +
 	"\vUpdateModel\x12\x1d.api.ai.v1.UpdateModelRequest\x1a\x1b.api.ai.v1.UpdateModelReply\x12I\n" +
 	"\vDeleteModel\x12\x1d.api.ai.v1.DeleteModelRequest\x1a\x1b.api.ai.v1.DeleteModelReply\x12F\n" +
@@ -3793,154 +4444,175 @@ func file_api_ai_v1_model_proto_rawDescGZIP() []byte {
 	return file_api_ai_v1_model_proto_rawDescData
 }
 
-var file_api_ai_v1_model_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
+var file_api_ai_v1_model_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
 var file_api_ai_v1_model_proto_goTypes = []any{
-	(*Provider)(nil),                     // 0: api.ai.v1.Provider
-	(*ModelInfo)(nil),                    // 1: api.ai.v1.ModelInfo
-	(*ModelCapabilities)(nil),            // 2: api.ai.v1.ModelCapabilities
-	(*ModelLimits)(nil),                  // 3: api.ai.v1.ModelLimits
-	(*ModelPricing)(nil),                 // 4: api.ai.v1.ModelPricing
-	(*UserQuota)(nil),                    // 5: api.ai.v1.UserQuota
-	(*UsageStats)(nil),                   // 6: api.ai.v1.UsageStats
-	(*RateLimitConfig)(nil),              // 7: api.ai.v1.RateLimitConfig
-	(*ModelHealth)(nil),                  // 8: api.ai.v1.ModelHealth
-	(*CreateProviderRequest)(nil),        // 9: api.ai.v1.CreateProviderRequest
-	(*CreateProviderReply)(nil),          // 10: api.ai.v1.CreateProviderReply
-	(*UpdateProviderRequest)(nil),        // 11: api.ai.v1.UpdateProviderRequest
-	(*UpdateProviderReply)(nil),          // 12: api.ai.v1.UpdateProviderReply
-	(*DeleteProviderRequest)(nil),        // 13: api.ai.v1.DeleteProviderRequest
-	(*DeleteProviderReply)(nil),          // 14: api.ai.v1.DeleteProviderReply
-	(*ListProvidersRequest)(nil),         // 15: api.ai.v1.ListProvidersRequest
-	(*ListProvidersReply)(nil),           // 16: api.ai.v1.ListProvidersReply
-	(*TestProviderRequest)(nil),          // 17: api.ai.v1.TestProviderRequest
-	(*TestProviderReply)(nil),            // 18: api.ai.v1.TestProviderReply
-	(*CreateModelRequest)(nil),           // 19: api.ai.v1.CreateModelRequest
-	(*CreateModelReply)(nil),             // 20: api.ai.v1.CreateModelReply
-	(*UpdateModelRequest)(nil),           // 21: api.ai.v1.UpdateModelRequest
-	(*UpdateModelReply)(nil),             // 22: api.ai.v1.UpdateModelReply
-	(*DeleteModelRequest)(nil),           // 23: api.ai.v1.DeleteModelRequest
-	(*DeleteModelReply)(nil),             // 24: api.ai.v1.DeleteModelReply
-	(*ListModelsRequest)(nil),            // 25: api.ai.v1.ListModelsRequest
-	(*ListModelsReply)(nil),              // 26: api.ai.v1.ListModelsReply
-	(*GetModelRequest)(nil),              // 27: api.ai.v1.GetModelRequest
-	(*GetModelReply)(nil),                // 28: api.ai.v1.GetModelReply
-	(*SwitchModelRequest)(nil),           // 29: api.ai.v1.SwitchModelRequest
-	(*SwitchModelReply)(nil),             // 30: api.ai.v1.SwitchModelReply
-	(*GetUserQuotaRequest)(nil),          // 31: api.ai.v1.GetUserQuotaRequest
-	(*GetUserQuotaReply)(nil),            // 32: api.ai.v1.GetUserQuotaReply
-	(*UpdateUserQuotaRequest)(nil),       // 33: api.ai.v1.UpdateUserQuotaRequest
-	(*UpdateUserQuotaReply)(nil),         // 34: api.ai.v1.UpdateUserQuotaReply
-	(*GetUsageStatsRequest)(nil),         // 35: api.ai.v1.GetUsageStatsRequest
-	(*GetUsageStatsReply)(nil),           // 36: api.ai.v1.GetUsageStatsReply
-	(*ResetUsageRequest)(nil),            // 37: api.ai.v1.ResetUsageRequest
-	(*ResetUsageReply)(nil),              // 38: api.ai.v1.ResetUsageReply
-	(*CheckRateLimitRequest)(nil),        // 39: api.ai.v1.CheckRateLimitRequest
-	(*CheckRateLimitReply)(nil),          // 40: api.ai.v1.CheckRateLimitReply
-	(*GetRateLimitConfigRequest)(nil),    // 41: api.ai.v1.GetRateLimitConfigRequest
-	(*GetRateLimitConfigReply)(nil),      // 42: api.ai.v1.GetRateLimitConfigReply
-	(*UpdateRateLimitConfigRequest)(nil), // 43: api.ai.v1.UpdateRateLimitConfigRequest
-	(*UpdateRateLimitConfigReply)(nil),   // 44: api.ai.v1.UpdateRateLimitConfigReply
-	(*HealthCheckRequest)(nil),           // 45: api.ai.v1.HealthCheckRequest
-	(*HealthCheckReply)(nil),             // 46: api.ai.v1.HealthCheckReply
-	(*GetModelMetricsRequest)(nil),       // 47: api.ai.v1.GetModelMetricsRequest
-	(*GetModelMetricsReply)(nil),         // 48: api.ai.v1.GetModelMetricsReply
-	nil,                                  // 49: api.ai.v1.Provider.DefaultHeadersEntry
-	nil,                                  // 50: api.ai.v1.Provider.ConfigEntry
-	nil,                                  // 51: api.ai.v1.ModelInfo.DefaultParamsEntry
-	nil,                                  // 52: api.ai.v1.CreateProviderRequest.DefaultHeadersEntry
-	nil,                                  // 53: api.ai.v1.CreateProviderRequest.ConfigEntry
-	nil,                                  // 54: api.ai.v1.UpdateProviderRequest.DefaultHeadersEntry
-	nil,                                  // 55: api.ai.v1.UpdateProviderRequest.ConfigEntry
-	nil,                                  // 56: api.ai.v1.CreateModelRequest.DefaultParamsEntry
-	nil,                                  // 57: api.ai.v1.UpdateModelRequest.DefaultParamsEntry
-	(*timestamppb.Timestamp)(nil),        // 58: google.protobuf.Timestamp
+	(*Provider)(nil),                        // 0: api.ai.v1.Provider
+	(*ProviderCredential)(nil),              // 1: api.ai.v1.ProviderCredential
+	(*ModelInfo)(nil),                       // 2: api.ai.v1.ModelInfo
+	(*ModelCapabilities)(nil),               // 3: api.ai.v1.ModelCapabilities
+	(*ModelLimits)(nil),                     // 4: api.ai.v1.ModelLimits
+	(*ModelPricing)(nil),                    // 5: api.ai.v1.ModelPricing
+	(*UserQuota)(nil),                       // 6: api.ai.v1.UserQuota
+	(*UsageStats)(nil),                      // 7: api.ai.v1.UsageStats
+	(*RateLimitConfig)(nil),                 // 8: api.ai.v1.RateLimitConfig
+	(*ModelHealth)(nil),                     // 9: api.ai.v1.ModelHealth
+	(*CreateProviderRequest)(nil),           // 10: api.ai.v1.CreateProviderRequest
+	(*CreateProviderReply)(nil),             // 11: api.ai.v1.CreateProviderReply
+	(*UpdateProviderRequest)(nil),           // 12: api.ai.v1.UpdateProviderRequest
+	(*UpdateProviderReply)(nil),             // 13: api.ai.v1.UpdateProviderReply
+	(*DeleteProviderRequest)(nil),           // 14: api.ai.v1.DeleteProviderRequest
+	(*DeleteProviderReply)(nil),             // 15: api.ai.v1.DeleteProviderReply
+	(*ListProvidersRequest)(nil),            // 16: api.ai.v1.ListProvidersRequest
+	(*ListProvidersReply)(nil),              // 17: api.ai.v1.ListProvidersReply
+	(*TestProviderRequest)(nil),             // 18: api.ai.v1.TestProviderRequest
+	(*TestProviderReply)(nil),               // 19: api.ai.v1.TestProviderReply
+	(*SyncProviderModelsRequest)(nil),       // 20: api.ai.v1.SyncProviderModelsRequest
+	(*SyncProviderModelsReply)(nil),         // 21: api.ai.v1.SyncProviderModelsReply
+	(*SetProviderCredentialRequest)(nil),    // 22: api.ai.v1.SetProviderCredentialRequest
+	(*SetProviderCredentialReply)(nil),      // 23: api.ai.v1.SetProviderCredentialReply
+	(*DeleteProviderCredentialRequest)(nil), // 24: api.ai.v1.DeleteProviderCredentialRequest
+	(*DeleteProviderCredentialReply)(nil),   // 25: api.ai.v1.DeleteProviderCredentialReply
+	(*ListProviderCredentialsRequest)(nil),  // 26: api.ai.v1.ListProviderCredentialsRequest
+	(*ListProviderCredentialsReply)(nil),    // 27: api.ai.v1.ListProviderCredentialsReply
+	(*CreateModelRequest)(nil),              // 28: api.ai.v1.CreateModelRequest
+	(*CreateModelReply)(nil),                // 29: api.ai.v1.CreateModelReply
+	(*UpdateModelRequest)(nil),              // 30: api.ai.v1.UpdateModelRequest
+	(*UpdateModelReply)(nil),                // 31: api.ai.v1.UpdateModelReply
+	(*DeleteModelRequest)(nil),              // 32: api.ai.v1.DeleteModelRequest
+	(*DeleteModelReply)(nil),                // 33: api.ai.v1.DeleteModelReply
+	(*ListModelsRequest)(nil),               // 34: api.ai.v1.ListModelsRequest
+	(*ListModelsReply)(nil),                 // 35: api.ai.v1.ListModelsReply
+	(*GetModelRequest)(nil),                 // 36: api.ai.v1.GetModelRequest
+	(*GetModelReply)(nil),                   // 37: api.ai.v1.GetModelReply
+	(*SwitchModelRequest)(nil),              // 38: api.ai.v1.SwitchModelRequest
+	(*SwitchModelReply)(nil),                // 39: api.ai.v1.SwitchModelReply
+	(*GetUserQuotaRequest)(nil),             // 40: api.ai.v1.GetUserQuotaRequest
+	(*GetUserQuotaReply)(nil),               // 41: api.ai.v1.GetUserQuotaReply
+	(*UpdateUserQuotaRequest)(nil),          // 42: api.ai.v1.UpdateUserQuotaRequest
+	(*UpdateUserQuotaReply)(nil),            // 43: api.ai.v1.UpdateUserQuotaReply
+	(*GetUsageStatsRequest)(nil),            // 44: api.ai.v1.GetUsageStatsRequest
+	(*GetUsageStatsReply)(nil),              // 45: api.ai.v1.GetUsageStatsReply
+	(*ResetUsageRequest)(nil),               // 46: api.ai.v1.ResetUsageRequest
+	(*ResetUsageReply)(nil),                 // 47: api.ai.v1.ResetUsageReply
+	(*CheckRateLimitRequest)(nil),           // 48: api.ai.v1.CheckRateLimitRequest
+	(*CheckRateLimitReply)(nil),             // 49: api.ai.v1.CheckRateLimitReply
+	(*GetRateLimitConfigRequest)(nil),       // 50: api.ai.v1.GetRateLimitConfigRequest
+	(*GetRateLimitConfigReply)(nil),         // 51: api.ai.v1.GetRateLimitConfigReply
+	(*UpdateRateLimitConfigRequest)(nil),    // 52: api.ai.v1.UpdateRateLimitConfigRequest
+	(*UpdateRateLimitConfigReply)(nil),      // 53: api.ai.v1.UpdateRateLimitConfigReply
+	(*HealthCheckRequest)(nil),              // 54: api.ai.v1.HealthCheckRequest
+	(*HealthCheckReply)(nil),                // 55: api.ai.v1.HealthCheckReply
+	(*GetModelMetricsRequest)(nil),          // 56: api.ai.v1.GetModelMetricsRequest
+	(*GetModelMetricsReply)(nil),            // 57: api.ai.v1.GetModelMetricsReply
+	nil,                                     // 58: api.ai.v1.Provider.DefaultHeadersEntry
+	nil,                                     // 59: api.ai.v1.Provider.ConfigEntry
+	nil,                                     // 60: api.ai.v1.ModelInfo.DefaultParamsEntry
+	nil,                                     // 61: api.ai.v1.CreateProviderRequest.DefaultHeadersEntry
+	nil,                                     // 62: api.ai.v1.CreateProviderRequest.ConfigEntry
+	nil,                                     // 63: api.ai.v1.UpdateProviderRequest.DefaultHeadersEntry
+	nil,                                     // 64: api.ai.v1.UpdateProviderRequest.ConfigEntry
+	nil,                                     // 65: api.ai.v1.CreateModelRequest.DefaultParamsEntry
+	nil,                                     // 66: api.ai.v1.UpdateModelRequest.DefaultParamsEntry
+	(*timestamppb.Timestamp)(nil),           // 67: google.protobuf.Timestamp
 }
 var file_api_ai_v1_model_proto_depIdxs = []int32{
-	49, // 0: api.ai.v1.Provider.default_headers:type_name -> api.ai.v1.Provider.DefaultHeadersEntry
-	50, // 1: api.ai.v1.Provider.config:type_name -> api.ai.v1.Provider.ConfigEntry
-	58, // 2: api.ai.v1.Provider.created_at:type_name -> google.protobuf.Timestamp
-	58, // 3: api.ai.v1.Provider.updated_at:type_name -> google.protobuf.Timestamp
-	2,  // 4: api.ai.v1.ModelInfo.capabilities:type_name -> api.ai.v1.ModelCapabilities
-	3,  // 5: api.ai.v1.ModelInfo.limits:type_name -> api.ai.v1.ModelLimits
-	4,  // 6: api.ai.v1.ModelInfo.pricing:type_name -> api.ai.v1.ModelPricing
-	51, // 7: api.ai.v1.ModelInfo.default_params:type_name -> api.ai.v1.ModelInfo.DefaultParamsEntry
-	58, // 8: api.ai.v1.ModelInfo.created_at:type_name -> google.protobuf.Timestamp
-	58, // 9: api.ai.v1.ModelInfo.updated_at:type_name -> google.protobuf.Timestamp
-	58, // 10: api.ai.v1.UserQuota.reset_daily_at:type_name -> google.protobuf.Timestamp
-	58, // 11: api.ai.v1.UserQuota.reset_monthly_at:type_name -> google.protobuf.Timestamp
-	58, // 12: api.ai.v1.RateLimitConfig.created_at:type_name -> google.protobuf.Timestamp
-	58, // 13: api.ai.v1.RateLimitConfig.updated_at:type_name -> google.protobuf.Timestamp
-	58, // 14: api.ai.v1.ModelHealth.last_check:type_name -> google.protobuf.Timestamp
-	52, // 15: api.ai.v1.CreateProviderRequest.default_headers:type_name -> api.ai.v1.CreateProviderRequest.DefaultHeadersEntry
-	53, // 16: api.ai.v1.CreateProviderRequest.config:type_name -> api.ai.v1.CreateProviderRequest.ConfigEntry
-	0,  // 17: api.ai.v1.CreateProviderReply.provider:type_name -> api.ai.v1.Provider
-	54, // 18: api.ai.v1.UpdateProviderRequest.default_headers:type_name -> api.ai.v1.UpdateProviderRequest.DefaultHeadersEntry
-	55, // 19: api.ai.v1.UpdateProviderRequest.config:type_name -> api.ai.v1.UpdateProviderRequest.ConfigEntry
-	0,  // 20: api.ai.v1.UpdateProviderReply.provider:type_name -> api.ai.v1.Provider
-	0,  // 21: api.ai.v1.ListProvidersReply.providers:type_name -> api.ai.v1.Provider
-	2,  // 22: api.ai.v1.CreateModelRequest.capabilities:type_name -> api.ai.v1.ModelCapabilities
-	3,  // 23: api.ai.v1.CreateModelRequest.limits:type_name -> api.ai.v1.ModelLimits
-	4,  // 24: api.ai.v1.CreateModelRequest.pricing:type_name -> api.ai.v1.ModelPricing
-	56, // 25: api.ai.v1.CreateModelRequest.default_params:type_name -> api.ai.v1.CreateModelRequest.DefaultParamsEntry
-	1,  // 26: api.ai.v1.CreateModelReply.model:type_name -> api.ai.v1.ModelInfo
-	2,  // 27: api.ai.v1.UpdateModelRequest.capabilities:type_name -> api.ai.v1.ModelCapabilities
-	3,  // 28: api.ai.v1.UpdateModelRequest.limits:type_name -> api.ai.v1.ModelLimits
-	4,  // 29: api.ai.v1.UpdateModelRequest.pricing:type_name -> api.ai.v1.ModelPricing
-	57, // 30: api.ai.v1.UpdateModelRequest.default_params:type_name -> api.ai.v1.UpdateModelRequest.DefaultParamsEntry
-	1,  // 31: api.ai.v1.UpdateModelReply.model:type_name -> api.ai.v1.ModelInfo
-	1,  // 32: api.ai.v1.ListModelsReply.models:type_name -> api.ai.v1.ModelInfo
-	1,  // 33: api.ai.v1.GetModelReply.model:type_name -> api.ai.v1.ModelInfo
-	5,  // 34: api.ai.v1.GetUserQuotaReply.quotas:type_name -> api.ai.v1.UserQuota
-	5,  // 35: api.ai.v1.UpdateUserQuotaReply.quota:type_name -> api.ai.v1.UserQuota
-	6,  // 36: api.ai.v1.GetUsageStatsReply.stats:type_name -> api.ai.v1.UsageStats
-	7,  // 37: api.ai.v1.GetRateLimitConfigReply.configs:type_name -> api.ai.v1.RateLimitConfig
-	7,  // 38: api.ai.v1.UpdateRateLimitConfigReply.config:type_name -> api.ai.v1.RateLimitConfig
-	8,  // 39: api.ai.v1.HealthCheckReply.health_status:type_name -> api.ai.v1.ModelHealth
-	9,  // 40: api.ai.v1.Model.CreateProvider:input_type -> api.ai.v1.CreateProviderRequest
-	11, // 41: api.ai.v1.Model.UpdateProvider:input_type -> api.ai.v1.UpdateProviderRequest
-	13, // 42: api.ai.v1.Model.DeleteProvider:input_type -> api.ai.v1.DeleteProviderRequest
-	15, // 43: api.ai.v1.Model.ListProviders:input_type -> api.ai.v1.ListProvidersRequest
-	17, // 44: api.ai.v1.Model.TestProvider:input_type -> api.ai.v1.TestProviderRequest
-	19, // 45: api.ai.v1.Model.CreateModel:input_type -> api.ai.v1.CreateModelRequest
-	21, // 46: api.ai.v1.Model.UpdateModel:input_type -> api.ai.v1.UpdateModelRequest
-	23, // 47: api.ai.v1.Model.DeleteModel:input_type -> api.ai.v1.DeleteModelRequest
-	25, // 48: api.ai.v1.Model.ListModels:input_type -> api.ai.v1.ListModelsRequest
-	27, // 49: api.ai.v1.Model.GetModel:input_type -> api.ai.v1.GetModelRequest
-	29, // 50: api.ai.v1.Model.SwitchModel:input_type -> api.ai.v1.SwitchModelRequest
-	31, // 51: api.ai.v1.Model.GetUserQuota:input_type -> api.ai.v1.GetUserQuotaRequest
-	33, // 52: api.ai.v1.Model.UpdateUserQuota:input_type -> api.ai.v1.UpdateUserQuotaRequest
-	35, // 53: api.ai.v1.Model.GetUsageStats:input_type -> api.ai.v1.GetUsageStatsRequest
-	37, // 54: api.ai.v1.Model.ResetUsage:input_type -> api.ai.v1.ResetUsageRequest
-	39, // 55: api.ai.v1.Model.CheckRateLimit:input_type -> api.ai.v1.CheckRateLimitRequest
-	41, // 56: api.ai.v1.Model.GetRateLimitConfig:input_type -> api.ai.v1.GetRateLimitConfigRequest
-	43, // 57: api.ai.v1.Model.UpdateRateLimitConfig:input_type -> api.ai.v1.UpdateRateLimitConfigRequest
-	45, // 58: api.ai.v1.Model.HealthCheck:input_type -> api.ai.v1.HealthCheckRequest
-	47, // 59: api.ai.v1.Model.GetModelMetrics:input_type -> api.ai.v1.GetModelMetricsRequest
-	10, // 60: api.ai.v1.Model.CreateProvider:output_type -> api.ai.v1.CreateProviderReply
-	12, // 61: api.ai.v1.Model.UpdateProvider:output_type -> api.ai.v1.UpdateProviderReply
-	14, // 62: api.ai.v1.Model.DeleteProvider:output_type -> api.ai.v1.DeleteProviderReply
-	16, // 63: api.ai.v1.Model.ListProviders:output_type -> api.ai.v1.ListProvidersReply
-	18, // 64: api.ai.v1.Model.TestProvider:output_type -> api.ai.v1.TestProviderReply
-	20, // 65: api.ai.v1.Model.CreateModel:output_type -> api.ai.v1.CreateModelReply
-	22, // 66: api.ai.v1.Model.UpdateModel:output_type -> api.ai.v1.UpdateModelReply
-	24, // 67: api.ai.v1.Model.DeleteModel:output_type -> api.ai.v1.DeleteModelReply
-	26, // 68: api.ai.v1.Model.ListModels:output_type -> api.ai.v1.ListModelsReply
-	28, // 69: api.ai.v1.Model.GetModel:output_type -> api.ai.v1.GetModelReply
-	30, // 70: api.ai.v1.Model.SwitchModel:output_type -> api.ai.v1.SwitchModelReply
-	32, // 71: api.ai.v1.Model.GetUserQuota:output_type -> api.ai.v1.GetUserQuotaReply
-	34, // 72: api.ai.v1.Model.UpdateUserQuota:output_type -> api.ai.v1.UpdateUserQuotaReply
-	36, // 73: api.ai.v1.Model.GetUsageStats:output_type -> api.ai.v1.GetUsageStatsReply
-	38, // 74: api.ai.v1.Model.ResetUsage:output_type -> api.ai.v1.ResetUsageReply
-	40, // 75: api.ai.v1.Model.CheckRateLimit:output_type -> api.ai.v1.CheckRateLimitReply
-	42, // 76: api.ai.v1.Model.GetRateLimitConfig:output_type -> api.ai.v1.GetRateLimitConfigReply
-	44, // 77: api.ai.v1.Model.UpdateRateLimitConfig:output_type -> api.ai.v1.UpdateRateLimitConfigReply
-	46, // 78: api.ai.v1.Model.HealthCheck:output_type -> api.ai.v1.HealthCheckReply
-	48, // 79: api.ai.v1.Model.GetModelMetrics:output_type -> api.ai.v1.GetModelMetricsReply
-	60, // [60:80] is the sub-list for method output_type
-	40, // [40:60] is the sub-list for method input_type
-	40, // [40:40] is the sub-list for extension type_name
-	40, // [40:40] is the sub-list for extension extendee
-	0,  // [0:40] is the sub-list for field type_name
+	58, // 0: api.ai.v1.Provider.default_headers:type_name -> api.ai.v1.Provider.DefaultHeadersEntry
+	59, // 1: api.ai.v1.Provider.config:type_name -> api.ai.v1.Provider.ConfigEntry
+	67, // 2: api.ai.v1.Provider.created_at:type_name -> google.protobuf.Timestamp
+	67, // 3: api.ai.v1.Provider.updated_at:type_name -> google.protobuf.Timestamp
+	67, // 4: api.ai.v1.ProviderCredential.created_at:type_name -> google.protobuf.Timestamp
+	67, // 5: api.ai.v1.ProviderCredential.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 6: api.ai.v1.ModelInfo.capabilities:type_name -> api.ai.v1.ModelCapabilities
+	4,  // 7: api.ai.v1.ModelInfo.limits:type_name -> api.ai.v1.ModelLimits
+	5,  // 8: api.ai.v1.ModelInfo.pricing:type_name -> api.ai.v1.ModelPricing
+	60, // 9: api.ai.v1.ModelInfo.default_params:type_name -> api.ai.v1.ModelInfo.DefaultParamsEntry
+	67, // 10: api.ai.v1.ModelInfo.created_at:type_name -> google.protobuf.Timestamp
+	67, // 11: api.ai.v1.ModelInfo.updated_at:type_name -> google.protobuf.Timestamp
+	67, // 12: api.ai.v1.UserQuota.reset_daily_at:type_name -> google.protobuf.Timestamp
+	67, // 13: api.ai.v1.UserQuota.reset_monthly_at:type_name -> google.protobuf.Timestamp
+	67, // 14: api.ai.v1.RateLimitConfig.created_at:type_name -> google.protobuf.Timestamp
+	67, // 15: api.ai.v1.RateLimitConfig.updated_at:type_name -> google.protobuf.Timestamp
+	67, // 16: api.ai.v1.ModelHealth.last_check:type_name -> google.protobuf.Timestamp
+	61, // 17: api.ai.v1.CreateProviderRequest.default_headers:type_name -> api.ai.v1.CreateProviderRequest.DefaultHeadersEntry
+	62, // 18: api.ai.v1.CreateProviderRequest.config:type_name -> api.ai.v1.CreateProviderRequest.ConfigEntry
+	0,  // 19: api.ai.v1.CreateProviderReply.provider:type_name -> api.ai.v1.Provider
+	63, // 20: api.ai.v1.UpdateProviderRequest.default_headers:type_name -> api.ai.v1.UpdateProviderRequest.DefaultHeadersEntry
+	64, // 21: api.ai.v1.UpdateProviderRequest.config:type_name -> api.ai.v1.UpdateProviderRequest.ConfigEntry
+	0,  // 22: api.ai.v1.UpdateProviderReply.provider:type_name -> api.ai.v1.Provider
+	0,  // 23: api.ai.v1.ListProvidersReply.providers:type_name -> api.ai.v1.Provider
+	1,  // 24: api.ai.v1.SetProviderCredentialReply.credential:type_name -> api.ai.v1.ProviderCredential
+	1,  // 25: api.ai.v1.ListProviderCredentialsReply.credentials:type_name -> api.ai.v1.ProviderCredential
+	3,  // 26: api.ai.v1.CreateModelRequest.capabilities:type_name -> api.ai.v1.ModelCapabilities
+	4,  // 27: api.ai.v1.CreateModelRequest.limits:type_name -> api.ai.v1.ModelLimits
+	5,  // 28: api.ai.v1.CreateModelRequest.pricing:type_name -> api.ai.v1.ModelPricing
+	65, // 29: api.ai.v1.CreateModelRequest.default_params:type_name -> api.ai.v1.CreateModelRequest.DefaultParamsEntry
+	2,  // 30: api.ai.v1.CreateModelReply.model:type_name -> api.ai.v1.ModelInfo
+	3,  // 31: api.ai.v1.UpdateModelRequest.capabilities:type_name -> api.ai.v1.ModelCapabilities
+	4,  // 32: api.ai.v1.UpdateModelRequest.limits:type_name -> api.ai.v1.ModelLimits
+	5,  // 33: api.ai.v1.UpdateModelRequest.pricing:type_name -> api.ai.v1.ModelPricing
+	66, // 34: api.ai.v1.UpdateModelRequest.default_params:type_name -> api.ai.v1.UpdateModelRequest.DefaultParamsEntry
+	2,  // 35: api.ai.v1.UpdateModelReply.model:type_name -> api.ai.v1.ModelInfo
+	2,  // 36: api.ai.v1.ListModelsReply.models:type_name -> api.ai.v1.ModelInfo
+	2,  // 37: api.ai.v1.GetModelReply.model:type_name -> api.ai.v1.ModelInfo
+	6,  // 38: api.ai.v1.GetUserQuotaReply.quotas:type_name -> api.ai.v1.UserQuota
+	6,  // 39: api.ai.v1.UpdateUserQuotaReply.quota:type_name -> api.ai.v1.UserQuota
+	7,  // 40: api.ai.v1.GetUsageStatsReply.stats:type_name -> api.ai.v1.UsageStats
+	8,  // 41: api.ai.v1.GetRateLimitConfigReply.configs:type_name -> api.ai.v1.RateLimitConfig
+	8,  // 42: api.ai.v1.UpdateRateLimitConfigReply.config:type_name -> api.ai.v1.RateLimitConfig
+	9,  // 43: api.ai.v1.HealthCheckReply.health_status:type_name -> api.ai.v1.ModelHealth
+	10, // 44: api.ai.v1.Model.CreateProvider:input_type -> api.ai.v1.CreateProviderRequest
+	12, // 45: api.ai.v1.Model.UpdateProvider:input_type -> api.ai.v1.UpdateProviderRequest
+	14, // 46: api.ai.v1.Model.DeleteProvider:input_type -> api.ai.v1.DeleteProviderRequest
+	16, // 47: api.ai.v1.Model.ListProviders:input_type -> api.ai.v1.ListProvidersRequest
+	18, // 48: api.ai.v1.Model.TestProvider:input_type -> api.ai.v1.TestProviderRequest
+	20, // 49: api.ai.v1.Model.SyncProviderModels:input_type -> api.ai.v1.SyncProviderModelsRequest
+	22, // 50: api.ai.v1.Model.SetProviderCredential:input_type -> api.ai.v1.SetProviderCredentialRequest
+	24, // 51: api.ai.v1.Model.DeleteProviderCredential:input_type -> api.ai.v1.DeleteProviderCredentialRequest
+	26, // 52: api.ai.v1.Model.ListProviderCredentials:input_type -> api.ai.v1.ListProviderCredentialsRequest
+	28, // 53: api.ai.v1.Model.CreateModel:input_type -> api.ai.v1.CreateModelRequest
+	30, // 54: api.ai.v1.Model.UpdateModel:input_type -> api.ai.v1.UpdateModelRequest
+	32, // 55: api.ai.v1.Model.DeleteModel:input_type -> api.ai.v1.DeleteModelRequest
+	34, // 56: api.ai.v1.Model.ListModels:input_type -> api.ai.v1.ListModelsRequest
+	36, // 57: api.ai.v1.Model.GetModel:input_type -> api.ai.v1.GetModelRequest
+	38, // 58: api.ai.v1.Model.SwitchModel:input_type -> api.ai.v1.SwitchModelRequest
+	40, // 59: api.ai.v1.Model.GetUserQuota:input_type -> api.ai.v1.GetUserQuotaRequest
+	42, // 60: api.ai.v1.Model.UpdateUserQuota:input_type -> api.ai.v1.UpdateUserQuotaRequest
+	44, // 61: api.ai.v1.Model.GetUsageStats:input_type -> api.ai.v1.GetUsageStatsRequest
+	46, // 62: api.ai.v1.Model.ResetUsage:input_type -> api.ai.v1.ResetUsageRequest
+	48, // 63: api.ai.v1.Model.CheckRateLimit:input_type -> api.ai.v1.CheckRateLimitRequest
+	50, // 64: api.ai.v1.Model.GetRateLimitConfig:input_type -> api.ai.v1.GetRateLimitConfigRequest
+	52, // 65: api.ai.v1.Model.UpdateRateLimitConfig:input_type -> api.ai.v1.UpdateRateLimitConfigRequest
+	54, // 66: api.ai.v1.Model.HealthCheck:input_type -> api.ai.v1.HealthCheckRequest
+	56, // 67: api.ai.v1.Model.GetModelMetrics:input_type -> api.ai.v1.GetModelMetricsRequest
+	11, // 68: api.ai.v1.Model.CreateProvider:output_type -> api.ai.v1.CreateProviderReply
+	13, // 69: api.ai.v1.Model.UpdateProvider:output_type -> api.ai.v1.UpdateProviderReply
+	15, // 70: api.ai.v1.Model.DeleteProvider:output_type -> api.ai.v1.DeleteProviderReply
+	17, // 71: api.ai.v1.Model.ListProviders:output_type -> api.ai.v1.ListProvidersReply
+	19, // 72: api.ai.v1.Model.TestProvider:output_type -> api.ai.v1.TestProviderReply
+	21, // 73: api.ai.v1.Model.SyncProviderModels:output_type -> api.ai.v1.SyncProviderModelsReply
+	23, // 74: api.ai.v1.Model.SetProviderCredential:output_type -> api.ai.v1.SetProviderCredentialReply
+	25, // 75: api.ai.v1.Model.DeleteProviderCredential:output_type -> api.ai.v1.DeleteProviderCredentialReply
+	27, // 76: api.ai.v1.Model.ListProviderCredentials:output_type -> api.ai.v1.ListProviderCredentialsReply
+	29, // 77: api.ai.v1.Model.CreateModel:output_type -> api.ai.v1.CreateModelReply
+	31, // 78: api.ai.v1.Model.UpdateModel:output_type -> api.ai.v1.UpdateModelReply
+	33, // 79: api.ai.v1.Model.DeleteModel:output_type -> api.ai.v1.DeleteModelReply
+	35, // 80: api.ai.v1.Model.ListModels:output_type -> api.ai.v1.ListModelsReply
+	37, // 81: api.ai.v1.Model.GetModel:output_type -> api.ai.v1.GetModelReply
+	39, // 82: api.ai.v1.Model.SwitchModel:output_type -> api.ai.v1.SwitchModelReply
+	41, // 83: api.ai.v1.Model.GetUserQuota:output_type -> api.ai.v1.GetUserQuotaReply
+	43, // 84: api.ai.v1.Model.UpdateUserQuota:output_type -> api.ai.v1.UpdateUserQuotaReply
+	45, // 85: api.ai.v1.Model.GetUsageStats:output_type -> api.ai.v1.GetUsageStatsReply
+	47, // 86: api.ai.v1.Model.ResetUsage:output_type -> api.ai.v1.ResetUsageReply
+	49, // 87: api.ai.v1.Model.CheckRateLimit:output_type -> api.ai.v1.CheckRateLimitReply
+	51, // 88: api.ai.v1.Model.GetRateLimitConfig:output_type -> api.ai.v1.GetRateLimitConfigReply
+	53, // 89: api.ai.v1.Model.UpdateRateLimitConfig:output_type -> api.ai.v1.UpdateRateLimitConfigReply
+	55, // 90: api.ai.v1.Model.HealthCheck:output_type -> api.ai.v1.HealthCheckReply
+	57, // 91: api.ai.v1.Model.GetModelMetrics:output_type -> api.ai.v1.GetModelMetricsReply
+	68, // [68:92] is the sub-list for method output_type
+	44, // [44:68] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_api_ai_v1_model_proto_init() }
@@ -3954,7 +4626,7 @@ func file_api_ai_v1_model_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_ai_v1_model_proto_rawDesc), len(file_api_ai_v1_model_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   58,
+			NumMessages:   67,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,26 +19,30 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Model_CreateProvider_FullMethodName        = "/api.ai.v1.Model/CreateProvider"
-	Model_UpdateProvider_FullMethodName        = "/api.ai.v1.Model/UpdateProvider"
-	Model_DeleteProvider_FullMethodName        = "/api.ai.v1.Model/DeleteProvider"
-	Model_ListProviders_FullMethodName         = "/api.ai.v1.Model/ListProviders"
-	Model_TestProvider_FullMethodName          = "/api.ai.v1.Model/TestProvider"
-	Model_CreateModel_FullMethodName           = "/api.ai.v1.Model/CreateModel"
-	Model_UpdateModel_FullMethodName           = "/api.ai.v1.Model/UpdateModel"
-	Model_DeleteModel_FullMethodName           = "/api.ai.v1.Model/DeleteModel"
-	Model_ListModels_FullMethodName            = "/api.ai.v1.Model/ListModels"
-	Model_GetModel_FullMethodName              = "/api.ai.v1.Model/GetModel"
-	Model_SwitchModel_FullMethodName           = "/api.ai.v1.Model/SwitchModel"
-	Model_GetUserQuota_FullMethodName          = "/api.ai.v1.Model/GetUserQuota"
-	Model_UpdateUserQuota_FullMethodName       = "/api.ai.v1.Model/UpdateUserQuota"
-	Model_GetUsageStats_FullMethodName         = "/api.ai.v1.Model/GetUsageStats"
-	Model_ResetUsage_FullMethodName            = "/api.ai.v1.Model/ResetUsage"
-	Model_CheckRateLimit_FullMethodName        = "/api.ai.v1.Model/CheckRateLimit"
-	Model_GetRateLimitConfig_FullMethodName    = "/api.ai.v1.Model/GetRateLimitConfig"
-	Model_UpdateRateLimitConfig_FullMethodName = "/api.ai.v1.Model/UpdateRateLimitConfig"
-	Model_HealthCheck_FullMethodName           = "/api.ai.v1.Model/HealthCheck"
-	Model_GetModelMetrics_FullMethodName       = "/api.ai.v1.Model/GetModelMetrics"
+	Model_CreateProvider_FullMethodName           = "/api.ai.v1.Model/CreateProvider"
+	Model_UpdateProvider_FullMethodName           = "/api.ai.v1.Model/UpdateProvider"
+	Model_DeleteProvider_FullMethodName           = "/api.ai.v1.Model/DeleteProvider"
+	Model_ListProviders_FullMethodName            = "/api.ai.v1.Model/ListProviders"
+	Model_TestProvider_FullMethodName             = "/api.ai.v1.Model/TestProvider"
+	Model_SyncProviderModels_FullMethodName       = "/api.ai.v1.Model/SyncProviderModels"
+	Model_SetProviderCredential_FullMethodName    = "/api.ai.v1.Model/SetProviderCredential"
+	Model_DeleteProviderCredential_FullMethodName = "/api.ai.v1.Model/DeleteProviderCredential"
+	Model_ListProviderCredentials_FullMethodName  = "/api.ai.v1.Model/ListProviderCredentials"
+	Model_CreateModel_FullMethodName              = "/api.ai.v1.Model/CreateModel"
+	Model_UpdateModel_FullMethodName              = "/api.ai.v1.Model/UpdateModel"
+	Model_DeleteModel_FullMethodName              = "/api.ai.v1.Model/DeleteModel"
+	Model_ListModels_FullMethodName               = "/api.ai.v1.Model/ListModels"
+	Model_GetModel_FullMethodName                 = "/api.ai.v1.Model/GetModel"
+	Model_SwitchModel_FullMethodName              = "/api.ai.v1.Model/SwitchModel"
+	Model_GetUserQuota_FullMethodName             = "/api.ai.v1.Model/GetUserQuota"
+	Model_UpdateUserQuota_FullMethodName          = "/api.ai.v1.Model/UpdateUserQuota"
+	Model_GetUsageStats_FullMethodName            = "/api.ai.v1.Model/GetUsageStats"
+	Model_ResetUsage_FullMethodName               = "/api.ai.v1.Model/ResetUsage"
+	Model_CheckRateLimit_FullMethodName           = "/api.ai.v1.Model/CheckRateLimit"
+	Model_GetRateLimitConfig_FullMethodName       = "/api.ai.v1.Model/GetRateLimitConfig"
+	Model_UpdateRateLimitConfig_FullMethodName    = "/api.ai.v1.Model/UpdateRateLimitConfig"
+	Model_HealthCheck_FullMethodName              = "/api.ai.v1.Model/HealthCheck"
+	Model_GetModelMetrics_FullMethodName          = "/api.ai.v1.Model/GetModelMetrics"
 )
 
 // ModelClient is the client API for Model service.
@@ -62,6 +66,16 @@ type ModelClient interface {
 	// TestProvider 测试模型提供商连接
 	// 验证API密钥和网络连接是否正常
 	TestProvider(ctx context.Context, in *TestProviderRequest, opts ...grpc.CallOption) (*TestProviderReply, error)
+	// SyncProviderModels 从提供商模型列表同步模型目录
+	// 新模型按内置元数据填充能力与限制，提供商已下线的模型标记为不可用，返回变更明细
+	SyncProviderModels(ctx context.Context, in *SyncProviderModelsRequest, opts ...grpc.CallOption) (*SyncProviderModelsReply, error)
+	// SetProviderCredential 保存用户或工作空间自有的提供商密钥
+	// 保存前向提供商验证密钥，生成时按 用户密钥 → 工作空间密钥 → 提供商默认密钥 的顺序使用
+	SetProviderCredential(ctx context.Context, in *SetProviderCredentialRequest, opts ...grpc.CallOption) (*SetProviderCredentialReply, error)
+	// DeleteProviderCredential 删除自有的提供商密钥
+	DeleteProviderCredential(ctx context.Context, in *DeleteProviderCredentialRequest, opts ...grpc.CallOption) (*DeleteProviderCredentialReply, error)
+	// ListProviderCredentials 获取自有的提供商密钥列表，密钥脱敏返回
+	ListProviderCredentials(ctx context.Context, in *ListProviderCredentialsRequest, opts ...grpc.CallOption) (*ListProviderCredentialsReply, error)
 	// CreateModel 注册新的AI模型
 	// 在指定提供商下创建模型配置
 	CreateModel(ctx context.Context, in *CreateModelRequest, opts ...grpc.CallOption) (*CreateModelReply, error)
@@ -167,6 +181,46 @@ func (c *modelClient) TestProvider(ctx context.Context, in *TestProviderRequest,
 	return out, nil
 }
 
+func (c *modelClient) SyncProviderModels(ctx context.Context, in *SyncProviderModelsRequest, opts ...grpc.CallOption) (*SyncProviderModelsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncProviderModelsReply)
+	err := c.cc.Invoke(ctx, Model_SyncProviderModels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *modelClient) SetProviderCredential(ctx context.Context, in *SetProviderCredentialRequest, opts ...grpc.CallOption) (*SetProviderCredentialReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetProviderCredentialReply)
+	err := c.cc.Invoke(ctx, Model_SetProviderCredential_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *modelClient) DeleteProviderCredential(ctx context.Context, in *DeleteProviderCredentialRequest, opts ...grpc.CallOption) (*DeleteProviderCredentialReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProviderCredentialReply)
+	err := c.cc.Invoke(ctx, Model_DeleteProviderCredential_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *modelClient) ListProviderCredentials(ctx context.Context, in *ListProviderCredentialsRequest, opts ...grpc.CallOption) (*ListProviderCredentialsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProviderCredentialsReply)
+	err := c.cc.Invoke(ctx, Model_ListProviderCredentials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *modelClient) CreateModel(ctx context.Context, in *CreateModelRequest, opts ...grpc.CallOption) (*CreateModelReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateModelReply)
@@ -338,6 +392,16 @@ type ModelServer interface {
 	// TestProvider 测试模型提供商连接
 	// 验证API密钥和网络连接是否正常
 	TestProvider(context.Context, *TestProviderRequest) (*TestProviderReply, error)
+	// SyncProviderModels 从提供商模型列表同步模型目录
+	// 新模型按内置元数据填充能力与限制，提供商已下线的模型标记为不可用，返回变更明细
+	SyncProviderModels(context.Context, *SyncProviderModelsRequest) (*SyncProviderModelsReply, error)
+	// SetProviderCredential 保存用户或工作空间自有的提供商密钥
+	// 保存前向提供商验证密钥，生成时按 用户密钥 → 工作空间密钥 → 提供商默认密钥 的顺序使用
+	SetProviderCredential(context.Context, *SetProviderCredentialRequest) (*SetProviderCredentialReply, error)
+	// DeleteProviderCredential 删除自有的提供商密钥
+	DeleteProviderCredential(context.Context, *DeleteProviderCredentialRequest) (*DeleteProviderCredentialReply, error)
+	// ListProviderCredentials 获取自有的提供商密钥列表，密钥脱敏返回
+	ListProviderCredentials(context.Context, *ListProviderCredentialsRequest) (*ListProviderCredentialsReply, error)
 	// CreateModel 注册新的AI模型
 	// 在指定提供商下创建模型配置
 	CreateModel(context.Context, *CreateModelRequest) (*CreateModelReply, error)
@@ -408,6 +472,18 @@ func (UnimplementedModelServer) ListProviders(context.Context, *ListProvidersReq
 func (UnimplementedModelServer) TestProvider(context.Context, *TestProviderRequest) (*TestProviderReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TestProvider not implemented")
 }
+func (UnimplementedModelServer) SyncProviderModels(context.Context, *SyncProviderModelsRequest) (*SyncProviderModelsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncProviderModels not implemented")
+}
+func (UnimplementedModelServer) SetProviderCredential(context.Context, *SetProviderCredentialRequest) (*SetProviderCredentialReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProviderCredential not implemented")
+}
+func (UnimplementedModelServer) DeleteProviderCredential(context.Context, *DeleteProviderCredentialRequest) (*DeleteProviderCredentialReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProviderCredential not implemented")
+}
+func (UnimplementedModelServer) ListProviderCredentials(context.Context, *ListProviderCredentialsRequest) (*ListProviderCredentialsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProviderCredentials not implemented")
+}
 func (UnimplementedModelServer) CreateModel(context.Context, *CreateModelRequest) (*CreateModelReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateModel not implemented")
 }
@@ -564,6 +640,78 @@ func _Model_TestProvider_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Model_SyncProviderModels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncProviderModelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelServer).SyncProviderModels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Model_SyncProviderModels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelServer).SyncProviderModels(ctx, req.(*SyncProviderModelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Model_SetProviderCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProviderCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelServer).SetProviderCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Model_SetProviderCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelServer).SetProviderCredential(ctx, req.(*SetProviderCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Model_DeleteProviderCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProviderCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelServer).DeleteProviderCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Model_DeleteProviderCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelServer).DeleteProviderCredential(ctx, req.(*DeleteProviderCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Model_ListProviderCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProviderCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelServer).ListProviderCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Model_ListProviderCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelServer).ListProviderCredentials(ctx, req.(*ListProviderCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Model_CreateModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateModelRequest)
 	if err := dec(in); err != nil {
@@ -861,6 +1009,22 @@ var Model_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TestProvider",
 			Handler:    _Model_TestProvider_Handler,
 		},
+		{
+			MethodName: "SyncProviderModels",
+			Handler:    _Model_SyncProviderModels_Handler,
+		},
+		{
+			MethodName: "SetProviderCredential",
+			Handler:    _Model_SetProviderCredential_Handler,
+		},
+		{
+			MethodName: "DeleteProviderCredential",
+			Handler:    _Model_DeleteProviderCredential_Handler,
+		},
+		{
+			MethodName: "ListProviderCredentials",
+			Handler:    _Model_ListProviderCredentials_Handler,
+		},
 		{
 			MethodName: "CreateModel",
 			Handler:    _Model_CreateModel_Handler,
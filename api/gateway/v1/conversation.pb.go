@@ -26,7 +26,7 @@ var File_api_gateway_v1_conversation_proto protoreflect.FileDescriptor
 
 const file_api_gateway_v1_conversation_proto_rawDesc = "" +
 	"\n" +
-	"!api/gateway/v1/conversation.proto\x12\x10api.universal.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1capi/ai/v1/conversation.proto2\xfd\x18\n" +
+	"!api/gateway/v1/conversation.proto\x12\x10api.universal.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1capi/ai/v1/conversation.proto2\xf4 \n" +
 	"\fConversation\x12\x83\x01\n" +
 	"\x12CreateConversation\x12$.api.ai.v1.CreateConversationRequest\x1a\".api.ai.v1.CreateConversationReply\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/ai/v1/conversations\x12|\n" +
 	"\x0fGetConversation\x12!.api.ai.v1.GetConversationRequest\x1a\x1f.api.ai.v1.GetConversationReply\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/ai/v1/conversations/{id}\x12\x88\x01\n" +
@@ -48,51 +48,69 @@ const file_api_gateway_v1_conversation_proto_rawDesc = "" +
 	"\x15GetConversationMemory\x12'.api.ai.v1.GetConversationMemoryRequest\x1a%.api.ai.v1.GetConversationMemoryReply\"9\x82\xd3\xe4\x93\x023\x121/api/ai/v1/conversations/{conversation_id}/memory\x12\x9e\x01\n" +
 	"\x14GetConversationStats\x12&.api.ai.v1.GetConversationStatsRequest\x1a$.api.ai.v1.GetConversationStatsReply\"8\x82\xd3\xe4\x93\x022\x120/api/ai/v1/conversations/{conversation_id}/stats\x12\x9c\x01\n" +
 	"\x12ExportConversation\x12$.api.ai.v1.ExportConversationRequest\x1a\".api.ai.v1.ExportConversationReply\"<\x82\xd3\xe4\x93\x026:\x01*\"1/api/ai/v1/conversations/{conversation_id}/export\x12\x9a\x01\n" +
-	"\x12ImportConversation\x12$.api.ai.v1.ImportConversationRequest\x1a\".api.ai.v1.ImportConversationReply\":\x82\xd3\xe4\x93\x024:\x01*\"//api/ai/v1/users/{user_id}/conversations/importBY\n" +
+	"\x12ImportConversation\x12$.api.ai.v1.ImportConversationRequest\x1a\".api.ai.v1.ImportConversationReply\":\x82\xd3\xe4\x93\x024:\x01*\"//api/ai/v1/users/{user_id}/conversations/import\x12\x99\x01\n" +
+	"\x11ShareConversation\x12#.api.ai.v1.ShareConversationRequest\x1a!.api.ai.v1.ShareConversationReply\"<\x82\xd3\xe4\x93\x026:\x01*\"1/api/ai/v1/conversations/{conversation_id}/shares\x12\xba\x01\n" +
+	"\x17RevokeConversationShare\x12).api.ai.v1.RevokeConversationShareRequest\x1a'.api.ai.v1.RevokeConversationShareReply\"K\x82\xd3\xe4\x93\x02E*C/api/ai/v1/conversations/{conversation_id}/shares/{grantee_user_id}\x12\xa5\x01\n" +
+	"\x16ListConversationShares\x12(.api.ai.v1.ListConversationSharesRequest\x1a&.api.ai.v1.ListConversationSharesReply\"9\x82\xd3\xe4\x93\x023\x121/api/ai/v1/conversations/{conversation_id}/shares\x12\xba\x01\n" +
+	"\x1bPublishConversationSnapshot\x12-.api.ai.v1.PublishConversationSnapshotRequest\x1a+.api.ai.v1.PublishConversationSnapshotReply\"?\x82\xd3\xe4\x93\x029:\x01*\"4/api/ai/v1/conversations/{conversation_id}/snapshots\x12\x9c\x01\n" +
+	"\x1aRevokeConversationSnapshot\x12,.api.ai.v1.RevokeConversationSnapshotRequest\x1a*.api.ai.v1.RevokeConversationSnapshotReply\"$\x82\xd3\xe4\x93\x02\x1e*\x1c/api/ai/v1/snapshots/{token}\x12\x97\x01\n" +
+	"\x17GetConversationSnapshot\x12).api.ai.v1.GetConversationSnapshotRequest\x1a'.api.ai.v1.GetConversationSnapshotReply\"(\x82\xd3\xe4\x93\x02\"\x12 /api/public/v1/snapshots/{token}BY\n" +
 	"#com.oldwei.universal.api.gateway.v1B\x13ConversationProtoV1P\x01Z\x1buniversal/api/gateway/v1;v1b\x06proto3"
 
 var file_api_gateway_v1_conversation_proto_goTypes = []any{
-	(*v1.CreateConversationRequest)(nil),        // 0: api.ai.v1.CreateConversationRequest
-	(*v1.GetConversationRequest)(nil),           // 1: api.ai.v1.GetConversationRequest
-	(*v1.UpdateConversationRequest)(nil),        // 2: api.ai.v1.UpdateConversationRequest
-	(*v1.DeleteConversationRequest)(nil),        // 3: api.ai.v1.DeleteConversationRequest
-	(*v1.ListConversationsRequest)(nil),         // 4: api.ai.v1.ListConversationsRequest
-	(*v1.ArchiveConversationRequest)(nil),       // 5: api.ai.v1.ArchiveConversationRequest
-	(*v1.RestoreConversationRequest)(nil),       // 6: api.ai.v1.RestoreConversationRequest
-	(*v1.SendMessageRequest)(nil),               // 7: api.ai.v1.SendMessageRequest
-	(*v1.GetMessagesRequest)(nil),               // 8: api.ai.v1.GetMessagesRequest
-	(*v1.DeleteMessageRequest)(nil),             // 9: api.ai.v1.DeleteMessageRequest
-	(*v1.RegenerateMessageRequest)(nil),         // 10: api.ai.v1.RegenerateMessageRequest
-	(*v1.GetConversationContextRequest)(nil),    // 11: api.ai.v1.GetConversationContextRequest
-	(*v1.UpdateConversationContextRequest)(nil), // 12: api.ai.v1.UpdateConversationContextRequest
-	(*v1.SummarizeConversationRequest)(nil),     // 13: api.ai.v1.SummarizeConversationRequest
-	(*v1.ClearConversationHistoryRequest)(nil),  // 14: api.ai.v1.ClearConversationHistoryRequest
-	(*v1.SetConversationMemoryRequest)(nil),     // 15: api.ai.v1.SetConversationMemoryRequest
-	(*v1.GetConversationMemoryRequest)(nil),     // 16: api.ai.v1.GetConversationMemoryRequest
-	(*v1.GetConversationStatsRequest)(nil),      // 17: api.ai.v1.GetConversationStatsRequest
-	(*v1.ExportConversationRequest)(nil),        // 18: api.ai.v1.ExportConversationRequest
-	(*v1.ImportConversationRequest)(nil),        // 19: api.ai.v1.ImportConversationRequest
-	(*v1.CreateConversationReply)(nil),          // 20: api.ai.v1.CreateConversationReply
-	(*v1.GetConversationReply)(nil),             // 21: api.ai.v1.GetConversationReply
-	(*v1.UpdateConversationReply)(nil),          // 22: api.ai.v1.UpdateConversationReply
-	(*v1.DeleteConversationReply)(nil),          // 23: api.ai.v1.DeleteConversationReply
-	(*v1.ListConversationsReply)(nil),           // 24: api.ai.v1.ListConversationsReply
-	(*v1.ArchiveConversationReply)(nil),         // 25: api.ai.v1.ArchiveConversationReply
-	(*v1.RestoreConversationReply)(nil),         // 26: api.ai.v1.RestoreConversationReply
-	(*v1.SendMessageReply)(nil),                 // 27: api.ai.v1.SendMessageReply
-	(*v1.SendMessageStreamReply)(nil),           // 28: api.ai.v1.SendMessageStreamReply
-	(*v1.GetMessagesReply)(nil),                 // 29: api.ai.v1.GetMessagesReply
-	(*v1.DeleteMessageReply)(nil),               // 30: api.ai.v1.DeleteMessageReply
-	(*v1.RegenerateMessageReply)(nil),           // 31: api.ai.v1.RegenerateMessageReply
-	(*v1.GetConversationContextReply)(nil),      // 32: api.ai.v1.GetConversationContextReply
-	(*v1.UpdateConversationContextReply)(nil),   // 33: api.ai.v1.UpdateConversationContextReply
-	(*v1.SummarizeConversationReply)(nil),       // 34: api.ai.v1.SummarizeConversationReply
-	(*v1.ClearConversationHistoryReply)(nil),    // 35: api.ai.v1.ClearConversationHistoryReply
-	(*v1.SetConversationMemoryReply)(nil),       // 36: api.ai.v1.SetConversationMemoryReply
-	(*v1.GetConversationMemoryReply)(nil),       // 37: api.ai.v1.GetConversationMemoryReply
-	(*v1.GetConversationStatsReply)(nil),        // 38: api.ai.v1.GetConversationStatsReply
-	(*v1.ExportConversationReply)(nil),          // 39: api.ai.v1.ExportConversationReply
-	(*v1.ImportConversationReply)(nil),          // 40: api.ai.v1.ImportConversationReply
+	(*v1.CreateConversationRequest)(nil),          // 0: api.ai.v1.CreateConversationRequest
+	(*v1.GetConversationRequest)(nil),             // 1: api.ai.v1.GetConversationRequest
+	(*v1.UpdateConversationRequest)(nil),          // 2: api.ai.v1.UpdateConversationRequest
+	(*v1.DeleteConversationRequest)(nil),          // 3: api.ai.v1.DeleteConversationRequest
+	(*v1.ListConversationsRequest)(nil),           // 4: api.ai.v1.ListConversationsRequest
+	(*v1.ArchiveConversationRequest)(nil),         // 5: api.ai.v1.ArchiveConversationRequest
+	(*v1.RestoreConversationRequest)(nil),         // 6: api.ai.v1.RestoreConversationRequest
+	(*v1.SendMessageRequest)(nil),                 // 7: api.ai.v1.SendMessageRequest
+	(*v1.GetMessagesRequest)(nil),                 // 8: api.ai.v1.GetMessagesRequest
+	(*v1.DeleteMessageRequest)(nil),               // 9: api.ai.v1.DeleteMessageRequest
+	(*v1.RegenerateMessageRequest)(nil),           // 10: api.ai.v1.RegenerateMessageRequest
+	(*v1.GetConversationContextRequest)(nil),      // 11: api.ai.v1.GetConversationContextRequest
+	(*v1.UpdateConversationContextRequest)(nil),   // 12: api.ai.v1.UpdateConversationContextRequest
+	(*v1.SummarizeConversationRequest)(nil),       // 13: api.ai.v1.SummarizeConversationRequest
+	(*v1.ClearConversationHistoryRequest)(nil),    // 14: api.ai.v1.ClearConversationHistoryRequest
+	(*v1.SetConversationMemoryRequest)(nil),       // 15: api.ai.v1.SetConversationMemoryRequest
+	(*v1.GetConversationMemoryRequest)(nil),       // 16: api.ai.v1.GetConversationMemoryRequest
+	(*v1.GetConversationStatsRequest)(nil),        // 17: api.ai.v1.GetConversationStatsRequest
+	(*v1.ExportConversationRequest)(nil),          // 18: api.ai.v1.ExportConversationRequest
+	(*v1.ImportConversationRequest)(nil),          // 19: api.ai.v1.ImportConversationRequest
+	(*v1.ShareConversationRequest)(nil),           // 20: api.ai.v1.ShareConversationRequest
+	(*v1.RevokeConversationShareRequest)(nil),     // 21: api.ai.v1.RevokeConversationShareRequest
+	(*v1.ListConversationSharesRequest)(nil),      // 22: api.ai.v1.ListConversationSharesRequest
+	(*v1.PublishConversationSnapshotRequest)(nil), // 23: api.ai.v1.PublishConversationSnapshotRequest
+	(*v1.RevokeConversationSnapshotRequest)(nil),  // 24: api.ai.v1.RevokeConversationSnapshotRequest
+	(*v1.GetConversationSnapshotRequest)(nil),     // 25: api.ai.v1.GetConversationSnapshotRequest
+	(*v1.CreateConversationReply)(nil),            // 26: api.ai.v1.CreateConversationReply
+	(*v1.GetConversationReply)(nil),               // 27: api.ai.v1.GetConversationReply
+	(*v1.UpdateConversationReply)(nil),            // 28: api.ai.v1.UpdateConversationReply
+	(*v1.DeleteConversationReply)(nil),            // 29: api.ai.v1.DeleteConversationReply
+	(*v1.ListConversationsReply)(nil),             // 30: api.ai.v1.ListConversationsReply
+	(*v1.ArchiveConversationReply)(nil),           // 31: api.ai.v1.ArchiveConversationReply
+	(*v1.RestoreConversationReply)(nil),           // 32: api.ai.v1.RestoreConversationReply
+	(*v1.SendMessageReply)(nil),                   // 33: api.ai.v1.SendMessageReply
+	(*v1.SendMessageStreamReply)(nil),             // 34: api.ai.v1.SendMessageStreamReply
+	(*v1.GetMessagesReply)(nil),                   // 35: api.ai.v1.GetMessagesReply
+	(*v1.DeleteMessageReply)(nil),                 // 36: api.ai.v1.DeleteMessageReply
+	(*v1.RegenerateMessageReply)(nil),             // 37: api.ai.v1.RegenerateMessageReply
+	(*v1.GetConversationContextReply)(nil),        // 38: api.ai.v1.GetConversationContextReply
+	(*v1.UpdateConversationContextReply)(nil),     // 39: api.ai.v1.UpdateConversationContextReply
+	(*v1.SummarizeConversationReply)(nil),         // 40: api.ai.v1.SummarizeConversationReply
+	(*v1.ClearConversationHistoryReply)(nil),      // 41: api.ai.v1.ClearConversationHistoryReply
+	(*v1.SetConversationMemoryReply)(nil),         // 42: api.ai.v1.SetConversationMemoryReply
+	(*v1.GetConversationMemoryReply)(nil),         // 43: api.ai.v1.GetConversationMemoryReply
+	(*v1.GetConversationStatsReply)(nil),          // 44: api.ai.v1.GetConversationStatsReply
+	(*v1.ExportConversationReply)(nil),            // 45: api.ai.v1.ExportConversationReply
+	(*v1.ImportConversationReply)(nil),            // 46: api.ai.v1.ImportConversationReply
+	(*v1.ShareConversationReply)(nil),             // 47: api.ai.v1.ShareConversationReply
+	(*v1.RevokeConversationShareReply)(nil),       // 48: api.ai.v1.RevokeConversationShareReply
+	(*v1.ListConversationSharesReply)(nil),        // 49: api.ai.v1.ListConversationSharesReply
+	(*v1.PublishConversationSnapshotReply)(nil),   // 50: api.ai.v1.PublishConversationSnapshotReply
+	(*v1.RevokeConversationSnapshotReply)(nil),    // 51: api.ai.v1.RevokeConversationSnapshotReply
+	(*v1.GetConversationSnapshotReply)(nil),       // 52: api.ai.v1.GetConversationSnapshotReply
 }
 var file_api_gateway_v1_conversation_proto_depIdxs = []int32{
 	0,  // 0: api.universal.v1.Conversation.CreateConversation:input_type -> api.ai.v1.CreateConversationRequest
@@ -116,29 +134,41 @@ var file_api_gateway_v1_conversation_proto_depIdxs = []int32{
 	17, // 18: api.universal.v1.Conversation.GetConversationStats:input_type -> api.ai.v1.GetConversationStatsRequest
 	18, // 19: api.universal.v1.Conversation.ExportConversation:input_type -> api.ai.v1.ExportConversationRequest
 	19, // 20: api.universal.v1.Conversation.ImportConversation:input_type -> api.ai.v1.ImportConversationRequest
-	20, // 21: api.universal.v1.Conversation.CreateConversation:output_type -> api.ai.v1.CreateConversationReply
-	21, // 22: api.universal.v1.Conversation.GetConversation:output_type -> api.ai.v1.GetConversationReply
-	22, // 23: api.universal.v1.Conversation.UpdateConversation:output_type -> api.ai.v1.UpdateConversationReply
-	23, // 24: api.universal.v1.Conversation.DeleteConversation:output_type -> api.ai.v1.DeleteConversationReply
-	24, // 25: api.universal.v1.Conversation.ListConversations:output_type -> api.ai.v1.ListConversationsReply
-	25, // 26: api.universal.v1.Conversation.ArchiveConversation:output_type -> api.ai.v1.ArchiveConversationReply
-	26, // 27: api.universal.v1.Conversation.RestoreConversation:output_type -> api.ai.v1.RestoreConversationReply
-	27, // 28: api.universal.v1.Conversation.SendMessage:output_type -> api.ai.v1.SendMessageReply
-	28, // 29: api.universal.v1.Conversation.SendStreamMessage:output_type -> api.ai.v1.SendMessageStreamReply
-	29, // 30: api.universal.v1.Conversation.GetMessages:output_type -> api.ai.v1.GetMessagesReply
-	30, // 31: api.universal.v1.Conversation.DeleteMessage:output_type -> api.ai.v1.DeleteMessageReply
-	31, // 32: api.universal.v1.Conversation.RegenerateMessage:output_type -> api.ai.v1.RegenerateMessageReply
-	32, // 33: api.universal.v1.Conversation.GetConversationContext:output_type -> api.ai.v1.GetConversationContextReply
-	33, // 34: api.universal.v1.Conversation.UpdateConversationContext:output_type -> api.ai.v1.UpdateConversationContextReply
-	34, // 35: api.universal.v1.Conversation.SummarizeConversation:output_type -> api.ai.v1.SummarizeConversationReply
-	35, // 36: api.universal.v1.Conversation.ClearConversationHistory:output_type -> api.ai.v1.ClearConversationHistoryReply
-	36, // 37: api.universal.v1.Conversation.SetConversationMemory:output_type -> api.ai.v1.SetConversationMemoryReply
-	37, // 38: api.universal.v1.Conversation.GetConversationMemory:output_type -> api.ai.v1.GetConversationMemoryReply
-	38, // 39: api.universal.v1.Conversation.GetConversationStats:output_type -> api.ai.v1.GetConversationStatsReply
-	39, // 40: api.universal.v1.Conversation.ExportConversation:output_type -> api.ai.v1.ExportConversationReply
-	40, // 41: api.universal.v1.Conversation.ImportConversation:output_type -> api.ai.v1.ImportConversationReply
-	21, // [21:42] is the sub-list for method output_type
-	0,  // [0:21] is the sub-list for method input_type
+	20, // 21: api.universal.v1.Conversation.ShareConversation:input_type -> api.ai.v1.ShareConversationRequest
+	21, // 22: api.universal.v1.Conversation.RevokeConversationShare:input_type -> api.ai.v1.RevokeConversationShareRequest
+	22, // 23: api.universal.v1.Conversation.ListConversationShares:input_type -> api.ai.v1.ListConversationSharesRequest
+	23, // 24: api.universal.v1.Conversation.PublishConversationSnapshot:input_type -> api.ai.v1.PublishConversationSnapshotRequest
+	24, // 25: api.universal.v1.Conversation.RevokeConversationSnapshot:input_type -> api.ai.v1.RevokeConversationSnapshotRequest
+	25, // 26: api.universal.v1.Conversation.GetConversationSnapshot:input_type -> api.ai.v1.GetConversationSnapshotRequest
+	26, // 27: api.universal.v1.Conversation.CreateConversation:output_type -> api.ai.v1.CreateConversationReply
+	27, // 28: api.universal.v1.Conversation.GetConversation:output_type -> api.ai.v1.GetConversationReply
+	28, // 29: api.universal.v1.Conversation.UpdateConversation:output_type -> api.ai.v1.UpdateConversationReply
+	29, // 30: api.universal.v1.Conversation.DeleteConversation:output_type -> api.ai.v1.DeleteConversationReply
+	30, // 31: api.universal.v1.Conversation.ListConversations:output_type -> api.ai.v1.ListConversationsReply
+	31, // 32: api.universal.v1.Conversation.ArchiveConversation:output_type -> api.ai.v1.ArchiveConversationReply
+	32, // 33: api.universal.v1.Conversation.RestoreConversation:output_type -> api.ai.v1.RestoreConversationReply
+	33, // 34: api.universal.v1.Conversation.SendMessage:output_type -> api.ai.v1.SendMessageReply
+	34, // 35: api.universal.v1.Conversation.SendStreamMessage:output_type -> api.ai.v1.SendMessageStreamReply
+	35, // 36: api.universal.v1.Conversation.GetMessages:output_type -> api.ai.v1.GetMessagesReply
+	36, // 37: api.universal.v1.Conversation.DeleteMessage:output_type -> api.ai.v1.DeleteMessageReply
+	37, // 38: api.universal.v1.Conversation.RegenerateMessage:output_type -> api.ai.v1.RegenerateMessageReply
+	38, // 39: api.universal.v1.Conversation.GetConversationContext:output_type -> api.ai.v1.GetConversationContextReply
+	39, // 40: api.universal.v1.Conversation.UpdateConversationContext:output_type -> api.ai.v1.UpdateConversationContextReply
+	40, // 41: api.universal.v1.Conversation.SummarizeConversation:output_type -> api.ai.v1.SummarizeConversationReply
+	41, // 42: api.universal.v1.Conversation.ClearConversationHistory:output_type -> api.ai.v1.ClearConversationHistoryReply
+	42, // 43: api.universal.v1.Conversation.SetConversationMemory:output_type -> api.ai.v1.SetConversationMemoryReply
+	43, // 44: api.universal.v1.Conversation.GetConversationMemory:output_type -> api.ai.v1.GetConversationMemoryReply
+	44, // 45: api.universal.v1.Conversation.GetConversationStats:output_type -> api.ai.v1.GetConversationStatsReply
+	45, // 46: api.universal.v1.Conversation.ExportConversation:output_type -> api.ai.v1.ExportConversationReply
+	46, // 47: api.universal.v1.Conversation.ImportConversation:output_type -> api.ai.v1.ImportConversationReply
+	47, // 48: api.universal.v1.Conversation.ShareConversation:output_type -> api.ai.v1.ShareConversationReply
+	48, // 49: api.universal.v1.Conversation.RevokeConversationShare:output_type -> api.ai.v1.RevokeConversationShareReply
+	49, // 50: api.universal.v1.Conversation.ListConversationShares:output_type -> api.ai.v1.ListConversationSharesReply
+	50, // 51: api.universal.v1.Conversation.PublishConversationSnapshot:output_type -> api.ai.v1.PublishConversationSnapshotReply
+	51, // 52: api.universal.v1.Conversation.RevokeConversationSnapshot:output_type -> api.ai.v1.RevokeConversationSnapshotReply
+	52, // 53: api.universal.v1.Conversation.GetConversationSnapshot:output_type -> api.ai.v1.GetConversationSnapshotReply
+	27, // [27:54] is the sub-list for method output_type
+	0,  // [0:27] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
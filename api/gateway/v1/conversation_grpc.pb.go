@@ -20,27 +20,33 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Conversation_CreateConversation_FullMethodName        = "/api.universal.v1.Conversation/CreateConversation"
-	Conversation_GetConversation_FullMethodName           = "/api.universal.v1.Conversation/GetConversation"
-	Conversation_UpdateConversation_FullMethodName        = "/api.universal.v1.Conversation/UpdateConversation"
-	Conversation_DeleteConversation_FullMethodName        = "/api.universal.v1.Conversation/DeleteConversation"
-	Conversation_ListConversations_FullMethodName         = "/api.universal.v1.Conversation/ListConversations"
-	Conversation_ArchiveConversation_FullMethodName       = "/api.universal.v1.Conversation/ArchiveConversation"
-	Conversation_RestoreConversation_FullMethodName       = "/api.universal.v1.Conversation/RestoreConversation"
-	Conversation_SendMessage_FullMethodName               = "/api.universal.v1.Conversation/SendMessage"
-	Conversation_SendStreamMessage_FullMethodName         = "/api.universal.v1.Conversation/SendStreamMessage"
-	Conversation_GetMessages_FullMethodName               = "/api.universal.v1.Conversation/GetMessages"
-	Conversation_DeleteMessage_FullMethodName             = "/api.universal.v1.Conversation/DeleteMessage"
-	Conversation_RegenerateMessage_FullMethodName         = "/api.universal.v1.Conversation/RegenerateMessage"
-	Conversation_GetConversationContext_FullMethodName    = "/api.universal.v1.Conversation/GetConversationContext"
-	Conversation_UpdateConversationContext_FullMethodName = "/api.universal.v1.Conversation/UpdateConversationContext"
-	Conversation_SummarizeConversation_FullMethodName     = "/api.universal.v1.Conversation/SummarizeConversation"
-	Conversation_ClearConversationHistory_FullMethodName  = "/api.universal.v1.Conversation/ClearConversationHistory"
-	Conversation_SetConversationMemory_FullMethodName     = "/api.universal.v1.Conversation/SetConversationMemory"
-	Conversation_GetConversationMemory_FullMethodName     = "/api.universal.v1.Conversation/GetConversationMemory"
-	Conversation_GetConversationStats_FullMethodName      = "/api.universal.v1.Conversation/GetConversationStats"
-	Conversation_ExportConversation_FullMethodName        = "/api.universal.v1.Conversation/ExportConversation"
-	Conversation_ImportConversation_FullMethodName        = "/api.universal.v1.Conversation/ImportConversation"
+	Conversation_CreateConversation_FullMethodName          = "/api.universal.v1.Conversation/CreateConversation"
+	Conversation_GetConversation_FullMethodName             = "/api.universal.v1.Conversation/GetConversation"
+	Conversation_UpdateConversation_FullMethodName          = "/api.universal.v1.Conversation/UpdateConversation"
+	Conversation_DeleteConversation_FullMethodName          = "/api.universal.v1.Conversation/DeleteConversation"
+	Conversation_ListConversations_FullMethodName           = "/api.universal.v1.Conversation/ListConversations"
+	Conversation_ArchiveConversation_FullMethodName         = "/api.universal.v1.Conversation/ArchiveConversation"
+	Conversation_RestoreConversation_FullMethodName         = "/api.universal.v1.Conversation/RestoreConversation"
+	Conversation_SendMessage_FullMethodName                 = "/api.universal.v1.Conversation/SendMessage"
+	Conversation_SendStreamMessage_FullMethodName           = "/api.universal.v1.Conversation/SendStreamMessage"
+	Conversation_GetMessages_FullMethodName                 = "/api.universal.v1.Conversation/GetMessages"
+	Conversation_DeleteMessage_FullMethodName               = "/api.universal.v1.Conversation/DeleteMessage"
+	Conversation_RegenerateMessage_FullMethodName           = "/api.universal.v1.Conversation/RegenerateMessage"
+	Conversation_GetConversationContext_FullMethodName      = "/api.universal.v1.Conversation/GetConversationContext"
+	Conversation_UpdateConversationContext_FullMethodName   = "/api.universal.v1.Conversation/UpdateConversationContext"
+	Conversation_SummarizeConversation_FullMethodName       = "/api.universal.v1.Conversation/SummarizeConversation"
+	Conversation_ClearConversationHistory_FullMethodName    = "/api.universal.v1.Conversation/ClearConversationHistory"
+	Conversation_SetConversationMemory_FullMethodName       = "/api.universal.v1.Conversation/SetConversationMemory"
+	Conversation_GetConversationMemory_FullMethodName       = "/api.universal.v1.Conversation/GetConversationMemory"
+	Conversation_GetConversationStats_FullMethodName        = "/api.universal.v1.Conversation/GetConversationStats"
+	Conversation_ExportConversation_FullMethodName          = "/api.universal.v1.Conversation/ExportConversation"
+	Conversation_ImportConversation_FullMethodName          = "/api.universal.v1.Conversation/ImportConversation"
+	Conversation_ShareConversation_FullMethodName           = "/api.universal.v1.Conversation/ShareConversation"
+	Conversation_RevokeConversationShare_FullMethodName     = "/api.universal.v1.Conversation/RevokeConversationShare"
+	Conversation_ListConversationShares_FullMethodName      = "/api.universal.v1.Conversation/ListConversationShares"
+	Conversation_PublishConversationSnapshot_FullMethodName = "/api.universal.v1.Conversation/PublishConversationSnapshot"
+	Conversation_RevokeConversationSnapshot_FullMethodName  = "/api.universal.v1.Conversation/RevokeConversationSnapshot"
+	Conversation_GetConversationSnapshot_FullMethodName     = "/api.universal.v1.Conversation/GetConversationSnapshot"
 )
 
 // ConversationClient is the client API for Conversation service.
@@ -91,6 +97,19 @@ type ConversationClient interface {
 	ExportConversation(ctx context.Context, in *v1.ExportConversationRequest, opts ...grpc.CallOption) (*v1.ExportConversationReply, error)
 	// ImportConversation 导入对话数据
 	ImportConversation(ctx context.Context, in *v1.ImportConversationRequest, opts ...grpc.CallOption) (*v1.ImportConversationReply, error)
+	// ShareConversation 共享对话
+	ShareConversation(ctx context.Context, in *v1.ShareConversationRequest, opts ...grpc.CallOption) (*v1.ShareConversationReply, error)
+	// RevokeConversationShare 撤销对话共享
+	RevokeConversationShare(ctx context.Context, in *v1.RevokeConversationShareRequest, opts ...grpc.CallOption) (*v1.RevokeConversationShareReply, error)
+	// ListConversationShares 获取对话的共享列表
+	ListConversationShares(ctx context.Context, in *v1.ListConversationSharesRequest, opts ...grpc.CallOption) (*v1.ListConversationSharesReply, error)
+	// PublishConversationSnapshot 发布对话公开快照
+	PublishConversationSnapshot(ctx context.Context, in *v1.PublishConversationSnapshotRequest, opts ...grpc.CallOption) (*v1.PublishConversationSnapshotReply, error)
+	// RevokeConversationSnapshot 撤销对话公开快照
+	RevokeConversationSnapshot(ctx context.Context, in *v1.RevokeConversationSnapshotRequest, opts ...grpc.CallOption) (*v1.RevokeConversationSnapshotReply, error)
+	// GetConversationSnapshot 匿名获取对话公开快照
+	// 公开访问路径，仅凭令牌即可读取，不需要登录
+	GetConversationSnapshot(ctx context.Context, in *v1.GetConversationSnapshotRequest, opts ...grpc.CallOption) (*v1.GetConversationSnapshotReply, error)
 }
 
 type conversationClient struct {
@@ -320,6 +339,66 @@ func (c *conversationClient) ImportConversation(ctx context.Context, in *v1.Impo
 	return out, nil
 }
 
+func (c *conversationClient) ShareConversation(ctx context.Context, in *v1.ShareConversationRequest, opts ...grpc.CallOption) (*v1.ShareConversationReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.ShareConversationReply)
+	err := c.cc.Invoke(ctx, Conversation_ShareConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) RevokeConversationShare(ctx context.Context, in *v1.RevokeConversationShareRequest, opts ...grpc.CallOption) (*v1.RevokeConversationShareReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.RevokeConversationShareReply)
+	err := c.cc.Invoke(ctx, Conversation_RevokeConversationShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) ListConversationShares(ctx context.Context, in *v1.ListConversationSharesRequest, opts ...grpc.CallOption) (*v1.ListConversationSharesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.ListConversationSharesReply)
+	err := c.cc.Invoke(ctx, Conversation_ListConversationShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) PublishConversationSnapshot(ctx context.Context, in *v1.PublishConversationSnapshotRequest, opts ...grpc.CallOption) (*v1.PublishConversationSnapshotReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.PublishConversationSnapshotReply)
+	err := c.cc.Invoke(ctx, Conversation_PublishConversationSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) RevokeConversationSnapshot(ctx context.Context, in *v1.RevokeConversationSnapshotRequest, opts ...grpc.CallOption) (*v1.RevokeConversationSnapshotReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.RevokeConversationSnapshotReply)
+	err := c.cc.Invoke(ctx, Conversation_RevokeConversationSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) GetConversationSnapshot(ctx context.Context, in *v1.GetConversationSnapshotRequest, opts ...grpc.CallOption) (*v1.GetConversationSnapshotReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.GetConversationSnapshotReply)
+	err := c.cc.Invoke(ctx, Conversation_GetConversationSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ConversationServer is the server API for Conversation service.
 // All implementations must embed UnimplementedConversationServer
 // for forward compatibility.
@@ -368,6 +447,19 @@ type ConversationServer interface {
 	ExportConversation(context.Context, *v1.ExportConversationRequest) (*v1.ExportConversationReply, error)
 	// ImportConversation 导入对话数据
 	ImportConversation(context.Context, *v1.ImportConversationRequest) (*v1.ImportConversationReply, error)
+	// ShareConversation 共享对话
+	ShareConversation(context.Context, *v1.ShareConversationRequest) (*v1.ShareConversationReply, error)
+	// RevokeConversationShare 撤销对话共享
+	RevokeConversationShare(context.Context, *v1.RevokeConversationShareRequest) (*v1.RevokeConversationShareReply, error)
+	// ListConversationShares 获取对话的共享列表
+	ListConversationShares(context.Context, *v1.ListConversationSharesRequest) (*v1.ListConversationSharesReply, error)
+	// PublishConversationSnapshot 发布对话公开快照
+	PublishConversationSnapshot(context.Context, *v1.PublishConversationSnapshotRequest) (*v1.PublishConversationSnapshotReply, error)
+	// RevokeConversationSnapshot 撤销对话公开快照
+	RevokeConversationSnapshot(context.Context, *v1.RevokeConversationSnapshotRequest) (*v1.RevokeConversationSnapshotReply, error)
+	// GetConversationSnapshot 匿名获取对话公开快照
+	// 公开访问路径，仅凭令牌即可读取，不需要登录
+	GetConversationSnapshot(context.Context, *v1.GetConversationSnapshotRequest) (*v1.GetConversationSnapshotReply, error)
 	mustEmbedUnimplementedConversationServer()
 }
 
@@ -441,6 +533,24 @@ func (UnimplementedConversationServer) ExportConversation(context.Context, *v1.E
 func (UnimplementedConversationServer) ImportConversation(context.Context, *v1.ImportConversationRequest) (*v1.ImportConversationReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportConversation not implemented")
 }
+func (UnimplementedConversationServer) ShareConversation(context.Context, *v1.ShareConversationRequest) (*v1.ShareConversationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareConversation not implemented")
+}
+func (UnimplementedConversationServer) RevokeConversationShare(context.Context, *v1.RevokeConversationShareRequest) (*v1.RevokeConversationShareReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeConversationShare not implemented")
+}
+func (UnimplementedConversationServer) ListConversationShares(context.Context, *v1.ListConversationSharesRequest) (*v1.ListConversationSharesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConversationShares not implemented")
+}
+func (UnimplementedConversationServer) PublishConversationSnapshot(context.Context, *v1.PublishConversationSnapshotRequest) (*v1.PublishConversationSnapshotReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishConversationSnapshot not implemented")
+}
+func (UnimplementedConversationServer) RevokeConversationSnapshot(context.Context, *v1.RevokeConversationSnapshotRequest) (*v1.RevokeConversationSnapshotReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeConversationSnapshot not implemented")
+}
+func (UnimplementedConversationServer) GetConversationSnapshot(context.Context, *v1.GetConversationSnapshotRequest) (*v1.GetConversationSnapshotReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversationSnapshot not implemented")
+}
 func (UnimplementedConversationServer) mustEmbedUnimplementedConversationServer() {}
 func (UnimplementedConversationServer) testEmbeddedByValue()                      {}
 
@@ -833,6 +943,114 @@ func _Conversation_ImportConversation_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Conversation_ShareConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ShareConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).ShareConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_ShareConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).ShareConversation(ctx, req.(*v1.ShareConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_RevokeConversationShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.RevokeConversationShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).RevokeConversationShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_RevokeConversationShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).RevokeConversationShare(ctx, req.(*v1.RevokeConversationShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_ListConversationShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ListConversationSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).ListConversationShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_ListConversationShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).ListConversationShares(ctx, req.(*v1.ListConversationSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_PublishConversationSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.PublishConversationSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).PublishConversationSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_PublishConversationSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).PublishConversationSnapshot(ctx, req.(*v1.PublishConversationSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_RevokeConversationSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.RevokeConversationSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).RevokeConversationSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_RevokeConversationSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).RevokeConversationSnapshot(ctx, req.(*v1.RevokeConversationSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_GetConversationSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.GetConversationSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).GetConversationSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_GetConversationSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).GetConversationSnapshot(ctx, req.(*v1.GetConversationSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Conversation_ServiceDesc is the grpc.ServiceDesc for Conversation service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -920,6 +1138,30 @@ var Conversation_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ImportConversation",
 			Handler:    _Conversation_ImportConversation_Handler,
 		},
+		{
+			MethodName: "ShareConversation",
+			Handler:    _Conversation_ShareConversation_Handler,
+		},
+		{
+			MethodName: "RevokeConversationShare",
+			Handler:    _Conversation_RevokeConversationShare_Handler,
+		},
+		{
+			MethodName: "ListConversationShares",
+			Handler:    _Conversation_ListConversationShares_Handler,
+		},
+		{
+			MethodName: "PublishConversationSnapshot",
+			Handler:    _Conversation_PublishConversationSnapshot_Handler,
+		},
+		{
+			MethodName: "RevokeConversationSnapshot",
+			Handler:    _Conversation_RevokeConversationSnapshot_Handler,
+		},
+		{
+			MethodName: "GetConversationSnapshot",
+			Handler:    _Conversation_GetConversationSnapshot_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
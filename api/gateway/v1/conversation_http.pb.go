@@ -29,14 +29,20 @@ const OperationConversationExportConversation = "/api.universal.v1.Conversation/
 const OperationConversationGetConversation = "/api.universal.v1.Conversation/GetConversation"
 const OperationConversationGetConversationContext = "/api.universal.v1.Conversation/GetConversationContext"
 const OperationConversationGetConversationMemory = "/api.universal.v1.Conversation/GetConversationMemory"
+const OperationConversationGetConversationSnapshot = "/api.universal.v1.Conversation/GetConversationSnapshot"
 const OperationConversationGetConversationStats = "/api.universal.v1.Conversation/GetConversationStats"
 const OperationConversationGetMessages = "/api.universal.v1.Conversation/GetMessages"
 const OperationConversationImportConversation = "/api.universal.v1.Conversation/ImportConversation"
+const OperationConversationListConversationShares = "/api.universal.v1.Conversation/ListConversationShares"
 const OperationConversationListConversations = "/api.universal.v1.Conversation/ListConversations"
+const OperationConversationPublishConversationSnapshot = "/api.universal.v1.Conversation/PublishConversationSnapshot"
 const OperationConversationRegenerateMessage = "/api.universal.v1.Conversation/RegenerateMessage"
 const OperationConversationRestoreConversation = "/api.universal.v1.Conversation/RestoreConversation"
+const OperationConversationRevokeConversationShare = "/api.universal.v1.Conversation/RevokeConversationShare"
+const OperationConversationRevokeConversationSnapshot = "/api.universal.v1.Conversation/RevokeConversationSnapshot"
 const OperationConversationSendMessage = "/api.universal.v1.Conversation/SendMessage"
 const OperationConversationSetConversationMemory = "/api.universal.v1.Conversation/SetConversationMemory"
+const OperationConversationShareConversation = "/api.universal.v1.Conversation/ShareConversation"
 const OperationConversationSummarizeConversation = "/api.universal.v1.Conversation/SummarizeConversation"
 const OperationConversationUpdateConversation = "/api.universal.v1.Conversation/UpdateConversation"
 const OperationConversationUpdateConversationContext = "/api.universal.v1.Conversation/UpdateConversationContext"
@@ -60,22 +66,35 @@ type ConversationHTTPServer interface {
 	GetConversationContext(context.Context, *v1.GetConversationContextRequest) (*v1.GetConversationContextReply, error)
 	// GetConversationMemory GetConversationMemory 获取对话记忆
 	GetConversationMemory(context.Context, *v1.GetConversationMemoryRequest) (*v1.GetConversationMemoryReply, error)
+	// GetConversationSnapshot GetConversationSnapshot 匿名获取对话公开快照
+	// 公开访问路径，仅凭令牌即可读取，不需要登录
+	GetConversationSnapshot(context.Context, *v1.GetConversationSnapshotRequest) (*v1.GetConversationSnapshotReply, error)
 	// GetConversationStats GetConversationStats 获取对话统计信息
 	GetConversationStats(context.Context, *v1.GetConversationStatsRequest) (*v1.GetConversationStatsReply, error)
 	// GetMessages GetMessages 获取对话的消息历史
 	GetMessages(context.Context, *v1.GetMessagesRequest) (*v1.GetMessagesReply, error)
 	// ImportConversation ImportConversation 导入对话数据
 	ImportConversation(context.Context, *v1.ImportConversationRequest) (*v1.ImportConversationReply, error)
+	// ListConversationShares ListConversationShares 获取对话的共享列表
+	ListConversationShares(context.Context, *v1.ListConversationSharesRequest) (*v1.ListConversationSharesReply, error)
 	// ListConversations ListConversations 获取用户的对话列表
 	ListConversations(context.Context, *v1.ListConversationsRequest) (*v1.ListConversationsReply, error)
+	// PublishConversationSnapshot PublishConversationSnapshot 发布对话公开快照
+	PublishConversationSnapshot(context.Context, *v1.PublishConversationSnapshotRequest) (*v1.PublishConversationSnapshotReply, error)
 	// RegenerateMessage RegenerateMessage 重新生成AI回复
 	RegenerateMessage(context.Context, *v1.RegenerateMessageRequest) (*v1.RegenerateMessageReply, error)
 	// RestoreConversation RestoreConversation 恢复已删除的对话
 	RestoreConversation(context.Context, *v1.RestoreConversationRequest) (*v1.RestoreConversationReply, error)
+	// RevokeConversationShare RevokeConversationShare 撤销对话共享
+	RevokeConversationShare(context.Context, *v1.RevokeConversationShareRequest) (*v1.RevokeConversationShareReply, error)
+	// RevokeConversationSnapshot RevokeConversationSnapshot 撤销对话公开快照
+	RevokeConversationSnapshot(context.Context, *v1.RevokeConversationSnapshotRequest) (*v1.RevokeConversationSnapshotReply, error)
 	// SendMessage SendMessage 向指定对话发送消息并获取AI回复
 	SendMessage(context.Context, *v1.SendMessageRequest) (*v1.SendMessageReply, error)
 	// SetConversationMemory SetConversationMemory 设置对话记忆
 	SetConversationMemory(context.Context, *v1.SetConversationMemoryRequest) (*v1.SetConversationMemoryReply, error)
+	// ShareConversation ShareConversation 共享对话
+	ShareConversation(context.Context, *v1.ShareConversationRequest) (*v1.ShareConversationReply, error)
 	// SummarizeConversation SummarizeConversation 总结对话内容
 	SummarizeConversation(context.Context, *v1.SummarizeConversationRequest) (*v1.SummarizeConversationReply, error)
 	// UpdateConversation UpdateConversation 更新对话的配置信息
@@ -106,6 +125,12 @@ func RegisterConversationHTTPServer(s *http.Server, srv ConversationHTTPServer)
 	r.GET("/api/ai/v1/conversations/{conversation_id}/stats", _Conversation_GetConversationStats0_HTTP_Handler(srv))
 	r.POST("/api/ai/v1/conversations/{conversation_id}/export", _Conversation_ExportConversation0_HTTP_Handler(srv))
 	r.POST("/api/ai/v1/users/{user_id}/conversations/import", _Conversation_ImportConversation0_HTTP_Handler(srv))
+	r.POST("/api/ai/v1/conversations/{conversation_id}/shares", _Conversation_ShareConversation0_HTTP_Handler(srv))
+	r.DELETE("/api/ai/v1/conversations/{conversation_id}/shares/{grantee_user_id}", _Conversation_RevokeConversationShare0_HTTP_Handler(srv))
+	r.GET("/api/ai/v1/conversations/{conversation_id}/shares", _Conversation_ListConversationShares0_HTTP_Handler(srv))
+	r.POST("/api/ai/v1/conversations/{conversation_id}/snapshots", _Conversation_PublishConversationSnapshot0_HTTP_Handler(srv))
+	r.DELETE("/api/ai/v1/snapshots/{token}", _Conversation_RevokeConversationSnapshot0_HTTP_Handler(srv))
+	r.GET("/api/public/v1/snapshots/{token}", _Conversation_GetConversationSnapshot0_HTTP_Handler(srv))
 }
 
 func _Conversation_CreateConversation0_HTTP_Handler(srv ConversationHTTPServer) func(ctx http.Context) error {
@@ -575,6 +600,144 @@ func _Conversation_ImportConversation0_HTTP_Handler(srv ConversationHTTPServer)
 	}
 }
 
+func _Conversation_ShareConversation0_HTTP_Handler(srv ConversationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.ShareConversationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConversationShareConversation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ShareConversation(ctx, req.(*v1.ShareConversationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.ShareConversationReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conversation_RevokeConversationShare0_HTTP_Handler(srv ConversationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.RevokeConversationShareRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConversationRevokeConversationShare)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeConversationShare(ctx, req.(*v1.RevokeConversationShareRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.RevokeConversationShareReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conversation_ListConversationShares0_HTTP_Handler(srv ConversationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.ListConversationSharesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConversationListConversationShares)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListConversationShares(ctx, req.(*v1.ListConversationSharesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.ListConversationSharesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conversation_PublishConversationSnapshot0_HTTP_Handler(srv ConversationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.PublishConversationSnapshotRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConversationPublishConversationSnapshot)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PublishConversationSnapshot(ctx, req.(*v1.PublishConversationSnapshotRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.PublishConversationSnapshotReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conversation_RevokeConversationSnapshot0_HTTP_Handler(srv ConversationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.RevokeConversationSnapshotRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConversationRevokeConversationSnapshot)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeConversationSnapshot(ctx, req.(*v1.RevokeConversationSnapshotRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.RevokeConversationSnapshotReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Conversation_GetConversationSnapshot0_HTTP_Handler(srv ConversationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.GetConversationSnapshotRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConversationGetConversationSnapshot)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetConversationSnapshot(ctx, req.(*v1.GetConversationSnapshotRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.GetConversationSnapshotReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type ConversationHTTPClient interface {
 	ArchiveConversation(ctx context.Context, req *v1.ArchiveConversationRequest, opts ...http.CallOption) (rsp *v1.ArchiveConversationReply, err error)
 	ClearConversationHistory(ctx context.Context, req *v1.ClearConversationHistoryRequest, opts ...http.CallOption) (rsp *v1.ClearConversationHistoryReply, err error)
@@ -585,14 +748,20 @@ type ConversationHTTPClient interface {
 	GetConversation(ctx context.Context, req *v1.GetConversationRequest, opts ...http.CallOption) (rsp *v1.GetConversationReply, err error)
 	GetConversationContext(ctx context.Context, req *v1.GetConversationContextRequest, opts ...http.CallOption) (rsp *v1.GetConversationContextReply, err error)
 	GetConversationMemory(ctx context.Context, req *v1.GetConversationMemoryRequest, opts ...http.CallOption) (rsp *v1.GetConversationMemoryReply, err error)
+	GetConversationSnapshot(ctx context.Context, req *v1.GetConversationSnapshotRequest, opts ...http.CallOption) (rsp *v1.GetConversationSnapshotReply, err error)
 	GetConversationStats(ctx context.Context, req *v1.GetConversationStatsRequest, opts ...http.CallOption) (rsp *v1.GetConversationStatsReply, err error)
 	GetMessages(ctx context.Context, req *v1.GetMessagesRequest, opts ...http.CallOption) (rsp *v1.GetMessagesReply, err error)
 	ImportConversation(ctx context.Context, req *v1.ImportConversationRequest, opts ...http.CallOption) (rsp *v1.ImportConversationReply, err error)
+	ListConversationShares(ctx context.Context, req *v1.ListConversationSharesRequest, opts ...http.CallOption) (rsp *v1.ListConversationSharesReply, err error)
 	ListConversations(ctx context.Context, req *v1.ListConversationsRequest, opts ...http.CallOption) (rsp *v1.ListConversationsReply, err error)
+	PublishConversationSnapshot(ctx context.Context, req *v1.PublishConversationSnapshotRequest, opts ...http.CallOption) (rsp *v1.PublishConversationSnapshotReply, err error)
 	RegenerateMessage(ctx context.Context, req *v1.RegenerateMessageRequest, opts ...http.CallOption) (rsp *v1.RegenerateMessageReply, err error)
 	RestoreConversation(ctx context.Context, req *v1.RestoreConversationRequest, opts ...http.CallOption) (rsp *v1.RestoreConversationReply, err error)
+	RevokeConversationShare(ctx context.Context, req *v1.RevokeConversationShareRequest, opts ...http.CallOption) (rsp *v1.RevokeConversationShareReply, err error)
+	RevokeConversationSnapshot(ctx context.Context, req *v1.RevokeConversationSnapshotRequest, opts ...http.CallOption) (rsp *v1.RevokeConversationSnapshotReply, err error)
 	SendMessage(ctx context.Context, req *v1.SendMessageRequest, opts ...http.CallOption) (rsp *v1.SendMessageReply, err error)
 	SetConversationMemory(ctx context.Context, req *v1.SetConversationMemoryRequest, opts ...http.CallOption) (rsp *v1.SetConversationMemoryReply, err error)
+	ShareConversation(ctx context.Context, req *v1.ShareConversationRequest, opts ...http.CallOption) (rsp *v1.ShareConversationReply, err error)
 	SummarizeConversation(ctx context.Context, req *v1.SummarizeConversationRequest, opts ...http.CallOption) (rsp *v1.SummarizeConversationReply, err error)
 	UpdateConversation(ctx context.Context, req *v1.UpdateConversationRequest, opts ...http.CallOption) (rsp *v1.UpdateConversationReply, err error)
 	UpdateConversationContext(ctx context.Context, req *v1.UpdateConversationContextRequest, opts ...http.CallOption) (rsp *v1.UpdateConversationContextReply, err error)
@@ -723,6 +892,19 @@ func (c *ConversationHTTPClientImpl) GetConversationMemory(ctx context.Context,
 	return &out, nil
 }
 
+func (c *ConversationHTTPClientImpl) GetConversationSnapshot(ctx context.Context, in *v1.GetConversationSnapshotRequest, opts ...http.CallOption) (*v1.GetConversationSnapshotReply, error) {
+	var out v1.GetConversationSnapshotReply
+	pattern := "/api/public/v1/snapshots/{token}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConversationGetConversationSnapshot))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ConversationHTTPClientImpl) GetConversationStats(ctx context.Context, in *v1.GetConversationStatsRequest, opts ...http.CallOption) (*v1.GetConversationStatsReply, error) {
 	var out v1.GetConversationStatsReply
 	pattern := "/api/ai/v1/conversations/{conversation_id}/stats"
@@ -762,6 +944,19 @@ func (c *ConversationHTTPClientImpl) ImportConversation(ctx context.Context, in
 	return &out, nil
 }
 
+func (c *ConversationHTTPClientImpl) ListConversationShares(ctx context.Context, in *v1.ListConversationSharesRequest, opts ...http.CallOption) (*v1.ListConversationSharesReply, error) {
+	var out v1.ListConversationSharesReply
+	pattern := "/api/ai/v1/conversations/{conversation_id}/shares"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConversationListConversationShares))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ConversationHTTPClientImpl) ListConversations(ctx context.Context, in *v1.ListConversationsRequest, opts ...http.CallOption) (*v1.ListConversationsReply, error) {
 	var out v1.ListConversationsReply
 	pattern := "/api/ai/v1/users/{user_id}/conversations"
@@ -775,6 +970,19 @@ func (c *ConversationHTTPClientImpl) ListConversations(ctx context.Context, in *
 	return &out, nil
 }
 
+func (c *ConversationHTTPClientImpl) PublishConversationSnapshot(ctx context.Context, in *v1.PublishConversationSnapshotRequest, opts ...http.CallOption) (*v1.PublishConversationSnapshotReply, error) {
+	var out v1.PublishConversationSnapshotReply
+	pattern := "/api/ai/v1/conversations/{conversation_id}/snapshots"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConversationPublishConversationSnapshot))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ConversationHTTPClientImpl) RegenerateMessage(ctx context.Context, in *v1.RegenerateMessageRequest, opts ...http.CallOption) (*v1.RegenerateMessageReply, error) {
 	var out v1.RegenerateMessageReply
 	pattern := "/api/ai/v1/messages/{message_id}/regenerate"
@@ -801,6 +1009,32 @@ func (c *ConversationHTTPClientImpl) RestoreConversation(ctx context.Context, in
 	return &out, nil
 }
 
+func (c *ConversationHTTPClientImpl) RevokeConversationShare(ctx context.Context, in *v1.RevokeConversationShareRequest, opts ...http.CallOption) (*v1.RevokeConversationShareReply, error) {
+	var out v1.RevokeConversationShareReply
+	pattern := "/api/ai/v1/conversations/{conversation_id}/shares/{grantee_user_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConversationRevokeConversationShare))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConversationHTTPClientImpl) RevokeConversationSnapshot(ctx context.Context, in *v1.RevokeConversationSnapshotRequest, opts ...http.CallOption) (*v1.RevokeConversationSnapshotReply, error) {
+	var out v1.RevokeConversationSnapshotReply
+	pattern := "/api/ai/v1/snapshots/{token}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConversationRevokeConversationSnapshot))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ConversationHTTPClientImpl) SendMessage(ctx context.Context, in *v1.SendMessageRequest, opts ...http.CallOption) (*v1.SendMessageReply, error) {
 	var out v1.SendMessageReply
 	pattern := "/api/ai/v1/conversations/{conversation_id}/messages"
@@ -827,6 +1061,19 @@ func (c *ConversationHTTPClientImpl) SetConversationMemory(ctx context.Context,
 	return &out, nil
 }
 
+func (c *ConversationHTTPClientImpl) ShareConversation(ctx context.Context, in *v1.ShareConversationRequest, opts ...http.CallOption) (*v1.ShareConversationReply, error) {
+	var out v1.ShareConversationReply
+	pattern := "/api/ai/v1/conversations/{conversation_id}/shares"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConversationShareConversation))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ConversationHTTPClientImpl) SummarizeConversation(ctx context.Context, in *v1.SummarizeConversationRequest, opts ...http.CallOption) (*v1.SummarizeConversationReply, error) {
 	var out v1.SummarizeConversationReply
 	pattern := "/api/ai/v1/conversations/{conversation_id}/summarize"
@@ -304,6 +304,7 @@ type ServiceStatus struct {
 	Endpoint       string                 `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`                                      // 服务端点
 	ResponseTimeMs int32                  `protobuf:"varint,4,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"` // 响应时间（毫秒）
 	Message        string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`                                        // 状态消息
+	Instances      []*InstanceStatus      `protobuf:"bytes,6,rep,name=instances,proto3" json:"instances,omitempty"`                                    // 各实例状态
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -373,6 +374,98 @@ func (x *ServiceStatus) GetMessage() string {
 	return ""
 }
 
+func (x *ServiceStatus) GetInstances() []*InstanceStatus {
+	if x != nil {
+		return x.Instances
+	}
+	return nil
+}
+
+// 服务实例状态
+type InstanceStatus struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                                                               // 实例ID
+	Endpoint       string                 `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`                                                                                   // gRPC地址
+	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                                                                                       // 实例状态: "healthy", "unhealthy", "degraded"
+	ResponseTimeMs int32                  `protobuf:"varint,4,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`                                              // 响应时间（毫秒）
+	Message        string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`                                                                                     // 状态消息
+	Dependencies   map[string]string      `protobuf:"bytes,6,rep,name=dependencies,proto3" json:"dependencies,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 依赖状态，如 db、redis、mcp
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InstanceStatus) Reset() {
+	*x = InstanceStatus{}
+	mi := &file_api_gateway_v1_gateway_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceStatus) ProtoMessage() {}
+
+func (x *InstanceStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_gateway_v1_gateway_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceStatus.ProtoReflect.Descriptor instead.
+func (*InstanceStatus) Descriptor() ([]byte, []int) {
+	return file_api_gateway_v1_gateway_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *InstanceStatus) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InstanceStatus) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *InstanceStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *InstanceStatus) GetResponseTimeMs() int32 {
+	if x != nil {
+		return x.ResponseTimeMs
+	}
+	return 0
+}
+
+func (x *InstanceStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *InstanceStatus) GetDependencies() map[string]string {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
 var File_api_gateway_v1_gateway_proto protoreflect.FileDescriptor
 
 const file_api_gateway_v1_gateway_proto_rawDesc = "" +
@@ -395,13 +488,24 @@ const file_api_gateway_v1_gateway_proto_rawDesc = "" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x129\n" +
 	"\n" +
 	"check_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcheckTime\x12;\n" +
-	"\bservices\x18\x04 \x03(\v2\x1f.api.universal.v1.ServiceStatusR\bservices\"\x9b\x01\n" +
+	"\bservices\x18\x04 \x03(\v2\x1f.api.universal.v1.ServiceStatusR\bservices\"\xdb\x01\n" +
 	"\rServiceStatus\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1a\n" +
 	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12(\n" +
 	"\x10response_time_ms\x18\x04 \x01(\x05R\x0eresponseTimeMs\x12\x18\n" +
-	"\amessage\x18\x05 \x01(\tR\amessage2\x92\x02\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12>\n" +
+	"\tinstances\x18\x06 \x03(\v2 .api.universal.v1.InstanceStatusR\tinstances\"\xb1\x02\n" +
+	"\x0eInstanceStatus\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bendpoint\x18\x02 \x01(\tR\bendpoint\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12(\n" +
+	"\x10response_time_ms\x18\x04 \x01(\x05R\x0eresponseTimeMs\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12V\n" +
+	"\fdependencies\x18\x06 \x03(\v22.api.universal.v1.InstanceStatus.DependenciesEntryR\fdependencies\x1a?\n" +
+	"\x11DependenciesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012\x92\x02\n" +
 	"\aGateway\x12~\n" +
 	"\x0eGetGatewayInfo\x12'.api.universal.v1.GetGatewayInfoRequest\x1a%.api.universal.v1.GetGatewayInfoReply\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/gateway/v1/info\x12\x86\x01\n" +
 	"\x10GetGatewayHealth\x12).api.universal.v1.GetGatewayHealthRequest\x1a'.api.universal.v1.GetGatewayHealthReply\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/gateway/v1/healthBT\n" +
@@ -419,7 +523,7 @@ func file_api_gateway_v1_gateway_proto_rawDescGZIP() []byte {
 	return file_api_gateway_v1_gateway_proto_rawDescData
 }
 
-var file_api_gateway_v1_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_gateway_v1_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_api_gateway_v1_gateway_proto_goTypes = []any{
 	(*GatewayInfo)(nil),             // 0: api.universal.v1.GatewayInfo
 	(*GetGatewayInfoRequest)(nil),   // 1: api.universal.v1.GetGatewayInfoRequest
@@ -427,22 +531,26 @@ var file_api_gateway_v1_gateway_proto_goTypes = []any{
 	(*GetGatewayHealthRequest)(nil), // 3: api.universal.v1.GetGatewayHealthRequest
 	(*GetGatewayHealthReply)(nil),   // 4: api.universal.v1.GetGatewayHealthReply
 	(*ServiceStatus)(nil),           // 5: api.universal.v1.ServiceStatus
-	(*timestamppb.Timestamp)(nil),   // 6: google.protobuf.Timestamp
+	(*InstanceStatus)(nil),          // 6: api.universal.v1.InstanceStatus
+	nil,                             // 7: api.universal.v1.InstanceStatus.DependenciesEntry
+	(*timestamppb.Timestamp)(nil),   // 8: google.protobuf.Timestamp
 }
 var file_api_gateway_v1_gateway_proto_depIdxs = []int32{
-	6, // 0: api.universal.v1.GatewayInfo.start_time:type_name -> google.protobuf.Timestamp
+	8, // 0: api.universal.v1.GatewayInfo.start_time:type_name -> google.protobuf.Timestamp
 	0, // 1: api.universal.v1.GetGatewayInfoReply.info:type_name -> api.universal.v1.GatewayInfo
-	6, // 2: api.universal.v1.GetGatewayHealthReply.check_time:type_name -> google.protobuf.Timestamp
+	8, // 2: api.universal.v1.GetGatewayHealthReply.check_time:type_name -> google.protobuf.Timestamp
 	5, // 3: api.universal.v1.GetGatewayHealthReply.services:type_name -> api.universal.v1.ServiceStatus
-	1, // 4: api.universal.v1.Gateway.GetGatewayInfo:input_type -> api.universal.v1.GetGatewayInfoRequest
-	3, // 5: api.universal.v1.Gateway.GetGatewayHealth:input_type -> api.universal.v1.GetGatewayHealthRequest
-	2, // 6: api.universal.v1.Gateway.GetGatewayInfo:output_type -> api.universal.v1.GetGatewayInfoReply
-	4, // 7: api.universal.v1.Gateway.GetGatewayHealth:output_type -> api.universal.v1.GetGatewayHealthReply
-	6, // [6:8] is the sub-list for method output_type
-	4, // [4:6] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	6, // 4: api.universal.v1.ServiceStatus.instances:type_name -> api.universal.v1.InstanceStatus
+	7, // 5: api.universal.v1.InstanceStatus.dependencies:type_name -> api.universal.v1.InstanceStatus.DependenciesEntry
+	1, // 6: api.universal.v1.Gateway.GetGatewayInfo:input_type -> api.universal.v1.GetGatewayInfoRequest
+	3, // 7: api.universal.v1.Gateway.GetGatewayHealth:input_type -> api.universal.v1.GetGatewayHealthRequest
+	2, // 8: api.universal.v1.Gateway.GetGatewayInfo:output_type -> api.universal.v1.GetGatewayInfoReply
+	4, // 9: api.universal.v1.Gateway.GetGatewayHealth:output_type -> api.universal.v1.GetGatewayHealthReply
+	8, // [8:10] is the sub-list for method output_type
+	6, // [6:8] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_api_gateway_v1_gateway_proto_init() }
@@ -456,7 +564,7 @@ func file_api_gateway_v1_gateway_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_gateway_v1_gateway_proto_rawDesc), len(file_api_gateway_v1_gateway_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
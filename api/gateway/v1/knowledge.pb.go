@@ -26,7 +26,7 @@ var File_api_gateway_v1_knowledge_proto protoreflect.FileDescriptor
 
 const file_api_gateway_v1_knowledge_proto_rawDesc = "" +
 	"\n" +
-	"\x1eapi/gateway/v1/knowledge.proto\x12\x10api.universal.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x19api/ai/v1/knowledge.proto2\xc0\x17\n" +
+	"\x1eapi/gateway/v1/knowledge.proto\x12\x10api.universal.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x19api/ai/v1/knowledge.proto2\xd6\x1b\n" +
 	"\tKnowledge\x12\x88\x01\n" +
 	"\x13CreateKnowledgeBase\x12%.api.ai.v1.CreateKnowledgeBaseRequest\x1a#.api.ai.v1.CreateKnowledgeBaseReply\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/ai/v1/knowledge/bases\x12\x8d\x01\n" +
 	"\x13UpdateKnowledgeBase\x12%.api.ai.v1.UpdateKnowledgeBaseRequest\x1a#.api.ai.v1.UpdateKnowledgeBaseReply\"*\x82\xd3\xe4\x93\x02$:\x01*\x1a\x1f/api/ai/v1/knowledge/bases/{id}\x12\x8a\x01\n" +
@@ -48,52 +48,61 @@ const file_api_gateway_v1_knowledge_proto_rawDesc = "" +
 	"\x13ListKnowledgeChunks\x12%.api.ai.v1.ListKnowledgeChunksRequest\x1a#.api.ai.v1.ListKnowledgeChunksReply\";\x82\xd3\xe4\x93\x025\x123/api/ai/v1/knowledge/documents/{document_id}/chunks\x12\x98\x01\n" +
 	"\x14ReindexKnowledgeBase\x12&.api.ai.v1.ReindexKnowledgeBaseRequest\x1a$.api.ai.v1.ReindexKnowledgeBaseReply\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/api/ai/v1/knowledge/bases/{id}/reindex\x12\x96\x01\n" +
 	"\x15GetKnowledgeBaseStats\x12'.api.ai.v1.GetKnowledgeBaseStatsRequest\x1a%.api.ai.v1.GetKnowledgeBaseStatsReply\"-\x82\xd3\xe4\x93\x02'\x12%/api/ai/v1/knowledge/bases/{id}/stats\x12\x98\x01\n" +
-	"\x14AnalyzeKnowledgeBase\x12&.api.ai.v1.AnalyzeKnowledgeBaseRequest\x1a$.api.ai.v1.AnalyzeKnowledgeBaseReply\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/api/ai/v1/knowledge/bases/{id}/analyzeB]\n" +
+	"\x14AnalyzeKnowledgeBase\x12&.api.ai.v1.AnalyzeKnowledgeBaseRequest\x1a$.api.ai.v1.AnalyzeKnowledgeBaseReply\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/api/ai/v1/knowledge/bases/{id}/analyze\x12\xa0\x01\n" +
+	"\x12ShareKnowledgeBase\x12$.api.ai.v1.ShareKnowledgeBaseRequest\x1a\".api.ai.v1.ShareKnowledgeBaseReply\"@\x82\xd3\xe4\x93\x02::\x01*\"5/api/ai/v1/knowledge/bases/{knowledge_base_id}/shares\x12\xc1\x01\n" +
+	"\x18RevokeKnowledgeBaseShare\x12*.api.ai.v1.RevokeKnowledgeBaseShareRequest\x1a(.api.ai.v1.RevokeKnowledgeBaseShareReply\"O\x82\xd3\xe4\x93\x02I*G/api/ai/v1/knowledge/bases/{knowledge_base_id}/shares/{grantee_user_id}\x12\xac\x01\n" +
+	"\x17ListKnowledgeBaseShares\x12).api.ai.v1.ListKnowledgeBaseSharesRequest\x1a'.api.ai.v1.ListKnowledgeBaseSharesReply\"=\x82\xd3\xe4\x93\x027\x125/api/ai/v1/knowledge/bases/{knowledge_base_id}/sharesB]\n" +
 	"#com.oldwei.universal.api.gateway.v1B\x17GatewayKnowledgeProtoV1P\x01Z\x1buniversal/api/gateway/v1;v1b\x06proto3"
 
 var file_api_gateway_v1_knowledge_proto_goTypes = []any{
-	(*v1.CreateKnowledgeBaseRequest)(nil),   // 0: api.ai.v1.CreateKnowledgeBaseRequest
-	(*v1.UpdateKnowledgeBaseRequest)(nil),   // 1: api.ai.v1.UpdateKnowledgeBaseRequest
-	(*v1.DeleteKnowledgeBaseRequest)(nil),   // 2: api.ai.v1.DeleteKnowledgeBaseRequest
-	(*v1.ListKnowledgeBasesRequest)(nil),    // 3: api.ai.v1.ListKnowledgeBasesRequest
-	(*v1.GetKnowledgeBaseRequest)(nil),      // 4: api.ai.v1.GetKnowledgeBaseRequest
-	(*v1.UploadDocumentRequest)(nil),        // 5: api.ai.v1.UploadDocumentRequest
-	(*v1.BatchUploadDocumentsRequest)(nil),  // 6: api.ai.v1.BatchUploadDocumentsRequest
-	(*v1.UpdateDocumentRequest)(nil),        // 7: api.ai.v1.UpdateDocumentRequest
-	(*v1.DeleteDocumentRequest)(nil),        // 8: api.ai.v1.DeleteDocumentRequest
-	(*v1.ListDocumentsRequest)(nil),         // 9: api.ai.v1.ListDocumentsRequest
-	(*v1.GetDocumentRequest)(nil),           // 10: api.ai.v1.GetDocumentRequest
-	(*v1.ProcessDocumentRequest)(nil),       // 11: api.ai.v1.ProcessDocumentRequest
-	(*v1.SearchKnowledgeRequest)(nil),       // 12: api.ai.v1.SearchKnowledgeRequest
-	(*v1.HybridSearchRequest)(nil),          // 13: api.ai.v1.HybridSearchRequest
-	(*v1.AdvancedSearchRequest)(nil),        // 14: api.ai.v1.AdvancedSearchRequest
-	(*v1.GetKnowledgeChunkRequest)(nil),     // 15: api.ai.v1.GetKnowledgeChunkRequest
-	(*v1.UpdateKnowledgeChunkRequest)(nil),  // 16: api.ai.v1.UpdateKnowledgeChunkRequest
-	(*v1.ListKnowledgeChunksRequest)(nil),   // 17: api.ai.v1.ListKnowledgeChunksRequest
-	(*v1.ReindexKnowledgeBaseRequest)(nil),  // 18: api.ai.v1.ReindexKnowledgeBaseRequest
-	(*v1.GetKnowledgeBaseStatsRequest)(nil), // 19: api.ai.v1.GetKnowledgeBaseStatsRequest
-	(*v1.AnalyzeKnowledgeBaseRequest)(nil),  // 20: api.ai.v1.AnalyzeKnowledgeBaseRequest
-	(*v1.CreateKnowledgeBaseReply)(nil),     // 21: api.ai.v1.CreateKnowledgeBaseReply
-	(*v1.UpdateKnowledgeBaseReply)(nil),     // 22: api.ai.v1.UpdateKnowledgeBaseReply
-	(*v1.DeleteKnowledgeBaseReply)(nil),     // 23: api.ai.v1.DeleteKnowledgeBaseReply
-	(*v1.ListKnowledgeBasesReply)(nil),      // 24: api.ai.v1.ListKnowledgeBasesReply
-	(*v1.GetKnowledgeBaseReply)(nil),        // 25: api.ai.v1.GetKnowledgeBaseReply
-	(*v1.UploadDocumentReply)(nil),          // 26: api.ai.v1.UploadDocumentReply
-	(*v1.BatchUploadDocumentsReply)(nil),    // 27: api.ai.v1.BatchUploadDocumentsReply
-	(*v1.UpdateDocumentReply)(nil),          // 28: api.ai.v1.UpdateDocumentReply
-	(*v1.DeleteDocumentReply)(nil),          // 29: api.ai.v1.DeleteDocumentReply
-	(*v1.ListDocumentsReply)(nil),           // 30: api.ai.v1.ListDocumentsReply
-	(*v1.GetDocumentReply)(nil),             // 31: api.ai.v1.GetDocumentReply
-	(*v1.ProcessDocumentReply)(nil),         // 32: api.ai.v1.ProcessDocumentReply
-	(*v1.SearchKnowledgeReply)(nil),         // 33: api.ai.v1.SearchKnowledgeReply
-	(*v1.HybridSearchReply)(nil),            // 34: api.ai.v1.HybridSearchReply
-	(*v1.AdvancedSearchReply)(nil),          // 35: api.ai.v1.AdvancedSearchReply
-	(*v1.GetKnowledgeChunkReply)(nil),       // 36: api.ai.v1.GetKnowledgeChunkReply
-	(*v1.UpdateKnowledgeChunkReply)(nil),    // 37: api.ai.v1.UpdateKnowledgeChunkReply
-	(*v1.ListKnowledgeChunksReply)(nil),     // 38: api.ai.v1.ListKnowledgeChunksReply
-	(*v1.ReindexKnowledgeBaseReply)(nil),    // 39: api.ai.v1.ReindexKnowledgeBaseReply
-	(*v1.GetKnowledgeBaseStatsReply)(nil),   // 40: api.ai.v1.GetKnowledgeBaseStatsReply
-	(*v1.AnalyzeKnowledgeBaseReply)(nil),    // 41: api.ai.v1.AnalyzeKnowledgeBaseReply
+	(*v1.CreateKnowledgeBaseRequest)(nil),      // 0: api.ai.v1.CreateKnowledgeBaseRequest
+	(*v1.UpdateKnowledgeBaseRequest)(nil),      // 1: api.ai.v1.UpdateKnowledgeBaseRequest
+	(*v1.DeleteKnowledgeBaseRequest)(nil),      // 2: api.ai.v1.DeleteKnowledgeBaseRequest
+	(*v1.ListKnowledgeBasesRequest)(nil),       // 3: api.ai.v1.ListKnowledgeBasesRequest
+	(*v1.GetKnowledgeBaseRequest)(nil),         // 4: api.ai.v1.GetKnowledgeBaseRequest
+	(*v1.UploadDocumentRequest)(nil),           // 5: api.ai.v1.UploadDocumentRequest
+	(*v1.BatchUploadDocumentsRequest)(nil),     // 6: api.ai.v1.BatchUploadDocumentsRequest
+	(*v1.UpdateDocumentRequest)(nil),           // 7: api.ai.v1.UpdateDocumentRequest
+	(*v1.DeleteDocumentRequest)(nil),           // 8: api.ai.v1.DeleteDocumentRequest
+	(*v1.ListDocumentsRequest)(nil),            // 9: api.ai.v1.ListDocumentsRequest
+	(*v1.GetDocumentRequest)(nil),              // 10: api.ai.v1.GetDocumentRequest
+	(*v1.ProcessDocumentRequest)(nil),          // 11: api.ai.v1.ProcessDocumentRequest
+	(*v1.SearchKnowledgeRequest)(nil),          // 12: api.ai.v1.SearchKnowledgeRequest
+	(*v1.HybridSearchRequest)(nil),             // 13: api.ai.v1.HybridSearchRequest
+	(*v1.AdvancedSearchRequest)(nil),           // 14: api.ai.v1.AdvancedSearchRequest
+	(*v1.GetKnowledgeChunkRequest)(nil),        // 15: api.ai.v1.GetKnowledgeChunkRequest
+	(*v1.UpdateKnowledgeChunkRequest)(nil),     // 16: api.ai.v1.UpdateKnowledgeChunkRequest
+	(*v1.ListKnowledgeChunksRequest)(nil),      // 17: api.ai.v1.ListKnowledgeChunksRequest
+	(*v1.ReindexKnowledgeBaseRequest)(nil),     // 18: api.ai.v1.ReindexKnowledgeBaseRequest
+	(*v1.GetKnowledgeBaseStatsRequest)(nil),    // 19: api.ai.v1.GetKnowledgeBaseStatsRequest
+	(*v1.AnalyzeKnowledgeBaseRequest)(nil),     // 20: api.ai.v1.AnalyzeKnowledgeBaseRequest
+	(*v1.ShareKnowledgeBaseRequest)(nil),       // 21: api.ai.v1.ShareKnowledgeBaseRequest
+	(*v1.RevokeKnowledgeBaseShareRequest)(nil), // 22: api.ai.v1.RevokeKnowledgeBaseShareRequest
+	(*v1.ListKnowledgeBaseSharesRequest)(nil),  // 23: api.ai.v1.ListKnowledgeBaseSharesRequest
+	(*v1.CreateKnowledgeBaseReply)(nil),        // 24: api.ai.v1.CreateKnowledgeBaseReply
+	(*v1.UpdateKnowledgeBaseReply)(nil),        // 25: api.ai.v1.UpdateKnowledgeBaseReply
+	(*v1.DeleteKnowledgeBaseReply)(nil),        // 26: api.ai.v1.DeleteKnowledgeBaseReply
+	(*v1.ListKnowledgeBasesReply)(nil),         // 27: api.ai.v1.ListKnowledgeBasesReply
+	(*v1.GetKnowledgeBaseReply)(nil),           // 28: api.ai.v1.GetKnowledgeBaseReply
+	(*v1.UploadDocumentReply)(nil),             // 29: api.ai.v1.UploadDocumentReply
+	(*v1.BatchUploadDocumentsReply)(nil),       // 30: api.ai.v1.BatchUploadDocumentsReply
+	(*v1.UpdateDocumentReply)(nil),             // 31: api.ai.v1.UpdateDocumentReply
+	(*v1.DeleteDocumentReply)(nil),             // 32: api.ai.v1.DeleteDocumentReply
+	(*v1.ListDocumentsReply)(nil),              // 33: api.ai.v1.ListDocumentsReply
+	(*v1.GetDocumentReply)(nil),                // 34: api.ai.v1.GetDocumentReply
+	(*v1.ProcessDocumentReply)(nil),            // 35: api.ai.v1.ProcessDocumentReply
+	(*v1.SearchKnowledgeReply)(nil),            // 36: api.ai.v1.SearchKnowledgeReply
+	(*v1.HybridSearchReply)(nil),               // 37: api.ai.v1.HybridSearchReply
+	(*v1.AdvancedSearchReply)(nil),             // 38: api.ai.v1.AdvancedSearchReply
+	(*v1.GetKnowledgeChunkReply)(nil),          // 39: api.ai.v1.GetKnowledgeChunkReply
+	(*v1.UpdateKnowledgeChunkReply)(nil),       // 40: api.ai.v1.UpdateKnowledgeChunkReply
+	(*v1.ListKnowledgeChunksReply)(nil),        // 41: api.ai.v1.ListKnowledgeChunksReply
+	(*v1.ReindexKnowledgeBaseReply)(nil),       // 42: api.ai.v1.ReindexKnowledgeBaseReply
+	(*v1.GetKnowledgeBaseStatsReply)(nil),      // 43: api.ai.v1.GetKnowledgeBaseStatsReply
+	(*v1.AnalyzeKnowledgeBaseReply)(nil),       // 44: api.ai.v1.AnalyzeKnowledgeBaseReply
+	(*v1.ShareKnowledgeBaseReply)(nil),         // 45: api.ai.v1.ShareKnowledgeBaseReply
+	(*v1.RevokeKnowledgeBaseShareReply)(nil),   // 46: api.ai.v1.RevokeKnowledgeBaseShareReply
+	(*v1.ListKnowledgeBaseSharesReply)(nil),    // 47: api.ai.v1.ListKnowledgeBaseSharesReply
 }
 var file_api_gateway_v1_knowledge_proto_depIdxs = []int32{
 	0,  // 0: api.universal.v1.Knowledge.CreateKnowledgeBase:input_type -> api.ai.v1.CreateKnowledgeBaseRequest
@@ -117,29 +126,35 @@ var file_api_gateway_v1_knowledge_proto_depIdxs = []int32{
 	18, // 18: api.universal.v1.Knowledge.ReindexKnowledgeBase:input_type -> api.ai.v1.ReindexKnowledgeBaseRequest
 	19, // 19: api.universal.v1.Knowledge.GetKnowledgeBaseStats:input_type -> api.ai.v1.GetKnowledgeBaseStatsRequest
 	20, // 20: api.universal.v1.Knowledge.AnalyzeKnowledgeBase:input_type -> api.ai.v1.AnalyzeKnowledgeBaseRequest
-	21, // 21: api.universal.v1.Knowledge.CreateKnowledgeBase:output_type -> api.ai.v1.CreateKnowledgeBaseReply
-	22, // 22: api.universal.v1.Knowledge.UpdateKnowledgeBase:output_type -> api.ai.v1.UpdateKnowledgeBaseReply
-	23, // 23: api.universal.v1.Knowledge.DeleteKnowledgeBase:output_type -> api.ai.v1.DeleteKnowledgeBaseReply
-	24, // 24: api.universal.v1.Knowledge.ListKnowledgeBases:output_type -> api.ai.v1.ListKnowledgeBasesReply
-	25, // 25: api.universal.v1.Knowledge.GetKnowledgeBase:output_type -> api.ai.v1.GetKnowledgeBaseReply
-	26, // 26: api.universal.v1.Knowledge.UploadDocument:output_type -> api.ai.v1.UploadDocumentReply
-	27, // 27: api.universal.v1.Knowledge.BatchUploadDocuments:output_type -> api.ai.v1.BatchUploadDocumentsReply
-	28, // 28: api.universal.v1.Knowledge.UpdateDocument:output_type -> api.ai.v1.UpdateDocumentReply
-	29, // 29: api.universal.v1.Knowledge.DeleteDocument:output_type -> api.ai.v1.DeleteDocumentReply
-	30, // 30: api.universal.v1.Knowledge.ListDocuments:output_type -> api.ai.v1.ListDocumentsReply
-	31, // 31: api.universal.v1.Knowledge.GetDocument:output_type -> api.ai.v1.GetDocumentReply
-	32, // 32: api.universal.v1.Knowledge.ProcessDocument:output_type -> api.ai.v1.ProcessDocumentReply
-	33, // 33: api.universal.v1.Knowledge.SearchKnowledge:output_type -> api.ai.v1.SearchKnowledgeReply
-	34, // 34: api.universal.v1.Knowledge.HybridSearch:output_type -> api.ai.v1.HybridSearchReply
-	35, // 35: api.universal.v1.Knowledge.AdvancedSearch:output_type -> api.ai.v1.AdvancedSearchReply
-	36, // 36: api.universal.v1.Knowledge.GetKnowledgeChunk:output_type -> api.ai.v1.GetKnowledgeChunkReply
-	37, // 37: api.universal.v1.Knowledge.UpdateKnowledgeChunk:output_type -> api.ai.v1.UpdateKnowledgeChunkReply
-	38, // 38: api.universal.v1.Knowledge.ListKnowledgeChunks:output_type -> api.ai.v1.ListKnowledgeChunksReply
-	39, // 39: api.universal.v1.Knowledge.ReindexKnowledgeBase:output_type -> api.ai.v1.ReindexKnowledgeBaseReply
-	40, // 40: api.universal.v1.Knowledge.GetKnowledgeBaseStats:output_type -> api.ai.v1.GetKnowledgeBaseStatsReply
-	41, // 41: api.universal.v1.Knowledge.AnalyzeKnowledgeBase:output_type -> api.ai.v1.AnalyzeKnowledgeBaseReply
-	21, // [21:42] is the sub-list for method output_type
-	0,  // [0:21] is the sub-list for method input_type
+	21, // 21: api.universal.v1.Knowledge.ShareKnowledgeBase:input_type -> api.ai.v1.ShareKnowledgeBaseRequest
+	22, // 22: api.universal.v1.Knowledge.RevokeKnowledgeBaseShare:input_type -> api.ai.v1.RevokeKnowledgeBaseShareRequest
+	23, // 23: api.universal.v1.Knowledge.ListKnowledgeBaseShares:input_type -> api.ai.v1.ListKnowledgeBaseSharesRequest
+	24, // 24: api.universal.v1.Knowledge.CreateKnowledgeBase:output_type -> api.ai.v1.CreateKnowledgeBaseReply
+	25, // 25: api.universal.v1.Knowledge.UpdateKnowledgeBase:output_type -> api.ai.v1.UpdateKnowledgeBaseReply
+	26, // 26: api.universal.v1.Knowledge.DeleteKnowledgeBase:output_type -> api.ai.v1.DeleteKnowledgeBaseReply
+	27, // 27: api.universal.v1.Knowledge.ListKnowledgeBases:output_type -> api.ai.v1.ListKnowledgeBasesReply
+	28, // 28: api.universal.v1.Knowledge.GetKnowledgeBase:output_type -> api.ai.v1.GetKnowledgeBaseReply
+	29, // 29: api.universal.v1.Knowledge.UploadDocument:output_type -> api.ai.v1.UploadDocumentReply
+	30, // 30: api.universal.v1.Knowledge.BatchUploadDocuments:output_type -> api.ai.v1.BatchUploadDocumentsReply
+	31, // 31: api.universal.v1.Knowledge.UpdateDocument:output_type -> api.ai.v1.UpdateDocumentReply
+	32, // 32: api.universal.v1.Knowledge.DeleteDocument:output_type -> api.ai.v1.DeleteDocumentReply
+	33, // 33: api.universal.v1.Knowledge.ListDocuments:output_type -> api.ai.v1.ListDocumentsReply
+	34, // 34: api.universal.v1.Knowledge.GetDocument:output_type -> api.ai.v1.GetDocumentReply
+	35, // 35: api.universal.v1.Knowledge.ProcessDocument:output_type -> api.ai.v1.ProcessDocumentReply
+	36, // 36: api.universal.v1.Knowledge.SearchKnowledge:output_type -> api.ai.v1.SearchKnowledgeReply
+	37, // 37: api.universal.v1.Knowledge.HybridSearch:output_type -> api.ai.v1.HybridSearchReply
+	38, // 38: api.universal.v1.Knowledge.AdvancedSearch:output_type -> api.ai.v1.AdvancedSearchReply
+	39, // 39: api.universal.v1.Knowledge.GetKnowledgeChunk:output_type -> api.ai.v1.GetKnowledgeChunkReply
+	40, // 40: api.universal.v1.Knowledge.UpdateKnowledgeChunk:output_type -> api.ai.v1.UpdateKnowledgeChunkReply
+	41, // 41: api.universal.v1.Knowledge.ListKnowledgeChunks:output_type -> api.ai.v1.ListKnowledgeChunksReply
+	42, // 42: api.universal.v1.Knowledge.ReindexKnowledgeBase:output_type -> api.ai.v1.ReindexKnowledgeBaseReply
+	43, // 43: api.universal.v1.Knowledge.GetKnowledgeBaseStats:output_type -> api.ai.v1.GetKnowledgeBaseStatsReply
+	44, // 44: api.universal.v1.Knowledge.AnalyzeKnowledgeBase:output_type -> api.ai.v1.AnalyzeKnowledgeBaseReply
+	45, // 45: api.universal.v1.Knowledge.ShareKnowledgeBase:output_type -> api.ai.v1.ShareKnowledgeBaseReply
+	46, // 46: api.universal.v1.Knowledge.RevokeKnowledgeBaseShare:output_type -> api.ai.v1.RevokeKnowledgeBaseShareReply
+	47, // 47: api.universal.v1.Knowledge.ListKnowledgeBaseShares:output_type -> api.ai.v1.ListKnowledgeBaseSharesReply
+	24, // [24:48] is the sub-list for method output_type
+	0,  // [0:24] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -20,27 +20,30 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Knowledge_CreateKnowledgeBase_FullMethodName   = "/api.universal.v1.Knowledge/CreateKnowledgeBase"
-	Knowledge_UpdateKnowledgeBase_FullMethodName   = "/api.universal.v1.Knowledge/UpdateKnowledgeBase"
-	Knowledge_DeleteKnowledgeBase_FullMethodName   = "/api.universal.v1.Knowledge/DeleteKnowledgeBase"
-	Knowledge_ListKnowledgeBases_FullMethodName    = "/api.universal.v1.Knowledge/ListKnowledgeBases"
-	Knowledge_GetKnowledgeBase_FullMethodName      = "/api.universal.v1.Knowledge/GetKnowledgeBase"
-	Knowledge_UploadDocument_FullMethodName        = "/api.universal.v1.Knowledge/UploadDocument"
-	Knowledge_BatchUploadDocuments_FullMethodName  = "/api.universal.v1.Knowledge/BatchUploadDocuments"
-	Knowledge_UpdateDocument_FullMethodName        = "/api.universal.v1.Knowledge/UpdateDocument"
-	Knowledge_DeleteDocument_FullMethodName        = "/api.universal.v1.Knowledge/DeleteDocument"
-	Knowledge_ListDocuments_FullMethodName         = "/api.universal.v1.Knowledge/ListDocuments"
-	Knowledge_GetDocument_FullMethodName           = "/api.universal.v1.Knowledge/GetDocument"
-	Knowledge_ProcessDocument_FullMethodName       = "/api.universal.v1.Knowledge/ProcessDocument"
-	Knowledge_SearchKnowledge_FullMethodName       = "/api.universal.v1.Knowledge/SearchKnowledge"
-	Knowledge_HybridSearch_FullMethodName          = "/api.universal.v1.Knowledge/HybridSearch"
-	Knowledge_AdvancedSearch_FullMethodName        = "/api.universal.v1.Knowledge/AdvancedSearch"
-	Knowledge_GetKnowledgeChunk_FullMethodName     = "/api.universal.v1.Knowledge/GetKnowledgeChunk"
-	Knowledge_UpdateKnowledgeChunk_FullMethodName  = "/api.universal.v1.Knowledge/UpdateKnowledgeChunk"
-	Knowledge_ListKnowledgeChunks_FullMethodName   = "/api.universal.v1.Knowledge/ListKnowledgeChunks"
-	Knowledge_ReindexKnowledgeBase_FullMethodName  = "/api.universal.v1.Knowledge/ReindexKnowledgeBase"
-	Knowledge_GetKnowledgeBaseStats_FullMethodName = "/api.universal.v1.Knowledge/GetKnowledgeBaseStats"
-	Knowledge_AnalyzeKnowledgeBase_FullMethodName  = "/api.universal.v1.Knowledge/AnalyzeKnowledgeBase"
+	Knowledge_CreateKnowledgeBase_FullMethodName      = "/api.universal.v1.Knowledge/CreateKnowledgeBase"
+	Knowledge_UpdateKnowledgeBase_FullMethodName      = "/api.universal.v1.Knowledge/UpdateKnowledgeBase"
+	Knowledge_DeleteKnowledgeBase_FullMethodName      = "/api.universal.v1.Knowledge/DeleteKnowledgeBase"
+	Knowledge_ListKnowledgeBases_FullMethodName       = "/api.universal.v1.Knowledge/ListKnowledgeBases"
+	Knowledge_GetKnowledgeBase_FullMethodName         = "/api.universal.v1.Knowledge/GetKnowledgeBase"
+	Knowledge_UploadDocument_FullMethodName           = "/api.universal.v1.Knowledge/UploadDocument"
+	Knowledge_BatchUploadDocuments_FullMethodName     = "/api.universal.v1.Knowledge/BatchUploadDocuments"
+	Knowledge_UpdateDocument_FullMethodName           = "/api.universal.v1.Knowledge/UpdateDocument"
+	Knowledge_DeleteDocument_FullMethodName           = "/api.universal.v1.Knowledge/DeleteDocument"
+	Knowledge_ListDocuments_FullMethodName            = "/api.universal.v1.Knowledge/ListDocuments"
+	Knowledge_GetDocument_FullMethodName              = "/api.universal.v1.Knowledge/GetDocument"
+	Knowledge_ProcessDocument_FullMethodName          = "/api.universal.v1.Knowledge/ProcessDocument"
+	Knowledge_SearchKnowledge_FullMethodName          = "/api.universal.v1.Knowledge/SearchKnowledge"
+	Knowledge_HybridSearch_FullMethodName             = "/api.universal.v1.Knowledge/HybridSearch"
+	Knowledge_AdvancedSearch_FullMethodName           = "/api.universal.v1.Knowledge/AdvancedSearch"
+	Knowledge_GetKnowledgeChunk_FullMethodName        = "/api.universal.v1.Knowledge/GetKnowledgeChunk"
+	Knowledge_UpdateKnowledgeChunk_FullMethodName     = "/api.universal.v1.Knowledge/UpdateKnowledgeChunk"
+	Knowledge_ListKnowledgeChunks_FullMethodName      = "/api.universal.v1.Knowledge/ListKnowledgeChunks"
+	Knowledge_ReindexKnowledgeBase_FullMethodName     = "/api.universal.v1.Knowledge/ReindexKnowledgeBase"
+	Knowledge_GetKnowledgeBaseStats_FullMethodName    = "/api.universal.v1.Knowledge/GetKnowledgeBaseStats"
+	Knowledge_AnalyzeKnowledgeBase_FullMethodName     = "/api.universal.v1.Knowledge/AnalyzeKnowledgeBase"
+	Knowledge_ShareKnowledgeBase_FullMethodName       = "/api.universal.v1.Knowledge/ShareKnowledgeBase"
+	Knowledge_RevokeKnowledgeBaseShare_FullMethodName = "/api.universal.v1.Knowledge/RevokeKnowledgeBaseShare"
+	Knowledge_ListKnowledgeBaseShares_FullMethodName  = "/api.universal.v1.Knowledge/ListKnowledgeBaseShares"
 )
 
 // KnowledgeClient is the client API for Knowledge service.
@@ -112,6 +115,15 @@ type KnowledgeClient interface {
 	// AnalyzeKnowledgeBase 分析知识库内容
 	// 分析知识库的内容分布、主题等
 	AnalyzeKnowledgeBase(ctx context.Context, in *v1.AnalyzeKnowledgeBaseRequest, opts ...grpc.CallOption) (*v1.AnalyzeKnowledgeBaseReply, error)
+	// ShareKnowledgeBase 共享知识库
+	// 将知识库以只读、编辑或管理员角色授权给其他用户
+	ShareKnowledgeBase(ctx context.Context, in *v1.ShareKnowledgeBaseRequest, opts ...grpc.CallOption) (*v1.ShareKnowledgeBaseReply, error)
+	// RevokeKnowledgeBaseShare 撤销知识库共享
+	// 撤销指定用户对知识库的访问权限
+	RevokeKnowledgeBaseShare(ctx context.Context, in *v1.RevokeKnowledgeBaseShareRequest, opts ...grpc.CallOption) (*v1.RevokeKnowledgeBaseShareReply, error)
+	// ListKnowledgeBaseShares 获取知识库的共享列表
+	// 返回知识库当前所有有效的授权记录
+	ListKnowledgeBaseShares(ctx context.Context, in *v1.ListKnowledgeBaseSharesRequest, opts ...grpc.CallOption) (*v1.ListKnowledgeBaseSharesReply, error)
 }
 
 type knowledgeClient struct {
@@ -335,6 +347,36 @@ func (c *knowledgeClient) AnalyzeKnowledgeBase(ctx context.Context, in *v1.Analy
 	return out, nil
 }
 
+func (c *knowledgeClient) ShareKnowledgeBase(ctx context.Context, in *v1.ShareKnowledgeBaseRequest, opts ...grpc.CallOption) (*v1.ShareKnowledgeBaseReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.ShareKnowledgeBaseReply)
+	err := c.cc.Invoke(ctx, Knowledge_ShareKnowledgeBase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeClient) RevokeKnowledgeBaseShare(ctx context.Context, in *v1.RevokeKnowledgeBaseShareRequest, opts ...grpc.CallOption) (*v1.RevokeKnowledgeBaseShareReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.RevokeKnowledgeBaseShareReply)
+	err := c.cc.Invoke(ctx, Knowledge_RevokeKnowledgeBaseShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeClient) ListKnowledgeBaseShares(ctx context.Context, in *v1.ListKnowledgeBaseSharesRequest, opts ...grpc.CallOption) (*v1.ListKnowledgeBaseSharesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.ListKnowledgeBaseSharesReply)
+	err := c.cc.Invoke(ctx, Knowledge_ListKnowledgeBaseShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KnowledgeServer is the server API for Knowledge service.
 // All implementations must embed UnimplementedKnowledgeServer
 // for forward compatibility.
@@ -404,6 +446,15 @@ type KnowledgeServer interface {
 	// AnalyzeKnowledgeBase 分析知识库内容
 	// 分析知识库的内容分布、主题等
 	AnalyzeKnowledgeBase(context.Context, *v1.AnalyzeKnowledgeBaseRequest) (*v1.AnalyzeKnowledgeBaseReply, error)
+	// ShareKnowledgeBase 共享知识库
+	// 将知识库以只读、编辑或管理员角色授权给其他用户
+	ShareKnowledgeBase(context.Context, *v1.ShareKnowledgeBaseRequest) (*v1.ShareKnowledgeBaseReply, error)
+	// RevokeKnowledgeBaseShare 撤销知识库共享
+	// 撤销指定用户对知识库的访问权限
+	RevokeKnowledgeBaseShare(context.Context, *v1.RevokeKnowledgeBaseShareRequest) (*v1.RevokeKnowledgeBaseShareReply, error)
+	// ListKnowledgeBaseShares 获取知识库的共享列表
+	// 返回知识库当前所有有效的授权记录
+	ListKnowledgeBaseShares(context.Context, *v1.ListKnowledgeBaseSharesRequest) (*v1.ListKnowledgeBaseSharesReply, error)
 	mustEmbedUnimplementedKnowledgeServer()
 }
 
@@ -477,6 +528,15 @@ func (UnimplementedKnowledgeServer) GetKnowledgeBaseStats(context.Context, *v1.G
 func (UnimplementedKnowledgeServer) AnalyzeKnowledgeBase(context.Context, *v1.AnalyzeKnowledgeBaseRequest) (*v1.AnalyzeKnowledgeBaseReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnalyzeKnowledgeBase not implemented")
 }
+func (UnimplementedKnowledgeServer) ShareKnowledgeBase(context.Context, *v1.ShareKnowledgeBaseRequest) (*v1.ShareKnowledgeBaseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareKnowledgeBase not implemented")
+}
+func (UnimplementedKnowledgeServer) RevokeKnowledgeBaseShare(context.Context, *v1.RevokeKnowledgeBaseShareRequest) (*v1.RevokeKnowledgeBaseShareReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeKnowledgeBaseShare not implemented")
+}
+func (UnimplementedKnowledgeServer) ListKnowledgeBaseShares(context.Context, *v1.ListKnowledgeBaseSharesRequest) (*v1.ListKnowledgeBaseSharesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKnowledgeBaseShares not implemented")
+}
 func (UnimplementedKnowledgeServer) mustEmbedUnimplementedKnowledgeServer() {}
 func (UnimplementedKnowledgeServer) testEmbeddedByValue()                   {}
 
@@ -865,6 +925,60 @@ func _Knowledge_AnalyzeKnowledgeBase_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Knowledge_ShareKnowledgeBase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ShareKnowledgeBaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeServer).ShareKnowledgeBase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Knowledge_ShareKnowledgeBase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeServer).ShareKnowledgeBase(ctx, req.(*v1.ShareKnowledgeBaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Knowledge_RevokeKnowledgeBaseShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.RevokeKnowledgeBaseShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeServer).RevokeKnowledgeBaseShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Knowledge_RevokeKnowledgeBaseShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeServer).RevokeKnowledgeBaseShare(ctx, req.(*v1.RevokeKnowledgeBaseShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Knowledge_ListKnowledgeBaseShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ListKnowledgeBaseSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeServer).ListKnowledgeBaseShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Knowledge_ListKnowledgeBaseShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeServer).ListKnowledgeBaseShares(ctx, req.(*v1.ListKnowledgeBaseSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Knowledge_ServiceDesc is the grpc.ServiceDesc for Knowledge service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -952,6 +1066,18 @@ var Knowledge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AnalyzeKnowledgeBase",
 			Handler:    _Knowledge_AnalyzeKnowledgeBase_Handler,
 		},
+		{
+			MethodName: "ShareKnowledgeBase",
+			Handler:    _Knowledge_ShareKnowledgeBase_Handler,
+		},
+		{
+			MethodName: "RevokeKnowledgeBaseShare",
+			Handler:    _Knowledge_RevokeKnowledgeBaseShare_Handler,
+		},
+		{
+			MethodName: "ListKnowledgeBaseShares",
+			Handler:    _Knowledge_ListKnowledgeBaseShares_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
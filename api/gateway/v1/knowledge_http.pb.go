@@ -31,11 +31,14 @@ const OperationKnowledgeGetKnowledgeBaseStats = "/api.universal.v1.Knowledge/Get
 const OperationKnowledgeGetKnowledgeChunk = "/api.universal.v1.Knowledge/GetKnowledgeChunk"
 const OperationKnowledgeHybridSearch = "/api.universal.v1.Knowledge/HybridSearch"
 const OperationKnowledgeListDocuments = "/api.universal.v1.Knowledge/ListDocuments"
+const OperationKnowledgeListKnowledgeBaseShares = "/api.universal.v1.Knowledge/ListKnowledgeBaseShares"
 const OperationKnowledgeListKnowledgeBases = "/api.universal.v1.Knowledge/ListKnowledgeBases"
 const OperationKnowledgeListKnowledgeChunks = "/api.universal.v1.Knowledge/ListKnowledgeChunks"
 const OperationKnowledgeProcessDocument = "/api.universal.v1.Knowledge/ProcessDocument"
 const OperationKnowledgeReindexKnowledgeBase = "/api.universal.v1.Knowledge/ReindexKnowledgeBase"
+const OperationKnowledgeRevokeKnowledgeBaseShare = "/api.universal.v1.Knowledge/RevokeKnowledgeBaseShare"
 const OperationKnowledgeSearchKnowledge = "/api.universal.v1.Knowledge/SearchKnowledge"
+const OperationKnowledgeShareKnowledgeBase = "/api.universal.v1.Knowledge/ShareKnowledgeBase"
 const OperationKnowledgeUpdateDocument = "/api.universal.v1.Knowledge/UpdateDocument"
 const OperationKnowledgeUpdateKnowledgeBase = "/api.universal.v1.Knowledge/UpdateKnowledgeBase"
 const OperationKnowledgeUpdateKnowledgeChunk = "/api.universal.v1.Knowledge/UpdateKnowledgeChunk"
@@ -75,6 +78,9 @@ type KnowledgeHTTPServer interface {
 	// ListDocuments ListDocuments 获取知识库中的文档列表
 	// 支持分页查询和状态过滤
 	ListDocuments(context.Context, *v1.ListDocumentsRequest) (*v1.ListDocumentsReply, error)
+	// ListKnowledgeBaseShares ListKnowledgeBaseShares 获取知识库的共享列表
+	// 返回知识库当前所有有效的授权记录
+	ListKnowledgeBaseShares(context.Context, *v1.ListKnowledgeBaseSharesRequest) (*v1.ListKnowledgeBaseSharesReply, error)
 	// ListKnowledgeBases ListKnowledgeBases 获取用户的知识库列表
 	// 支持分页查询和关键词搜索
 	ListKnowledgeBases(context.Context, *v1.ListKnowledgeBasesRequest) (*v1.ListKnowledgeBasesReply, error)
@@ -87,9 +93,15 @@ type KnowledgeHTTPServer interface {
 	// ReindexKnowledgeBase ReindexKnowledgeBase 重新索引知识库
 	// 重新对知识库中的所有文档进行向量化处理
 	ReindexKnowledgeBase(context.Context, *v1.ReindexKnowledgeBaseRequest) (*v1.ReindexKnowledgeBaseReply, error)
+	// RevokeKnowledgeBaseShare RevokeKnowledgeBaseShare 撤销知识库共享
+	// 撤销指定用户对知识库的访问权限
+	RevokeKnowledgeBaseShare(context.Context, *v1.RevokeKnowledgeBaseShareRequest) (*v1.RevokeKnowledgeBaseShareReply, error)
 	// SearchKnowledge SearchKnowledge 在知识库中搜索相关内容
 	// 基于语义相似度搜索知识库内容，支持相似度阈值过滤
 	SearchKnowledge(context.Context, *v1.SearchKnowledgeRequest) (*v1.SearchKnowledgeReply, error)
+	// ShareKnowledgeBase ShareKnowledgeBase 共享知识库
+	// 将知识库以只读、编辑或管理员角色授权给其他用户
+	ShareKnowledgeBase(context.Context, *v1.ShareKnowledgeBaseRequest) (*v1.ShareKnowledgeBaseReply, error)
 	// UpdateDocument UpdateDocument 更新文档内容
 	// 更新已有文档的内容和元数据
 	UpdateDocument(context.Context, *v1.UpdateDocumentRequest) (*v1.UpdateDocumentReply, error)
@@ -126,6 +138,9 @@ func RegisterKnowledgeHTTPServer(s *http.Server, srv KnowledgeHTTPServer) {
 	r.POST("/api/ai/v1/knowledge/bases/{id}/reindex", _Knowledge_ReindexKnowledgeBase0_HTTP_Handler(srv))
 	r.GET("/api/ai/v1/knowledge/bases/{id}/stats", _Knowledge_GetKnowledgeBaseStats0_HTTP_Handler(srv))
 	r.POST("/api/ai/v1/knowledge/bases/{id}/analyze", _Knowledge_AnalyzeKnowledgeBase0_HTTP_Handler(srv))
+	r.POST("/api/ai/v1/knowledge/bases/{knowledge_base_id}/shares", _Knowledge_ShareKnowledgeBase0_HTTP_Handler(srv))
+	r.DELETE("/api/ai/v1/knowledge/bases/{knowledge_base_id}/shares/{grantee_user_id}", _Knowledge_RevokeKnowledgeBaseShare0_HTTP_Handler(srv))
+	r.GET("/api/ai/v1/knowledge/bases/{knowledge_base_id}/shares", _Knowledge_ListKnowledgeBaseShares0_HTTP_Handler(srv))
 }
 
 func _Knowledge_CreateKnowledgeBase0_HTTP_Handler(srv KnowledgeHTTPServer) func(ctx http.Context) error {
@@ -583,6 +598,75 @@ func _Knowledge_AnalyzeKnowledgeBase0_HTTP_Handler(srv KnowledgeHTTPServer) func
 	}
 }
 
+func _Knowledge_ShareKnowledgeBase0_HTTP_Handler(srv KnowledgeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.ShareKnowledgeBaseRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationKnowledgeShareKnowledgeBase)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ShareKnowledgeBase(ctx, req.(*v1.ShareKnowledgeBaseRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.ShareKnowledgeBaseReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Knowledge_RevokeKnowledgeBaseShare0_HTTP_Handler(srv KnowledgeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.RevokeKnowledgeBaseShareRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationKnowledgeRevokeKnowledgeBaseShare)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeKnowledgeBaseShare(ctx, req.(*v1.RevokeKnowledgeBaseShareRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.RevokeKnowledgeBaseShareReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Knowledge_ListKnowledgeBaseShares0_HTTP_Handler(srv KnowledgeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.ListKnowledgeBaseSharesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationKnowledgeListKnowledgeBaseShares)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListKnowledgeBaseShares(ctx, req.(*v1.ListKnowledgeBaseSharesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.ListKnowledgeBaseSharesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type KnowledgeHTTPClient interface {
 	AdvancedSearch(ctx context.Context, req *v1.AdvancedSearchRequest, opts ...http.CallOption) (rsp *v1.AdvancedSearchReply, err error)
 	AnalyzeKnowledgeBase(ctx context.Context, req *v1.AnalyzeKnowledgeBaseRequest, opts ...http.CallOption) (rsp *v1.AnalyzeKnowledgeBaseReply, err error)
@@ -595,11 +679,14 @@ type KnowledgeHTTPClient interface {
 	GetKnowledgeChunk(ctx context.Context, req *v1.GetKnowledgeChunkRequest, opts ...http.CallOption) (rsp *v1.GetKnowledgeChunkReply, err error)
 	HybridSearch(ctx context.Context, req *v1.HybridSearchRequest, opts ...http.CallOption) (rsp *v1.HybridSearchReply, err error)
 	ListDocuments(ctx context.Context, req *v1.ListDocumentsRequest, opts ...http.CallOption) (rsp *v1.ListDocumentsReply, err error)
+	ListKnowledgeBaseShares(ctx context.Context, req *v1.ListKnowledgeBaseSharesRequest, opts ...http.CallOption) (rsp *v1.ListKnowledgeBaseSharesReply, err error)
 	ListKnowledgeBases(ctx context.Context, req *v1.ListKnowledgeBasesRequest, opts ...http.CallOption) (rsp *v1.ListKnowledgeBasesReply, err error)
 	ListKnowledgeChunks(ctx context.Context, req *v1.ListKnowledgeChunksRequest, opts ...http.CallOption) (rsp *v1.ListKnowledgeChunksReply, err error)
 	ProcessDocument(ctx context.Context, req *v1.ProcessDocumentRequest, opts ...http.CallOption) (rsp *v1.ProcessDocumentReply, err error)
 	ReindexKnowledgeBase(ctx context.Context, req *v1.ReindexKnowledgeBaseRequest, opts ...http.CallOption) (rsp *v1.ReindexKnowledgeBaseReply, err error)
+	RevokeKnowledgeBaseShare(ctx context.Context, req *v1.RevokeKnowledgeBaseShareRequest, opts ...http.CallOption) (rsp *v1.RevokeKnowledgeBaseShareReply, err error)
 	SearchKnowledge(ctx context.Context, req *v1.SearchKnowledgeRequest, opts ...http.CallOption) (rsp *v1.SearchKnowledgeReply, err error)
+	ShareKnowledgeBase(ctx context.Context, req *v1.ShareKnowledgeBaseRequest, opts ...http.CallOption) (rsp *v1.ShareKnowledgeBaseReply, err error)
 	UpdateDocument(ctx context.Context, req *v1.UpdateDocumentRequest, opts ...http.CallOption) (rsp *v1.UpdateDocumentReply, err error)
 	UpdateKnowledgeBase(ctx context.Context, req *v1.UpdateKnowledgeBaseRequest, opts ...http.CallOption) (rsp *v1.UpdateKnowledgeBaseReply, err error)
 	UpdateKnowledgeChunk(ctx context.Context, req *v1.UpdateKnowledgeChunkRequest, opts ...http.CallOption) (rsp *v1.UpdateKnowledgeChunkReply, err error)
@@ -757,6 +844,19 @@ func (c *KnowledgeHTTPClientImpl) ListDocuments(ctx context.Context, in *v1.List
 	return &out, nil
 }
 
+func (c *KnowledgeHTTPClientImpl) ListKnowledgeBaseShares(ctx context.Context, in *v1.ListKnowledgeBaseSharesRequest, opts ...http.CallOption) (*v1.ListKnowledgeBaseSharesReply, error) {
+	var out v1.ListKnowledgeBaseSharesReply
+	pattern := "/api/ai/v1/knowledge/bases/{knowledge_base_id}/shares"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationKnowledgeListKnowledgeBaseShares))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *KnowledgeHTTPClientImpl) ListKnowledgeBases(ctx context.Context, in *v1.ListKnowledgeBasesRequest, opts ...http.CallOption) (*v1.ListKnowledgeBasesReply, error) {
 	var out v1.ListKnowledgeBasesReply
 	pattern := "/api/ai/v1/knowledge/bases"
@@ -809,6 +909,19 @@ func (c *KnowledgeHTTPClientImpl) ReindexKnowledgeBase(ctx context.Context, in *
 	return &out, nil
 }
 
+func (c *KnowledgeHTTPClientImpl) RevokeKnowledgeBaseShare(ctx context.Context, in *v1.RevokeKnowledgeBaseShareRequest, opts ...http.CallOption) (*v1.RevokeKnowledgeBaseShareReply, error) {
+	var out v1.RevokeKnowledgeBaseShareReply
+	pattern := "/api/ai/v1/knowledge/bases/{knowledge_base_id}/shares/{grantee_user_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationKnowledgeRevokeKnowledgeBaseShare))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *KnowledgeHTTPClientImpl) SearchKnowledge(ctx context.Context, in *v1.SearchKnowledgeRequest, opts ...http.CallOption) (*v1.SearchKnowledgeReply, error) {
 	var out v1.SearchKnowledgeReply
 	pattern := "/api/ai/v1/knowledge/search"
@@ -822,6 +935,19 @@ func (c *KnowledgeHTTPClientImpl) SearchKnowledge(ctx context.Context, in *v1.Se
 	return &out, nil
 }
 
+func (c *KnowledgeHTTPClientImpl) ShareKnowledgeBase(ctx context.Context, in *v1.ShareKnowledgeBaseRequest, opts ...http.CallOption) (*v1.ShareKnowledgeBaseReply, error) {
+	var out v1.ShareKnowledgeBaseReply
+	pattern := "/api/ai/v1/knowledge/bases/{knowledge_base_id}/shares"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationKnowledgeShareKnowledgeBase))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *KnowledgeHTTPClientImpl) UpdateDocument(ctx context.Context, in *v1.UpdateDocumentRequest, opts ...http.CallOption) (*v1.UpdateDocumentReply, error) {
 	var out v1.UpdateDocumentReply
 	pattern := "/api/ai/v1/knowledge/documents/{id}"
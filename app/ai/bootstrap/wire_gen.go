@@ -0,0 +1,88 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run -mod=mod github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package bootstrap
+
+import (
+	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"universal/app/ai/internal/biz"
+	"universal/app/ai/internal/conf"
+	"universal/app/ai/internal/data"
+	"universal/app/ai/internal/server"
+	"universal/app/ai/internal/service"
+)
+
+// Injectors from wire.go:
+
+// wireApp init kratos application.
+func wireApp(confServer *conf.Server, confData *conf.Data, registry *conf.Registry, scheduler *conf.Scheduler, router *conf.Router, health *conf.Health, embedding *conf.Embedding, info Info, logger log.Logger) (*kratos.App, func(), error) {
+	dataData, cleanup, err := data.NewData(confData, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	aiRepo := data.NewAiRepo(dataData, logger)
+	topicCacheRepo := data.NewTopicCacheRepo(dataData, logger)
+	jobLocker := data.NewJobLocker(dataData, logger)
+	embeddingOptions := data.NewEmbeddingOptions(embedding)
+	localEmbedder := data.NewLocalEmbedder()
+	modelRepo := data.NewModelRepo(dataData, logger)
+	providerRepo := data.NewProviderRepo(dataData, logger)
+	credentialRepo := data.NewCredentialRepo(dataData, logger)
+	discoveryRegistry, err := data.NewRegistry(registry)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	discovery := data.NewDiscovery(discoveryRegistry)
+	workspaceClient, cleanup2, err := data.NewWorkspaceServiceClient(discovery, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	workspaceRepo := data.NewWorkspaceRepo(workspaceClient, logger)
+	workspaceUsecase := biz.NewWorkspaceUsecase(workspaceRepo, modelRepo, logger)
+	completionClient := data.NewCompletionClient(logger)
+	credentialUsecase := biz.NewCredentialUsecase(credentialRepo, modelRepo, providerRepo, workspaceUsecase, completionClient, logger)
+	embedder := biz.NewEmbedder(embeddingOptions, localEmbedder, modelRepo, providerRepo, credentialUsecase, completionClient, logger)
+	aiUsecase := biz.NewAiUsecase(aiRepo, topicCacheRepo, jobLocker, embedder, workspaceUsecase, logger)
+	aiService := service.NewAiService(aiUsecase)
+	quotaRepo := data.NewQuotaRepo(dataData, logger)
+	rateLimitRepo := data.NewRateLimitRepo(dataData, logger)
+	healthRepo := data.NewHealthRepo(dataData, logger)
+	healthEventPublisher := data.NewHealthEventPublisher(dataData, logger)
+	healthOptions := data.NewHealthOptions(health)
+	healthUsecase := biz.NewHealthUsecase(modelRepo, providerRepo, healthRepo, completionClient, healthEventPublisher, healthOptions, logger)
+	modelUsecase := biz.NewModelUsecase(providerRepo, modelRepo, quotaRepo, rateLimitRepo, healthRepo, healthUsecase, workspaceUsecase, logger)
+	modelSyncUsecase := biz.NewModelSyncUsecase(modelRepo, providerRepo, completionClient, logger)
+	modelService := service.NewModelService(modelUsecase, credentialUsecase, modelSyncUsecase)
+	conversationRepo := data.NewConversationRepo(dataData, logger)
+	shareRepo := data.NewShareRepo(dataData, logger)
+	knowledgeRepo := data.NewKnowledgeRepo(dataData, logger)
+	shareUsecase := biz.NewShareUsecase(shareRepo, knowledgeRepo, conversationRepo, workspaceUsecase, logger)
+	limiterRepo := data.NewLimiterRepo(dataData, logger)
+	limiterUsecase := biz.NewLimiterUsecase(limiterRepo, quotaRepo, rateLimitRepo, modelRepo, workspaceUsecase, logger)
+	routeRepo := data.NewRouteRepo(router)
+	routerOptions := data.NewRouterOptions(router)
+	modelRouter := biz.NewModelRouter(routeRepo, modelRepo, providerRepo, healthRepo, credentialUsecase, completionClient, routerOptions, logger)
+	conversationUsecase := biz.NewConversationUsecase(conversationRepo, workspaceUsecase, shareUsecase, limiterUsecase, modelRouter, credentialUsecase, logger)
+	conversationService := service.NewConversationService(conversationUsecase, shareUsecase, logger)
+	generator := data.NewIDGenerator(dataData)
+	knowledgeUsecase := biz.NewKnowledgeUsecase(knowledgeRepo, workspaceUsecase, shareUsecase, embedder, generator, logger)
+	knowledgeService := service.NewKnowledgeService(knowledgeUsecase, shareUsecase, logger)
+	checker := data.NewDependencyChecker(dataData, logger)
+	grpcServer := server.NewGRPCServer(confServer, aiService, modelService, conversationService, knowledgeService, checker, logger)
+	httpServer := server.NewHTTPServer(confServer, aiService, logger)
+	schedulerRepo := data.NewSchedulerRepo(dataData, logger)
+	schedulerUsecase := biz.NewSchedulerUsecase(schedulerRepo, jobLocker, logger)
+	serverScheduler := server.NewScheduler(scheduler, health, schedulerUsecase, healthUsecase, modelSyncUsecase, logger)
+	registrar := server.NewRegistrar(discoveryRegistry)
+	app := newApp(info, logger, grpcServer, httpServer, serverScheduler, checker, registrar)
+	return app, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
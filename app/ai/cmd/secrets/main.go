@@ -0,0 +1,81 @@
+// secrets 敏感字段管理命令。
+//
+// 轮换主密钥的步骤：
+//  1. secrets -genkey 生成新密钥，追加到所有副本使用的密钥文件中，此时主密钥版本保持不变，滚动重启后各副本均可解密新版本；
+//  2. 将 data.secret.primary_key_id 改为新版本并滚动重启，新写入的数据使用新版本加密；
+//  3. 执行 secrets -conf <配置目录> 将存量数据重新加密为新版本，服务无需停机；
+//  4. 确认没有行再使用旧版本后，从密钥文件中移除旧密钥。
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"universal/app/ai/internal/conf"
+	"universal/app/ai/internal/data"
+	"universal/pkg/envelope"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	flagconf string
+	genkey   bool
+)
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.BoolVar(&genkey, "genkey", false, "print a new random master key and exit")
+}
+
+func main() {
+	flag.Parse()
+	if genkey {
+		key, err := envelope.GenerateKey()
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(key)
+		return
+	}
+
+	logger := log.With(log.NewStdLogger(os.Stdout), "ts", log.DefaultTimestamp)
+	c := config.New(
+		config.WithSource(
+			file.NewSource(flagconf),
+		),
+	)
+	defer c.Close()
+
+	if err := c.Load(); err != nil {
+		panic(err)
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		panic(err)
+	}
+
+	d, cleanup, err := data.NewData(bc.Data, logger)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	result, err := data.NewSecretRotator(d, logger).Rotate(context.Background())
+	if err != nil {
+		cleanup()
+		fmt.Fprintf(os.Stderr, "re-encryption failed after %d rows: %v\n", result.Rotated, err)
+		os.Exit(1)
+	}
+	fmt.Printf("re-encrypted %d rows, skipped %d concurrently modified rows\n", result.Rotated, result.Skipped)
+	if result.Skipped > 0 {
+		// 跳过的行再次执行即可
+		cleanup()
+		os.Exit(2)
+	}
+}
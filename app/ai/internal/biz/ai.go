@@ -2,33 +2,972 @@ package biz
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
+	pb "universal/api/ai/v1"
+	"universal/pkg/identity"
+	"universal/pkg/textseg"
+
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// defaultAnalyticsRange 未指定时间范围时统计最近30天
+	defaultAnalyticsRange = 30 * 24 * time.Hour
+	// maxAnalyticsBuckets 单个时序的最大分桶数，防止小时粒度查询过长区间
+	maxAnalyticsBuckets = 2000
+	// trendStableThreshold 增长率在该范围内视为平稳
+	trendStableThreshold = 0.05
 )
 
-// Ai is an Ai model.
-type Ai struct {
-	Hello string
+// 统计过滤条件键
+const (
+	AnalyticsFilterModelName   = "model_name" // 多个模型以逗号分隔
+	AnalyticsFilterWorkspaceID = "workspace_id"
+	AnalyticsFilterRole        = "role"
+)
+
+// AnalyticsAdminLevel 可查看其他用户与全平台统计的用户等级，由认证代理经 X-User-Level 提供
+const AnalyticsAdminLevel = "admin"
+
+// ErrAnalyticsForbidden 非管理员查看其他用户或全平台统计
+var ErrAnalyticsForbidden = errors.Forbidden("ANALYTICS_FORBIDDEN", "only admins can view other users' or system-wide analytics")
+
+// errAnalyticsInvalidArgument 统计参数不合法
+func errAnalyticsInvalidArgument(message string) error {
+	return errors.BadRequest("ANALYTICS_INVALID_ARGUMENT", message)
+}
+
+// AnalyticsScope 统计范围
+type AnalyticsScope struct {
+	UserID      int64
+	WorkspaceID *int64 // nil表示不限，0表示个人空间
+	ModelNames  []string
+	Role        string
+	Start       time.Time
+	End         time.Time
+	WithContent bool // 是否加载消息正文
+}
+
+// ConversationEvent 对话统计记录
+type ConversationEvent struct {
+	ID          int64
+	UserID      int64
+	ModelName   string
+	Status      int
+	CreatedAt   time.Time
+	TotalCost   float64
+	WorkspaceID int64
+}
+
+// MessageEvent 消息统计记录
+type MessageEvent struct {
+	ID             int64
+	ConversationID int64
+	UserID         int64
+	ModelName      string
+	Role           string
+	Status         int
+	Content        string
+	InputTokens    int64
+	OutputTokens   int64
+	ResponseTime   float64
+	Cost           float64
+	CreatedAt      time.Time
 }
 
-// AiRepo is an Ai repo.
+// ToolEvent 工具执行统计记录
+type ToolEvent struct {
+	UserID         int64
+	ConversationID int64
+	ToolName       string
+	Status         int
+	ExecutionTime  int64 // 毫秒
+	Cost           float64
+	CreatedAt      time.Time
+}
+
+// AnalyticsTotals 汇总计数
+type AnalyticsTotals struct {
+	Users         int64
+	Conversations int64
+	Messages      int64
+	Tokens        int64
+	Cost          float64
+}
+
+// AiRepo 统计分析数据访问接口，Scan系列方法逐行回调避免一次性加载
 type AiRepo interface {
-	CreateAi(context.Context, *Ai) (*Ai, error)
+	ScanConversations(ctx context.Context, scope *AnalyticsScope, fn func(*ConversationEvent) error) error
+	ScanMessages(ctx context.Context, scope *AnalyticsScope, fn func(*MessageEvent) error) error
+	ScanToolExecutions(ctx context.Context, scope *AnalyticsScope, fn func(*ToolEvent) error) error
+	// Totals 统计范围内的对话、消息数，token与成本取自每日使用统计
+	Totals(ctx context.Context, scope *AnalyticsScope) (*AnalyticsTotals, error)
+	// CountActiveUsers 统计范围内发送过消息的用户数
+	CountActiveUsers(ctx context.Context, scope *AnalyticsScope) (int64, error)
+	ListModelHealth(ctx context.Context) ([]*ModelHealth, error)
+	// ResourceUsage 进程与数据库的资源使用情况
+	ResourceUsage(ctx context.Context) (*pb.ResourceUsageStats, error)
 }
 
-// AiUsecase is an Ai usecase.
+// AiUsecase 对话、使用量、模型性能与系统总览的统计分析
 type AiUsecase struct {
-	repo AiRepo
-	log  *log.Helper
+	repo       AiRepo
+	topicCache TopicCacheRepo
+	locker     JobLocker
+	embedder   Embedder
+	workspaces *WorkspaceUsecase
+	log        *log.Helper
+}
+
+// NewAiUsecase 创建统计分析业务用例
+func NewAiUsecase(repo AiRepo, topicCache TopicCacheRepo, locker JobLocker, embedder Embedder, workspaces *WorkspaceUsecase, logger log.Logger) *AiUsecase {
+	return &AiUsecase{
+		repo:       repo,
+		topicCache: topicCache,
+		locker:     locker,
+		embedder:   embedder,
+		workspaces: workspaces,
+		log:        log.NewHelper(logger),
+	}
+}
+
+// NewAnalyticsScope 根据请求参数构造统计范围。普通用户只能查看自己的数据，userID 为0时取网关验证的调用方；
+// 管理员可指定任意用户，userID 为0表示全部用户。按工作空间过滤时校验调用方是该空间的成员
+func (uc *AiUsecase) NewAnalyticsScope(ctx context.Context, userID int64, start, end *time.Time, filters map[string]string) (*AnalyticsScope, error) {
+	caller := identity.FromContext(ctx)
+	if caller <= 0 {
+		return nil, ErrAnalyticsForbidden
+	}
+	if !isAnalyticsAdmin(ctx) {
+		if userID != 0 && userID != caller {
+			return nil, ErrAnalyticsForbidden
+		}
+		userID = caller
+	}
+
+	scope, err := parseAnalyticsScope(userID, start, end, filters)
+	if err != nil {
+		return nil, err
+	}
+	if scope.WorkspaceID != nil && *scope.WorkspaceID > 0 {
+		if _, err := uc.workspaces.Membership(ctx, *scope.WorkspaceID, caller); err != nil {
+			return nil, err
+		}
+	}
+	return scope, nil
+}
+
+// isAnalyticsAdmin 网关验证的调用方是否为管理员等级
+func isAnalyticsAdmin(ctx context.Context) bool {
+	return identity.LevelFromContext(ctx) == AnalyticsAdminLevel
+}
+
+// parseAnalyticsScope 解析统计时间范围与过滤条件，未指定时间时默认最近30天
+func parseAnalyticsScope(userID int64, start, end *time.Time, filters map[string]string) (*AnalyticsScope, error) {
+	scope := &AnalyticsScope{UserID: userID, End: time.Now()}
+	if end != nil && !end.IsZero() {
+		scope.End = *end
+	}
+	scope.Start = scope.End.Add(-defaultAnalyticsRange)
+	if start != nil && !start.IsZero() {
+		scope.Start = *start
+	}
+	if !scope.Start.Before(scope.End) {
+		return nil, errAnalyticsInvalidArgument("start_time must be before end_time")
+	}
+
+	for key, value := range filters {
+		switch key {
+		case AnalyticsFilterModelName:
+			for _, name := range strings.Split(value, ",") {
+				if name = strings.TrimSpace(name); name != "" {
+					scope.ModelNames = append(scope.ModelNames, name)
+				}
+			}
+		case AnalyticsFilterWorkspaceID:
+			id, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || id < 0 {
+				return nil, errAnalyticsInvalidArgument("invalid workspace_id filter: " + value)
+			}
+			scope.WorkspaceID = &id
+		case AnalyticsFilterRole:
+			scope.Role = value
+		default:
+			return nil, errAnalyticsInvalidArgument("unsupported filter: " + key)
+		}
+	}
+	return scope, nil
+}
+
+// GetConversationAnalytics 对话统计分析，按粒度输出所选指标的时序数据
+func (uc *AiUsecase) GetConversationAnalytics(ctx context.Context, scope *AnalyticsScope, granularity pb.AnalyticsGranularity, metrics []pb.AnalyticsMetric) (*pb.ConversationAnalytics, error) {
+	if len(metrics) == 0 {
+		metrics = []pb.AnalyticsMetric{
+			pb.AnalyticsMetric_ANALYTICS_METRIC_CONVERSATION_COUNT,
+			pb.AnalyticsMetric_ANALYTICS_METRIC_MESSAGE_COUNT,
+			pb.AnalyticsMetric_ANALYTICS_METRIC_TOKEN_USAGE,
+			pb.AnalyticsMetric_ANALYTICS_METRIC_RESPONSE_TIME,
+			pb.AnalyticsMetric_ANALYTICS_METRIC_USER_ENGAGEMENT,
+			pb.AnalyticsMetric_ANALYTICS_METRIC_TOOL_USAGE,
+		}
+	}
+
+	series := make(map[pb.AnalyticsMetric]*timeSeries, len(metrics))
+	for _, metric := range metrics {
+		s, err := newTimeSeries(scope.Start, scope.End, granularity)
+		if err != nil {
+			return nil, err
+		}
+		s.average = metric == pb.AnalyticsMetric_ANALYTICS_METRIC_RESPONSE_TIME
+		series[metric] = s
+	}
+	add := func(metric pb.AnalyticsMetric, fn func(*timeSeries)) {
+		if s, ok := series[metric]; ok {
+			fn(s)
+		}
+	}
+
+	analytics := &pb.ConversationAnalytics{
+		ModelUsageDistribution: make(map[string]int64),
+		TimeDistribution:       make(map[string]int64),
+	}
+
+	err := uc.repo.ScanConversations(ctx, scope, func(c *ConversationEvent) error {
+		analytics.TotalConversations++
+		analytics.ModelUsageDistribution[c.ModelName]++
+		add(pb.AnalyticsMetric_ANALYTICS_METRIC_CONVERSATION_COUNT, func(s *timeSeries) {
+			s.add(c.CreatedAt, 1, c.ModelName, 1)
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan conversations: %w", err)
+	}
+
+	var (
+		messageCount       int64
+		responseTimeSum    float64
+		responseTimeCount  int64
+		users              = make(map[int64]struct{})
+		conversations      = make(map[int64]struct{})
+		completed          = make(map[int64]struct{})
+		firstHalfUsers     = make(map[int64]struct{})
+		secondHalfUsers    = make(map[int64]struct{})
+		midpoint           = scope.Start.Add(scope.End.Sub(scope.Start) / 2)
+		toolUsers          = make(map[int64]struct{})
+		conversationOwners = make(map[int64]int64)
+	)
+	err = uc.repo.ScanMessages(ctx, scope, func(m *MessageEvent) error {
+		messageCount++
+		conversations[m.ConversationID] = struct{}{}
+		conversationOwners[m.ConversationID] = m.UserID
+		add(pb.AnalyticsMetric_ANALYTICS_METRIC_MESSAGE_COUNT, func(s *timeSeries) {
+			s.add(m.CreatedAt, 1, m.Role, 1)
+		})
+
+		switch m.Role {
+		case "user":
+			users[m.UserID] = struct{}{}
+			if m.CreatedAt.Before(midpoint) {
+				firstHalfUsers[m.UserID] = struct{}{}
+			} else {
+				secondHalfUsers[m.UserID] = struct{}{}
+			}
+			analytics.TimeDistribution[fmt.Sprintf("%02d", m.CreatedAt.Local().Hour())]++
+			add(pb.AnalyticsMetric_ANALYTICS_METRIC_USER_ENGAGEMENT, func(s *timeSeries) {
+				s.addUnique(m.CreatedAt, m.UserID)
+				s.add(m.CreatedAt, 0, "messages", 1)
+			})
+		case "assistant":
+			if m.Status == 3 { // completed
+				completed[m.ConversationID] = struct{}{}
+			}
+			if m.ResponseTime > 0 {
+				responseTimeSum += m.ResponseTime
+				responseTimeCount++
+				add(pb.AnalyticsMetric_ANALYTICS_METRIC_RESPONSE_TIME, func(s *timeSeries) {
+					s.add(m.CreatedAt, m.ResponseTime, m.ModelName, m.ResponseTime)
+				})
+			}
+			add(pb.AnalyticsMetric_ANALYTICS_METRIC_TOKEN_USAGE, func(s *timeSeries) {
+				s.add(m.CreatedAt, float64(m.InputTokens), "input", float64(m.InputTokens))
+				s.add(m.CreatedAt, float64(m.OutputTokens), "output", float64(m.OutputTokens))
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan messages: %w", err)
+	}
+
+	err = uc.repo.ScanToolExecutions(ctx, scope, func(t *ToolEvent) error {
+		if t.UserID > 0 {
+			toolUsers[t.UserID] = struct{}{}
+		} else if owner, ok := conversationOwners[t.ConversationID]; ok {
+			toolUsers[owner] = struct{}{}
+		}
+		add(pb.AnalyticsMetric_ANALYTICS_METRIC_TOOL_USAGE, func(s *timeSeries) {
+			s.add(t.CreatedAt, 1, t.ToolName, 1)
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan tool executions: %w", err)
+	}
+
+	analytics.ActiveUsers = int64(len(users))
+	analytics.AverageConversationLength = ratio(float64(messageCount), float64(len(conversations)))
+	analytics.AverageResponseTime = ratio(responseTimeSum, float64(responseTimeCount))
+	analytics.Engagement = &pb.UserEngagementMetrics{
+		AverageMessagesPerConversation: analytics.AverageConversationLength,
+		ConversationCompletionRate:     ratio(float64(len(completed)), float64(len(conversations))),
+		UserRetentionRate:              ratio(float64(countIntersection(firstHalfUsers, secondHalfUsers)), float64(len(firstHalfUsers))),
+		FeatureAdoptionRate:            ratio(float64(countIntersection(toolUsers, users)), float64(len(users))),
+	}
+
+	for _, metric := range metrics {
+		analytics.TimeSeriesData = append(analytics.TimeSeriesData, series[metric].points(metricLabel(metric))...)
+	}
+	return analytics, nil
+}
+
+// GetUserUsageStats 用户使用统计，可选输出各模型用量与成本明细
+func (uc *AiUsecase) GetUserUsageStats(ctx context.Context, scope *AnalyticsScope, includeCostBreakdown, includeModelUsage bool) (*pb.UserUsageStats, error) {
+	if scope.UserID <= 0 {
+		return nil, errAnalyticsInvalidArgument("user_id is required")
+	}
+
+	timeline, err := newTimeSeries(scope.Start, scope.End, pb.AnalyticsGranularity_ANALYTICS_GRANULARITY_DAY)
+	if err != nil {
+		return nil, err
+	}
+	scope.WithContent = true
+
+	stats := &pb.UserUsageStats{UserId: scope.UserID}
+	err = uc.repo.ScanConversations(ctx, scope, func(c *ConversationEvent) error {
+		stats.TotalConversations++
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan conversations: %w", err)
+	}
+
+	var (
+		modelUsage      = make(map[string]*pb.ModelUsageStats)
+		responseTimes   = make(map[string]float64)
+		costByModel     = make(map[string]float64)
+		sessions        = make(map[int64][2]time.Time)
+		hourly          [24]int64
+		userRunes       int64
+		userMessages    int64
+		modelRequests   = make(map[string]int64)
+		conversationIDs = make(map[int64]struct{})
+		keywords        = make(map[string]int64)
+	)
+	err = uc.repo.ScanMessages(ctx, scope, func(m *MessageEvent) error {
+		stats.TotalMessages++
+		conversationIDs[m.ConversationID] = struct{}{}
+		if span, ok := sessions[m.ConversationID]; !ok {
+			sessions[m.ConversationID] = [2]time.Time{m.CreatedAt, m.CreatedAt}
+		} else if m.CreatedAt.After(span[1]) {
+			sessions[m.ConversationID] = [2]time.Time{span[0], m.CreatedAt}
+		}
+
+		tokens := m.InputTokens + m.OutputTokens
+		timeline.add(m.CreatedAt, 1, "tokens", float64(tokens))
+		timeline.add(m.CreatedAt, 0, "cost", m.Cost)
+
+		switch m.Role {
+		case "user":
+			hourly[m.CreatedAt.Local().Hour()]++
+			userRunes += int64(len([]rune(m.Content)))
+			userMessages++
+			for _, keyword := range textseg.Default().Keywords(m.Content) {
+				keywords[keyword]++
+			}
+		case "assistant":
+			stats.TotalInputTokens += m.InputTokens
+			stats.TotalOutputTokens += m.OutputTokens
+			stats.TotalCost += m.Cost
+			costByModel[m.ModelName] += m.Cost
+			modelRequests[m.ModelName]++
+
+			usage, ok := modelUsage[m.ModelName]
+			if !ok {
+				usage = &pb.ModelUsageStats{ModelName: m.ModelName}
+				modelUsage[m.ModelName] = usage
+			}
+			usage.RequestCount++
+			usage.InputTokens += m.InputTokens
+			usage.OutputTokens += m.OutputTokens
+			usage.Cost += m.Cost
+			responseTimes[m.ModelName] += m.ResponseTime
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan messages: %w", err)
+	}
+
+	var toolCalls int64
+	toolCost := make(map[string]float64)
+	err = uc.repo.ScanToolExecutions(ctx, scope, func(t *ToolEvent) error {
+		toolCalls++
+		stats.TotalCost += t.Cost
+		toolCost[t.ToolName] += t.Cost
+		timeline.add(t.CreatedAt, 0, "cost", t.Cost)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan tool executions: %w", err)
+	}
+
+	var sessionSeconds float64
+	for _, span := range sessions {
+		sessionSeconds += span[1].Sub(span[0]).Seconds()
+	}
+	stats.AverageSessionDuration = ratio(sessionSeconds, float64(len(sessions)))
+
+	if includeModelUsage {
+		stats.ModelUsage = modelUsage
+		for name, usage := range modelUsage {
+			usage.AverageResponseTime = ratio(responseTimes[name], float64(usage.RequestCount))
+		}
+	}
+	if includeCostBreakdown {
+		stats.CostBreakdown = make(map[string]float64, len(costByModel)+len(toolCost))
+		for name, cost := range costByModel {
+			stats.CostBreakdown["model:"+name] = cost
+		}
+		for name, cost := range toolCost {
+			stats.CostBreakdown["tool:"+name] = cost
+		}
+	}
+
+	for i, bucket := range timeline.buckets {
+		stats.UsageTimeline = append(stats.UsageTimeline, &pb.UsageTimePoint{
+			Timestamp:    timestamppb.New(bucket),
+			MessageCount: int32(timeline.values[i]),
+			TokenCount:   int32(timeline.dims[i]["tokens"]),
+			Cost:         timeline.dims[i]["cost"],
+		})
+	}
+
+	stats.BehaviorProfile = &pb.UserBehaviorProfile{
+		PreferredModels:      topKeys(modelRequests, 3),
+		FrequentTopics:       topKeys(keywords, 5),
+		MostActiveTime:       mostActiveHour(hourly),
+		ComplexityPreference: math.Min(ratio(float64(userRunes), float64(userMessages))/500, 1),
+		FeatureUsage: map[string]float64{
+			"tool_calls_per_conversation": ratio(float64(toolCalls), float64(len(conversationIDs))),
+		},
+	}
+	return stats, nil
+}
+
+// GetModelPerformanceStats 模型性能统计，按模型汇总助手消息，仅管理员可查看
+func (uc *AiUsecase) GetModelPerformanceStats(ctx context.Context, scope *AnalyticsScope, includeErrorAnalysis bool) ([]*pb.ModelPerformanceStats, error) {
+	if !isAnalyticsAdmin(ctx) {
+		return nil, ErrAnalyticsForbidden
+	}
+
+	type accumulator struct {
+		requests, succeeded, failed, pending int64
+		responseTime, cost                   float64
+		inputTokens, outputTokens            int64
+		timeline                             *timeSeries
+		successTimeline                      *timeSeries
+	}
+	models := make(map[string]*accumulator)
+
+	assistantScope := *scope
+	assistantScope.Role = "assistant"
+	err := uc.repo.ScanMessages(ctx, &assistantScope, func(m *MessageEvent) error {
+		acc, ok := models[m.ModelName]
+		if !ok {
+			timeline, err := newTimeSeries(scope.Start, scope.End, pb.AnalyticsGranularity_ANALYTICS_GRANULARITY_DAY)
+			if err != nil {
+				return err
+			}
+			timeline.average = true
+			successTimeline, _ := newTimeSeries(scope.Start, scope.End, pb.AnalyticsGranularity_ANALYTICS_GRANULARITY_DAY)
+			successTimeline.average = true
+			acc = &accumulator{timeline: timeline, successTimeline: successTimeline}
+			models[m.ModelName] = acc
+		}
+
+		acc.requests++
+		acc.inputTokens += m.InputTokens
+		acc.outputTokens += m.OutputTokens
+		acc.responseTime += m.ResponseTime
+		acc.cost += m.Cost
+		success := 0.0
+		switch m.Status {
+		case 3: // completed
+			acc.succeeded++
+			success = 1
+		case 4: // failed
+			acc.failed++
+		default:
+			acc.pending++
+		}
+		acc.timeline.add(m.CreatedAt, m.ResponseTime, "", 0)
+		acc.successTimeline.add(m.CreatedAt, success, "", 0)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan messages: %w", err)
+	}
+
+	names := make([]string, 0, len(models))
+	for name := range models {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	stats := make([]*pb.ModelPerformanceStats, 0, len(names))
+	for _, name := range names {
+		acc := models[name]
+		s := &pb.ModelPerformanceStats{
+			ModelName:           name,
+			TotalRequests:       acc.requests,
+			SuccessRate:         ratio(float64(acc.succeeded), float64(acc.succeeded+acc.failed)),
+			AverageResponseTime: ratio(acc.responseTime, float64(acc.requests)),
+			AverageInputTokens:  ratio(float64(acc.inputTokens), float64(acc.requests)),
+			AverageOutputTokens: ratio(float64(acc.outputTokens), float64(acc.requests)),
+			CostPerRequest:      ratio(acc.cost, float64(acc.requests)),
+		}
+		if includeErrorAnalysis {
+			s.ErrorDistribution = map[string]int64{
+				"failed":     acc.failed,
+				"incomplete": acc.pending,
+			}
+		}
+		bucketHours := acc.timeline.bucketDuration().Hours()
+		for i, bucket := range acc.timeline.buckets {
+			if acc.timeline.counts[i] == 0 {
+				continue
+			}
+			s.PerformanceTimeline = append(s.PerformanceTimeline, &pb.PerformanceTimePoint{
+				Timestamp:    timestamppb.New(bucket),
+				ResponseTime: acc.timeline.value(i),
+				SuccessRate:  acc.successTimeline.value(i),
+				Throughput:   ratio(acc.timeline.counts[i], bucketHours),
+			})
+		}
+		stats = append(stats, s)
+	}
+	return stats, nil
+}
+
+// GetConversationTrends 对话趋势，增长率为后半段相对前半段的变化
+func (uc *AiUsecase) GetConversationTrends(ctx context.Context, scope *AnalyticsScope, granularity pb.AnalyticsGranularity, metrics []pb.TrendMetric) ([]*pb.TrendData, error) {
+	if len(metrics) == 0 {
+		metrics = []pb.TrendMetric{
+			pb.TrendMetric_TREND_METRIC_ACTIVE_USERS,
+			pb.TrendMetric_TREND_METRIC_NEW_CONVERSATIONS,
+			pb.TrendMetric_TREND_METRIC_MESSAGE_VOLUME,
+			pb.TrendMetric_TREND_METRIC_TOKEN_CONSUMPTION,
+			pb.TrendMetric_TREND_METRIC_COST,
+		}
+	}
+
+	series := make(map[pb.TrendMetric]*timeSeries, len(metrics))
+	for _, metric := range metrics {
+		s, err := newTimeSeries(scope.Start, scope.End, granularity)
+		if err != nil {
+			return nil, err
+		}
+		series[metric] = s
+	}
+	add := func(metric pb.TrendMetric, fn func(*timeSeries)) {
+		if s, ok := series[metric]; ok {
+			fn(s)
+		}
+	}
+
+	if _, ok := series[pb.TrendMetric_TREND_METRIC_NEW_CONVERSATIONS]; ok {
+		err := uc.repo.ScanConversations(ctx, scope, func(c *ConversationEvent) error {
+			series[pb.TrendMetric_TREND_METRIC_NEW_CONVERSATIONS].add(c.CreatedAt, 1, c.ModelName, 1)
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan conversations: %w", err)
+		}
+	}
+
+	err := uc.repo.ScanMessages(ctx, scope, func(m *MessageEvent) error {
+		add(pb.TrendMetric_TREND_METRIC_MESSAGE_VOLUME, func(s *timeSeries) {
+			s.add(m.CreatedAt, 1, m.Role, 1)
+		})
+		if m.Role == "user" {
+			add(pb.TrendMetric_TREND_METRIC_ACTIVE_USERS, func(s *timeSeries) {
+				s.addUnique(m.CreatedAt, m.UserID)
+			})
+		}
+		if m.Role == "assistant" {
+			add(pb.TrendMetric_TREND_METRIC_TOKEN_CONSUMPTION, func(s *timeSeries) {
+				s.add(m.CreatedAt, float64(m.InputTokens+m.OutputTokens), m.ModelName, float64(m.InputTokens+m.OutputTokens))
+			})
+			add(pb.TrendMetric_TREND_METRIC_COST, func(s *timeSeries) {
+				s.add(m.CreatedAt, m.Cost, m.ModelName, m.Cost)
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan messages: %w", err)
+	}
+
+	trends := make([]*pb.TrendData, 0, len(metrics))
+	for _, metric := range metrics {
+		s := series[metric]
+		growth := s.growthRate()
+		direction := "stable"
+		if growth > trendStableThreshold {
+			direction = "up"
+		} else if growth < -trendStableThreshold {
+			direction = "down"
+		}
+		trends = append(trends, &pb.TrendData{
+			Metric:         metric,
+			DataPoints:     s.points(trendLabel(metric)),
+			GrowthRate:     growth,
+			TrendDirection: direction,
+		})
+	}
+	return trends, nil
+}
+
+// GetSystemOverview 系统总览，仅管理员可查看
+func (uc *AiUsecase) GetSystemOverview(ctx context.Context, scope *AnalyticsScope, includeHealth, includeResource bool) (*pb.SystemOverviewStats, error) {
+	if !isAnalyticsAdmin(ctx) {
+		return nil, ErrAnalyticsForbidden
+	}
+
+	totals, err := uc.repo.Totals(ctx, scope)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count totals: %w", err)
+	}
+
+	now := time.Now()
+	today := &AnalyticsScope{Start: time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local), End: now}
+	activeToday, err := uc.repo.CountActiveUsers(ctx, today)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count active users: %w", err)
+	}
+
+	overview := &pb.SystemOverviewStats{
+		TotalUsers:           totals.Users,
+		ActiveUsersToday:     activeToday,
+		TotalConversations:   totals.Conversations,
+		TotalMessages:        totals.Messages,
+		TotalTokensProcessed: totals.Tokens,
+		TotalCost:            totals.Cost,
+		ServiceStats:         make(map[string]*pb.ServiceStats),
+	}
+
+	// 对话服务与工具服务的请求统计
+	var requests, failed int64
+	var responseTime float64
+	assistantScope := *scope
+	assistantScope.Role = "assistant"
+	err = uc.repo.ScanMessages(ctx, &assistantScope, func(m *MessageEvent) error {
+		requests++
+		responseTime += m.ResponseTime
+		if m.Status == 4 { // failed
+			failed++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan messages: %w", err)
+	}
+	overview.ServiceStats["conversation"] = &pb.ServiceStats{
+		ServiceName:         "conversation",
+		UptimePercentage:    100 * (1 - ratio(float64(failed), float64(requests))),
+		RequestCount:        requests,
+		AverageResponseTime: ratio(responseTime, float64(requests)),
+		ErrorRate:           ratio(float64(failed), float64(requests)),
+	}
+
+	var toolCalls, toolFailed int64
+	var toolTime float64
+	err = uc.repo.ScanToolExecutions(ctx, scope, func(t *ToolEvent) error {
+		toolCalls++
+		toolTime += float64(t.ExecutionTime) / 1000
+		if t.Status >= 4 { // failed, timeout, cancelled
+			toolFailed++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan tool executions: %w", err)
+	}
+	overview.ServiceStats["tool"] = &pb.ServiceStats{
+		ServiceName:         "tool",
+		UptimePercentage:    100 * (1 - ratio(float64(toolFailed), float64(toolCalls))),
+		RequestCount:        toolCalls,
+		AverageResponseTime: ratio(toolTime, float64(toolCalls)),
+		ErrorRate:           ratio(float64(toolFailed), float64(toolCalls)),
+	}
+
+	if includeHealth {
+		health, err := uc.repo.ListModelHealth(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list model health: %w", err)
+		}
+		var healthy int
+		var healthScore float64
+		for _, h := range health {
+			if h.IsHealthy {
+				healthy++
+			}
+			healthScore += h.SuccessRate
+		}
+		errorRate := ratio(float64(failed+toolFailed), float64(requests+toolCalls))
+		overview.Health = &pb.SystemHealthMetrics{
+			OverallHealthScore:  100 * ratio(healthScore, float64(len(health))),
+			ServiceAvailability: 100 * ratio(float64(healthy), float64(len(health))),
+			AverageResponseTime: overview.ServiceStats["conversation"].AverageResponseTime,
+			ErrorRate:           errorRate,
+		}
+	}
+
+	if includeResource {
+		usage, err := uc.repo.ResourceUsage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get resource usage: %w", err)
+		}
+		overview.ResourceUsage = usage
+		if overview.Health != nil {
+			overview.Health.ActiveConnections = usage.DatabaseConnections
+		}
+	}
+	return overview, nil
+}
+
+// timeSeries 按时间粒度分桶的时序累加器
+type timeSeries struct {
+	granularity pb.AnalyticsGranularity
+	buckets     []time.Time
+	index       map[int64]int
+	values      []float64
+	counts      []float64
+	dims        []map[string]float64
+	dimCounts   []map[string]float64
+	seen        []map[int64]struct{}
+	average     bool // 输出平均值而非累加值
+}
+
+func newTimeSeries(start, end time.Time, granularity pb.AnalyticsGranularity) (*timeSeries, error) {
+	if granularity == pb.AnalyticsGranularity_ANALYTICS_GRANULARITY_UNSPECIFIED {
+		granularity = pb.AnalyticsGranularity_ANALYTICS_GRANULARITY_DAY
+	}
+	s := &timeSeries{granularity: granularity, index: make(map[int64]int)}
+	for t := bucketStart(start, granularity); t.Before(end); t = nextBucket(t, granularity) {
+		if len(s.buckets) >= maxAnalyticsBuckets {
+			return nil, errAnalyticsInvalidArgument("time range too large for the requested granularity")
+		}
+		s.index[t.Unix()] = len(s.buckets)
+		s.buckets = append(s.buckets, t)
+		s.dims = append(s.dims, make(map[string]float64))
+		s.dimCounts = append(s.dimCounts, make(map[string]float64))
+		s.seen = append(s.seen, nil)
+	}
+	s.values = make([]float64, len(s.buckets))
+	s.counts = make([]float64, len(s.buckets))
+	return s, nil
+}
+
+func (s *timeSeries) bucketOf(t time.Time) (int, bool) {
+	i, ok := s.index[bucketStart(t, s.granularity).Unix()]
+	return i, ok
+}
+
+// add 累加数值，dim非空时同时累加到对应维度
+func (s *timeSeries) add(t time.Time, value float64, dim string, dimValue float64) {
+	i, ok := s.bucketOf(t)
+	if !ok {
+		return
+	}
+	s.values[i] += value
+	s.counts[i]++
+	if dim != "" {
+		s.dims[i][dim] += dimValue
+		s.dimCounts[i][dim]++
+	}
+}
+
+// addUnique 按ID去重计数
+func (s *timeSeries) addUnique(t time.Time, id int64) {
+	i, ok := s.bucketOf(t)
+	if !ok {
+		return
+	}
+	if s.seen[i] == nil {
+		s.seen[i] = make(map[int64]struct{})
+	}
+	if _, ok := s.seen[i][id]; !ok {
+		s.seen[i][id] = struct{}{}
+		s.values[i]++
+	}
+}
+
+func (s *timeSeries) value(i int) float64 {
+	if s.average {
+		return ratio(s.values[i], s.counts[i])
+	}
+	return s.values[i]
+}
+
+func (s *timeSeries) points(label string) []*pb.TimeSeriesPoint {
+	points := make([]*pb.TimeSeriesPoint, len(s.buckets))
+	for i, bucket := range s.buckets {
+		dims := make(map[string]float64, len(s.dims[i]))
+		for k, v := range s.dims[i] {
+			if s.average {
+				v = ratio(v, s.dimCounts[i][k])
+			}
+			dims[k] = v
+		}
+		points[i] = &pb.TimeSeriesPoint{
+			Timestamp:  timestamppb.New(bucket),
+			Value:      s.value(i),
+			Label:      label,
+			Dimensions: dims,
+		}
+	}
+	return points
+}
+
+// growthRate 后半段相对前半段的增长率
+func (s *timeSeries) growthRate() float64 {
+	half := len(s.buckets) / 2
+	var first, second float64
+	for i := range s.buckets {
+		if i < half {
+			first += s.value(i)
+		} else {
+			second += s.value(i)
+		}
+	}
+	if first == 0 {
+		if second > 0 {
+			return 1
+		}
+		return 0
+	}
+	return (second - first) / first
+}
+
+func (s *timeSeries) bucketDuration() time.Duration {
+	if len(s.buckets) == 0 {
+		return time.Hour
+	}
+	return nextBucket(s.buckets[0], s.granularity).Sub(s.buckets[0])
+}
+
+// bucketStart 计算时间所在分桶的起点，周以周一为起点
+func bucketStart(t time.Time, granularity pb.AnalyticsGranularity) time.Time {
+	t = t.Local()
+	switch granularity {
+	case pb.AnalyticsGranularity_ANALYTICS_GRANULARITY_HOUR:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
+	case pb.AnalyticsGranularity_ANALYTICS_GRANULARITY_WEEK:
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case pb.AnalyticsGranularity_ANALYTICS_GRANULARITY_MONTH:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	}
+}
+
+func nextBucket(t time.Time, granularity pb.AnalyticsGranularity) time.Time {
+	switch granularity {
+	case pb.AnalyticsGranularity_ANALYTICS_GRANULARITY_HOUR:
+		return t.Add(time.Hour)
+	case pb.AnalyticsGranularity_ANALYTICS_GRANULARITY_WEEK:
+		return t.AddDate(0, 0, 7)
+	case pb.AnalyticsGranularity_ANALYTICS_GRANULARITY_MONTH:
+		return t.AddDate(0, 1, 0)
+	default:
+		return t.AddDate(0, 0, 1)
+	}
+}
+
+func metricLabel(metric pb.AnalyticsMetric) string {
+	return strings.ToLower(strings.TrimPrefix(metric.String(), "ANALYTICS_METRIC_"))
+}
+
+func trendLabel(metric pb.TrendMetric) string {
+	return strings.ToLower(strings.TrimPrefix(metric.String(), "TREND_METRIC_"))
+}
+
+func ratio(numerator, denominator float64) float64 {
+	if denominator == 0 {
+		return 0
+	}
+	return numerator / denominator
+}
+
+func countIntersection(a, b map[int64]struct{}) int {
+	n := 0
+	for id := range a {
+		if _, ok := b[id]; ok {
+			n++
+		}
+	}
+	return n
+}
+
+// topKeys 按计数降序返回前n个键，计数相同时按键排序保证结果稳定
+func topKeys(counts map[string]int64, n int) []string {
+	keys := make([]string, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	if len(keys) > n {
+		keys = keys[:n]
+	}
+	return keys
 }
 
-// NewAiUsecase new an Ai usecase.
-func NewAiUsecase(repo AiRepo, logger log.Logger) *AiUsecase {
-	return &AiUsecase{repo: repo, log: log.NewHelper(logger)}
+func mostActiveHour(hourly [24]int64) string {
+	best := -1
+	for h, n := range hourly {
+		if n > 0 && (best < 0 || n > hourly[best]) {
+			best = h
+		}
+	}
+	if best < 0 {
+		return ""
+	}
+	return fmt.Sprintf("%02d:00-%02d:00", best, (best+1)%24)
 }
 
-// CreateAi creates an Ai, and returns the new Ai.
-func (uc *AiUsecase) CreateAi(ctx context.Context, g *Ai) (*Ai, error) {
-	uc.log.WithContext(ctx).Infof("CreateAi: %v", g.Hello)
-	return uc.repo.CreateAi(ctx, g)
+// shannonIndex 香农多样性指数
+func shannonIndex(counts map[string]int64, total int64) float64 {
+	var h float64
+	for _, n := range counts {
+		if p := ratio(float64(n), float64(total)); p > 0 {
+			h -= p * math.Log(p)
+		}
+	}
+	return h
 }
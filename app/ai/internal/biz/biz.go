@@ -3,4 +3,4 @@ package biz
 import "github.com/google/wire"
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewAiUsecase, NewModelUsecase, NewConversationUsecase, NewKnowledgeUsecase, NewToolUsecase)
+var ProviderSet = wire.NewSet(NewAiUsecase, NewModelUsecase, NewConversationUsecase, NewKnowledgeUsecase, NewToolUsecase, NewShareUsecase, NewWorkspaceUsecase, NewLimiterUsecase, NewSchedulerUsecase, NewPricingUsecase, NewEmbedder, NewModelRouter, NewHealthUsecase, NewCredentialUsecase, NewModelSyncUsecase)
@@ -0,0 +1,151 @@
+package biz
+
+import (
+	"strings"
+
+	pb "universal/api/ai/v1"
+)
+
+// 未收录模型的默认限制
+const (
+	defaultCatalogContextWindow = 8192
+	defaultCatalogMaxOutput     = 4096
+	defaultCatalogTemperature   = 2.0
+)
+
+// ProviderModel 提供商模型列表中的一项
+type ProviderModel struct {
+	ID      string
+	OwnedBy string
+	// Family 与 ParameterSize 仅 Ollama 返回
+	Family        string
+	ParameterSize string
+}
+
+// catalogEntry 内置模型元数据，按名称前缀匹配，取最长前缀
+type catalogEntry struct {
+	prefix         string
+	displayName    string
+	skip           bool // 语音、图像等无法用于对话或向量化的模型，同步时忽略
+	embedding      bool
+	tools          bool
+	vision         bool
+	contextWindow  int32
+	maxOutput      int32
+	maxTemperature float64
+}
+
+// modelCatalog 常见模型的能力与限制，同步时作为新模型的默认值，价格需要手动维护
+var modelCatalog = []catalogEntry{
+	// OpenAI
+	{prefix: "gpt-4o", displayName: "GPT-4o", tools: true, vision: true, contextWindow: 128000, maxOutput: 16384, maxTemperature: 2},
+	{prefix: "gpt-4o-mini", displayName: "GPT-4o mini", tools: true, vision: true, contextWindow: 128000, maxOutput: 16384, maxTemperature: 2},
+	{prefix: "gpt-4.1", displayName: "GPT-4.1", tools: true, vision: true, contextWindow: 1047576, maxOutput: 32768, maxTemperature: 2},
+	{prefix: "gpt-4-turbo", displayName: "GPT-4 Turbo", tools: true, vision: true, contextWindow: 128000, maxOutput: 4096, maxTemperature: 2},
+	{prefix: "gpt-4", displayName: "GPT-4", tools: true, contextWindow: 8192, maxOutput: 8192, maxTemperature: 2},
+	{prefix: "gpt-3.5-turbo", displayName: "GPT-3.5 Turbo", tools: true, contextWindow: 16385, maxOutput: 4096, maxTemperature: 2},
+	{prefix: "o1", displayName: "o1", tools: true, vision: true, contextWindow: 200000, maxOutput: 100000, maxTemperature: 1},
+	{prefix: "o3", displayName: "o3", tools: true, vision: true, contextWindow: 200000, maxOutput: 100000, maxTemperature: 1},
+	{prefix: "o4-mini", displayName: "o4-mini", tools: true, vision: true, contextWindow: 200000, maxOutput: 100000, maxTemperature: 1},
+	{prefix: "text-embedding-3", embedding: true, contextWindow: 8191},
+	{prefix: "text-embedding-ada-002", displayName: "Ada Embedding v2", embedding: true, contextWindow: 8191},
+	{prefix: "whisper", skip: true},
+	{prefix: "tts-", skip: true},
+	{prefix: "dall-e", skip: true},
+	{prefix: "gpt-image", skip: true},
+	{prefix: "gpt-4o-audio", skip: true},
+	{prefix: "gpt-4o-realtime", skip: true},
+	{prefix: "gpt-4o-transcribe", skip: true},
+	{prefix: "gpt-4o-mini-tts", skip: true},
+	{prefix: "omni-moderation", skip: true},
+	{prefix: "text-moderation", skip: true},
+	{prefix: "babbage", skip: true},
+	{prefix: "davinci", skip: true},
+	// Anthropic
+	{prefix: "claude-3-haiku", displayName: "Claude 3 Haiku", tools: true, vision: true, contextWindow: 200000, maxOutput: 4096, maxTemperature: 1},
+	{prefix: "claude-3-opus", displayName: "Claude 3 Opus", tools: true, vision: true, contextWindow: 200000, maxOutput: 4096, maxTemperature: 1},
+	{prefix: "claude-3-5", tools: true, vision: true, contextWindow: 200000, maxOutput: 8192, maxTemperature: 1},
+	{prefix: "claude-3-7-sonnet", displayName: "Claude 3.7 Sonnet", tools: true, vision: true, contextWindow: 200000, maxOutput: 64000, maxTemperature: 1},
+	{prefix: "claude-sonnet-4", tools: true, vision: true, contextWindow: 200000, maxOutput: 64000, maxTemperature: 1},
+	{prefix: "claude-opus-4", tools: true, vision: true, contextWindow: 200000, maxOutput: 32000, maxTemperature: 1},
+	{prefix: "claude-haiku-4", tools: true, vision: true, contextWindow: 200000, maxOutput: 64000, maxTemperature: 1},
+	// 开源模型，Ollama 名称带 :tag 后缀
+	{prefix: "llama3", displayName: "Llama 3", contextWindow: 8192, maxOutput: 4096, maxTemperature: 2},
+	{prefix: "llama3.1", displayName: "Llama 3.1", tools: true, contextWindow: 131072, maxOutput: 4096, maxTemperature: 2},
+	{prefix: "llama3.2", displayName: "Llama 3.2", tools: true, contextWindow: 131072, maxOutput: 4096, maxTemperature: 2},
+	{prefix: "llama3.2-vision", displayName: "Llama 3.2 Vision", vision: true, contextWindow: 131072, maxOutput: 4096, maxTemperature: 2},
+	{prefix: "llama3.3", displayName: "Llama 3.3", tools: true, contextWindow: 131072, maxOutput: 4096, maxTemperature: 2},
+	{prefix: "qwen2.5", displayName: "Qwen 2.5", tools: true, contextWindow: 32768, maxOutput: 8192, maxTemperature: 2},
+	{prefix: "qwen3", displayName: "Qwen 3", tools: true, contextWindow: 40960, maxOutput: 8192, maxTemperature: 2},
+	{prefix: "deepseek-r1", displayName: "DeepSeek R1", contextWindow: 131072, maxOutput: 8192, maxTemperature: 2},
+	{prefix: "deepseek-chat", displayName: "DeepSeek V3", tools: true, contextWindow: 65536, maxOutput: 8192, maxTemperature: 2},
+	{prefix: "deepseek-reasoner", displayName: "DeepSeek R1", contextWindow: 65536, maxOutput: 32768, maxTemperature: 2},
+	{prefix: "mistral", displayName: "Mistral", tools: true, contextWindow: 32768, maxOutput: 4096, maxTemperature: 2},
+	{prefix: "gemma2", displayName: "Gemma 2", contextWindow: 8192, maxOutput: 4096, maxTemperature: 2},
+	{prefix: "gemma3", displayName: "Gemma 3", vision: true, contextWindow: 131072, maxOutput: 8192, maxTemperature: 2},
+	{prefix: "nomic-embed-text", displayName: "Nomic Embed Text", embedding: true, contextWindow: 8192},
+	{prefix: "mxbai-embed-large", displayName: "mxbai Embed Large", embedding: true, contextWindow: 512},
+	{prefix: "bge-m3", displayName: "BGE-M3", embedding: true, contextWindow: 8192},
+}
+
+// lookupCatalog 按名称查找内置元数据，未收录时按名称与模型家族推断
+func lookupCatalog(m *ProviderModel) catalogEntry {
+	name := strings.ToLower(m.ID)
+	var best catalogEntry
+	for _, entry := range modelCatalog {
+		if strings.HasPrefix(name, entry.prefix) && len(entry.prefix) > len(best.prefix) {
+			best = entry
+		}
+	}
+	if best.prefix != "" {
+		return best
+	}
+
+	family := strings.ToLower(m.Family)
+	if strings.Contains(name, "embed") || strings.Contains(family, "bert") {
+		return catalogEntry{embedding: true, contextWindow: defaultCatalogContextWindow}
+	}
+	return catalogEntry{
+		contextWindow:  defaultCatalogContextWindow,
+		maxOutput:      defaultCatalogMaxOutput,
+		maxTemperature: defaultCatalogTemperature,
+	}
+}
+
+// newCatalogModel 按内置元数据生成同步新增的模型
+func newCatalogModel(providerID int64, m *ProviderModel, entry catalogEntry) *Model {
+	displayName := entry.displayName
+	if displayName == "" {
+		displayName = m.ID
+	}
+
+	capabilities := &pb.ModelCapabilities{
+		SupportsChat:      !entry.embedding,
+		SupportsEmbedding: entry.embedding,
+		SupportsTools:     entry.tools,
+		SupportsVision:    entry.vision,
+		SupportsStreaming: !entry.embedding,
+		SupportedFormats:  []string{"text"},
+	}
+	if entry.vision {
+		capabilities.SupportedFormats = append(capabilities.SupportedFormats, "image")
+	}
+
+	limits := &pb.ModelLimits{
+		MaxTokens:       entry.contextWindow,
+		MaxInputTokens:  entry.contextWindow - entry.maxOutput,
+		MaxOutputTokens: entry.maxOutput,
+		ContextWindow:   entry.contextWindow,
+		MaxTemperature:  entry.maxTemperature,
+	}
+
+	return &Model{
+		ProviderID:   providerID,
+		Name:         m.ID,
+		DisplayName:  displayName,
+		Version:      m.ParameterSize,
+		Capabilities: capabilities,
+		Limits:       limits,
+		Status:       0, // 默认可用
+	}
+}
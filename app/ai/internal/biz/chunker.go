@@ -0,0 +1,122 @@
+package biz
+
+import (
+	"hash/fnv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	defaultChunkSize    = 1000
+	defaultChunkOverlap = 200
+	// chunkBoundaryMod 行内容哈希对该值取模为零且分块已达到最小长度时在该行后切分，
+	// 分块边界由内容决定，文档局部修改后只有附近的分块发生变化
+	chunkBoundaryMod = 4
+)
+
+// documentChunk 文档分块，位置为字符偏移
+type documentChunk struct {
+	Content string
+	Start   int
+	End     int
+}
+
+// paragraph 文档中的一行非空文本
+type paragraph struct {
+	text  string
+	start int
+	end   int
+}
+
+// splitDocument 按行分块：非空行依次合并，超过 size 时开始新分块；
+// 单行超过 size 时按 size 切分，相邻分块重叠 overlap 个字符
+func splitDocument(content string, size, overlap int) []documentChunk {
+	if size <= 0 {
+		size = defaultChunkSize
+	}
+	if overlap < 0 || overlap >= size {
+		overlap = 0
+	}
+	minSize := size / 4
+
+	var chunks []documentChunk
+	var current []paragraph
+	currentLen := 0
+	flush := func() {
+		if len(current) == 0 {
+			return
+		}
+		texts := make([]string, len(current))
+		for i, p := range current {
+			texts[i] = p.text
+		}
+		chunks = append(chunks, documentChunk{
+			Content: strings.Join(texts, "\n"),
+			Start:   current[0].start,
+			End:     current[len(current)-1].end,
+		})
+		current, currentLen = current[:0], 0
+	}
+
+	for _, p := range splitParagraphs(content) {
+		n := utf8.RuneCountInString(p.text)
+		if n > size {
+			flush()
+			chunks = append(chunks, splitLong(p, size, overlap)...)
+			continue
+		}
+		if currentLen > 0 && currentLen+n > size {
+			flush()
+		}
+		current = append(current, p)
+		currentLen += n
+		if currentLen >= minSize && paragraphHash(p.text)%chunkBoundaryMod == 0 {
+			flush()
+		}
+	}
+	flush()
+	return chunks
+}
+
+// splitParagraphs 按行切分并跳过空行，去除行尾空白，记录每行在原文中的字符位置
+func splitParagraphs(content string) []paragraph {
+	var paragraphs []paragraph
+	pos := 0
+	for _, line := range strings.Split(content, "\n") {
+		n := utf8.RuneCountInString(line)
+		if text := strings.TrimRightFunc(line, unicode.IsSpace); strings.TrimSpace(text) != "" {
+			paragraphs = append(paragraphs, paragraph{text: text, start: pos, end: pos + utf8.RuneCountInString(text)})
+		}
+		pos += n + 1
+	}
+	return paragraphs
+}
+
+// splitLong 按固定长度切分超长段落
+func splitLong(p paragraph, size, overlap int) []documentChunk {
+	runes := []rune(p.text)
+	step := size - overlap
+	var chunks []documentChunk
+	for i := 0; i < len(runes); i += step {
+		end := i + size
+		if end > len(runes) {
+			end = len(runes)
+		}
+		chunks = append(chunks, documentChunk{
+			Content: string(runes[i:end]),
+			Start:   p.start + i,
+			End:     p.start + end,
+		})
+		if end == len(runes) {
+			break
+		}
+	}
+	return chunks
+}
+
+func paragraphHash(text string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(text))
+	return h.Sum32()
+}
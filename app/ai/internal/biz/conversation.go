@@ -2,19 +2,31 @@ package biz
 
 import (
 	"context"
+	"fmt"
 	"time"
+	"unicode/utf8"
 
 	"universal/app/ai/internal/data/model"
+	"universal/pkg/identity"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
 // ConversationUsecase 对话业务逻辑
 type ConversationUsecase struct {
-	repo   ConversationRepo
-	logger *log.Helper
+	repo        ConversationRepo
+	workspaces  *WorkspaceUsecase
+	share       *ShareUsecase
+	limiter     *LimiterUsecase
+	router      *ModelRouter
+	credentials *CredentialUsecase
+	logger      *log.Helper
 }
 
+// maxContextMessages 生成时携带的最近历史消息数
+const maxContextMessages = 50
+
 // ConversationRepo 对话仓库接口
 type ConversationRepo interface {
 	// 对话管理
@@ -32,6 +44,8 @@ type ConversationRepo interface {
 	UpdateMessage(ctx context.Context, message *model.Message) (*model.Message, error)
 	DeleteMessage(ctx context.Context, messageIDs []int64, hardDelete bool) error
 	ListMessages(ctx context.Context, conversationID int64, page, pageSize int32, filters MessageFilter) ([]*model.Message, int64, error)
+	// ListRecentMessages 获取早于指定消息的最近有效消息（排除失败与已删除），按时间正序返回，beforeID为0时不限
+	ListRecentMessages(ctx context.Context, conversationID, beforeID int64, limit int) ([]*model.Message, error)
 
 	// 工具调用
 	CreateToolCall(ctx context.Context, toolCall *model.ToolCall) (*model.ToolCall, error)
@@ -42,17 +56,19 @@ type ConversationRepo interface {
 	SetConversationMemory(ctx context.Context, memory *model.ConversationMemory) error
 
 	// 统计信息
-	UpdateConversationStats(ctx context.Context, conversationID int64, inputTokens, outputTokens int64, duration time.Duration) error
+	UpdateConversationStats(ctx context.Context, conversationID int64, inputTokens, outputTokens int64, duration time.Duration, cost float64) error
 	GetConversationStats(ctx context.Context, conversationID int64) (*ConversationStats, error)
 }
 
 // ConversationFilter 对话过滤条件
 type ConversationFilter struct {
-	Keyword  string
-	Status   int32
-	Tags     []string
-	SortBy   string
-	SortDesc bool
+	Keyword     string
+	Status      int32
+	Tags        []string
+	SortBy      string
+	SortDesc    bool
+	OwnedOnly   bool  // 仅返回自己创建的，否则包含共享给自己的
+	WorkspaceID int64 // 当前工作空间，0表示个人空间
 }
 
 // MessageFilter 消息过滤条件
@@ -72,24 +88,44 @@ type ConversationStats struct {
 	TotalInputTokens      int64
 	TotalOutputTokens     int64
 	ToolCallCount         int64
+	TotalCost             float64
 	TotalDuration         time.Duration
 	AverageResponseTime   float64
 	LastMessageAt         *time.Time
 }
 
 // NewConversationUsecase 创建对话业务逻辑实例
-func NewConversationUsecase(repo ConversationRepo, logger log.Logger) *ConversationUsecase {
+func NewConversationUsecase(repo ConversationRepo, workspaces *WorkspaceUsecase, share *ShareUsecase, limiter *LimiterUsecase, router *ModelRouter, credentials *CredentialUsecase, logger log.Logger) *ConversationUsecase {
 	return &ConversationUsecase{
-		repo:   repo,
-		logger: log.NewHelper(logger),
+		repo:        repo,
+		workspaces:  workspaces,
+		share:       share,
+		limiter:     limiter,
+		router:      router,
+		credentials: credentials,
+		logger:      log.NewHelper(logger),
 	}
 }
 
 // CreateConversation 创建对话
 func (uc *ConversationUsecase) CreateConversation(ctx context.Context, userID int64, title, modelName, systemPrompt string, config model.ConversationConfig, description string, tags []string, priority int32) (*model.Conversation, error) {
+	// 在工作空间中创建时归属该空间，并使用空间默认的对话模型
+	ws, err := uc.workspaces.Active(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	var workspaceID int64
+	if ws != nil {
+		workspaceID = ws.ID
+		if modelName == "" {
+			modelName = uc.workspaces.DefaultModelName(ctx, ws.DefaultChatModelID)
+		}
+	}
+
 	now := time.Now()
 	conversation := &model.Conversation{
 		UserID:       userID,
+		WorkspaceID:  workspaceID,
 		Title:        title,
 		ModelName:    modelName,
 		SystemPrompt: systemPrompt,
@@ -108,12 +144,24 @@ func (uc *ConversationUsecase) CreateConversation(ctx context.Context, userID in
 
 // GetConversation 获取对话
 func (uc *ConversationUsecase) GetConversation(ctx context.Context, id int64) (*model.Conversation, error) {
-	return uc.repo.GetConversation(ctx, id)
+	return uc.authorize(ctx, id, model.ShareRoleViewer)
+}
+
+// authorize 获取对话并校验调用方的权限
+func (uc *ConversationUsecase) authorize(ctx context.Context, id int64, minRole int) (*model.Conversation, error) {
+	conversation, err := uc.repo.GetConversation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.share.Authorize(ctx, model.ShareResourceConversation, conversation.ID, conversation.UserID, conversation.WorkspaceID, minRole); err != nil {
+		return nil, err
+	}
+	return conversation, nil
 }
 
 // UpdateConversation 更新对话
 func (uc *ConversationUsecase) UpdateConversation(ctx context.Context, id int64, title, systemPrompt, description string, config model.ConversationConfig, tags []string, priority int32) (*model.Conversation, error) {
-	conversation, err := uc.repo.GetConversation(ctx, id)
+	conversation, err := uc.authorize(ctx, id, model.ShareRoleEditor)
 	if err != nil {
 		return nil, err
 	}
@@ -142,19 +190,33 @@ func (uc *ConversationUsecase) UpdateConversation(ctx context.Context, id int64,
 	return uc.repo.UpdateConversation(ctx, conversation)
 }
 
-// DeleteConversation 删除对话
+// DeleteConversation 删除对话，仅所有者可删除
 func (uc *ConversationUsecase) DeleteConversation(ctx context.Context, id int64, hardDelete bool) error {
+	if _, err := uc.authorize(ctx, id, model.ShareRoleOwner); err != nil {
+		return err
+	}
 	return uc.repo.DeleteConversation(ctx, id, hardDelete)
 }
 
-// ListConversations 获取对话列表
-func (uc *ConversationUsecase) ListConversations(ctx context.Context, userID int64, page, pageSize int32, keyword string, status int32, tags []string, sortBy string, sortDesc bool) ([]*model.Conversation, int64, error) {
+// ListConversations 获取网关验证的调用方可见的对话列表，userID 非零时须为调用方本人
+func (uc *ConversationUsecase) ListConversations(ctx context.Context, userID int64, page, pageSize int32, keyword string, status int32, tags []string, sortBy string, sortDesc bool, ownedOnly bool) ([]*model.Conversation, int64, error) {
+	userID, err := verifiedCaller(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
 	filter := ConversationFilter{
-		Keyword:  keyword,
-		Status:   status,
-		Tags:     tags,
-		SortBy:   sortBy,
-		SortDesc: sortDesc,
+		Keyword:   keyword,
+		Status:    status,
+		Tags:      tags,
+		SortBy:    sortBy,
+		SortDesc:  sortDesc,
+		OwnedOnly: ownedOnly,
+	}
+
+	filter.WorkspaceID, err = uc.workspaces.ActiveID(ctx, userID)
+	if err != nil {
+		return nil, 0, err
 	}
 
 	return uc.repo.ListConversations(ctx, userID, page, pageSize, filter)
@@ -162,22 +224,56 @@ func (uc *ConversationUsecase) ListConversations(ctx context.Context, userID int
 
 // ArchiveConversation 归档对话
 func (uc *ConversationUsecase) ArchiveConversation(ctx context.Context, id int64) error {
+	if _, err := uc.authorize(ctx, id, model.ShareRoleEditor); err != nil {
+		return err
+	}
 	return uc.repo.ArchiveConversation(ctx, id)
 }
 
 // RestoreConversation 恢复对话
 func (uc *ConversationUsecase) RestoreConversation(ctx context.Context, id int64) error {
+	if _, err := uc.authorize(ctx, id, model.ShareRoleEditor); err != nil {
+		return err
+	}
 	return uc.repo.RestoreConversation(ctx, id)
 }
 
-// SendMessage 发送消息
+// SendMessage 发送消息，经模型路由生成回复，生成失败时用户消息标记为失败
 func (uc *ConversationUsecase) SendMessage(ctx context.Context, conversationID int64, content string, attachments []MessageAttachmentInfo, enableTools bool, allowedTools []string, options map[string]string, parentMessageID *int64) (*model.Message, *model.Message, error) {
+	conversation, err := uc.authorize(ctx, conversationID, model.ShareRoleEditor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	messages, err := uc.buildContext(ctx, conversation, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	messages = append(messages, ChatMessage{Role: "user", Content: content})
+	requirements := RouteRequirements{
+		Tools:         enableTools,
+		ContextTokens: estimateTokens(messages),
+	}
+	for _, att := range attachments {
+		if att.Type == 1 { // image
+			requirements.Vision = true
+		}
+	}
+
+	// 调用模型前预留配额与限流额度，被拒绝时不创建消息
+	reservation, err := uc.reserve(ctx, conversation, &requirements)
+	if err != nil {
+		return nil, nil, err
+	}
+	outcome := &UsageOutcome{}
+	defer uc.settle(ctx, reservation, outcome)
+
 	// 创建用户消息
 	userMessage := &model.Message{
 		ConversationID: conversationID,
 		Role:           "user",
 		Content:        content,
-		Status:         1, // pending
+		Status:         2, // processing
 		CreatedAt:      time.Now(),
 		UpdatedAt:      time.Now(),
 	}
@@ -186,41 +282,38 @@ func (uc *ConversationUsecase) SendMessage(ctx context.Context, conversationID i
 		userMessage.ParentMessageID = parentMessageID
 	}
 
-	userMessage, err := uc.repo.CreateMessage(ctx, userMessage)
+	userMessage, err = uc.repo.CreateMessage(ctx, userMessage)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	// TODO: 实现AI回复逻辑
-	// 这里应该调用AI服务生成回复
-	assistantMessage := &model.Message{
-		ConversationID: conversationID,
-		Role:           "assistant",
-		Content:        "这是一个模拟回复", // 临时内容
-		Status:         3,          // completed
-		CreatedAt:      time.Now(),
-		UpdatedAt:      time.Now(),
-		ResponseTime:   1.5, // 模拟响应时间
-		InputTokens:    100,
-		OutputTokens:   50,
+	assistantMessage, err := uc.generate(ctx, conversation, messages, requirements, outcome)
+	userMessage.Status = 3 // completed
+	if err != nil {
+		userMessage.Status = 4 // failed
+	}
+	userMessage.UpdatedAt = time.Now()
+	if _, updateErr := uc.repo.UpdateMessage(ctx, userMessage); updateErr != nil {
+		uc.logger.Warnw("failed to update user message status", "message_id", userMessage.ID, "error", updateErr)
 	}
-
-	assistantMessage, err = uc.repo.CreateMessage(ctx, assistantMessage)
 	if err != nil {
 		return userMessage, nil, err
 	}
 
-	// 更新对话统计信息
-	err = uc.repo.UpdateConversationStats(ctx, conversationID, int64(assistantMessage.InputTokens), int64(assistantMessage.OutputTokens), time.Duration(assistantMessage.ResponseTime*float64(time.Second)))
+	assistantMessage.ParentMessageID = &userMessage.ID
+	assistantMessage, err = uc.saveReply(ctx, assistantMessage)
 	if err != nil {
-		uc.logger.Warnw("failed to update conversation stats", "error", err)
+		return userMessage, nil, err
 	}
-
 	return userMessage, assistantMessage, nil
 }
 
 // GetMessages 获取消息列表
 func (uc *ConversationUsecase) GetMessages(ctx context.Context, conversationID int64, page, pageSize int32, includeToolCalls, includeAttachments, includeMetrics bool, roleFilter, statusFilter string) ([]*model.Message, int64, error) {
+	if _, err := uc.authorize(ctx, conversationID, model.ShareRoleViewer); err != nil {
+		return nil, 0, err
+	}
+
 	filter := MessageFilter{
 		Role:               roleFilter,
 		IncludeToolCalls:   includeToolCalls,
@@ -247,41 +340,67 @@ func (uc *ConversationUsecase) GetMessages(ctx context.Context, conversationID i
 
 // DeleteMessage 删除消息
 func (uc *ConversationUsecase) DeleteMessage(ctx context.Context, messageIDs []int64, hardDelete bool) error {
+	for _, id := range messageIDs {
+		message, err := uc.repo.GetMessage(ctx, id)
+		if err != nil {
+			return err
+		}
+		if _, err := uc.authorize(ctx, message.ConversationID, model.ShareRoleEditor); err != nil {
+			return err
+		}
+	}
 	return uc.repo.DeleteMessage(ctx, messageIDs, hardDelete)
 }
 
-// RegenerateMessage 重新生成消息
+// RegenerateMessage 以原回复之前的上下文重新生成助手回复，原回复保留
 func (uc *ConversationUsecase) RegenerateMessage(ctx context.Context, messageID int64, options map[string]string) (*model.Message, error) {
 	originalMessage, err := uc.repo.GetMessage(ctx, messageID)
 	if err != nil {
 		return nil, err
 	}
+	if originalMessage.Role != "assistant" {
+		return nil, fmt.Errorf("only assistant messages can be regenerated")
+	}
+	conversation, err := uc.authorize(ctx, originalMessage.ConversationID, model.ShareRoleEditor)
+	if err != nil {
+		return nil, err
+	}
 
-	// TODO: 实现重新生成逻辑
-	// 这里应该调用AI服务重新生成回复
-	newMessage := &model.Message{
-		ConversationID:  originalMessage.ConversationID,
-		Role:            originalMessage.Role,
-		Content:         "这是重新生成的回复", // 临时内容
-		Status:          3,           // completed
-		ParentMessageID: originalMessage.ParentMessageID,
-		CreatedAt:       time.Now(),
-		UpdatedAt:       time.Now(),
-		ResponseTime:    1.2,
-		InputTokens:     120,
-		OutputTokens:    60,
+	messages, err := uc.buildContext(ctx, conversation, originalMessage.ID)
+	if err != nil {
+		return nil, err
+	}
+	requirements := RouteRequirements{ContextTokens: estimateTokens(messages)}
+
+	reservation, err := uc.reserve(ctx, conversation, &requirements)
+	if err != nil {
+		return nil, err
 	}
+	outcome := &UsageOutcome{}
+	defer uc.settle(ctx, reservation, outcome)
 
-	return uc.repo.CreateMessage(ctx, newMessage)
+	newMessage, err := uc.generate(ctx, conversation, messages, requirements, outcome)
+	if err != nil {
+		return nil, err
+	}
+	newMessage.ParentMessageID = originalMessage.ParentMessageID
+	return uc.saveReply(ctx, newMessage)
 }
 
 // GetConversationMemory 获取对话记忆
 func (uc *ConversationUsecase) GetConversationMemory(ctx context.Context, conversationID int64) (*model.ConversationMemory, error) {
+	if _, err := uc.authorize(ctx, conversationID, model.ShareRoleViewer); err != nil {
+		return nil, err
+	}
 	return uc.repo.GetConversationMemory(ctx, conversationID)
 }
 
 // SetConversationMemory 设置对话记忆
 func (uc *ConversationUsecase) SetConversationMemory(ctx context.Context, conversationID int64, summary string, keyPoints []string, userPreferences map[string]string, importantFacts []string) error {
+	if _, err := uc.authorize(ctx, conversationID, model.ShareRoleEditor); err != nil {
+		return err
+	}
+
 	memory := &model.ConversationMemory{
 		ConversationID:  conversationID,
 		Summary:         summary,
@@ -298,11 +417,18 @@ func (uc *ConversationUsecase) SetConversationMemory(ctx context.Context, conver
 
 // GetConversationStats 获取对话统计信息
 func (uc *ConversationUsecase) GetConversationStats(ctx context.Context, conversationID int64) (*ConversationStats, error) {
+	if _, err := uc.authorize(ctx, conversationID, model.ShareRoleViewer); err != nil {
+		return nil, err
+	}
 	return uc.repo.GetConversationStats(ctx, conversationID)
 }
 
 // ClearConversationHistory 清空对话历史
 func (uc *ConversationUsecase) ClearConversationHistory(ctx context.Context, conversationID int64, keepSystemMessages bool) error {
+	if _, err := uc.authorize(ctx, conversationID, model.ShareRoleEditor); err != nil {
+		return err
+	}
+
 	// TODO: 实现清空历史逻辑
 	// 需要删除所有非系统消息（如果keepSystemMessages为true）
 	return nil
@@ -310,6 +436,10 @@ func (uc *ConversationUsecase) ClearConversationHistory(ctx context.Context, con
 
 // SummarizeConversation 总结对话
 func (uc *ConversationUsecase) SummarizeConversation(ctx context.Context, conversationID int64, maxMessages int32, summaryStyle string) (string, []string, error) {
+	if _, err := uc.authorize(ctx, conversationID, model.ShareRoleViewer); err != nil {
+		return "", nil, err
+	}
+
 	// TODO: 实现对话总结逻辑
 	// 这里应该调用AI服务生成对话总结
 	summary := "这是对话的总结"
@@ -318,6 +448,146 @@ func (uc *ConversationUsecase) SummarizeConversation(ctx context.Context, conver
 	return summary, keyPoints, nil
 }
 
+// buildContext 组装系统提示词与最近的历史消息，beforeID非0时只取该消息之前的历史
+func (uc *ConversationUsecase) buildContext(ctx context.Context, conversation *model.Conversation, beforeID int64) ([]ChatMessage, error) {
+	history, err := uc.repo.ListRecentMessages(ctx, conversation.ID, beforeID, maxContextMessages)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list history: %w", err)
+	}
+
+	messages := make([]ChatMessage, 0, len(history)+2)
+	if conversation.SystemPrompt != "" {
+		messages = append(messages, ChatMessage{Role: "system", Content: conversation.SystemPrompt})
+	}
+	for _, m := range history {
+		messages = append(messages, ChatMessage{Role: m.Role, Content: m.Content})
+	}
+	return messages, nil
+}
+
+// reserve 按路由首选模型为网关验证的调用方预留配额与限流额度，发生回退时在结算时转为计入实际使用的模型；
+// 首选模型有自有凭证时不占用平台配额，本次生成也只使用自有凭证
+func (uc *ConversationUsecase) reserve(ctx context.Context, conversation *model.Conversation, requirements *RouteRequirements) (*Reservation, error) {
+	primary, err := uc.router.Primary(ctx, conversation.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	requirements.Scope, err = uc.callerScope(ctx, conversation)
+	if err != nil {
+		return nil, err
+	}
+	ownKey, err := uc.credentials.HasOwnKey(ctx, primary, requirements.Scope)
+	if err != nil {
+		return nil, err
+	}
+	requirements.OwnKeyOnly = ownKey
+
+	limitReq := &LimitRequest{
+		UserID:      requirements.Scope.UserID,
+		WorkspaceID: requirements.Scope.WorkspaceID,
+		ModelName:   primary,
+		UserLevel:   UserLevelFromContext(ctx),
+		InputTokens: requirements.ContextTokens,
+		SkipQuota:   ownKey,
+	}
+	if conversation.Config.MaxTokens != nil {
+		limitReq.MaxOutputTokens = int64(*conversation.Config.MaxTokens)
+	}
+	return uc.limiter.Reserve(ctx, limitReq)
+}
+
+// callerScope 确定计费与凭证查找的范围：始终是调用方本人而非对话所有者，
+// 调用方是对话所属工作空间的成员时使用该工作空间的共享配额与凭证，否则使用个人配额与凭证
+func (uc *ConversationUsecase) callerScope(ctx context.Context, conversation *model.Conversation) (CredentialScope, error) {
+	scope := CredentialScope{UserID: identity.FromContext(ctx)}
+	if scope.UserID <= 0 {
+		return CredentialScope{}, ErrResourceForbidden
+	}
+	if conversation.WorkspaceID > 0 {
+		_, err := uc.workspaces.Membership(ctx, conversation.WorkspaceID, scope.UserID)
+		switch {
+		case err == nil:
+			scope.WorkspaceID = conversation.WorkspaceID
+		case !errors.Is(err, ErrWorkspaceForbidden):
+			return CredentialScope{}, err
+		}
+	}
+	return scope, nil
+}
+
+// settle 结算预留额度，请求被取消时仍需完成，否则预扣额度无法归还
+func (uc *ConversationUsecase) settle(ctx context.Context, reservation *Reservation, outcome *UsageOutcome) {
+	if err := uc.limiter.Settle(context.WithoutCancel(ctx), reservation, outcome); err != nil {
+		uc.logger.Warnw("failed to settle usage", "user_id", reservation.UserID, "error", err)
+	}
+}
+
+// generate 经模型路由生成助手回复并计费，回复未持久化
+func (uc *ConversationUsecase) generate(ctx context.Context, conversation *model.Conversation, messages []ChatMessage, requirements RouteRequirements, outcome *UsageOutcome) (*model.Message, error) {
+	params := GenerationParams{
+		Temperature: conversation.Config.Temperature,
+		TopP:        conversation.Config.TopP,
+		Stop:        conversation.Config.StopSequences,
+	}
+	if conversation.Config.MaxTokens != nil {
+		params.MaxTokens = *conversation.Config.MaxTokens
+	}
+
+	outcome.Called = true
+	gen, err := uc.router.Generate(ctx, conversation.ModelName, requirements, messages, params)
+	if err != nil {
+		outcome.Failed = true
+		return nil, err
+	}
+	usage := gen.Result.Usage
+	cost := PriceTokens(gen.Model.Pricing, usage).Total
+	outcome.ModelID = gen.Model.ID
+	outcome.InputTokens = usage.InputTokens
+	outcome.OutputTokens = usage.OutputTokens
+	outcome.Cost = cost
+	outcome.ResponseTime = gen.Latency
+	outcome.OwnCredential = gen.CredentialSource != CredentialSourceProvider
+
+	return &model.Message{
+		ConversationID:    conversation.ID,
+		Role:              "assistant",
+		Content:           gen.Result.Content,
+		Status:            3, // completed
+		CreatedAt:         time.Now(),
+		UpdatedAt:         time.Now(),
+		ResponseTime:      gen.Latency.Seconds(),
+		InputTokens:       int(usage.InputTokens),
+		CachedInputTokens: int(usage.CachedInputTokens),
+		OutputTokens:      int(usage.OutputTokens),
+		Cost:              cost,
+		ModelUsed:         gen.Model.Name, // 发生回退时与对话模型不同
+		CredentialSource:  gen.CredentialSource,
+	}, nil
+}
+
+// saveReply 保存助手回复并更新对话统计
+func (uc *ConversationUsecase) saveReply(ctx context.Context, message *model.Message) (*model.Message, error) {
+	message, err := uc.repo.CreateMessage(ctx, message)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.repo.UpdateConversationStats(ctx, message.ConversationID, int64(message.InputTokens), int64(message.OutputTokens), time.Duration(message.ResponseTime*float64(time.Second)), message.Cost)
+	if err != nil {
+		uc.logger.Warnw("failed to update conversation stats", "error", err)
+	}
+	return message, nil
+}
+
+// estimateTokens 按字符数保守估计上下文token数
+func estimateTokens(messages []ChatMessage) int64 {
+	var n int64
+	for _, m := range messages {
+		n += int64(utf8.RuneCountInString(m.Content))
+	}
+	return n
+}
+
 // MessageAttachmentInfo 消息附件信息
 type MessageAttachmentInfo struct {
 	Name     string
@@ -0,0 +1,183 @@
+package biz
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 生成调用使用的凭证来源
+const (
+	CredentialSourceProvider  = "provider"  // 提供商默认密钥，计入平台配额
+	CredentialSourceUser      = "user"      // 用户自有密钥
+	CredentialSourceWorkspace = "workspace" // 工作空间自有密钥
+)
+
+// credentialValidateTimeout 保存凭证前向提供商验证的超时时间
+const credentialValidateTimeout = 15 * time.Second
+
+var (
+	// ErrCredentialForbidden 非工作空间管理员不能管理工作空间凭证
+	ErrCredentialForbidden = errors.Forbidden("CREDENTIAL_FORBIDDEN", "only workspace admins can manage workspace credentials")
+)
+
+// ProviderCredential 用户或工作空间自有的提供商凭证，UserID 与 WorkspaceID 有且只有一个非0
+type ProviderCredential struct {
+	ID          int64     `json:"id"`
+	ProviderID  int64     `json:"provider_id"`
+	UserID      int64     `json:"user_id"`
+	WorkspaceID int64     `json:"workspace_id"`
+	Name        string    `json:"name"`
+	APIKey      string    `json:"api_key"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CredentialScope 解析自有凭证的用户与工作空间
+type CredentialScope struct {
+	UserID      int64
+	WorkspaceID int64
+}
+
+// CredentialRepo 自有凭证数据访问接口，密钥加密存储
+type CredentialRepo interface {
+	SaveCredential(ctx context.Context, credential *ProviderCredential) (*ProviderCredential, error)
+	DeleteCredential(ctx context.Context, providerID, userID, workspaceID int64) error
+	ListCredentials(ctx context.Context, userID, workspaceID int64) ([]*ProviderCredential, error)
+	// FindCredentials 获取提供商下用户个人凭证与工作空间凭证，workspaceID为0时只查个人凭证
+	FindCredentials(ctx context.Context, providerID, userID, workspaceID int64) ([]*ProviderCredential, error)
+}
+
+// CredentialUsecase 自有凭证管理与解析
+type CredentialUsecase struct {
+	repo         CredentialRepo
+	modelRepo    ModelRepo
+	providerRepo ProviderRepo
+	workspaces   *WorkspaceUsecase
+	client       CompletionClient
+	log          *log.Helper
+}
+
+// NewCredentialUsecase 创建自有凭证业务用例
+func NewCredentialUsecase(repo CredentialRepo, modelRepo ModelRepo, providerRepo ProviderRepo, workspaces *WorkspaceUsecase, client CompletionClient, logger log.Logger) *CredentialUsecase {
+	return &CredentialUsecase{
+		repo:         repo,
+		modelRepo:    modelRepo,
+		providerRepo: providerRepo,
+		workspaces:   workspaces,
+		client:       client,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// SetCredential 验证并保存自有凭证，workspaceID非0时保存为工作空间凭证
+func (uc *CredentialUsecase) SetCredential(ctx context.Context, operatorID, workspaceID, providerID int64, apiKey, name string) (*ProviderCredential, error) {
+	if apiKey == "" {
+		return nil, errors.BadRequest("INVALID_CREDENTIAL", "api key is required")
+	}
+	owner, err := uc.owner(ctx, operatorID, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	provider, err := uc.providerRepo.GetProvider(ctx, providerID)
+	if err != nil {
+		return nil, fmt.Errorf("provider not found: %w", err)
+	}
+
+	// 保存前验证密钥，避免生成时才发现不可用
+	validateCtx, cancel := context.WithTimeout(ctx, credentialValidateTimeout)
+	defer cancel()
+	if _, err := uc.client.ListModels(validateCtx, provider, apiKey); err != nil {
+		var pe *ProviderError
+		if stderrors.As(err, &pe) && (pe.StatusCode == http.StatusUnauthorized || pe.StatusCode == http.StatusForbidden) {
+			return nil, errors.BadRequest("INVALID_CREDENTIAL", pe.Message)
+		}
+		return nil, errors.ServiceUnavailable("CREDENTIAL_VALIDATION_FAILED", err.Error())
+	}
+
+	owner.ProviderID = providerID
+	owner.APIKey = apiKey
+	owner.Name = name
+	return uc.repo.SaveCredential(ctx, owner)
+}
+
+// DeleteCredential 删除自有凭证
+func (uc *CredentialUsecase) DeleteCredential(ctx context.Context, operatorID, workspaceID, providerID int64) error {
+	owner, err := uc.owner(ctx, operatorID, workspaceID)
+	if err != nil {
+		return err
+	}
+	return uc.repo.DeleteCredential(ctx, providerID, owner.UserID, owner.WorkspaceID)
+}
+
+// ListCredentials 获取个人凭证或工作空间凭证，工作空间凭证对全部成员可见
+func (uc *CredentialUsecase) ListCredentials(ctx context.Context, userID, workspaceID int64) ([]*ProviderCredential, error) {
+	if workspaceID > 0 {
+		if _, err := uc.workspaces.Membership(ctx, workspaceID, userID); err != nil {
+			return nil, err
+		}
+		return uc.repo.ListCredentials(ctx, 0, workspaceID)
+	}
+	return uc.repo.ListCredentials(ctx, userID, 0)
+}
+
+// Resolve 按 用户密钥 → 工作空间密钥 → 提供商默认密钥 的顺序解析本次调用使用的密钥
+func (uc *CredentialUsecase) Resolve(ctx context.Context, provider *Provider, scope CredentialScope) (string, string, error) {
+	if scope.UserID > 0 {
+		credentials, err := uc.repo.FindCredentials(ctx, provider.ID, scope.UserID, scope.WorkspaceID)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to find credentials: %w", err)
+		}
+		var workspaceKey string
+		for _, c := range credentials {
+			if c.UserID == scope.UserID {
+				return c.APIKey, CredentialSourceUser, nil
+			}
+			workspaceKey = c.APIKey
+		}
+		if workspaceKey != "" {
+			return workspaceKey, CredentialSourceWorkspace, nil
+		}
+	}
+	return provider.DefaultAPIKey, CredentialSourceProvider, nil
+}
+
+// HasOwnKey 模型所属提供商是否有本次调用可用的自有凭证
+func (uc *CredentialUsecase) HasOwnKey(ctx context.Context, modelName string, scope CredentialScope) (bool, error) {
+	m, err := uc.modelRepo.GetModelByName(ctx, modelName)
+	if err != nil {
+		return false, fmt.Errorf("failed to get model: %w", err)
+	}
+	if m == nil {
+		return false, nil
+	}
+	provider, err := uc.providerRepo.GetProvider(ctx, m.ProviderID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get provider: %w", err)
+	}
+	_, source, err := uc.Resolve(ctx, provider, scope)
+	if err != nil {
+		return false, err
+	}
+	return source != CredentialSourceProvider, nil
+}
+
+// owner 校验操作权限并返回凭证归属，工作空间凭证只能由管理员管理
+func (uc *CredentialUsecase) owner(ctx context.Context, operatorID, workspaceID int64) (*ProviderCredential, error) {
+	if workspaceID <= 0 {
+		return &ProviderCredential{UserID: operatorID}, nil
+	}
+	ws, err := uc.workspaces.Membership(ctx, workspaceID, operatorID)
+	if err != nil {
+		return nil, err
+	}
+	if ws.Role < WorkspaceRoleAdmin {
+		return nil, ErrCredentialForbidden
+	}
+	return &ProviderCredential{WorkspaceID: workspaceID}, nil
+}
@@ -0,0 +1,250 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	pb "universal/api/ai/v1"
+	"universal/app/ai/internal/data/model"
+	"universal/pkg/fingerprint"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+const (
+	// nearDuplicateJaccard MinHash 估计的 Jaccard 相似度不低于该值视为近似重复
+	nearDuplicateJaccard = 0.8
+	// nearDuplicateHamming SimHash 汉明距离不超过该值视为近似重复
+	nearDuplicateHamming = 3
+	// similarityMaxBucket LSH 桶内超过该数量时只与桶内第一个知识块比较，避免大量相同内容时两两比较
+	similarityMaxBucket = 200
+	// similarityMaxPairs / similarityMaxInsights 结果中输出的近似重复对与洞察条数上限
+	similarityMaxPairs    = 1000
+	similarityMaxInsights = 20
+)
+
+// NearDuplicatePair 近似重复的知识块对
+type NearDuplicatePair struct {
+	ChunkID         int64   `json:"chunk_id"`
+	DocumentID      int64   `json:"document_id"`
+	OtherChunkID    int64   `json:"other_chunk_id"`
+	OtherDocumentID int64   `json:"other_document_id"`
+	Jaccard         float64 `json:"jaccard"`
+	Hamming         int     `json:"hamming"`
+}
+
+// SimilarityReport 知识库相似性分析结果
+type SimilarityReport struct {
+	Documents int
+	Chunks    int
+	// DuplicateDocuments 内容哈希相同的文档分组，每组按ID升序
+	DuplicateDocuments [][]*model.Document
+	// NearDuplicates 近似重复的知识块对，按相似度降序，最多 similarityMaxPairs 对
+	NearDuplicates []NearDuplicatePair
+	// NearDuplicatePairs 近似重复对总数
+	NearDuplicatePairs int
+	// RedundantChunks 近似重复的知识块按连通关系聚类后，每类保留一个时可清理的数量
+	RedundantChunks int
+}
+
+// AnalyzeKnowledgeBase 分析知识库，未指定类型时执行全部已支持的分析
+func (uc *KnowledgeUsecase) AnalyzeKnowledgeBase(ctx context.Context, kbID int64, types []pb.AnalysisType) ([]*pb.AnalysisResult, error) {
+	if _, err := uc.authorize(ctx, kbID, model.ShareRoleViewer); err != nil {
+		return nil, err
+	}
+	if len(types) == 0 {
+		types = []pb.AnalysisType{pb.AnalysisType_ANALYSIS_TYPE_SIMILARITY_ANALYSIS}
+	}
+
+	var results []*pb.AnalysisResult
+	for _, t := range types {
+		switch t {
+		case pb.AnalysisType_ANALYSIS_TYPE_SIMILARITY_ANALYSIS:
+			report, err := uc.AnalyzeSimilarity(ctx, kbID)
+			if err != nil {
+				return nil, err
+			}
+			result, err := report.toProto()
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, result)
+		default:
+			return nil, errors.BadRequest("ANALYSIS_TYPE_UNSUPPORTED", fmt.Sprintf("analysis type %s is not supported", t))
+		}
+	}
+	return results, nil
+}
+
+// AnalyzeSimilarity 检测内容完全相同的文档与近似重复的知识块。
+// 知识块按 MinHash 签名做 LSH 分桶，只比较落入同一桶的候选对，
+// 估计的 Jaccard 相似度或 SimHash 汉明距离满足阈值时视为近似重复。
+func (uc *KnowledgeUsecase) AnalyzeSimilarity(ctx context.Context, kbID int64) (*SimilarityReport, error) {
+	docs, err := uc.repo.ListDocumentHashes(ctx, kbID)
+	if err != nil {
+		return nil, err
+	}
+	chunks, err := uc.repo.ListChunkFingerprints(ctx, kbID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &SimilarityReport{Documents: len(docs), Chunks: len(chunks)}
+
+	byHash := make(map[string][]*model.Document)
+	var hashes []string
+	for _, doc := range docs {
+		if doc.Hash == "" {
+			continue
+		}
+		if _, ok := byHash[doc.Hash]; !ok {
+			hashes = append(hashes, doc.Hash)
+		}
+		byHash[doc.Hash] = append(byHash[doc.Hash], doc)
+	}
+	for _, hash := range hashes {
+		if group := byHash[hash]; len(group) > 1 {
+			report.DuplicateDocuments = append(report.DuplicateDocuments, group)
+		}
+	}
+
+	buckets := make(map[uint64][]int)
+	for i, chunk := range chunks {
+		for _, key := range fingerprint.Signature(chunk.MinHash).BandKeys() {
+			buckets[key] = append(buckets[key], i)
+		}
+	}
+
+	parent := make([]int, len(chunks))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	compared := make(map[[2]int]struct{})
+	compare := func(i, j int) {
+		if i > j {
+			i, j = j, i
+		}
+		if _, ok := compared[[2]int{i, j}]; ok {
+			return
+		}
+		compared[[2]int{i, j}] = struct{}{}
+
+		a, b := chunks[i], chunks[j]
+		jaccard := fingerprint.Signature(a.MinHash).Jaccard(fingerprint.Signature(b.MinHash))
+		hamming := fingerprint.Hamming(uint64(a.SimHash), uint64(b.SimHash))
+		if jaccard < nearDuplicateJaccard && hamming > nearDuplicateHamming {
+			return
+		}
+		report.NearDuplicatePairs++
+		report.NearDuplicates = append(report.NearDuplicates, NearDuplicatePair{
+			ChunkID:         a.ID,
+			DocumentID:      a.DocumentID,
+			OtherChunkID:    b.ID,
+			OtherDocumentID: b.DocumentID,
+			Jaccard:         jaccard,
+			Hamming:         hamming,
+		})
+		if ri, rj := find(i), find(j); ri != rj {
+			parent[rj] = ri
+			report.RedundantChunks++
+		}
+	}
+	for _, members := range buckets {
+		if len(members) < 2 {
+			continue
+		}
+		if len(members) > similarityMaxBucket {
+			for _, j := range members[1:] {
+				compare(members[0], j)
+			}
+			continue
+		}
+		for x := 0; x < len(members); x++ {
+			for y := x + 1; y < len(members); y++ {
+				compare(members[x], members[y])
+			}
+		}
+	}
+
+	sort.Slice(report.NearDuplicates, func(i, j int) bool {
+		a, b := report.NearDuplicates[i], report.NearDuplicates[j]
+		if a.Jaccard != b.Jaccard {
+			return a.Jaccard > b.Jaccard
+		}
+		return a.ChunkID < b.ChunkID
+	})
+	if len(report.NearDuplicates) > similarityMaxPairs {
+		report.NearDuplicates = report.NearDuplicates[:similarityMaxPairs]
+	}
+
+	uc.logger.WithContext(ctx).Infof("knowledge base %d similarity analyzed: %d duplicate document groups, %d near-duplicate chunk pairs",
+		kbID, len(report.DuplicateDocuments), report.NearDuplicatePairs)
+
+	return report, nil
+}
+
+func (r *SimilarityReport) toProto() (*pb.AnalysisResult, error) {
+	duplicateDocuments := 0
+	groups := make([][]int64, 0, len(r.DuplicateDocuments))
+	for _, group := range r.DuplicateDocuments {
+		duplicateDocuments += len(group) - 1
+		ids := make([]int64, len(group))
+		for i, doc := range group {
+			ids[i] = doc.ID
+		}
+		groups = append(groups, ids)
+	}
+	redundancy := 0.0
+	if r.Chunks > 0 {
+		redundancy = float64(r.RedundantChunks) / float64(r.Chunks)
+	}
+
+	var insights []string
+	for _, group := range r.DuplicateDocuments {
+		if len(insights) >= similarityMaxInsights {
+			break
+		}
+		insights = append(insights, fmt.Sprintf("文档 %s(%d) 与其他 %d 个文档内容完全相同", group[0].Name, group[0].ID, len(group)-1))
+	}
+	for _, pair := range r.NearDuplicates {
+		if len(insights) >= similarityMaxInsights {
+			break
+		}
+		insights = append(insights, fmt.Sprintf("知识块 %d(文档 %d) 与知识块 %d(文档 %d) 近似重复，相似度 %.2f",
+			pair.ChunkID, pair.DocumentID, pair.OtherChunkID, pair.OtherDocumentID, pair.Jaccard))
+	}
+
+	visualization, err := json.Marshal(map[string]interface{}{
+		"duplicate_documents": groups,
+		"near_duplicates":     r.NearDuplicates,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AnalysisResult{
+		Type:        pb.AnalysisType_ANALYSIS_TYPE_SIMILARITY_ANALYSIS,
+		Title:       "相似性分析",
+		Description: "检测内容完全相同的文档与近似重复的知识块，可据此清理冗余内容",
+		Metrics: map[string]float64{
+			"documents":            float64(r.Documents),
+			"chunks":               float64(r.Chunks),
+			"duplicate_documents":  float64(duplicateDocuments),
+			"near_duplicate_pairs": float64(r.NearDuplicatePairs),
+			"redundant_chunks":     float64(r.RedundantChunks),
+			"redundancy_ratio":     redundancy,
+		},
+		Insights:          insights,
+		VisualizationData: visualization,
+	}, nil
+}
@@ -0,0 +1,124 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// defaultEmbeddingBatchSize 单次请求提供商的默认文本数
+const defaultEmbeddingBatchSize = 64
+
+// ErrEmbeddingModelNotConfigured 未指定向量模型且未配置默认向量模型
+var ErrEmbeddingModelNotConfigured = errors.ServiceUnavailable("EMBEDDING_MODEL_NOT_CONFIGURED", "no embedding model configured")
+
+// EmbeddingOptions 向量化配置
+type EmbeddingOptions struct {
+	Model     string // 默认向量模型
+	Local     bool   // 使用本地哈希向量化，仅用于开发与离线环境
+	BatchSize int
+}
+
+// EmbeddingRequest 单次提供商向量化请求
+type EmbeddingRequest struct {
+	Provider *Provider
+	Model    *Model
+	APIKey   string
+	Input    []string
+}
+
+// EmbeddingResult 单次提供商向量化结果，向量顺序与输入一致
+type EmbeddingResult struct {
+	Vectors [][]float64
+	Usage   TokenUsage
+}
+
+// LocalEmbedder 本地向量化实现，不调用模型提供商
+type LocalEmbedder interface {
+	Embedder
+}
+
+// modelEmbedder 经模型提供商向量化，使用提供商的平台密钥
+type modelEmbedder struct {
+	opts         EmbeddingOptions
+	modelRepo    ModelRepo
+	providerRepo ProviderRepo
+	credentials  *CredentialUsecase
+	client       CompletionClient
+	log          *log.Helper
+}
+
+// NewEmbedder 创建文本向量化实现，知识库索引与话题分析共用；配置 local 时使用本地哈希向量化
+func NewEmbedder(opts *EmbeddingOptions, local LocalEmbedder, modelRepo ModelRepo, providerRepo ProviderRepo, credentials *CredentialUsecase, client CompletionClient, logger log.Logger) Embedder {
+	helper := log.NewHelper(logger)
+	o := EmbeddingOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.Local {
+		helper.Warn("using local hash embeddings, semantic search quality is limited; do not use in production")
+		return local
+	}
+	if o.BatchSize <= 0 {
+		o.BatchSize = defaultEmbeddingBatchSize
+	}
+	return &modelEmbedder{
+		opts:         o,
+		modelRepo:    modelRepo,
+		providerRepo: providerRepo,
+		credentials:  credentials,
+		client:       client,
+		log:          helper,
+	}
+}
+
+func (e *modelEmbedder) Embed(ctx context.Context, modelName string, texts []string) ([][]float64, error) {
+	if modelName == "" {
+		modelName = e.opts.Model
+	}
+	if modelName == "" {
+		return nil, ErrEmbeddingModelNotConfigured
+	}
+	m, err := e.modelRepo.GetModelByName(ctx, modelName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get embedding model: %w", err)
+	}
+	if m == nil {
+		return nil, fmt.Errorf("embedding model not found: %s", modelName)
+	}
+	provider, err := e.providerRepo.GetProvider(ctx, m.ProviderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get provider of %s: %w", modelName, err)
+	}
+	if provider == nil {
+		return nil, fmt.Errorf("provider of embedding model %s not found", modelName)
+	}
+	apiKey, _, err := e.credentials.Resolve(ctx, provider, CredentialScope{})
+	if err != nil {
+		return nil, err
+	}
+
+	vectors := make([][]float64, 0, len(texts))
+	for start := 0; start < len(texts); start += e.opts.BatchSize {
+		end := start + e.opts.BatchSize
+		if end > len(texts) {
+			end = len(texts)
+		}
+		result, err := e.client.Embed(ctx, &EmbeddingRequest{
+			Provider: provider,
+			Model:    m,
+			APIKey:   apiKey,
+			Input:    texts[start:end],
+		})
+		if err != nil {
+			return nil, fmt.Errorf("embed with %s: %w", modelName, err)
+		}
+		if len(result.Vectors) != end-start {
+			return nil, fmt.Errorf("embed with %s: got %d vectors for %d texts", modelName, len(result.Vectors), end-start)
+		}
+		vectors = append(vectors, result.Vectors...)
+	}
+	return vectors, nil
+}
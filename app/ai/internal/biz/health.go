@@ -0,0 +1,403 @@
+package biz
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// JobHealthProbe 模型健康探测任务
+const JobHealthProbe = "health-probe"
+
+// 健康样本来源
+const (
+	HealthSourceProbe   = "probe"   // 主动探测
+	HealthSourceTraffic = "traffic" // 线上调用
+)
+
+// 探测策略，由 Provider.Config["health_probe"] 指定，默认 models
+const (
+	ProbeStrategyModels     = "models"     // 请求模型列表，不产生费用
+	ProbeStrategyCompletion = "completion" // 以最小输出发起一次真实生成
+)
+
+const (
+	defaultProbeTimeout     = 15 * time.Second
+	defaultProbeConcurrency = 4
+	defaultHealthWindow     = 15 * time.Minute
+	defaultMinSuccessRate   = 0.8
+	defaultHealthRetention  = 7 * 24 * time.Hour
+	// maxHealthSamples 统计窗口内参与计算的最大样本数
+	maxHealthSamples = 5000
+)
+
+// HealthCheckRecord 一次探测或线上调用的健康样本
+type HealthCheckRecord struct {
+	ID           int64
+	ModelID      int64
+	ProviderID   int64
+	Source       string
+	Success      bool
+	Latency      float64 // 毫秒
+	StatusCode   int
+	ErrorMessage string
+	CheckedAt    time.Time
+}
+
+// HealthEvent 模型健康状态变化事件
+type HealthEvent struct {
+	ModelID      int64     `json:"model_id"`
+	ModelName    string    `json:"model_name"`
+	ProviderID   int64     `json:"provider_id"`
+	IsHealthy    bool      `json:"is_healthy"`
+	SuccessRate  float64   `json:"success_rate"`
+	ErrorMessage string    `json:"error_message"`
+	ChangedAt    time.Time `json:"changed_at"`
+}
+
+// HealthEventPublisher 发布健康状态变化事件
+type HealthEventPublisher interface {
+	PublishHealthEvent(ctx context.Context, event *HealthEvent) error
+}
+
+// HealthOptions 健康探测与统计参数
+type HealthOptions struct {
+	ProbeTimeout     time.Duration
+	ProbeConcurrency int
+	Window           time.Duration
+	MinSuccessRate   float64
+	Retention        time.Duration
+}
+
+// HealthUsecase 模型健康探测，汇总探测与线上调用的样本计算成功率与延迟分位数
+type HealthUsecase struct {
+	modelRepo    ModelRepo
+	providerRepo ProviderRepo
+	healthRepo   HealthRepo
+	client       CompletionClient
+	events       HealthEventPublisher
+	opts         HealthOptions
+	log          *log.Helper
+}
+
+// NewHealthUsecase 创建模型健康探测业务用例
+func NewHealthUsecase(modelRepo ModelRepo, providerRepo ProviderRepo, healthRepo HealthRepo, client CompletionClient, events HealthEventPublisher, opts *HealthOptions, logger log.Logger) *HealthUsecase {
+	o := HealthOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.ProbeTimeout <= 0 {
+		o.ProbeTimeout = defaultProbeTimeout
+	}
+	if o.ProbeConcurrency <= 0 {
+		o.ProbeConcurrency = defaultProbeConcurrency
+	}
+	if o.Window <= 0 {
+		o.Window = defaultHealthWindow
+	}
+	if o.MinSuccessRate <= 0 {
+		o.MinSuccessRate = defaultMinSuccessRate
+	}
+	if o.Retention <= 0 {
+		o.Retention = defaultHealthRetention
+	}
+	return &HealthUsecase{
+		modelRepo:    modelRepo,
+		providerRepo: providerRepo,
+		healthRepo:   healthRepo,
+		client:       client,
+		events:       events,
+		opts:         o,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// RunProbes 定时任务入口，探测全部模型并清理过期的历史样本
+func (uc *HealthUsecase) RunProbes(ctx context.Context, now time.Time, _ *time.Location) error {
+	if _, err := uc.ProbeAll(ctx); err != nil {
+		return err
+	}
+	n, err := uc.healthRepo.DeleteHealthChecksBefore(ctx, now.Add(-uc.opts.Retention))
+	if err != nil {
+		return fmt.Errorf("failed to prune health history: %w", err)
+	}
+	if n > 0 {
+		uc.log.WithContext(ctx).Infof("pruned %d health check records", n)
+	}
+	return nil
+}
+
+// ProbeAll 探测已启用提供商下的全部可用模型，同一提供商的模型列表探测只请求一次
+func (uc *HealthUsecase) ProbeAll(ctx context.Context) ([]*ModelHealth, error) {
+	providers := make(map[int64]*Provider)
+	for page := int32(1); ; page++ {
+		batch, total, err := uc.providerRepo.ListProviders(ctx, 0, page, 100) // enabled
+		if err != nil {
+			return nil, fmt.Errorf("failed to list providers: %w", err)
+		}
+		for _, p := range batch {
+			providers[p.ID] = p
+		}
+		if len(batch) == 0 || int64(len(providers)) >= total {
+			break
+		}
+	}
+
+	var models []*Model
+	for page, listed := int32(1), int64(0); ; page++ {
+		batch, total, err := uc.modelRepo.ListModels(ctx, 0, 0, nil, page, 100) // available
+		if err != nil {
+			return nil, fmt.Errorf("failed to list models: %w", err)
+		}
+		listed += int64(len(batch))
+		for _, m := range batch {
+			if providers[m.ProviderID] != nil {
+				models = append(models, m)
+			}
+		}
+		if len(batch) == 0 || listed >= total {
+			break
+		}
+	}
+
+	listings := &providerListings{results: make(map[int64]*providerListing)}
+	results := make([]*ModelHealth, len(models))
+	sem := make(chan struct{}, uc.opts.ProbeConcurrency)
+	var wg sync.WaitGroup
+	for i, m := range models {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, m *Model) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			health, err := uc.probe(ctx, m, providers[m.ProviderID], listings)
+			if err != nil {
+				uc.log.WithContext(ctx).Errorf("failed to probe model %s: %v", m.Name, err)
+				return
+			}
+			results[i] = health
+		}(i, m)
+	}
+	wg.Wait()
+
+	healths := make([]*ModelHealth, 0, len(results))
+	for _, h := range results {
+		if h != nil {
+			healths = append(healths, h)
+		}
+	}
+	return healths, nil
+}
+
+// ProbeModel 立即探测单个模型并刷新其健康状态
+func (uc *HealthUsecase) ProbeModel(ctx context.Context, modelID int64) (*ModelHealth, error) {
+	m, err := uc.modelRepo.GetModel(ctx, modelID)
+	if err != nil {
+		return nil, fmt.Errorf("model not found: %w", err)
+	}
+	provider, err := uc.providerRepo.GetProvider(ctx, m.ProviderID)
+	if err != nil {
+		return nil, fmt.Errorf("provider not found: %w", err)
+	}
+	return uc.probe(ctx, m, provider, &providerListings{results: make(map[int64]*providerListing)})
+}
+
+// TestProvider 通过模型列表接口测试提供商连通性，apiKey 为空时使用提供商默认密钥
+func (uc *HealthUsecase) TestProvider(ctx context.Context, id int64, apiKey string) (bool, float64, string, error) {
+	provider, err := uc.providerRepo.GetProvider(ctx, id)
+	if err != nil {
+		return false, 0, "provider not found", err
+	}
+	if apiKey == "" {
+		apiKey = provider.DefaultAPIKey
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, uc.opts.ProbeTimeout)
+	defer cancel()
+	started := time.Now()
+	_, err = uc.client.ListModels(ctx, provider, apiKey)
+	responseTime := milliseconds(time.Since(started))
+	if err != nil {
+		return false, responseTime, err.Error(), nil
+	}
+	return true, responseTime, "", nil
+}
+
+// probe 按提供商的探测策略探测模型，写入样本后重新计算健康状态
+func (uc *HealthUsecase) probe(ctx context.Context, m *Model, provider *Provider, listings *providerListings) (*ModelHealth, error) {
+	var record *HealthCheckRecord
+	if provider.Config["health_probe"] == ProbeStrategyCompletion {
+		record = uc.probeCompletion(ctx, provider, m)
+	} else {
+		record = listings.get(provider.ID, func() *HealthCheckRecord { return uc.probeModels(ctx, provider) })
+	}
+	record.ModelID = m.ID
+	record.ProviderID = provider.ID
+	record.Source = HealthSourceProbe
+	if err := uc.healthRepo.CreateHealthCheck(ctx, record); err != nil {
+		return nil, fmt.Errorf("failed to save health check: %w", err)
+	}
+	return uc.refresh(ctx, m, record)
+}
+
+func (uc *HealthUsecase) probeModels(ctx context.Context, provider *Provider) *HealthCheckRecord {
+	ctx, cancel := context.WithTimeout(ctx, uc.opts.ProbeTimeout)
+	defer cancel()
+	started := time.Now()
+	_, err := uc.client.ListModels(ctx, provider, provider.DefaultAPIKey)
+	return newHealthRecord(time.Since(started), err)
+}
+
+func (uc *HealthUsecase) probeCompletion(ctx context.Context, provider *Provider, m *Model) *HealthCheckRecord {
+	ctx, cancel := context.WithTimeout(ctx, uc.opts.ProbeTimeout)
+	defer cancel()
+	started := time.Now()
+	_, err := uc.client.Complete(ctx, &CompletionRequest{
+		Provider: provider,
+		Model:    m,
+		APIKey:   provider.DefaultAPIKey,
+		Messages: []ChatMessage{{Role: "user", Content: "ping"}},
+		Params:   GenerationParams{MaxTokens: 1},
+	})
+	return newHealthRecord(time.Since(started), err)
+}
+
+// newHealthRecord 由调用结果生成健康样本
+func newHealthRecord(latency time.Duration, err error) *HealthCheckRecord {
+	record := &HealthCheckRecord{
+		Success:   err == nil,
+		Latency:   milliseconds(latency),
+		CheckedAt: time.Now(),
+	}
+	if err != nil {
+		record.ErrorMessage = err.Error()
+		var pe *ProviderError
+		if stderrors.As(err, &pe) {
+			record.StatusCode = pe.StatusCode
+		}
+	}
+	return record
+}
+
+// refresh 按统计窗口内的样本重新计算健康状态，最近一次探测失败或成功率低于阈值时判定为不健康
+func (uc *HealthUsecase) refresh(ctx context.Context, m *Model, latest *HealthCheckRecord) (*ModelHealth, error) {
+	previous, err := uc.healthRepo.GetModelHealth(ctx, m.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get model health: %w", err)
+	}
+	samples, err := uc.healthRepo.ListHealthChecks(ctx, m.ID, latest.CheckedAt.Add(-uc.opts.Window), maxHealthSamples)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list health checks: %w", err)
+	}
+
+	health := summarizeHealth(m.ID, samples)
+	health.IsHealthy = latest.Success && health.SuccessRate >= uc.opts.MinSuccessRate
+	health.ErrorMessage = latest.ErrorMessage
+	health.LastCheck = latest.CheckedAt
+	if err := uc.healthRepo.UpdateModelHealth(ctx, health); err != nil {
+		return nil, fmt.Errorf("failed to update model health: %w", err)
+	}
+
+	// 首次探测没有可比较的状态
+	if !previous.LastCheck.IsZero() && previous.IsHealthy != health.IsHealthy {
+		uc.log.WithContext(ctx).Infof("model %s health changed: healthy=%t success_rate=%.2f", m.Name, health.IsHealthy, health.SuccessRate)
+		event := &HealthEvent{
+			ModelID:      m.ID,
+			ModelName:    m.Name,
+			ProviderID:   m.ProviderID,
+			IsHealthy:    health.IsHealthy,
+			SuccessRate:  health.SuccessRate,
+			ErrorMessage: health.ErrorMessage,
+			ChangedAt:    health.LastCheck,
+		}
+		if err := uc.events.PublishHealthEvent(ctx, event); err != nil {
+			uc.log.WithContext(ctx).Warnf("failed to publish health event of model %s: %v", m.Name, err)
+		}
+	}
+	return health, nil
+}
+
+// summarizeHealth 计算成功率与延迟分位数，有线上调用时延迟只统计线上调用，避免探测请求拉低分位数
+func summarizeHealth(modelID int64, samples []*HealthCheckRecord) *ModelHealth {
+	health := &ModelHealth{ModelID: modelID, TotalRequests: int64(len(samples))}
+	var probe, traffic []float64
+	for _, s := range samples {
+		if !s.Success {
+			health.FailedRequests++
+			continue
+		}
+		if s.Source == HealthSourceTraffic {
+			traffic = append(traffic, s.Latency)
+		} else {
+			probe = append(probe, s.Latency)
+		}
+	}
+	if health.TotalRequests > 0 {
+		health.SuccessRate = float64(health.TotalRequests-health.FailedRequests) / float64(health.TotalRequests)
+	}
+
+	latencies := traffic
+	if len(latencies) == 0 {
+		latencies = probe
+	}
+	if len(latencies) == 0 {
+		return health
+	}
+	sort.Float64s(latencies)
+	var sum float64
+	for _, l := range latencies {
+		sum += l
+	}
+	health.ResponseTime = sum / float64(len(latencies))
+	health.LatencyP50 = percentile(latencies, 0.50)
+	health.LatencyP95 = percentile(latencies, 0.95)
+	health.LatencyP99 = percentile(latencies, 0.99)
+	return health
+}
+
+// percentile 最近秩法计算已排序样本的分位数
+func percentile(sorted []float64, p float64) float64 {
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d.Nanoseconds()) / 1e6
+}
+
+// providerListings 一轮探测内按提供商缓存的模型列表探测结果
+type providerListings struct {
+	mu      sync.Mutex
+	results map[int64]*providerListing
+}
+
+type providerListing struct {
+	once   sync.Once
+	record *HealthCheckRecord
+}
+
+// get 返回提供商的探测结果副本，同一提供商只探测一次
+func (l *providerListings) get(providerID int64, probe func() *HealthCheckRecord) *HealthCheckRecord {
+	l.mu.Lock()
+	listing, ok := l.results[providerID]
+	if !ok {
+		listing = &providerListing{}
+		l.results[providerID] = listing
+	}
+	l.mu.Unlock()
+
+	listing.once.Do(func() { listing.record = probe() })
+	record := *listing.record
+	return &record
+}
@@ -0,0 +1,159 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"universal/app/ai/internal/data/model"
+	"universal/pkg/fingerprint"
+	"universal/pkg/langdetect"
+	"universal/pkg/textseg"
+)
+
+// ChunkChanges 文档重新分块后的知识块变更
+type ChunkChanges struct {
+	Added      []*model.KnowledgeChunk // 新增的知识块，已完成向量化
+	Updated    []*model.KnowledgeChunk // 内容未变化的知识块，只更新序号与位置
+	DeletedIDs []int64                 // 需要删除的知识块
+}
+
+// IndexResult 文档索引结果
+type IndexResult struct {
+	Added     int
+	Removed   int
+	Unchanged int
+	// Tokens 新增知识块的 token 数
+	Tokens int64
+}
+
+// indexDocument 按知识库的分块配置重新分块，与已有知识块按内容比对：
+// 内容相同的知识块沿用原有向量，只向量化新增或变化的知识块，删除已不存在的知识块，
+// 知识库统计按增量更新
+func (uc *KnowledgeUsecase) indexDocument(ctx context.Context, doc *model.Document) (*IndexResult, error) {
+	kb, err := uc.repo.GetKnowledgeBase(ctx, doc.KnowledgeBaseID)
+	if err != nil {
+		return nil, err
+	}
+	pieces := splitDocument(doc.Content, kb.ChunkSize, kb.ChunkOverlap)
+
+	existing, err := uc.repo.ListDocumentChunks(ctx, doc.ID)
+	if err != nil {
+		return nil, err
+	}
+	// 同一文档中可能有内容相同的知识块，按内容排队依次沿用；没有向量的知识块不沿用
+	reusable := make(map[string][]*model.KnowledgeChunk)
+	for _, chunk := range existing {
+		if len(chunk.Embedding) > 0 {
+			reusable[chunk.Content] = append(reusable[chunk.Content], chunk)
+		}
+	}
+
+	var changes ChunkChanges
+	reused := make(map[int64]bool)
+	for i, piece := range pieces {
+		if queue := reusable[piece.Content]; len(queue) > 0 {
+			chunk := queue[0]
+			reusable[piece.Content] = queue[1:]
+			reused[chunk.ID] = true
+			chunk.ChunkIndex = i
+			chunk.StartPosition = piece.Start
+			chunk.EndPosition = piece.End
+			changes.Updated = append(changes.Updated, chunk)
+			continue
+		}
+		changes.Added = append(changes.Added, newChunk(doc, i, piece, kb.IndexVersion))
+	}
+	if err := uc.embedChunks(ctx, kb.EmbeddingModel, changes.Added); err != nil {
+		return nil, err
+	}
+
+	var stats KnowledgeBaseStatsUpdate
+	result := &IndexResult{Added: len(changes.Added), Unchanged: len(changes.Updated)}
+	for _, chunk := range changes.Added {
+		result.Tokens += int64(chunk.TokenCount)
+		stats.IndexSizeDelta += embeddingSize(chunk.Embedding)
+	}
+	for _, chunk := range existing {
+		if reused[chunk.ID] {
+			continue
+		}
+		changes.DeletedIDs = append(changes.DeletedIDs, chunk.ID)
+		stats.TokensDelta -= int64(chunk.TokenCount)
+		stats.IndexSizeDelta -= embeddingSize(chunk.Embedding)
+	}
+	result.Removed = len(changes.DeletedIDs)
+	stats.ChunkCountDelta = int64(result.Added - result.Removed)
+	stats.TokensDelta += result.Tokens
+
+	if err := uc.repo.ApplyChunkChanges(ctx, doc.ID, changes); err != nil {
+		return nil, err
+	}
+	doc.ChunkCount = len(pieces)
+
+	if stats != (KnowledgeBaseStatsUpdate{}) {
+		if err := uc.repo.UpdateKnowledgeBaseStats(ctx, doc.KnowledgeBaseID, stats); err != nil {
+			uc.logger.Warnw("failed to update knowledge base stats", "kb_id", doc.KnowledgeBaseID, "error", err)
+		}
+	}
+
+	return result, nil
+}
+
+// embeddingSize 向量占用的空间，单位 MB
+func embeddingSize(embedding model.EmbeddingVector) float64 {
+	return float64(len(embedding)*8) / 1024 / 1024
+}
+
+// newChunk 由文档分块创建知识块，token 数按字符数估算。
+// 计算内容指纹并按块识别语言，混合语言的文档各块分别识别，无法识别时沿用文档的语言；关键词按块的语言提取
+func newChunk(doc *model.Document, index int, piece documentChunk, indexVersion int) *model.KnowledgeChunk {
+	n := utf8.RuneCountInString(piece.Content)
+	lang := langdetect.Detect(piece.Content)
+	if lang == "" {
+		lang = doc.Language
+	}
+	fp := fingerprint.Of(piece.Content)
+	now := time.Now()
+	return &model.KnowledgeChunk{
+		DocumentID:      doc.ID,
+		KnowledgeBaseID: doc.KnowledgeBaseID,
+		Content:         piece.Content,
+		ChunkIndex:      index,
+		StartPosition:   piece.Start,
+		EndPosition:     piece.End,
+		Language:        lang,
+		Keywords:        model.StringSlice(textseg.Default().TopKeywordsFor(lang, piece.Content, model.MaxChunkKeywords)),
+		ChunkType:       1, // text
+		CharacterCount:  n,
+		TokenCount:      n,
+		SimHash:         int64(fp.SimHash),
+		MinHash:         model.MinHashSignature(fp.MinHash),
+		IndexVersion:    indexVersion,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+}
+
+// embedChunks 使用知识库的向量模型批量向量化知识块
+func (uc *KnowledgeUsecase) embedChunks(ctx context.Context, embeddingModel string, chunks []*model.KnowledgeChunk) error {
+	if len(chunks) == 0 {
+		return nil
+	}
+	texts := make([]string, len(chunks))
+	for i, chunk := range chunks {
+		texts[i] = chunk.Content
+	}
+	embeddings, err := uc.embedder.Embed(ctx, embeddingModel, texts)
+	if err != nil {
+		return fmt.Errorf("embed chunks: %w", err)
+	}
+	if len(embeddings) != len(chunks) {
+		return fmt.Errorf("embed chunks: got %d embeddings for %d chunks", len(embeddings), len(chunks))
+	}
+	for i, chunk := range chunks {
+		chunk.Embedding = model.EmbeddingVector(embeddings[i])
+	}
+	return nil
+}
@@ -2,17 +2,28 @@ package biz
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
 	"time"
 
 	"universal/app/ai/internal/data/model"
+	"universal/pkg/idgen"
+	"universal/pkg/langdetect"
+	"universal/pkg/textseg"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
 // KnowledgeUsecase 知识库业务逻辑
 type KnowledgeUsecase struct {
-	repo   KnowledgeRepo
-	logger *log.Helper
+	repo       KnowledgeRepo
+	workspaces *WorkspaceUsecase
+	share      *ShareUsecase
+	embedder   Embedder
+	ids        *idgen.Generator
+	logger     *log.Helper
 }
 
 // KnowledgeRepo 知识库仓库接口
@@ -23,6 +34,8 @@ type KnowledgeRepo interface {
 	UpdateKnowledgeBase(ctx context.Context, kb *model.KnowledgeBase) (*model.KnowledgeBase, error)
 	DeleteKnowledgeBase(ctx context.Context, id int64, hardDelete bool) error
 	ListKnowledgeBases(ctx context.Context, userID int64, page, pageSize int32, filters KnowledgeBaseFilter) ([]*model.KnowledgeBase, int64, error)
+	ClaimReindex(ctx context.Context, id int64, jobID string, from []int) (bool, error)
+	ReleaseReindex(ctx context.Context, id int64, jobID string) (bool, error)
 
 	// 文档管理
 	CreateDocument(ctx context.Context, doc *model.Document) (*model.Document, error)
@@ -30,6 +43,11 @@ type KnowledgeRepo interface {
 	UpdateDocument(ctx context.Context, doc *model.Document) (*model.Document, error)
 	DeleteDocument(ctx context.Context, documentIDs []int64, hardDelete bool) error
 	ListDocuments(ctx context.Context, kbID int64, page, pageSize int32, filters DocumentFilter) ([]*model.Document, int64, error)
+	FindDocumentByHash(ctx context.Context, kbID int64, hash string) (*model.Document, error)
+	FindLatestDocumentByName(ctx context.Context, kbID int64, name string) (*model.Document, error)
+	SaveDocumentVersion(ctx context.Context, doc *model.Document, snapshot *model.DocumentVersion, version int) (bool, error)
+	GetDocumentVersion(ctx context.Context, documentID int64, version int) (*model.DocumentVersion, error)
+	ListDocumentHashes(ctx context.Context, kbID int64) ([]*model.Document, error)
 
 	// 知识块管理
 	CreateKnowledgeChunks(ctx context.Context, chunks []*model.KnowledgeChunk) error
@@ -37,10 +55,19 @@ type KnowledgeRepo interface {
 	UpdateKnowledgeChunk(ctx context.Context, chunk *model.KnowledgeChunk) (*model.KnowledgeChunk, error)
 	ListKnowledgeChunks(ctx context.Context, documentID int64, page, pageSize int32) ([]*model.KnowledgeChunk, int64, error)
 	DeleteKnowledgeChunks(ctx context.Context, documentID int64) error
+	ListDocumentChunks(ctx context.Context, documentID int64) ([]*model.KnowledgeChunk, error)
+	ApplyChunkChanges(ctx context.Context, documentID int64, changes ChunkChanges) error
+	ListChunkFingerprints(ctx context.Context, kbID int64) ([]*model.KnowledgeChunk, error)
 
-	// 知识搜索
+	// 重建索引，知识块按索引版本区分，查询只使用知识库当前生效的版本
+	ListIndexedDocuments(ctx context.Context, kbID int64) ([]*model.Document, error)
+	CreateIndexChunks(ctx context.Context, kbID int64, jobID string, chunks []*model.KnowledgeChunk) (bool, error)
+	DeleteIndexChunks(ctx context.Context, kbID int64, jobID string, indexVersion int, documentIDs []int64) (bool, error)
+	SwapIndex(ctx context.Context, kb *model.KnowledgeBase, from int, jobID string) (bool, error)
+
+	// 知识搜索，keywords 为按查询语言切分的关键词
 	SearchKnowledge(ctx context.Context, kbID int64, query string, limit int32, threshold float64, filters map[string]string) ([]*model.KnowledgeChunk, error)
-	HybridSearch(ctx context.Context, kbID int64, query string, limit int32, semanticWeight, keywordWeight, threshold float64, filters map[string]string) ([]*HybridSearchResult, error)
+	HybridSearch(ctx context.Context, kbID int64, query string, keywords []string, limit int32, semanticWeight, keywordWeight, threshold float64, filters map[string]string) ([]*HybridSearchResult, error)
 
 	// 统计和分析
 	GetKnowledgeBaseStats(ctx context.Context, kbID int64) (*KnowledgeBaseStats, error)
@@ -55,11 +82,13 @@ type KnowledgeRepo interface {
 
 // KnowledgeBaseFilter 知识库过滤条件
 type KnowledgeBaseFilter struct {
-	Keyword  string
-	Status   int32
-	Tags     []string
-	SortBy   string
-	SortDesc bool
+	Keyword     string
+	Status      int32
+	Tags        []string
+	SortBy      string
+	SortDesc    bool
+	OwnedOnly   bool  // 仅返回自己创建的，否则包含共享给自己的
+	WorkspaceID int64 // 当前工作空间，0表示个人空间
 }
 
 // DocumentFilter 文档过滤条件
@@ -112,22 +141,77 @@ type DocumentUploadInfo struct {
 	Metadata    model.DocumentMetadata
 	Tags        []string
 	AutoProcess bool
+	// DuplicatePolicy 知识库中已有相同内容时的处理方式
+	DuplicatePolicy DuplicatePolicy
+}
+
+// maxQueryKeywords 查询参与关键词检索的关键词上限
+const maxQueryKeywords = 8
+
+// DuplicatePolicy 上传内容重复时的处理方式
+type DuplicatePolicy int
+
+const (
+	DuplicateReject     DuplicatePolicy = iota // 拒绝上传
+	DuplicateSkip                              // 返回已有文档
+	DuplicateNewVersion                        // 作为同名文档的新版本更新，旧版本保留在历史中；内容未变化时返回已有文档
+)
+
+// DocumentUpdateInfo 文档更新信息，字段为空时保持不变
+type DocumentUpdateInfo struct {
+	Name     string
+	Content  []byte
+	Metadata *model.DocumentMetadata
+	Tags     []string
+	// Reprocess 内容未变化时也重新分块并补齐缺失的向量
+	Reprocess bool
+	// RollbackToVersion 回滚到指定的历史版本，大于 0 时忽略 Content
+	RollbackToVersion int
 }
 
+var (
+	// ErrDocumentDuplicate 知识库中已有相同内容的文档
+	ErrDocumentDuplicate = errors.Conflict("DOCUMENT_DUPLICATE", "a document with the same content already exists in the knowledge base")
+	// ErrDocumentVersionConflict 文档在读取后已被其他请求修改
+	ErrDocumentVersionConflict = errors.Conflict("DOCUMENT_VERSION_CONFLICT", "the document was modified concurrently, reload it and retry")
+	// ErrDocumentVersionNotFound 文档不存在指定的历史版本
+	ErrDocumentVersionNotFound = errors.NotFound("DOCUMENT_VERSION_NOT_FOUND", "document version not found")
+	// ErrKnowledgeBaseIndexing 知识库正在重建索引或当前状态不允许重建索引
+	ErrKnowledgeBaseIndexing = errors.Conflict("KNOWLEDGE_BASE_INDEXING", "knowledge base is being reindexed or cannot be reindexed in its current status")
+)
+
 // NewKnowledgeUsecase 创建知识库业务逻辑实例
-func NewKnowledgeUsecase(repo KnowledgeRepo, logger log.Logger) *KnowledgeUsecase {
+func NewKnowledgeUsecase(repo KnowledgeRepo, workspaces *WorkspaceUsecase, share *ShareUsecase, embedder Embedder, ids *idgen.Generator, logger log.Logger) *KnowledgeUsecase {
 	return &KnowledgeUsecase{
-		repo:   repo,
-		logger: log.NewHelper(logger),
+		repo:       repo,
+		workspaces: workspaces,
+		share:      share,
+		embedder:   embedder,
+		ids:        ids,
+		logger:     log.NewHelper(logger),
 	}
 }
 
 // CreateKnowledgeBase 创建知识库
 func (uc *KnowledgeUsecase) CreateKnowledgeBase(ctx context.Context, userID int64, name, description, embeddingModel string, chunkSize, chunkOverlap int32, config model.KnowledgeBaseConfig, tags []string, language string) (*model.KnowledgeBase, error) {
+	// 在工作空间中创建时归属该空间，并使用空间默认的向量化模型
+	ws, err := uc.workspaces.Active(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	var workspaceID int64
+	if ws != nil {
+		workspaceID = ws.ID
+		if embeddingModel == "" {
+			embeddingModel = uc.workspaces.DefaultModelName(ctx, ws.DefaultEmbeddingModelID)
+		}
+	}
+
 	now := time.Now()
 
 	kb := &model.KnowledgeBase{
 		UserID:         userID,
+		WorkspaceID:    workspaceID,
 		Name:           name,
 		Description:    description,
 		EmbeddingModel: embeddingModel,
@@ -153,30 +237,67 @@ func (uc *KnowledgeUsecase) CreateKnowledgeBase(ctx context.Context, userID int6
 
 // GetKnowledgeBase 获取知识库
 func (uc *KnowledgeUsecase) GetKnowledgeBase(ctx context.Context, id int64) (*model.KnowledgeBase, error) {
-	return uc.repo.GetKnowledgeBase(ctx, id)
+	return uc.authorize(ctx, id, model.ShareRoleViewer)
 }
 
-// UpdateKnowledgeBase 更新知识库
-func (uc *KnowledgeUsecase) UpdateKnowledgeBase(ctx context.Context, id int64, name, description, embeddingModel string, chunkSize, chunkOverlap int32, config model.KnowledgeBaseConfig, tags []string, reindexAfterUpdate bool) (*model.KnowledgeBase, error) {
+// authorize 获取知识库并校验调用方的权限，AccessRole 填充为调用方的实际权限
+func (uc *KnowledgeUsecase) authorize(ctx context.Context, id int64, minRole int) (*model.KnowledgeBase, error) {
 	kb, err := uc.repo.GetKnowledgeBase(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	role, err := uc.share.Authorize(ctx, model.ShareResourceKnowledgeBase, kb.ID, kb.UserID, kb.WorkspaceID, minRole)
+	if err != nil {
+		return nil, err
+	}
+	kb.AccessRole = role
+	return kb, nil
+}
+
+// authorizeDocument 获取文档并校验调用方对其所属知识库的权限
+func (uc *KnowledgeUsecase) authorizeDocument(ctx context.Context, id int64, minRole int) (*model.Document, error) {
+	doc, err := uc.repo.GetDocument(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.authorize(ctx, doc.KnowledgeBaseID, minRole); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// UpdateKnowledgeBase 更新知识库。向量化模型、分块或索引配置变化且要求重新索引时，
+// 新配置在重建索引成功后才生效，重建期间搜索仍使用原有索引
+func (uc *KnowledgeUsecase) UpdateKnowledgeBase(ctx context.Context, id int64, name, description, embeddingModel string, chunkSize, chunkOverlap int32, config model.KnowledgeBaseConfig, tags []string, reindexAfterUpdate bool) (*model.KnowledgeBase, error) {
+	kb, err := uc.authorize(ctx, id, model.ShareRoleEditor)
+	if err != nil {
+		return nil, err
+	}
 
 	// 检查是否需要重新索引
+	settings := indexSettingsOf(kb)
 	needReindex := false
 	if embeddingModel != "" && embeddingModel != kb.EmbeddingModel {
-		kb.EmbeddingModel = embeddingModel
+		settings.EmbeddingModel = embeddingModel
 		needReindex = true
 	}
 	if chunkSize > 0 && int(chunkSize) != kb.ChunkSize {
-		kb.ChunkSize = int(chunkSize)
+		settings.ChunkSize = int(chunkSize)
 		needReindex = true
 	}
 	if chunkOverlap > 0 && int(chunkOverlap) != kb.ChunkOverlap {
-		kb.ChunkOverlap = int(chunkOverlap)
+		settings.ChunkOverlap = int(chunkOverlap)
 		needReindex = true
 	}
+	if config.EmbeddingDimension > 0 {
+		settings.Config = config
+		needReindex = true
+	}
+
+	// 重建索引期间不允许修改索引配置，避免切换时覆盖
+	if needReindex && kb.Status == 2 { // indexing
+		return nil, ErrKnowledgeBaseIndexing
+	}
 
 	// 更新其他字段
 	if name != "" {
@@ -188,46 +309,114 @@ func (uc *KnowledgeUsecase) UpdateKnowledgeBase(ctx context.Context, id int64, n
 	if len(tags) > 0 {
 		kb.Tags = model.StringSlice(tags)
 	}
-	if config.EmbeddingDimension > 0 {
-		kb.Config = config
-		needReindex = true
+
+	// 不重新索引时新配置直接生效，已有知识块保持不变
+	reindex := needReindex && reindexAfterUpdate
+	if !reindex {
+		settings.apply(kb)
 	}
 
 	kb.UpdatedAt = time.Now()
+	kb, err = uc.repo.UpdateKnowledgeBase(ctx, kb)
+	if err != nil {
+		return nil, err
+	}
 
-	// 如果需要重新索引且用户请求重新索引
-	if needReindex && reindexAfterUpdate {
+	if reindex {
+		if _, err := uc.startReindex(ctx, kb, settings, false); err != nil {
+			return nil, err
+		}
 		kb.Status = 2 // indexing
-		// TODO: 启动重新索引任务
-		uc.logger.Infow("knowledge base configuration changed, reindexing required", "kb_id", id)
 	}
 
-	return uc.repo.UpdateKnowledgeBase(ctx, kb)
+	return kb, nil
 }
 
-// DeleteKnowledgeBase 删除知识库
+// DeleteKnowledgeBase 删除知识库，仅所有者可删除
 func (uc *KnowledgeUsecase) DeleteKnowledgeBase(ctx context.Context, id int64, hardDelete bool) error {
+	if _, err := uc.authorize(ctx, id, model.ShareRoleOwner); err != nil {
+		return err
+	}
 	return uc.repo.DeleteKnowledgeBase(ctx, id, hardDelete)
 }
 
-// ListKnowledgeBases 获取知识库列表
-func (uc *KnowledgeUsecase) ListKnowledgeBases(ctx context.Context, userID int64, page, pageSize int32, keyword string, status int32, tags []string, sortBy string, sortDesc bool) ([]*model.KnowledgeBase, int64, error) {
+// ListKnowledgeBases 获取网关验证的调用方可见的知识库列表，userID 非零时须为调用方本人
+func (uc *KnowledgeUsecase) ListKnowledgeBases(ctx context.Context, userID int64, page, pageSize int32, keyword string, status int32, tags []string, sortBy string, sortDesc bool, ownedOnly bool) ([]*model.KnowledgeBase, int64, error) {
+	userID, err := verifiedCaller(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
 	filter := KnowledgeBaseFilter{
-		Keyword:  keyword,
-		Status:   status,
-		Tags:     tags,
-		SortBy:   sortBy,
-		SortDesc: sortDesc,
+		Keyword:   keyword,
+		Status:    status,
+		Tags:      tags,
+		SortBy:    sortBy,
+		SortDesc:  sortDesc,
+		OwnedOnly: ownedOnly,
+	}
+
+	ws, err := uc.workspaces.Active(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if ws != nil {
+		filter.WorkspaceID = ws.ID
+	}
+
+	kbs, total, err := uc.repo.ListKnowledgeBases(ctx, userID, page, pageSize, filter)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	return uc.repo.ListKnowledgeBases(ctx, userID, page, pageSize, filter)
+	// 工作空间内的知识库对所有成员可见
+	if ws != nil {
+		for _, kb := range kbs {
+			if kb.AccessRole == 0 && kb.WorkspaceID == ws.ID {
+				kb.AccessRole = workspaceAccessRole(ws.Role)
+			}
+		}
+	}
+
+	return kbs, total, nil
 }
 
-// UploadDocument 上传文档
-func (uc *KnowledgeUsecase) UploadDocument(ctx context.Context, kbID int64, uploadInfo DocumentUploadInfo) (*model.Document, error) {
+// UploadDocument 上传文档，duplicate 表示内容与已有文档相同，返回的是已有文档
+func (uc *KnowledgeUsecase) UploadDocument(ctx context.Context, kbID int64, uploadInfo DocumentUploadInfo) (*model.Document, bool, error) {
+	if _, err := uc.authorize(ctx, kbID, model.ShareRoleEditor); err != nil {
+		return nil, false, err
+	}
+
 	// 生成文档哈希
 	hash := uc.generateContentHash(uploadInfo.Content)
 
+	existing, err := uc.repo.FindDocumentByHash(ctx, kbID, hash)
+	if err != nil {
+		return nil, false, err
+	}
+	if existing != nil {
+		return uc.duplicateDocument(existing, uploadInfo.DuplicatePolicy)
+	}
+
+	// 同名文档已存在时作为其新版本更新，只重新向量化变化的知识块
+	if uploadInfo.DuplicatePolicy == DuplicateNewVersion {
+		previous, err := uc.repo.FindLatestDocumentByName(ctx, kbID, uploadInfo.Name)
+		if err != nil {
+			return nil, false, err
+		}
+		if previous != nil {
+			document, _, err := uc.UpdateDocument(ctx, previous.ID, DocumentUpdateInfo{
+				Content:  uploadInfo.Content,
+				Metadata: &uploadInfo.Metadata,
+				Tags:     uploadInfo.Tags,
+			})
+			if err != nil {
+				return nil, false, err
+			}
+			return document, false, nil
+		}
+	}
+
 	now := time.Now()
 	doc := &model.Document{
 		KnowledgeBaseID: kbID,
@@ -245,11 +434,22 @@ func (uc *KnowledgeUsecase) UploadDocument(ctx context.Context, kbID int64, uplo
 	}
 
 	// 检测文档语言
-	doc.Language = uc.detectLanguage(string(uploadInfo.Content))
+	doc.Language = uc.detectLanguage(ctx, kbID, string(uploadInfo.Content))
 
 	document, err := uc.repo.CreateDocument(ctx, doc)
+	if errors.Is(err, ErrDocumentDuplicate) {
+		// 并发上传相同内容时由唯一索引拒绝后到的一方，按重复策略处理
+		existing, findErr := uc.repo.FindDocumentByHash(ctx, kbID, hash)
+		if findErr != nil {
+			return nil, false, findErr
+		}
+		if existing == nil {
+			return nil, false, err
+		}
+		return uc.duplicateDocument(existing, uploadInfo.DuplicatePolicy)
+	}
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	// 如果启用自动处理，开始处理文档
@@ -260,11 +460,118 @@ func (uc *KnowledgeUsecase) UploadDocument(ctx context.Context, kbID int64, uplo
 		}
 	}
 
-	return document, nil
+	return document, false, nil
+}
+
+// duplicateDocument 按重复策略处理与已有文档内容相同的上传
+func (uc *KnowledgeUsecase) duplicateDocument(existing *model.Document, policy DuplicatePolicy) (*model.Document, bool, error) {
+	if policy == DuplicateReject {
+		return nil, false, ErrDocumentDuplicate.WithMetadata(map[string]string{
+			"document_id": strconv.FormatInt(existing.ID, 10),
+			"name":        existing.Name,
+		})
+	}
+	return existing, true, nil
+}
+
+// UpdateDocument 更新文档。内容变化时保存上一版本用于回滚、版本号加一，
+// 并增量更新索引：只向量化新增或变化的知识块，删除已不存在的知识块
+func (uc *KnowledgeUsecase) UpdateDocument(ctx context.Context, id int64, info DocumentUpdateInfo) (*model.Document, *IndexResult, error) {
+	doc, err := uc.authorizeDocument(ctx, id, model.ShareRoleEditor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content := info.Content
+	if info.RollbackToVersion > 0 {
+		version, err := uc.repo.GetDocumentVersion(ctx, id, info.RollbackToVersion)
+		if err != nil {
+			return nil, nil, err
+		}
+		if version == nil {
+			return nil, nil, ErrDocumentVersionNotFound.WithMetadata(map[string]string{
+				"document_id": strconv.FormatInt(id, 10),
+				"version":     strconv.Itoa(info.RollbackToVersion),
+			})
+		}
+		// 回滚时恢复历史版本的内容与属性，请求中显式指定的属性优先
+		content = []byte(version.Content)
+		doc.Name = version.Name
+		doc.Tags = version.Tags
+		doc.Metadata = version.Metadata
+	}
+
+	version := doc.Version
+	var snapshot *model.DocumentVersion
+	var stats KnowledgeBaseStatsUpdate
+	if content != nil {
+		if hash := uc.generateContentHash(content); hash != doc.Hash {
+			snapshot = &model.DocumentVersion{
+				DocumentID: doc.ID,
+				Version:    doc.Version,
+				Name:       doc.Name,
+				Content:    doc.Content,
+				Hash:       doc.Hash,
+				FileSize:   doc.FileSize,
+				Language:   doc.Language,
+				Tags:       doc.Tags,
+				Metadata:   doc.Metadata,
+				CreatedAt:  time.Now(),
+			}
+			stats.CharactersDelta = int64(len(content) - len(doc.Content))
+			stats.StorageSizeDelta = float64(int64(len(content))-doc.FileSize) / 1024 / 1024
+
+			doc.Content = string(content)
+			doc.Hash = hash
+			doc.FileSize = int64(len(content))
+			doc.Version++
+			doc.Language = uc.detectLanguage(ctx, doc.KnowledgeBaseID, doc.Content)
+		}
+	}
+
+	if info.Name != "" {
+		doc.Name = info.Name
+	}
+	if len(info.Tags) > 0 {
+		doc.Tags = model.StringSlice(info.Tags)
+	}
+	if info.Metadata != nil {
+		doc.Metadata = *info.Metadata
+	}
+	doc.UpdatedAt = time.Now()
+
+	// 以读取时的版本号为条件保存，并发更新时只有一方成功，另一方的修改不会被覆盖
+	ok, err := uc.repo.SaveDocumentVersion(ctx, doc, snapshot, version)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !ok {
+		return nil, nil, ErrDocumentVersionConflict.WithMetadata(map[string]string{
+			"document_id": strconv.FormatInt(doc.ID, 10),
+			"version":     strconv.Itoa(version),
+		})
+	}
+	if snapshot != nil {
+		if err := uc.repo.UpdateKnowledgeBaseStats(ctx, doc.KnowledgeBaseID, stats); err != nil {
+			uc.logger.Warnw("failed to update knowledge base stats", "kb_id", doc.KnowledgeBaseID, "error", err)
+		}
+	}
+
+	if snapshot == nil && !info.Reprocess {
+		return doc, &IndexResult{Unchanged: doc.ChunkCount}, nil
+	}
+	result, err := uc.runProcessingJob(ctx, doc)
+	if err != nil {
+		return nil, nil, err
+	}
+	return doc, result, nil
 }
 
 // ProcessDocument 处理文档
 func (uc *KnowledgeUsecase) ProcessDocument(ctx context.Context, documentID int64, forceReprocess bool) error {
+	if _, err := uc.authorizeDocument(ctx, documentID, model.ShareRoleEditor); err != nil {
+		return err
+	}
 	return uc.processDocument(ctx, documentID, forceReprocess)
 }
 
@@ -279,35 +586,84 @@ func (uc *KnowledgeUsecase) processDocument(ctx context.Context, documentID int6
 		return nil
 	}
 
-	// 创建处理任务
+	_, err = uc.runProcessingJob(ctx, doc)
+	return err
+}
+
+// runProcessingJob 创建处理任务并对文档分块、向量化，任务与文档状态随处理结果更新
+func (uc *KnowledgeUsecase) runProcessingJob(ctx context.Context, doc *model.Document) (*IndexResult, error) {
+	jobID, err := uc.generateJobID()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
 	job := &model.ProcessingJob{
-		ID:              uc.generateJobID(),
-		DocumentID:      documentID,
+		ID:              jobID,
+		DocumentID:      doc.ID,
 		KnowledgeBaseID: doc.KnowledgeBaseID,
 		JobType:         "process",
-		Status:          1, // pending
-		CreatedAt:       time.Now(),
-		UpdatedAt:       time.Now(),
+		Status:          2, // running
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		StartedAt:       &now,
+	}
+	if _, err := uc.repo.CreateProcessingJob(ctx, job); err != nil {
+		return nil, err
 	}
 
-	_, err = uc.repo.CreateProcessingJob(ctx, job)
-	if err != nil {
-		return err
+	doc.Status = 2 // processing
+	if _, err := uc.repo.UpdateDocument(ctx, doc); err != nil {
+		return nil, err
 	}
 
-	// TODO: 异步处理文档
-	// 这里应该启动一个异步任务来处理文档，包括：
-	// 1. 文档分块
-	// 2. 生成嵌入向量
-	// 3. 存储到向量数据库
+	result, indexErr := uc.indexDocument(ctx, doc)
+
+	finished := time.Now()
+	job.UpdatedAt = finished
+	job.CompletedAt = &finished
+	if indexErr != nil {
+		job.Status = 4 // failed
+		job.ErrorMessage = indexErr.Error()
+		doc.Status = 4 // failed
+		doc.ProcessingError = indexErr.Error()
+	} else {
+		job.Status = 3 // completed
+		job.Progress = 100
+		job.Result = model.JobResult{
+			ChunksCreated:   result.Added,
+			TokensProcessed: result.Tokens,
+			ProcessingTime:  finished.Sub(now).Seconds(),
+			Metrics: map[string]interface{}{
+				"chunks_removed":   result.Removed,
+				"chunks_unchanged": result.Unchanged,
+			},
+		}
+		doc.Status = 3 // processed
+		doc.ProcessingProgress = 100
+		doc.ProcessingError = ""
+		doc.LastProcessedAt = &finished
+	}
+	doc.UpdatedAt = finished
+
+	uc.saveJob(ctx, job)
+	if _, err := uc.repo.UpdateDocument(ctx, doc); err != nil {
+		return nil, err
+	}
+	if indexErr != nil {
+		return nil, indexErr
+	}
 
-	uc.logger.Infow("document processing job created", "job_id", job.ID, "doc_id", documentID)
+	uc.logger.WithContext(ctx).Infof("document %d processed: %d chunks added, %d removed, %d unchanged",
+		doc.ID, result.Added, result.Removed, result.Unchanged)
 
-	return nil
+	return result, nil
 }
 
 // SearchKnowledge 在知识库中搜索
 func (uc *KnowledgeUsecase) SearchKnowledge(ctx context.Context, kbID int64, query string, limit int32, threshold float64, filters map[string]string, includeMetadata bool) ([]*model.KnowledgeChunk, error) {
+	if _, err := uc.authorize(ctx, kbID, model.ShareRoleViewer); err != nil {
+		return nil, err
+	}
 	chunks, err := uc.repo.SearchKnowledge(ctx, kbID, query, limit, threshold, filters)
 	if err != nil {
 		return nil, err
@@ -321,41 +677,44 @@ func (uc *KnowledgeUsecase) SearchKnowledge(ctx context.Context, kbID int64, que
 
 // HybridSearch 混合搜索
 func (uc *KnowledgeUsecase) HybridSearch(ctx context.Context, kbID int64, query string, limit int32, semanticWeight, keywordWeight, threshold float64, filters map[string]string) ([]*HybridSearchResult, error) {
-	return uc.repo.HybridSearch(ctx, kbID, query, limit, semanticWeight, keywordWeight, threshold, filters)
+	if _, err := uc.authorize(ctx, kbID, model.ShareRoleViewer); err != nil {
+		return nil, err
+	}
+	return uc.repo.HybridSearch(ctx, kbID, query, uc.queryKeywords(ctx, kbID, query), limit, semanticWeight, keywordWeight, threshold, filters)
 }
 
 // GetKnowledgeBaseStats 获取知识库统计信息
 func (uc *KnowledgeUsecase) GetKnowledgeBaseStats(ctx context.Context, kbID int64, includeDetailed bool) (*KnowledgeBaseStats, error) {
+	if _, err := uc.authorize(ctx, kbID, model.ShareRoleViewer); err != nil {
+		return nil, err
+	}
 	return uc.repo.GetKnowledgeBaseStats(ctx, kbID)
 }
 
-// ReindexKnowledgeBase 重新索引知识库
+// ReindexKnowledgeBase 按当前配置重建知识库索引，返回任务ID。
+// forceReindex 用于接管中断的重建任务：知识库处于索引中状态时也重新开始
 func (uc *KnowledgeUsecase) ReindexKnowledgeBase(ctx context.Context, kbID int64, forceReindex bool) (string, error) {
-	// 创建重新索引任务
-	job := &model.ProcessingJob{
-		ID:              uc.generateJobID(),
-		KnowledgeBaseID: kbID,
-		JobType:         "reindex",
-		Status:          1, // pending
-		CreatedAt:       time.Now(),
-		UpdatedAt:       time.Now(),
-		Options: map[string]interface{}{
-			"force_reindex": forceReindex,
-		},
+	kb, err := uc.authorize(ctx, kbID, model.ShareRoleEditor)
+	if err != nil {
+		return "", err
 	}
 
-	_, err := uc.repo.CreateProcessingJob(ctx, job)
+	job, err := uc.startReindex(ctx, kb, indexSettingsOf(kb), forceReindex)
 	if err != nil {
 		return "", err
 	}
 
-	// TODO: 启动异步重新索引任务
-
 	return job.ID, nil
 }
 
 // DeleteDocument 删除文档
 func (uc *KnowledgeUsecase) DeleteDocument(ctx context.Context, documentIDs []int64, hardDelete bool) error {
+	for _, docID := range documentIDs {
+		if _, err := uc.authorizeDocument(ctx, docID, model.ShareRoleEditor); err != nil {
+			return err
+		}
+	}
+
 	// 如果是硬删除，需要先删除相关的知识块
 	if hardDelete {
 		for _, docID := range documentIDs {
@@ -371,17 +730,27 @@ func (uc *KnowledgeUsecase) DeleteDocument(ctx context.Context, documentIDs []in
 
 // 辅助方法
 func (uc *KnowledgeUsecase) generateContentHash(content []byte) string {
-	// TODO: 实现内容哈希生成
-	return "mock_hash"
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// detectLanguage 识别文本语言，无法识别时使用知识库的默认语言
+func (uc *KnowledgeUsecase) detectLanguage(ctx context.Context, kbID int64, content string) string {
+	if lang := langdetect.Detect(content); lang != "" {
+		return lang
+	}
+	kb, err := uc.repo.GetKnowledgeBase(ctx, kbID)
+	if err != nil {
+		return ""
+	}
+	return kb.Language
 }
 
-func (uc *KnowledgeUsecase) detectLanguage(content string) string {
-	// TODO: 实现语言检测
-	// 可以使用第三方库如 lingua-go
-	return "zh"
+// queryKeywords 按查询的语言切分关键词，用于关键词检索；查询过短无法识别语言时按知识库的默认语言切分
+func (uc *KnowledgeUsecase) queryKeywords(ctx context.Context, kbID int64, query string) []string {
+	return textseg.Default().TopKeywordsFor(uc.detectLanguage(ctx, kbID, query), query, maxQueryKeywords)
 }
 
-func (uc *KnowledgeUsecase) generateJobID() string {
-	// TODO: 生成唯一的任务ID
-	return "job_" + time.Now().Format("20060102150405")
+func (uc *KnowledgeUsecase) generateJobID() (string, error) {
+	return uc.ids.GenerateString("job_")
 }
@@ -0,0 +1,360 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"universal/pkg/identity"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// DefaultUserLevel 未指定用户等级时使用的限流等级
+	DefaultUserLevel = "free"
+	// ReservationLease 并发槽位租约，副本异常退出时槽位到期自动释放
+	ReservationLease = 5 * time.Minute
+	// defaultOutputReserve 未配置最大输出时为回复预留的token数
+	defaultOutputReserve = 1024
+)
+
+// 限流原因
+const (
+	LimitReasonConcurrency     = "concurrent_requests"
+	LimitReasonRequestsPerMin  = "requests_per_minute"
+	LimitReasonTokensPerMin    = "tokens_per_minute"
+	LimitReasonBurst           = "burst_limit"
+	LimitReasonDailyTokens     = "daily_token_limit"
+	LimitReasonDailyRequests   = "daily_request_limit"
+	LimitReasonMonthlyTokens   = "monthly_token_limit"
+	LimitReasonMonthlyRequests = "monthly_request_limit"
+)
+
+// LimitRequest 生成调用前的限流请求
+type LimitRequest struct {
+	UserID          int64
+	WorkspaceID     int64 // 资源所属工作空间，非0时使用空间共享配额
+	ModelName       string
+	UserLevel       string
+	InputTokens     int64 // 预估输入token数
+	MaxOutputTokens int64 // 回复最大token数，0时取模型限制
+	SkipQuota       bool  // 使用自有凭证时不预扣平台配额，分钟窗口与并发槽位仍然生效
+}
+
+// Reservation 一次生成调用占用的额度，调用结束后必须Settle
+type Reservation struct {
+	ID          string
+	UserID      int64
+	WorkspaceID int64
+	ModelID     int64
+	QuotaID     int64 // 未预扣配额时为0
+	Tokens      int64 // 预留的token数
+	Acquired    bool  // 是否占用了分钟窗口与并发槽位
+}
+
+// LimitDecision 分钟窗口与并发槽位的检查结果
+type LimitDecision struct {
+	Allowed    bool
+	Reason     string
+	RetryAfter time.Duration
+}
+
+// UsageOutcome 生成调用的实际消耗
+type UsageOutcome struct {
+	Called        bool  // 是否实际调用了模型，未调用时不记录使用事件
+	ModelID       int64 // 实际生成回复的模型，发生回退时与预留的首选模型不同
+	Failed        bool
+	InputTokens   int64
+	OutputTokens  int64
+	Cost          float64
+	ResponseTime  time.Duration
+	OwnCredential bool // 实际使用了自有凭证，预扣的配额全部退回
+}
+
+// LimiterRepo 分布式限流接口，多个副本共享同一份窗口与槽位
+type LimiterRepo interface {
+	// Acquire 原子地检查并占用分钟窗口与并发槽位
+	Acquire(ctx context.Context, reservation *Reservation, rule *RateLimitConfig) (*LimitDecision, error)
+	// Release 用实际token数修正分钟窗口，并释放并发槽位
+	Release(ctx context.Context, reservation *Reservation, actualTokens int64) error
+	// Cancel 撤销未执行调用的全部占用
+	Cancel(ctx context.Context, reservation *Reservation) error
+	// Record 将已完成的调用直接计入分钟窗口，不做限额检查，用于回退模型的结算
+	Record(ctx context.Context, reservation *Reservation, actualTokens int64) error
+}
+
+// LimiterUsecase 生成调用的配额与限流
+type LimiterUsecase struct {
+	limiter       LimiterRepo
+	quotaRepo     QuotaRepo
+	rateLimitRepo RateLimitRepo
+	modelRepo     ModelRepo
+	workspaces    *WorkspaceUsecase
+	log           *log.Helper
+}
+
+// NewLimiterUsecase 创建限流业务用例
+func NewLimiterUsecase(limiter LimiterRepo, quotaRepo QuotaRepo, rateLimitRepo RateLimitRepo, modelRepo ModelRepo, workspaces *WorkspaceUsecase, logger log.Logger) *LimiterUsecase {
+	return &LimiterUsecase{
+		limiter:       limiter,
+		quotaRepo:     quotaRepo,
+		rateLimitRepo: rateLimitRepo,
+		modelRepo:     modelRepo,
+		workspaces:    workspaces,
+		log:           log.NewHelper(logger),
+	}
+}
+
+// Reserve 在调用模型前预留额度，先占用分钟窗口与并发槽位，再预扣日/月配额
+func (uc *LimiterUsecase) Reserve(ctx context.Context, req *LimitRequest) (*Reservation, error) {
+	m, err := uc.modelRepo.GetModelByName(ctx, req.ModelName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get model: %w", err)
+	}
+	if m == nil {
+		return nil, fmt.Errorf("model not found: %s", req.ModelName)
+	}
+
+	outputTokens := req.MaxOutputTokens
+	if outputTokens <= 0 && m.Limits != nil && m.Limits.MaxOutputTokens > 0 {
+		outputTokens = int64(m.Limits.MaxOutputTokens)
+	}
+	if outputTokens <= 0 {
+		outputTokens = defaultOutputReserve
+	}
+
+	reservation := &Reservation{
+		ID:          newReservationID(),
+		UserID:      req.UserID,
+		WorkspaceID: req.WorkspaceID,
+		ModelID:     m.ID,
+		Tokens:      req.InputTokens + outputTokens,
+	}
+
+	// 分钟窗口与并发槽位
+	level := req.UserLevel
+	if level == "" {
+		level = DefaultUserLevel
+	}
+	rule, err := uc.rateLimitRule(ctx, m.ID, level)
+	if err != nil {
+		return nil, err
+	}
+	if rule != nil {
+		decision, err := uc.limiter.Acquire(ctx, reservation, rule)
+		if err != nil {
+			return nil, fmt.Errorf("failed to acquire rate limit: %w", err)
+		}
+		if !decision.Allowed {
+			uc.log.WithContext(ctx).Infof("rate limited: user=%d model=%d level=%s reason=%s", req.UserID, m.ID, level, decision.Reason)
+			return nil, RateLimitedError(decision.Reason, decision.RetryAfter)
+		}
+		reservation.Acquired = true
+	}
+
+	// 日/月配额
+	if req.SkipQuota {
+		return reservation, nil
+	}
+	quota, err := uc.quota(ctx, req.WorkspaceID, req.UserID, m.ID)
+	if err != nil {
+		uc.cancel(ctx, reservation)
+		return nil, err
+	}
+	reservation.QuotaID = quota.ID
+
+	reserved, err := uc.quotaRepo.ReserveQuota(ctx, quota.ID, reservation.Tokens, req.WorkspaceID > 0)
+	if err != nil {
+		uc.cancel(ctx, reservation)
+		return nil, fmt.Errorf("failed to reserve quota: %w", err)
+	}
+	if !reserved {
+		uc.cancel(ctx, reservation)
+		reason, retryAfter := quotaExceeded(quota, reservation.Tokens, req.WorkspaceID > 0)
+		uc.log.WithContext(ctx).Infof("quota exceeded: user=%d workspace=%d model=%d reason=%s", req.UserID, req.WorkspaceID, m.ID, reason)
+		return nil, RateLimitedError(reason, retryAfter)
+	}
+
+	return reservation, nil
+}
+
+// Settle 调用结束后按实际消耗结算并释放槽位，记录使用事件供定时任务汇总；
+// 由回退模型生成时，首选模型的占用全部撤销，改为计入实际使用的模型
+func (uc *LimiterUsecase) Settle(ctx context.Context, reservation *Reservation, outcome *UsageOutcome) error {
+	uc.recordUsage(ctx, reservation, outcome)
+	if outcome.ModelID > 0 && outcome.ModelID != reservation.ModelID {
+		return uc.settleFallback(ctx, reservation, outcome)
+	}
+
+	actual := outcome.InputTokens + outcome.OutputTokens
+	uc.release(ctx, reservation, actual)
+
+	if reservation.QuotaID == 0 {
+		return nil
+	}
+	tokenDelta, requestDelta := actual-reservation.Tokens, int64(0)
+	if outcome.OwnCredential {
+		tokenDelta, requestDelta = -reservation.Tokens, -1
+	}
+	if err := uc.quotaRepo.SettleQuota(ctx, reservation.QuotaID, tokenDelta, requestDelta); err != nil {
+		return fmt.Errorf("failed to settle quota: %w", err)
+	}
+	return nil
+}
+
+// settleFallback 撤销首选模型的分钟窗口、并发槽位与预扣配额，按实际消耗计入回退模型；
+// 调用已经完成，回退模型的窗口与配额只累加不拒绝
+func (uc *LimiterUsecase) settleFallback(ctx context.Context, reservation *Reservation, outcome *UsageOutcome) error {
+	uc.cancel(ctx, reservation)
+	actual := outcome.InputTokens + outcome.OutputTokens
+	fallback := *reservation
+	fallback.ModelID = outcome.ModelID
+	fallback.Tokens = actual
+	if err := uc.limiter.Record(ctx, &fallback, actual); err != nil {
+		uc.log.WithContext(ctx).Warnf("failed to record fallback usage for reservation %s: %v", reservation.ID, err)
+	}
+
+	if reservation.QuotaID == 0 {
+		return nil
+	}
+	if err := uc.quotaRepo.SettleQuota(ctx, reservation.QuotaID, -reservation.Tokens, -1); err != nil {
+		return fmt.Errorf("failed to refund primary quota: %w", err)
+	}
+	if outcome.OwnCredential {
+		return nil
+	}
+	quota, err := uc.quota(ctx, reservation.WorkspaceID, reservation.UserID, outcome.ModelID)
+	if err != nil {
+		return err
+	}
+	if err := uc.quotaRepo.SettleQuota(ctx, quota.ID, actual, 1); err != nil {
+		return fmt.Errorf("failed to charge fallback quota: %w", err)
+	}
+	return nil
+}
+
+// release 释放分钟窗口与并发槽位，失败时仅记录日志，槽位会在租约到期后自动释放
+func (uc *LimiterUsecase) release(ctx context.Context, reservation *Reservation, actualTokens int64) {
+	if !reservation.Acquired {
+		return
+	}
+	if err := uc.limiter.Release(ctx, reservation, actualTokens); err != nil {
+		uc.log.WithContext(ctx).Warnf("failed to release reservation %s: %v", reservation.ID, err)
+	}
+}
+
+// recordUsage 记录使用事件，失败时仅记录日志，不影响配额结算
+func (uc *LimiterUsecase) recordUsage(ctx context.Context, reservation *Reservation, outcome *UsageOutcome) {
+	if !outcome.Called {
+		return
+	}
+	modelID := reservation.ModelID
+	if outcome.ModelID > 0 {
+		modelID = outcome.ModelID
+	}
+	err := uc.quotaRepo.RecordUsage(ctx, &UsageEvent{
+		UserID:       reservation.UserID,
+		WorkspaceID:  reservation.WorkspaceID,
+		ModelID:      modelID,
+		Failed:       outcome.Failed,
+		InputTokens:  outcome.InputTokens,
+		OutputTokens: outcome.OutputTokens,
+		Cost:         outcome.Cost,
+		ResponseTime: outcome.ResponseTime,
+	})
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("failed to record usage for reservation %s: %v", reservation.ID, err)
+	}
+}
+
+// cancel 配额预扣失败时撤销已占用的分钟窗口与并发槽位
+func (uc *LimiterUsecase) cancel(ctx context.Context, reservation *Reservation) {
+	if !reservation.Acquired {
+		return
+	}
+	if err := uc.limiter.Cancel(ctx, reservation); err != nil {
+		uc.log.WithContext(ctx).Warnf("failed to cancel reservation %s: %v", reservation.ID, err)
+	}
+}
+
+// rateLimitRule 获取模型在指定用户等级下的限流规则，未配置时返回nil
+func (uc *LimiterUsecase) rateLimitRule(ctx context.Context, modelID int64, level string) (*RateLimitConfig, error) {
+	configs, err := uc.rateLimitRepo.GetRateLimitConfig(ctx, modelID, level)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rate limit config: %w", err)
+	}
+	if len(configs) == 0 {
+		return nil, nil
+	}
+	return configs[0], nil
+}
+
+// quota 获取个人配额或工作空间共享配额
+func (uc *LimiterUsecase) quota(ctx context.Context, workspaceID, userID, modelID int64) (*UserQuota, error) {
+	if workspaceID <= 0 {
+		return uc.quotaRepo.GetUserQuota(ctx, userID, modelID)
+	}
+	ws, err := uc.workspaces.Membership(ctx, workspaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.quotaRepo.GetWorkspaceQuota(ctx, ws, modelID)
+}
+
+// quotaExceeded 根据配额快照判断超限原因及可重试时间
+func quotaExceeded(quota *UserQuota, tokens int64, unlimitedZero bool) (string, time.Duration) {
+	exceeded := func(used, requested, limit int64) bool {
+		if unlimitedZero && limit <= 0 {
+			return false
+		}
+		return used+requested > limit
+	}
+
+	switch {
+	case exceeded(quota.DailyRequestsUsed, 1, quota.DailyRequestLimit):
+		return LimitReasonDailyRequests, time.Until(quota.ResetDailyAt)
+	case exceeded(quota.DailyTokensUsed, tokens, quota.DailyTokenLimit):
+		return LimitReasonDailyTokens, time.Until(quota.ResetDailyAt)
+	case exceeded(quota.MonthlyRequestsUsed, 1, quota.MonthlyRequestLimit):
+		return LimitReasonMonthlyRequests, time.Until(quota.ResetMonthlyAt)
+	default:
+		return LimitReasonMonthlyTokens, time.Until(quota.ResetMonthlyAt)
+	}
+}
+
+// RateLimitedError 构造限流错误，对应 gRPC ResourceExhausted / HTTP 429，元数据携带重试时间
+func RateLimitedError(reason string, retryAfter time.Duration) error {
+	seconds := int64(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	return errors.New(http.StatusTooManyRequests, "RATE_LIMITED", fmt.Sprintf("rate limit exceeded: %s", reason)).
+		WithMetadata(map[string]string{
+			"reason":              reason,
+			"retry_after_seconds": strconv.FormatInt(seconds, 10),
+		})
+}
+
+// UserLevelFromContext 获取网关验证的调用方用户等级，未提供时返回默认等级
+func UserLevelFromContext(ctx context.Context) string {
+	if level := identity.LevelFromContext(ctx); level != "" {
+		return level
+	}
+	return DefaultUserLevel
+}
+
+// newReservationID 生成预留记录ID
+func newReservationID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 36)
+	}
+	return hex.EncodeToString(b)
+}
@@ -3,10 +3,10 @@ package biz
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"time"
 
 	pb "universal/api/ai/v1"
+	"universal/pkg/envelope"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -39,13 +39,16 @@ type Model struct {
 	Pricing       *pb.ModelPricing      `json:"pricing"`
 	DefaultParams map[string]string     `json:"default_params"`
 	Status        int32                 `json:"status"`
+	MissingSince  *time.Time            `json:"missing_since"` // 同步时发现提供商已下线该模型的时间
 	CreatedAt     time.Time             `json:"created_at"`
 	UpdatedAt     time.Time             `json:"updated_at"`
 }
 
 // UserQuota 用户配额业务实体
 type UserQuota struct {
+	ID                  int64     `json:"id"`
 	UserID              int64     `json:"user_id"`
+	WorkspaceID         int64     `json:"workspace_id"` // 非0时为工作空间成员共享配额
 	ModelID             int64     `json:"model_id"`
 	DailyTokenLimit     int64     `json:"daily_token_limit"`
 	MonthlyTokenLimit   int64     `json:"monthly_token_limit"`
@@ -62,6 +65,7 @@ type UserQuota struct {
 // UsageStats 使用统计业务实体
 type UsageStats struct {
 	UserID             int64   `json:"user_id"`
+	WorkspaceID        int64   `json:"workspace_id"`
 	ModelID            int64   `json:"model_id"`
 	Date               string  `json:"date"`
 	TotalRequests      int64   `json:"total_requests"`
@@ -74,6 +78,19 @@ type UsageStats struct {
 	AvgResponseTime    float64 `json:"avg_response_time"`
 }
 
+// UsageEvent 一次模型调用的使用事件，成功与失败均记录，由定时任务汇总为使用统计
+type UsageEvent struct {
+	UserID       int64
+	WorkspaceID  int64
+	ModelID      int64
+	ToolCall     bool // 对话中的工具调用，只计入费用，不计入请求数
+	Failed       bool
+	InputTokens  int64
+	OutputTokens int64
+	Cost         float64
+	ResponseTime time.Duration
+}
+
 // RateLimitConfig 限流配置业务实体
 type RateLimitConfig struct {
 	ID                 int64     `json:"id"`
@@ -97,6 +114,9 @@ type ModelHealth struct {
 	SuccessRate    float64   `json:"success_rate"`
 	TotalRequests  int64     `json:"total_requests"`
 	FailedRequests int64     `json:"failed_requests"`
+	LatencyP50     float64   `json:"latency_p50"`
+	LatencyP95     float64   `json:"latency_p95"`
+	LatencyP99     float64   `json:"latency_p99"`
 	ErrorMessage   string    `json:"error_message"`
 	LastCheck      time.Time `json:"last_check"`
 }
@@ -120,15 +140,27 @@ type ModelRepo interface {
 	GetModelByName(ctx context.Context, name string) (*Model, error)
 	ListModels(ctx context.Context, providerID int64, status int32, capabilities []string, page, pageSize int32) ([]*Model, int64, error)
 	SwitchUserDefaultModel(ctx context.Context, userID, modelID int64) error
+	// MarkModelsMissing 将提供商已下线的模型标记为不可用
+	MarkModelsMissing(ctx context.Context, ids []int64, at time.Time) error
+	// RestoreModels 清除下线标记，因下线而不可用的模型恢复可用
+	RestoreModels(ctx context.Context, ids []int64) error
 }
 
 // QuotaRepo 配额数据访问接口
 type QuotaRepo interface {
 	GetUserQuota(ctx context.Context, userID, modelID int64) (*UserQuota, error)
 	UpdateUserQuota(ctx context.Context, quota *UserQuota) (*UserQuota, error)
-	GetUsageStats(ctx context.Context, userID, modelID int64, startDate, endDate, groupBy string) ([]*UsageStats, error)
+	GetUsageStats(ctx context.Context, workspaceID, userID, modelID int64, startDate, endDate, groupBy string) ([]*UsageStats, error)
 	ResetUsage(ctx context.Context, userID, modelID int64, resetType string) (int64, error)
 	CheckRateLimit(ctx context.Context, userID, modelID int64, requestedTokens int32) (bool, string, int32, int32, int32, error)
+	// 工作空间共享配额，限额以用户服务中的工作空间配置为准
+	GetWorkspaceQuota(ctx context.Context, workspace *Workspace, modelID int64) (*UserQuota, error)
+	CheckWorkspaceRateLimit(ctx context.Context, workspace *Workspace, modelID int64, requestedTokens int32) (bool, string, int32, int32, int32, error)
+	// 生成调用的预扣与结算
+	ReserveQuota(ctx context.Context, quotaID, tokens int64, unlimitedZero bool) (bool, error)
+	SettleQuota(ctx context.Context, quotaID, tokenDelta, requestDelta int64) error
+	// RecordUsage 记录一次模型调用的使用事件
+	RecordUsage(ctx context.Context, event *UsageEvent) error
 }
 
 // RateLimitRepo 限流配置数据访问接口
@@ -141,6 +173,10 @@ type RateLimitRepo interface {
 type HealthRepo interface {
 	GetModelHealth(ctx context.Context, modelID int64) (*ModelHealth, error)
 	UpdateModelHealth(ctx context.Context, health *ModelHealth) error
+	// 健康检查历史样本
+	CreateHealthCheck(ctx context.Context, record *HealthCheckRecord) error
+	ListHealthChecks(ctx context.Context, modelID int64, since time.Time, limit int) ([]*HealthCheckRecord, error)
+	DeleteHealthChecksBefore(ctx context.Context, before time.Time) (int64, error)
 	GetModelMetrics(ctx context.Context, modelID int64, startTime, endTime string) (*pb.GetModelMetricsReply, error)
 }
 
@@ -151,6 +187,8 @@ type ModelUsecase struct {
 	quotaRepo     QuotaRepo
 	rateLimitRepo RateLimitRepo
 	healthRepo    HealthRepo
+	health        *HealthUsecase
+	workspaces    *WorkspaceUsecase
 	log           *log.Helper
 }
 
@@ -161,6 +199,8 @@ func NewModelUsecase(
 	quotaRepo QuotaRepo,
 	rateLimitRepo RateLimitRepo,
 	healthRepo HealthRepo,
+	health *HealthUsecase,
+	workspaces *WorkspaceUsecase,
 	logger log.Logger,
 ) *ModelUsecase {
 	return &ModelUsecase{
@@ -169,6 +209,8 @@ func NewModelUsecase(
 		quotaRepo:     quotaRepo,
 		rateLimitRepo: rateLimitRepo,
 		healthRepo:    healthRepo,
+		health:        health,
+		workspaces:    workspaces,
 		log:           log.NewHelper(logger),
 	}
 }
@@ -217,7 +259,8 @@ func (uc *ModelUsecase) UpdateProvider(ctx context.Context, req *pb.UpdateProvid
 	if req.ApiBaseUrl != "" {
 		existing.APIBaseURL = req.ApiBaseUrl
 	}
-	if req.DefaultApiKey != "" {
+	// 客户端回传的脱敏值不覆盖原密钥
+	if req.DefaultApiKey != "" && !envelope.IsRedacted(req.DefaultApiKey) {
 		existing.DefaultAPIKey = req.DefaultApiKey
 	}
 	if req.DefaultHeaders != nil {
@@ -259,71 +302,7 @@ func (uc *ModelUsecase) ListProviders(ctx context.Context, status int32, page, p
 
 // TestProvider 测试提供商连接
 func (uc *ModelUsecase) TestProvider(ctx context.Context, id int64, apiKey string) (bool, float64, string, error) {
-	provider, err := uc.providerRepo.GetProvider(ctx, id)
-	if err != nil {
-		return false, 0, "provider not found", err
-	}
-
-	// 使用提供的 API Key 或默认的
-	testKey := apiKey
-	if testKey == "" {
-		testKey = provider.DefaultAPIKey
-	}
-
-	// 执行健康检查请求
-	startTime := time.Now()
-
-	// 根据提供商类型构建测试请求
-	var testURL string
-	var testHeaders map[string]string
-
-	switch provider.Name {
-	case "openai":
-		testURL = provider.APIBaseURL + "/v1/models"
-		testHeaders = map[string]string{
-			"Authorization": "Bearer " + testKey,
-			"Content-Type":  "application/json",
-		}
-	case "anthropic":
-		testURL = provider.APIBaseURL + "/v1/messages"
-		testHeaders = map[string]string{
-			"x-api-key":    testKey,
-			"Content-Type": "application/json",
-		}
-	default:
-		// 通用测试：尝试 GET 请求到基础 URL
-		testURL = provider.APIBaseURL
-		testHeaders = provider.DefaultHeaders
-		if testKey != "" {
-			testHeaders["Authorization"] = "Bearer " + testKey
-		}
-	}
-
-	// 创建 HTTP 客户端并执行请求
-	client := &http.Client{Timeout: 10 * time.Second}
-	req, err := http.NewRequestWithContext(ctx, "GET", testURL, nil)
-	if err != nil {
-		return false, 0, fmt.Sprintf("failed to create request: %v", err), err
-	}
-
-	for key, value := range testHeaders {
-		req.Header.Set(key, value)
-	}
-
-	resp, err := client.Do(req)
-	responseTime := float64(time.Since(startTime).Nanoseconds()) / 1e6 // 转换为毫秒
-
-	if err != nil {
-		return false, responseTime, fmt.Sprintf("request failed: %v", err), err
-	}
-	defer resp.Body.Close()
-
-	// 检查响应状态
-	if resp.StatusCode >= 200 && resp.StatusCode < 400 {
-		return true, responseTime, "", nil
-	}
-
-	return false, responseTime, fmt.Sprintf("unexpected status code: %d", resp.StatusCode), nil
+	return uc.health.TestProvider(ctx, id, apiKey)
 }
 
 // 模型管理相关方法
@@ -438,8 +417,15 @@ func (uc *ModelUsecase) SwitchModel(ctx context.Context, userID, modelID int64)
 
 // 配额管理相关方法
 
-// GetUserQuota 获取用户配额
+// GetUserQuota 获取用户配额，在工作空间中时返回成员共享的配额
 func (uc *ModelUsecase) GetUserQuota(ctx context.Context, userID, modelID int64) (*UserQuota, error) {
+	ws, err := uc.workspaces.Active(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if ws != nil {
+		return uc.quotaRepo.GetWorkspaceQuota(ctx, ws, modelID)
+	}
 	return uc.quotaRepo.GetUserQuota(ctx, userID, modelID)
 }
 
@@ -448,9 +434,13 @@ func (uc *ModelUsecase) UpdateUserQuota(ctx context.Context, quota *UserQuota) (
 	return uc.quotaRepo.UpdateUserQuota(ctx, quota)
 }
 
-// GetUsageStats 获取使用统计
+// GetUsageStats 获取使用统计，按当前工作空间隔离
 func (uc *ModelUsecase) GetUsageStats(ctx context.Context, userID, modelID int64, startDate, endDate, groupBy string) ([]*UsageStats, error) {
-	return uc.quotaRepo.GetUsageStats(ctx, userID, modelID, startDate, endDate, groupBy)
+	workspaceID, err := uc.workspaces.ActiveID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.quotaRepo.GetUsageStats(ctx, workspaceID, userID, modelID, startDate, endDate, groupBy)
 }
 
 // ResetUsage 重置使用量
@@ -458,8 +448,15 @@ func (uc *ModelUsecase) ResetUsage(ctx context.Context, userID, modelID int64, r
 	return uc.quotaRepo.ResetUsage(ctx, userID, modelID, resetType)
 }
 
-// CheckRateLimit 检查速率限制
+// CheckRateLimit 检查速率限制，在工作空间中时按成员共享配额检查
 func (uc *ModelUsecase) CheckRateLimit(ctx context.Context, userID, modelID int64, requestedTokens int32) (bool, string, int32, int32, int32, error) {
+	ws, err := uc.workspaces.Active(ctx, userID)
+	if err != nil {
+		return false, "", 0, 0, 0, err
+	}
+	if ws != nil {
+		return uc.quotaRepo.CheckWorkspaceRateLimit(ctx, ws, modelID, requestedTokens)
+	}
 	return uc.quotaRepo.CheckRateLimit(ctx, userID, modelID, requestedTokens)
 }
 
@@ -477,47 +474,16 @@ func (uc *ModelUsecase) UpdateRateLimitConfig(ctx context.Context, config *RateL
 
 // 健康检查相关方法
 
-// HealthCheck 执行健康检查
-func (uc *ModelUsecase) HealthCheck(ctx context.Context, modelID int64) (*ModelHealth, error) {
-	// 如果 modelID 为 0，检查所有模型
+// HealthCheck 立即探测并返回模型健康状态，modelID 为0时探测全部模型
+func (uc *ModelUsecase) HealthCheck(ctx context.Context, modelID int64) ([]*ModelHealth, error) {
 	if modelID == 0 {
-		// 这里可以扩展为检查所有模型的逻辑
-		return nil, fmt.Errorf("health check for all models not implemented")
-	}
-
-	// 获取模型信息
-	model, err := uc.modelRepo.GetModel(ctx, modelID)
-	if err != nil {
-		return nil, fmt.Errorf("model not found: %w", err)
+		return uc.health.ProbeAll(ctx)
 	}
-
-	// 获取提供商信息
-	provider, err := uc.providerRepo.GetProvider(ctx, model.ProviderID)
+	health, err := uc.health.ProbeModel(ctx, modelID)
 	if err != nil {
-		return nil, fmt.Errorf("provider not found: %w", err)
-	}
-
-	// 执行健康检查
-	isHealthy, responseTime, errorMessage, _ := uc.TestProvider(ctx, provider.ID, "")
-
-	health := &ModelHealth{
-		ModelID:        modelID,
-		IsHealthy:      isHealthy,
-		ResponseTime:   responseTime,
-		SuccessRate:    0.0, // 需要从统计数据计算
-		TotalRequests:  0,   // 需要从统计数据计算
-		FailedRequests: 0,   // 需要从统计数据计算
-		ErrorMessage:   errorMessage,
-		LastCheck:      time.Now(),
+		return nil, err
 	}
-
-	// 更新健康状态
-	err = uc.healthRepo.UpdateModelHealth(ctx, health)
-	if err != nil {
-		uc.log.Warnf("failed to update model health: %v", err)
-	}
-
-	return health, nil
+	return []*ModelHealth{health}, nil
 }
 
 // GetModelMetrics 获取模型指标
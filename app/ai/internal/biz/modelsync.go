@@ -0,0 +1,184 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// JobModelSync 模型目录同步任务
+const JobModelSync = "model-sync"
+
+// 模型目录同步方式，由 Provider.Config["model_sync"] 指定，默认 manual
+const (
+	ModelSyncManual = "manual" // 只能通过接口手动同步
+	ModelSyncAuto   = "auto"   // 同时参与定时同步
+)
+
+// modelSyncTimeout 请求提供商模型列表的超时时间
+const modelSyncTimeout = 30 * time.Second
+
+// ModelSyncResult 模型目录同步结果
+type ModelSyncResult struct {
+	ProviderID int64
+	Added      []string // 新增的模型
+	Restored   []string // 重新出现并恢复可用的模型
+	Removed    []string // 提供商已下线、标记为不可用的模型
+	Conflicts  []string // 名称已被其他提供商的模型占用而跳过的模型
+	Unchanged  int32
+}
+
+// ModelSyncUsecase 从提供商模型列表同步模型目录
+type ModelSyncUsecase struct {
+	modelRepo    ModelRepo
+	providerRepo ProviderRepo
+	client       CompletionClient
+	log          *log.Helper
+}
+
+// NewModelSyncUsecase 创建模型目录同步用例
+func NewModelSyncUsecase(modelRepo ModelRepo, providerRepo ProviderRepo, client CompletionClient, logger log.Logger) *ModelSyncUsecase {
+	return &ModelSyncUsecase{
+		modelRepo:    modelRepo,
+		providerRepo: providerRepo,
+		client:       client,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// SyncProvider 同步单个提供商的模型目录，dryRun 时只计算变更。
+// 已存在的模型保留手动维护的配置，只有同步时标记为不可用的模型会在重新出现后自动恢复。
+func (uc *ModelSyncUsecase) SyncProvider(ctx context.Context, providerID int64, dryRun bool) (*ModelSyncResult, error) {
+	provider, err := uc.providerRepo.GetProvider(ctx, providerID)
+	if err != nil {
+		return nil, fmt.Errorf("provider not found: %w", err)
+	}
+
+	listCtx, cancel := context.WithTimeout(ctx, modelSyncTimeout)
+	defer cancel()
+	listed, err := uc.client.ListModels(listCtx, provider, provider.DefaultAPIKey)
+	if err != nil {
+		return nil, errors.ServiceUnavailable("MODEL_SYNC_FAILED", err.Error())
+	}
+
+	existing := make(map[string]*Model)
+	for page, total := int32(1), 0; ; page++ {
+		batch, count, err := uc.modelRepo.ListModels(ctx, providerID, -1, nil, page, 100) // all
+		if err != nil {
+			return nil, fmt.Errorf("failed to list models: %w", err)
+		}
+		total += len(batch)
+		for _, m := range batch {
+			existing[m.Name] = m
+		}
+		if len(batch) == 0 || int64(total) >= count {
+			break
+		}
+	}
+
+	result := &ModelSyncResult{ProviderID: providerID}
+	var created []*Model
+	var restored []int64
+	seen := make(map[string]bool, len(listed))
+	for _, pm := range listed {
+		if seen[pm.ID] {
+			continue
+		}
+		entry := lookupCatalog(pm)
+		if entry.skip {
+			continue
+		}
+		seen[pm.ID] = true
+
+		if m := existing[pm.ID]; m != nil {
+			if m.MissingSince != nil {
+				restored = append(restored, m.ID)
+			}
+			if m.Status == 1 && m.MissingSince != nil { // 不可用
+				result.Restored = append(result.Restored, m.Name)
+			} else {
+				result.Unchanged++
+			}
+			continue
+		}
+
+		// 模型名称全局唯一，被其他提供商占用时跳过
+		other, err := uc.modelRepo.GetModelByName(ctx, pm.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get model: %w", err)
+		}
+		if other != nil {
+			result.Conflicts = append(result.Conflicts, pm.ID)
+			continue
+		}
+		result.Added = append(result.Added, pm.ID)
+		created = append(created, newCatalogModel(providerID, pm, entry))
+	}
+
+	// 列表为空多半是配置或权限问题，此时不下线任何模型
+	var missing []int64
+	if len(seen) > 0 {
+		for _, m := range existing {
+			if !seen[m.Name] && m.Status != 1 && m.MissingSince == nil {
+				result.Removed = append(result.Removed, m.Name)
+				missing = append(missing, m.ID)
+			}
+		}
+	} else if len(existing) > 0 {
+		uc.log.WithContext(ctx).Warnf("provider %s returned no models, skip marking models unavailable", provider.Name)
+	}
+
+	if dryRun {
+		return result, nil
+	}
+	for _, m := range created {
+		if _, err := uc.modelRepo.CreateModel(ctx, m); err != nil {
+			return nil, fmt.Errorf("failed to create model %s: %w", m.Name, err)
+		}
+	}
+	if err := uc.modelRepo.RestoreModels(ctx, restored); err != nil {
+		return nil, fmt.Errorf("failed to restore models: %w", err)
+	}
+	if err := uc.modelRepo.MarkModelsMissing(ctx, missing, time.Now()); err != nil {
+		return nil, fmt.Errorf("failed to mark models unavailable: %w", err)
+	}
+	return result, nil
+}
+
+// RunSync 同步全部启用了自动同步的提供商，单个提供商失败不影响其他提供商
+func (uc *ModelSyncUsecase) RunSync(ctx context.Context, now time.Time, loc *time.Location) error {
+	var providers []*Provider
+	for page := int32(1); ; page++ {
+		batch, total, err := uc.providerRepo.ListProviders(ctx, 0, page, 100) // enabled
+		if err != nil {
+			return fmt.Errorf("failed to list providers: %w", err)
+		}
+		for _, p := range batch {
+			if p.Config["model_sync"] == ModelSyncAuto {
+				providers = append(providers, p)
+			}
+		}
+		if len(batch) == 0 || int64(page)*100 >= total {
+			break
+		}
+	}
+
+	for _, p := range providers {
+		result, err := uc.SyncProvider(ctx, p.ID, false)
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("failed to sync models of provider %s: %v", p.Name, err)
+			continue
+		}
+		if len(result.Added)+len(result.Restored)+len(result.Removed) > 0 {
+			uc.log.WithContext(ctx).Infof("synced models of provider %s: added %v, restored %v, removed %v",
+				p.Name, result.Added, result.Restored, result.Removed)
+		}
+		if len(result.Conflicts) > 0 {
+			uc.log.WithContext(ctx).Warnf("models of provider %s conflict with other providers: %v", p.Name, result.Conflicts)
+		}
+	}
+	return nil
+}
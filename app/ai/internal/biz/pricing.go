@@ -0,0 +1,87 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+
+	pb "universal/api/ai/v1"
+	"universal/app/ai/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// TokenUsage 单次模型调用的token用量
+type TokenUsage struct {
+	InputTokens       int64 // 输入token总数，包含命中缓存的部分
+	CachedInputTokens int64
+	OutputTokens      int64
+}
+
+// CostBreakdown 单次调用的费用明细
+type CostBreakdown struct {
+	InputCost       float64
+	CachedInputCost float64
+	OutputCost      float64
+	RequestCost     float64
+	Total           float64
+	Currency        string
+}
+
+// PricingUsecase 按模型定价计算调用费用
+type PricingUsecase struct {
+	modelRepo ModelRepo
+	log       *log.Helper
+}
+
+// NewPricingUsecase 创建计费业务用例
+func NewPricingUsecase(modelRepo ModelRepo, logger log.Logger) *PricingUsecase {
+	return &PricingUsecase{
+		modelRepo: modelRepo,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// PriceMessage 计算一条助手消息的费用，模型未配置定价时费用为0
+func (uc *PricingUsecase) PriceMessage(ctx context.Context, modelName string, usage TokenUsage) (*CostBreakdown, error) {
+	m, err := uc.modelRepo.GetModelByName(ctx, modelName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get model: %w", err)
+	}
+	if m == nil {
+		return nil, fmt.Errorf("model not found: %s", modelName)
+	}
+	return PriceTokens(m.Pricing, usage), nil
+}
+
+// PriceTokens 按定价计算token费用，命中缓存的输入token未单独定价时按普通输入单价计费
+func PriceTokens(pricing *pb.ModelPricing, usage TokenUsage) *CostBreakdown {
+	cost := &CostBreakdown{}
+	if pricing == nil {
+		return cost
+	}
+
+	cached := usage.CachedInputTokens
+	if cached > usage.InputTokens {
+		cached = usage.InputTokens
+	}
+	cachedPrice := pricing.CachedInputPricePerToken
+	if cachedPrice <= 0 {
+		cachedPrice = pricing.InputPricePerToken
+	}
+
+	cost.Currency = pricing.Currency
+	cost.InputCost = float64(usage.InputTokens-cached) * pricing.InputPricePerToken
+	cost.CachedInputCost = float64(cached) * cachedPrice
+	cost.OutputCost = float64(usage.OutputTokens) * pricing.OutputPricePerToken
+	cost.RequestCost = pricing.PricePerRequest
+	cost.Total = cost.InputCost + cost.CachedInputCost + cost.OutputCost + cost.RequestCost
+	return cost
+}
+
+// PriceToolCall 计算一次工具调用的费用，失败的调用不计费
+func PriceToolCall(tool *model.Tool, status int) float64 {
+	if tool == nil || status != 3 { // success
+		return 0
+	}
+	return tool.Config.PricePerCall
+}
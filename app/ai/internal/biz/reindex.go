@@ -0,0 +1,307 @@
+package biz
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"universal/app/ai/internal/data/model"
+)
+
+// reindexJobTimeout 重建索引任务的最长执行时间
+const reindexJobTimeout = 2 * time.Hour
+
+// errReindexSuperseded 强制重建索引后原任务失去归属，原任务中止且不回滚，影子索引由新任务清理
+var errReindexSuperseded = stderrors.New("reindex superseded by a newer job")
+
+// IndexSettings 决定索引内容的知识库配置，变化后需要重建索引
+type IndexSettings struct {
+	EmbeddingModel string
+	ChunkSize      int
+	ChunkOverlap   int
+	Config         model.KnowledgeBaseConfig
+}
+
+func indexSettingsOf(kb *model.KnowledgeBase) IndexSettings {
+	return IndexSettings{
+		EmbeddingModel: kb.EmbeddingModel,
+		ChunkSize:      kb.ChunkSize,
+		ChunkOverlap:   kb.ChunkOverlap,
+		Config:         kb.Config,
+	}
+}
+
+func (s IndexSettings) apply(kb *model.KnowledgeBase) {
+	kb.EmbeddingModel = s.EmbeddingModel
+	kb.ChunkSize = s.ChunkSize
+	kb.ChunkOverlap = s.ChunkOverlap
+	kb.Config = s.Config
+}
+
+// shadowDocument 文档在影子索引中的内容哈希与统计
+type shadowDocument struct {
+	hash      string
+	chunks    int64
+	tokens    int64
+	indexSize float64
+}
+
+// startReindex 将知识库置为索引中并由新任务持有，在后台按 settings 构建新版本的影子索引。
+// 构建期间搜索仍使用当前版本，完成后原子切换并使新配置生效，失败时丢弃影子索引，原索引与配置保持不变。
+// 任务只在后台运行，服务重启后中断的任务不会恢复，知识库停留在索引中，须以 force 重新发起；
+// force 时新任务取代原任务，原任务的写入与切换均以任务ID为条件，发现失去归属后中止
+func (uc *KnowledgeUsecase) startReindex(ctx context.Context, kb *model.KnowledgeBase, settings IndexSettings, force bool) (*model.ProcessingJob, error) {
+	jobID, err := uc.generateJobID()
+	if err != nil {
+		return nil, err
+	}
+	from := []int{1, 3} // active, error
+	if force {
+		from = append(from, 2) // indexing
+	}
+	ok, err := uc.repo.ClaimReindex(ctx, kb.ID, jobID, from)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrKnowledgeBaseIndexing.WithMetadata(map[string]string{
+			"knowledge_base_id": strconv.FormatInt(kb.ID, 10),
+		})
+	}
+
+	now := time.Now()
+	job := &model.ProcessingJob{
+		ID:              jobID,
+		KnowledgeBaseID: kb.ID,
+		JobType:         "reindex",
+		Status:          1, // pending
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		Options: map[string]interface{}{
+			"force_reindex":   force,
+			"embedding_model": settings.EmbeddingModel,
+			"chunk_size":      settings.ChunkSize,
+			"chunk_overlap":   settings.ChunkOverlap,
+			"config":          settings.Config,
+			"from_version":    kb.IndexVersion,
+			"to_version":      kb.IndexVersion + 1,
+		},
+	}
+	if _, err := uc.repo.CreateProcessingJob(ctx, job); err != nil {
+		if _, restoreErr := uc.repo.ReleaseReindex(ctx, kb.ID, jobID); restoreErr != nil {
+			uc.logger.Warnw("failed to restore knowledge base status", "kb_id", kb.ID, "error", restoreErr)
+		}
+		return nil, err
+	}
+	if previous := kb.ReindexJobID; kb.Status == 2 && previous != "" && previous != jobID {
+		uc.cancelSupersededJob(ctx, previous, jobID)
+	}
+
+	jobCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), reindexJobTimeout)
+	kbID, version := kb.ID, kb.IndexVersion
+	go func() {
+		defer cancel()
+		uc.runReindex(jobCtx, kbID, version, settings, job)
+	}()
+
+	return job, nil
+}
+
+// runReindex 构建影子索引并切换，任务进度按已完成的文档数更新
+func (uc *KnowledgeUsecase) runReindex(ctx context.Context, kbID int64, from int, settings IndexSettings, job *model.ProcessingJob) {
+	to := from + 1
+	started := time.Now()
+	job.Status = 2 // running
+	job.StartedAt = &started
+	job.UpdatedAt = started
+	uc.saveJob(ctx, job)
+
+	shadow, err := uc.buildShadowIndex(ctx, kbID, to, settings, job)
+	var totals shadowDocument
+	if err == nil {
+		totals, err = uc.swapIndex(ctx, kbID, from, to, settings, shadow, job.ID)
+	}
+
+	// 任务超时后仍需回滚并记录结果
+	ctx = context.WithoutCancel(ctx)
+	finished := time.Now()
+	job.UpdatedAt = finished
+	job.CompletedAt = &finished
+	if stderrors.Is(err, errReindexSuperseded) {
+		job.Status = 5 // cancelled
+		job.ErrorMessage = err.Error()
+		uc.saveJob(ctx, job)
+		uc.logger.WithContext(ctx).Infof("reindex job %s for knowledge base %d was superseded", job.ID, kbID)
+		return
+	}
+	if err != nil {
+		if _, rollbackErr := uc.repo.DeleteIndexChunks(ctx, kbID, job.ID, to, nil); rollbackErr != nil {
+			uc.logger.Warnw("failed to drop shadow index", "kb_id", kbID, "index_version", to, "error", rollbackErr)
+		}
+		if _, rollbackErr := uc.repo.ReleaseReindex(ctx, kbID, job.ID); rollbackErr != nil {
+			uc.logger.Warnw("failed to restore knowledge base status", "kb_id", kbID, "error", rollbackErr)
+		}
+		job.Status = 4 // failed
+		job.ErrorMessage = err.Error()
+		uc.saveJob(ctx, job)
+		uc.logger.WithContext(ctx).Errorf("reindex job %s for knowledge base %d failed: %v", job.ID, kbID, err)
+		return
+	}
+
+	job.Status = 3 // completed
+	job.Progress = 100
+	job.Result = model.JobResult{
+		ChunksCreated:   int(totals.chunks),
+		TokensProcessed: totals.tokens,
+		ProcessingTime:  finished.Sub(started).Seconds(),
+		Metrics: map[string]interface{}{
+			"documents":     len(shadow),
+			"index_version": to,
+		},
+	}
+	uc.saveJob(ctx, job)
+
+	// 切换后清理旧版本索引，并增量处理重建期间内容发生变化的文档
+	if _, err := uc.repo.DeleteIndexChunks(ctx, kbID, "", from, nil); err != nil {
+		uc.logger.Warnw("failed to drop previous index", "kb_id", kbID, "index_version", from, "error", err)
+	}
+	uc.catchUpReindex(ctx, kbID, shadow)
+
+	uc.logger.WithContext(ctx).Infof("knowledge base %d reindexed to version %d: %d documents, %d chunks in %s",
+		kbID, to, len(shadow), totals.chunks, finished.Sub(started))
+}
+
+// buildShadowIndex 按新配置为已处理完成的文档构建 to 版本的知识块，先清理上次中断或被取代的任务遗留的同版本知识块
+func (uc *KnowledgeUsecase) buildShadowIndex(ctx context.Context, kbID int64, to int, settings IndexSettings, job *model.ProcessingJob) (map[int64]shadowDocument, error) {
+	owned, err := uc.repo.DeleteIndexChunks(ctx, kbID, job.ID, to, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !owned {
+		return nil, errReindexSuperseded
+	}
+	docs, err := uc.repo.ListIndexedDocuments(ctx, kbID)
+	if err != nil {
+		return nil, err
+	}
+
+	shadow := make(map[int64]shadowDocument, len(docs))
+	for i, d := range docs {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		doc, err := uc.repo.GetDocument(ctx, d.ID)
+		if err != nil {
+			return nil, fmt.Errorf("load document %d: %w", d.ID, err)
+		}
+
+		pieces := splitDocument(doc.Content, settings.ChunkSize, settings.ChunkOverlap)
+		chunks := make([]*model.KnowledgeChunk, len(pieces))
+		for j, piece := range pieces {
+			chunks[j] = newChunk(doc, j, piece, to)
+		}
+		if err := uc.embedChunks(ctx, settings.EmbeddingModel, chunks); err != nil {
+			return nil, fmt.Errorf("document %d: %w", doc.ID, err)
+		}
+		owned, err := uc.repo.CreateIndexChunks(ctx, kbID, job.ID, chunks)
+		if err != nil {
+			return nil, err
+		}
+		if !owned {
+			return nil, errReindexSuperseded
+		}
+
+		entry := shadowDocument{hash: doc.Hash, chunks: int64(len(chunks))}
+		for _, chunk := range chunks {
+			entry.tokens += int64(chunk.TokenCount)
+			entry.indexSize += embeddingSize(chunk.Embedding)
+		}
+		shadow[doc.ID] = entry
+
+		job.Progress = float64(i+1) / float64(len(docs)) * 100
+		job.UpdatedAt = time.Now()
+		uc.saveJob(ctx, job)
+	}
+	return shadow, nil
+}
+
+// swapIndex 切换到影子索引并使新配置生效，知识库的块数量、token 数与索引大小按影子索引重新计算
+func (uc *KnowledgeUsecase) swapIndex(ctx context.Context, kbID int64, from, to int, settings IndexSettings, shadow map[int64]shadowDocument, jobID string) (shadowDocument, error) {
+	var totals shadowDocument
+	for _, entry := range shadow {
+		totals.chunks += entry.chunks
+		totals.tokens += entry.tokens
+		totals.indexSize += entry.indexSize
+	}
+
+	now := time.Now()
+	kb := &model.KnowledgeBase{
+		ID:            kbID,
+		IndexVersion:  to,
+		Status:        1, // active
+		ChunkCount:    totals.chunks,
+		TotalTokens:   totals.tokens,
+		IndexSize:     totals.indexSize,
+		LastIndexedAt: &now,
+		UpdatedAt:     now,
+	}
+	settings.apply(kb)
+
+	// 索引版本只在持有重建索引时切换，未切换说明已被强制重建取代
+	swapped, err := uc.repo.SwapIndex(ctx, kb, from, jobID)
+	if err != nil {
+		return totals, err
+	}
+	if !swapped {
+		return totals, errReindexSuperseded
+	}
+	return totals, nil
+}
+
+// catchUpReindex 构建影子索引期间内容发生变化或新处理完成的文档仍写入了旧版本，切换后按新配置增量处理
+func (uc *KnowledgeUsecase) catchUpReindex(ctx context.Context, kbID int64, shadow map[int64]shadowDocument) {
+	docs, err := uc.repo.ListIndexedDocuments(ctx, kbID)
+	if err != nil {
+		uc.logger.Warnw("failed to list documents after reindex", "kb_id", kbID, "error", err)
+		return
+	}
+	for _, d := range docs {
+		if entry, ok := shadow[d.ID]; ok && entry.hash == d.Hash {
+			continue
+		}
+		doc, err := uc.repo.GetDocument(ctx, d.ID)
+		if err == nil {
+			_, err = uc.indexDocument(ctx, doc)
+		}
+		if err != nil {
+			uc.logger.Warnw("failed to index document changed during reindex", "doc_id", d.ID, "error", err)
+		}
+	}
+}
+
+// cancelSupersededJob 将被强制重建取代的任务标记为已取消。原任务仍在运行时会在下次写入时自行中止，
+// 服务重启后遗留的任务则不再有进程更新
+func (uc *KnowledgeUsecase) cancelSupersededJob(ctx context.Context, jobID, by string) {
+	job, err := uc.repo.GetProcessingJob(ctx, jobID)
+	if err != nil {
+		uc.logger.Warnw("failed to load superseded reindex job", "job_id", jobID, "error", err)
+		return
+	}
+	if job.Status != 1 && job.Status != 2 { // pending, running
+		return
+	}
+	now := time.Now()
+	job.Status = 5 // cancelled
+	job.ErrorMessage = fmt.Sprintf("superseded by reindex job %s", by)
+	job.UpdatedAt = now
+	job.CompletedAt = &now
+	uc.saveJob(ctx, job)
+}
+
+func (uc *KnowledgeUsecase) saveJob(ctx context.Context, job *model.ProcessingJob) {
+	if err := uc.repo.UpdateProcessingJob(ctx, job); err != nil {
+		uc.logger.Warnw("failed to update processing job", "job_id", job.ID, "error", err)
+	}
+}
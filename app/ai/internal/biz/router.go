@@ -0,0 +1,561 @@
+package biz
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 候选模型排序策略
+const (
+	RoutePolicyOrdered         = "ordered"          // 按回退链顺序
+	RoutePolicyCheapest        = "cheapest"         // 按预估费用升序
+	RoutePolicyLowestLatency   = "lowest_latency"   // 按近期平均延迟升序
+	RoutePolicyCapabilityMatch = "capability_match" // 优先能力刚好满足需求的模型
+)
+
+const (
+	defaultRouteAttempts    = 3
+	defaultRouteTimeout     = 60 * time.Second
+	defaultBreakerWindow    = time.Minute
+	defaultBreakerRequests  = 10
+	defaultBreakerErrorRate = 0.5
+	defaultBreakerCooldown  = 30 * time.Second
+	defaultHealthMaxAge     = 5 * time.Minute
+	// latencyDecay 延迟指数移动平均的衰减系数
+	latencyDecay = 0.2
+)
+
+// ChatMessage 发送给模型的对话消息
+type ChatMessage struct {
+	Role    string
+	Content string
+}
+
+// GenerationParams 生成参数，为空时使用模型默认值
+type GenerationParams struct {
+	MaxTokens   int
+	Temperature *float64
+	TopP        *float64
+	Stop        []string
+}
+
+// CompletionRequest 单次提供商调用请求
+type CompletionRequest struct {
+	Provider *Provider
+	Model    *Model
+	APIKey   string
+	Messages []ChatMessage
+	Params   GenerationParams
+}
+
+// CompletionResult 单次提供商调用结果
+type CompletionResult struct {
+	Content      string
+	FinishReason string
+	Usage        TokenUsage
+}
+
+// CompletionClient 调用提供商生成回复，按提供商类型适配请求协议
+type CompletionClient interface {
+	Complete(ctx context.Context, req *CompletionRequest) (*CompletionResult, error)
+	// Embed 调用提供商的向量化接口
+	Embed(ctx context.Context, req *EmbeddingRequest) (*EmbeddingResult, error)
+	// ListModels 列出提供商可用的模型
+	ListModels(ctx context.Context, provider *Provider, apiKey string) ([]*ProviderModel, error)
+}
+
+// ProviderError 提供商返回的错误响应
+type ProviderError struct {
+	StatusCode int
+	Message    string
+	RetryAfter time.Duration
+}
+
+func (e *ProviderError) Error() string {
+	return fmt.Sprintf("provider responded %d: %s", e.StatusCode, e.Message)
+}
+
+// Retryable 限流、超时与服务端错误可换用其他提供商重试
+func (e *ProviderError) Retryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode == http.StatusRequestTimeout || e.StatusCode >= 500
+}
+
+// Route 模型或别名的回退链
+type Route struct {
+	Name   string
+	Models []string
+	Policy string
+}
+
+// RouterOptions 路由重试与熔断参数
+type RouterOptions struct {
+	MaxAttempts      int
+	RequestTimeout   time.Duration
+	BreakerWindow    time.Duration
+	BreakerRequests  int
+	BreakerErrorRate float64
+	BreakerCooldown  time.Duration
+	HealthMaxAge     time.Duration
+}
+
+// RouteRepo 路由配置
+type RouteRepo interface {
+	// GetRoute 获取模型或别名的回退链，未配置时返回nil
+	GetRoute(ctx context.Context, name string) (*Route, error)
+}
+
+// RouteRequirements 本次生成对模型能力的要求
+type RouteRequirements struct {
+	Tools         bool
+	Vision        bool
+	ContextTokens int64 // 预估的上下文token数
+	Scope         CredentialScope
+	OwnKeyOnly    bool // 只使用自有凭证的候选，未预扣平台配额时设置
+}
+
+// RouteAttempt 一次候选模型调用的结果
+type RouteAttempt struct {
+	Model   string
+	Latency time.Duration
+	Err     error
+}
+
+// Generation 路由生成结果
+type Generation struct {
+	Model            *Model
+	Result           *CompletionResult
+	Latency          time.Duration
+	Attempts         []RouteAttempt
+	CredentialSource string // 实际使用的凭证来源
+}
+
+// routeCandidate 可参与路由的候选模型
+type routeCandidate struct {
+	model    *Model
+	provider *Provider
+	apiKey   string
+	source   string // 凭证来源
+	cost     float64
+	latency  time.Duration
+	surplus  int // 超出需求的能力数
+}
+
+// ModelRouter 模型路由，按回退链与策略选择候选模型，对限流与服务端错误换用下一个候选重试
+type ModelRouter struct {
+	routes       RouteRepo
+	modelRepo    ModelRepo
+	providerRepo ProviderRepo
+	healthRepo   HealthRepo
+	credentials  *CredentialUsecase
+	client       CompletionClient
+	opts         RouterOptions
+	breakers     *breakerSet
+	log          *log.Helper
+}
+
+// NewModelRouter 创建模型路由
+func NewModelRouter(routes RouteRepo, modelRepo ModelRepo, providerRepo ProviderRepo, healthRepo HealthRepo, credentials *CredentialUsecase, client CompletionClient, opts *RouterOptions, logger log.Logger) *ModelRouter {
+	o := RouterOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.MaxAttempts <= 0 {
+		o.MaxAttempts = defaultRouteAttempts
+	}
+	if o.RequestTimeout <= 0 {
+		o.RequestTimeout = defaultRouteTimeout
+	}
+	if o.BreakerWindow <= 0 {
+		o.BreakerWindow = defaultBreakerWindow
+	}
+	if o.BreakerRequests <= 0 {
+		o.BreakerRequests = defaultBreakerRequests
+	}
+	if o.BreakerErrorRate <= 0 {
+		o.BreakerErrorRate = defaultBreakerErrorRate
+	}
+	if o.BreakerCooldown <= 0 {
+		o.BreakerCooldown = defaultBreakerCooldown
+	}
+	if o.HealthMaxAge <= 0 {
+		o.HealthMaxAge = defaultHealthMaxAge
+	}
+	return &ModelRouter{
+		routes:       routes,
+		modelRepo:    modelRepo,
+		providerRepo: providerRepo,
+		healthRepo:   healthRepo,
+		credentials:  credentials,
+		client:       client,
+		opts:         o,
+		breakers:     newBreakerSet(o),
+		log:          log.NewHelper(logger),
+	}
+}
+
+// Primary 回退链中的首选模型名，配额与限流按首选模型计算
+func (r *ModelRouter) Primary(ctx context.Context, target string) (string, error) {
+	route, err := r.routes.GetRoute(ctx, target)
+	if err != nil {
+		return "", fmt.Errorf("failed to get route: %w", err)
+	}
+	if route == nil || len(route.Models) == 0 {
+		return target, nil
+	}
+	return route.Models[0], nil
+}
+
+// Generate 按路由生成回复，返回实际使用的模型与每次尝试的结果
+func (r *ModelRouter) Generate(ctx context.Context, target string, req RouteRequirements, messages []ChatMessage, params GenerationParams) (*Generation, error) {
+	candidates, err := r.candidates(ctx, target, req, params)
+	if err != nil {
+		return nil, err
+	}
+
+	gen := &Generation{}
+	var lastErr error
+	for _, c := range candidates {
+		if len(gen.Attempts) >= r.opts.MaxAttempts {
+			break
+		}
+		breaker := r.breakers.get(c.model.ID)
+		if !breaker.allow(time.Now()) {
+			continue
+		}
+
+		callCtx, cancel := context.WithTimeout(ctx, r.opts.RequestTimeout)
+		started := time.Now()
+		result, err := r.client.Complete(callCtx, &CompletionRequest{
+			Provider: c.provider,
+			Model:    c.model,
+			APIKey:   c.apiKey,
+			Messages: messages,
+			Params:   params,
+		})
+		cancel()
+		latency := time.Since(started)
+		gen.Attempts = append(gen.Attempts, RouteAttempt{Model: c.model.Name, Latency: latency, Err: err})
+
+		// 调用方取消不计入模型错误率
+		if ctx.Err() != nil {
+			breaker.release()
+			return nil, ctx.Err()
+		}
+		// 请求本身被拒绝不代表模型不可用
+		breaker.record(time.Now(), latency, err == nil, err == nil || !retryable(err))
+		r.recordHealth(ctx, c, latency, err)
+		if err == nil {
+			gen.Model = c.model
+			gen.Result = result
+			gen.Latency = latency
+			gen.CredentialSource = c.source
+			if len(gen.Attempts) > 1 {
+				r.log.WithContext(ctx).Infof("route %s fell back to %s after %d attempts", target, c.model.Name, len(gen.Attempts))
+			}
+			return gen, nil
+		}
+
+		lastErr = err
+		r.log.WithContext(ctx).Warnf("route %s: model %s failed: %v", target, c.model.Name, err)
+		if !retryable(err) {
+			return nil, generationError(err)
+		}
+	}
+
+	if lastErr == nil {
+		return nil, errors.ServiceUnavailable("MODEL_UNAVAILABLE", fmt.Sprintf("no available model for %s, all candidates are circuit broken", target))
+	}
+	return nil, generationError(lastErr)
+}
+
+// recordHealth 记录线上调用的健康样本，请求本身被拒绝的调用计为成功
+func (r *ModelRouter) recordHealth(ctx context.Context, c *routeCandidate, latency time.Duration, err error) {
+	record := newHealthRecord(latency, err)
+	record.ModelID = c.model.ID
+	record.ProviderID = c.provider.ID
+	record.Source = HealthSourceTraffic
+	record.Success = err == nil || !retryable(err)
+	if err := r.healthRepo.CreateHealthCheck(context.WithoutCancel(ctx), record); err != nil {
+		r.log.WithContext(ctx).Warnf("failed to record health of model %s: %v", c.model.Name, err)
+	}
+}
+
+// candidates 解析回退链，过滤不可用与能力不满足的模型并按策略排序
+func (r *ModelRouter) candidates(ctx context.Context, target string, req RouteRequirements, params GenerationParams) ([]*routeCandidate, error) {
+	route, err := r.routes.GetRoute(ctx, target)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get route: %w", err)
+	}
+	if route == nil {
+		route = &Route{Name: target, Models: []string{target}}
+	}
+
+	now := time.Now()
+	providers := make(map[int64]*Provider)
+	var candidates []*routeCandidate
+	for _, name := range route.Models {
+		m, err := r.modelRepo.GetModelByName(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get model %s: %w", name, err)
+		}
+		if m == nil || m.Status != 0 { // available
+			continue
+		}
+		if !meetsRequirements(m, req) {
+			continue
+		}
+
+		p, ok := providers[m.ProviderID]
+		if !ok {
+			if p, err = r.providerRepo.GetProvider(ctx, m.ProviderID); err != nil {
+				return nil, fmt.Errorf("failed to get provider of %s: %w", name, err)
+			}
+			providers[m.ProviderID] = p
+		}
+		if p == nil || p.Status != 0 { // enabled
+			continue
+		}
+		apiKey, source, err := r.credentials.Resolve(ctx, p, req.Scope)
+		if err != nil {
+			return nil, err
+		}
+		if req.OwnKeyOnly && source == CredentialSourceProvider {
+			continue
+		}
+
+		// 近期健康检查失败的模型视为熔断
+		health, err := r.healthRepo.GetModelHealth(ctx, m.ID)
+		if err != nil {
+			r.log.WithContext(ctx).Warnf("failed to get health of model %s: %v", name, err)
+		} else if !health.IsHealthy && now.Sub(health.LastCheck) < r.opts.HealthMaxAge {
+			continue
+		}
+
+		outputTokens := int64(params.MaxTokens)
+		if outputTokens <= 0 {
+			outputTokens = defaultOutputReserve
+		}
+		candidates = append(candidates, &routeCandidate{
+			model:    m,
+			provider: p,
+			apiKey:   apiKey,
+			source:   source,
+			cost:     PriceTokens(m.Pricing, TokenUsage{InputTokens: req.ContextTokens, OutputTokens: outputTokens}).Total,
+			latency:  r.breakers.get(m.ID).averageLatency(health),
+			surplus:  capabilitySurplus(m, req),
+		})
+	}
+	if len(candidates) == 0 {
+		return nil, errors.ServiceUnavailable("MODEL_UNAVAILABLE", fmt.Sprintf("no available model for %s", target))
+	}
+
+	switch route.Policy {
+	case RoutePolicyCheapest:
+		sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].cost < candidates[j].cost })
+	case RoutePolicyLowestLatency:
+		// 无延迟数据的模型排在最后
+		sort.SliceStable(candidates, func(i, j int) bool {
+			a, b := candidates[i].latency, candidates[j].latency
+			return a > 0 && (b == 0 || a < b)
+		})
+	case RoutePolicyCapabilityMatch:
+		sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].surplus < candidates[j].surplus })
+	}
+	return candidates, nil
+}
+
+// meetsRequirements 检查模型能力与上下文窗口是否满足需求，未配置能力的模型视为仅支持对话
+func meetsRequirements(m *Model, req RouteRequirements) bool {
+	caps := m.Capabilities
+	if req.Tools && (caps == nil || !caps.SupportsTools) {
+		return false
+	}
+	if req.Vision && (caps == nil || !caps.SupportsVision) {
+		return false
+	}
+	if req.ContextTokens > 0 && m.Limits != nil {
+		window := int64(m.Limits.ContextWindow)
+		if m.Limits.MaxInputTokens > 0 {
+			window = int64(m.Limits.MaxInputTokens)
+		}
+		if window > 0 && req.ContextTokens > window {
+			return false
+		}
+	}
+	return true
+}
+
+// capabilitySurplus 模型具备但本次不需要的能力数
+func capabilitySurplus(m *Model, req RouteRequirements) int {
+	caps := m.Capabilities
+	if caps == nil {
+		return 0
+	}
+	surplus := 0
+	for _, extra := range []bool{
+		caps.SupportsTools && !req.Tools,
+		caps.SupportsVision && !req.Vision,
+		caps.SupportsAudio,
+		caps.SupportsEmbedding,
+	} {
+		if extra {
+			surplus++
+		}
+	}
+	return surplus
+}
+
+// retryable 网络错误与可重试的提供商错误换用下一个候选
+func retryable(err error) bool {
+	var pe *ProviderError
+	if stderrors.As(err, &pe) {
+		return pe.Retryable()
+	}
+	return true
+}
+
+// generationError 将提供商错误转换为对外错误
+func generationError(err error) error {
+	var pe *ProviderError
+	if !stderrors.As(err, &pe) {
+		return errors.ServiceUnavailable("MODEL_UNAVAILABLE", err.Error())
+	}
+	switch {
+	case pe.StatusCode == http.StatusTooManyRequests:
+		e := errors.New(http.StatusTooManyRequests, "PROVIDER_RATE_LIMITED", pe.Message)
+		if pe.RetryAfter > 0 {
+			e = e.WithMetadata(map[string]string{"retry_after_seconds": strconv.Itoa(int(pe.RetryAfter.Seconds()))})
+		}
+		return e
+	case pe.StatusCode >= 500 || pe.StatusCode == http.StatusRequestTimeout:
+		return errors.ServiceUnavailable("MODEL_UNAVAILABLE", pe.Message)
+	default:
+		return errors.BadRequest("MODEL_REQUEST_REJECTED", pe.Message)
+	}
+}
+
+// breakerSet 按模型维护的熔断器，状态仅在本副本内生效
+type breakerSet struct {
+	opts     RouterOptions
+	mu       sync.Mutex
+	breakers map[int64]*breaker
+}
+
+func newBreakerSet(opts RouterOptions) *breakerSet {
+	return &breakerSet{opts: opts, breakers: make(map[int64]*breaker)}
+}
+
+func (s *breakerSet) get(modelID int64) *breaker {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, ok := s.breakers[modelID]
+	if !ok {
+		b = &breaker{opts: s.opts}
+		s.breakers[modelID] = b
+	}
+	return b
+}
+
+// 熔断器状态
+const (
+	breakerClosed = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+// breaker 按固定窗口统计错误率的熔断器，半开状态只放行一个试探请求
+type breaker struct {
+	opts        RouterOptions
+	mu          sync.Mutex
+	state       int
+	windowStart time.Time
+	requests    int
+	failures    int
+	openedAt    time.Time
+	probing     bool
+	latency     time.Duration // 成功请求延迟的指数移动平均
+}
+
+func (b *breaker) allow(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if now.Sub(b.openedAt) < b.opts.BreakerCooldown {
+			return false
+		}
+		b.state = breakerHalfOpen
+		b.probing = true
+		return true
+	case breakerHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	}
+	return true
+}
+
+// release 放弃本次放行，不计入统计
+func (b *breaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
+
+func (b *breaker) record(now time.Time, latency time.Duration, completed, success bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if completed {
+		if b.latency == 0 {
+			b.latency = latency
+		} else {
+			b.latency = time.Duration(latencyDecay*float64(latency) + (1-latencyDecay)*float64(b.latency))
+		}
+	}
+
+	if b.state == breakerHalfOpen {
+		b.probing = false
+		if success {
+			b.state = breakerClosed
+			b.windowStart, b.requests, b.failures = now, 0, 0
+		} else {
+			b.state, b.openedAt = breakerOpen, now
+		}
+		return
+	}
+
+	if now.Sub(b.windowStart) >= b.opts.BreakerWindow {
+		b.windowStart, b.requests, b.failures = now, 0, 0
+	}
+	b.requests++
+	if !success {
+		b.failures++
+	}
+	if b.requests >= b.opts.BreakerRequests && float64(b.failures)/float64(b.requests) > b.opts.BreakerErrorRate {
+		b.state, b.openedAt = breakerOpen, now
+	}
+}
+
+// averageLatency 近期平均延迟，本副本无调用记录时取健康检查的响应时间（毫秒）
+func (b *breaker) averageLatency(health *ModelHealth) time.Duration {
+	b.mu.Lock()
+	latency := b.latency
+	b.mu.Unlock()
+	if latency == 0 && health != nil && health.ResponseTime > 0 {
+		latency = time.Duration(health.ResponseTime * float64(time.Millisecond))
+	}
+	return latency
+}
@@ -0,0 +1,126 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 定时任务名称，同时作为分布式锁的键
+const (
+	JobQuotaReset  = "quota-reset"
+	JobUsageRollup = "usage-rollup"
+)
+
+// 配额重置周期
+const (
+	ResetPeriodDaily   = "daily"
+	ResetPeriodMonthly = "monthly"
+)
+
+// JobLocker 分布式任务锁，保证多个副本中同一任务同一时刻只有一个实例执行
+type JobLocker interface {
+	// TryLock 尝试获取任务锁，未获取到时返回false，获取成功时返回释放函数
+	TryLock(ctx context.Context, name string, ttl time.Duration) (func(), bool, error)
+}
+
+// UsageRollup 按用户/工作空间/模型汇总的单日使用量
+type UsageRollup struct {
+	UserID             int64
+	WorkspaceID        int64
+	ModelID            int64
+	TotalRequests      int64
+	SuccessfulRequests int64
+	FailedRequests     int64
+	InputTokens        int64
+	OutputTokens       int64
+	TotalCost          float64
+	AvgResponseTime    float64
+}
+
+// SchedulerRepo 定时任务数据访问接口
+type SchedulerRepo interface {
+	// ResetExpiredQuotas 清零到期的日/月配额计数并设置下一次重置时间，返回更新行数
+	ResetExpiredQuotas(ctx context.Context, period string, now, next time.Time) (int64, error)
+	// AggregateUsage 从使用事件汇总[start, end)区间内的使用量，包含失败的调用
+	AggregateUsage(ctx context.Context, start, end time.Time) ([]*UsageRollup, error)
+	// SaveUsageStats 覆盖写入指定日期的使用统计
+	SaveUsageStats(ctx context.Context, date string, rollups []*UsageRollup) error
+}
+
+// SchedulerUsecase 配额重置与使用统计汇总任务
+type SchedulerUsecase struct {
+	repo   SchedulerRepo
+	locker JobLocker
+	log    *log.Helper
+}
+
+// NewSchedulerUsecase 创建定时任务业务用例
+func NewSchedulerUsecase(repo SchedulerRepo, locker JobLocker, logger log.Logger) *SchedulerUsecase {
+	return &SchedulerUsecase{
+		repo:   repo,
+		locker: locker,
+		log:    log.NewHelper(logger),
+	}
+}
+
+// RunExclusive 保证每个按 interval 对齐的时间窗口内任务只在一个副本执行一次。
+// 锁以窗口起点为键且执行结束后不释放，其他副本在同一窗口内触发时直接跳过；
+// 锁保留两个窗口，容忍副本间的时钟偏差，持有锁的副本崩溃后下一个窗口可由其他副本接管
+func (uc *SchedulerUsecase) RunExclusive(ctx context.Context, name string, interval time.Duration, fn func(context.Context) error) error {
+	window := time.Now().Truncate(interval)
+	key := fmt.Sprintf("%s:%d", name, window.Unix())
+	_, ok, err := uc.locker.TryLock(ctx, key, 2*interval)
+	if err != nil {
+		return fmt.Errorf("failed to acquire job lock %s: %w", name, err)
+	}
+	if !ok {
+		uc.log.WithContext(ctx).Debugf("job %s already ran in window %s, skipped", name, window.Format(time.RFC3339))
+		return nil
+	}
+	return fn(ctx)
+}
+
+// ResetQuotas 重置已到达边界的日/月配额，下一次重置时间按指定时区的零点计算
+func (uc *SchedulerUsecase) ResetQuotas(ctx context.Context, now time.Time, loc *time.Location) error {
+	local := now.In(loc)
+	today := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	boundaries := []struct {
+		period string
+		next   time.Time
+	}{
+		{ResetPeriodDaily, today.AddDate(0, 0, 1)},
+		{ResetPeriodMonthly, time.Date(local.Year(), local.Month()+1, 1, 0, 0, 0, 0, loc)},
+	}
+
+	for _, b := range boundaries {
+		n, err := uc.repo.ResetExpiredQuotas(ctx, b.period, now, b.next)
+		if err != nil {
+			return fmt.Errorf("failed to reset %s quotas: %w", b.period, err)
+		}
+		if n > 0 {
+			uc.log.WithContext(ctx).Infof("reset %d %s quotas, next reset at %s", n, b.period, b.next.Format(time.RFC3339))
+		}
+	}
+	return nil
+}
+
+// RollupUsage 重新汇总今天与昨天的使用统计，结果覆盖写入，重复执行不会重复累加
+func (uc *SchedulerUsecase) RollupUsage(ctx context.Context, now time.Time, loc *time.Location) error {
+	local := now.In(loc)
+	today := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+
+	for _, start := range []time.Time{today.AddDate(0, 0, -1), today} {
+		date := start.Format("2006-01-02")
+		rollups, err := uc.repo.AggregateUsage(ctx, start, start.AddDate(0, 0, 1))
+		if err != nil {
+			return fmt.Errorf("failed to aggregate usage for %s: %w", date, err)
+		}
+		if err := uc.repo.SaveUsageStats(ctx, date, rollups); err != nil {
+			return fmt.Errorf("failed to save usage stats for %s: %w", date, err)
+		}
+	}
+	return nil
+}
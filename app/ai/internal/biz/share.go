@@ -0,0 +1,443 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"universal/app/ai/internal/data/model"
+	"universal/pkg/identity"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrShareForbidden 无权执行共享操作
+	ErrShareForbidden = errors.Forbidden("SHARE_FORBIDDEN", "no permission to manage sharing of this resource")
+	// ErrResourceForbidden 调用方对资源没有所需的权限
+	ErrResourceForbidden = errors.Forbidden("RESOURCE_FORBIDDEN", "no permission to access this resource")
+	// ErrShareInvalid 共享参数不合法
+	ErrShareInvalid = errors.BadRequest("SHARE_INVALID", "invalid share request")
+	// ErrSnapshotNotFound 快照不存在或已过期
+	ErrSnapshotNotFound = errors.NotFound("SNAPSHOT_NOT_FOUND", "conversation snapshot not found or expired")
+)
+
+const (
+	// snapshotTokenBytes 快照令牌随机字节数，base64url编码后为43个字符
+	snapshotTokenBytes = 32
+	// snapshotMaxMessages 单个快照最多冻结的消息数
+	snapshotMaxMessages = 1000
+	// snapshotPageSize 生成快照时分页读取消息的大小
+	snapshotPageSize = 200
+)
+
+// ShareRepo 共享仓库接口
+type ShareRepo interface {
+	// 授权管理
+	SaveShare(ctx context.Context, share *model.ResourceShare) (*model.ResourceShare, error)
+	GetShare(ctx context.Context, resourceType string, resourceID, granteeID int64) (*model.ResourceShare, error)
+	DeleteShare(ctx context.Context, resourceType string, resourceID, granteeID int64) error
+	ListShares(ctx context.Context, resourceType string, resourceID int64) ([]*model.ResourceShare, error)
+
+	// 公开快照
+	CreateSnapshot(ctx context.Context, snapshot *model.ConversationSnapshot) (*model.ConversationSnapshot, error)
+	GetSnapshotByToken(ctx context.Context, token string) (*model.ConversationSnapshot, error)
+	ListSnapshots(ctx context.Context, conversationID int64) ([]*model.ConversationSnapshot, error)
+	DeleteSnapshot(ctx context.Context, token string) error
+	IncrementSnapshotViews(ctx context.Context, id int64) error
+}
+
+// ShareUsecase 共享与协作业务逻辑
+type ShareUsecase struct {
+	repo       ShareRepo
+	kbRepo     KnowledgeRepo
+	convRepo   ConversationRepo
+	workspaces *WorkspaceUsecase
+	logger     *log.Helper
+}
+
+// NewShareUsecase 创建共享业务逻辑实例
+func NewShareUsecase(repo ShareRepo, kbRepo KnowledgeRepo, convRepo ConversationRepo, workspaces *WorkspaceUsecase, logger log.Logger) *ShareUsecase {
+	return &ShareUsecase{
+		repo:       repo,
+		kbRepo:     kbRepo,
+		convRepo:   convRepo,
+		workspaces: workspaces,
+		logger:     log.NewHelper(logger),
+	}
+}
+
+// Authorize 校验网关验证的调用方对资源的权限不低于 minRole，返回实际权限。
+// 所有者、被授权用户与资源所属工作空间的成员可访问，取其中最高的权限
+func (uc *ShareUsecase) Authorize(ctx context.Context, resourceType string, resourceID, ownerID, workspaceID int64, minRole int) (int, error) {
+	userID := identity.FromContext(ctx)
+	role, err := uc.accessRole(ctx, resourceType, resourceID, ownerID, userID)
+	if err != nil {
+		return 0, err
+	}
+	if role < minRole && workspaceID > 0 && userID > 0 {
+		ws, err := uc.workspaces.Membership(ctx, workspaceID, userID)
+		switch {
+		case err == nil:
+			role = max(role, workspaceAccessRole(ws.Role))
+		case !errors.Is(err, ErrWorkspaceForbidden):
+			return 0, err
+		}
+	}
+	if role < minRole {
+		return 0, ErrResourceForbidden
+	}
+	return role, nil
+}
+
+// verifiedCaller 返回网关验证的调用方ID，请求携带的 userID 非零且与调用方不一致时拒绝
+func verifiedCaller(ctx context.Context, userID int64) (int64, error) {
+	caller := identity.FromContext(ctx)
+	if caller <= 0 || (userID != 0 && userID != caller) {
+		return 0, ErrResourceForbidden
+	}
+	return caller, nil
+}
+
+// ShareKnowledgeBase 共享知识库
+func (uc *ShareUsecase) ShareKnowledgeBase(ctx context.Context, kbID, granteeID int64, role int, expiresAt *time.Time) (*model.ResourceShare, error) {
+	return uc.grant(ctx, model.ShareResourceKnowledgeBase, kbID, granteeID, role, expiresAt)
+}
+
+// RevokeKnowledgeBaseShare 撤销知识库共享
+func (uc *ShareUsecase) RevokeKnowledgeBaseShare(ctx context.Context, kbID, granteeID int64) error {
+	return uc.revoke(ctx, model.ShareResourceKnowledgeBase, kbID, granteeID)
+}
+
+// ListKnowledgeBaseShares 获取知识库共享列表
+func (uc *ShareUsecase) ListKnowledgeBaseShares(ctx context.Context, kbID int64) ([]*model.ResourceShare, error) {
+	return uc.list(ctx, model.ShareResourceKnowledgeBase, kbID)
+}
+
+// ShareConversation 共享对话
+func (uc *ShareUsecase) ShareConversation(ctx context.Context, conversationID, granteeID int64, role int, expiresAt *time.Time) (*model.ResourceShare, error) {
+	return uc.grant(ctx, model.ShareResourceConversation, conversationID, granteeID, role, expiresAt)
+}
+
+// RevokeConversationShare 撤销对话共享
+func (uc *ShareUsecase) RevokeConversationShare(ctx context.Context, conversationID, granteeID int64) error {
+	return uc.revoke(ctx, model.ShareResourceConversation, conversationID, granteeID)
+}
+
+// ListConversationShares 获取对话共享列表及已发布的快照
+func (uc *ShareUsecase) ListConversationShares(ctx context.Context, conversationID int64) ([]*model.ResourceShare, []*model.ConversationSnapshot, error) {
+	shares, err := uc.list(ctx, model.ShareResourceConversation, conversationID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snapshots, err := uc.repo.ListSnapshots(ctx, conversationID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return shares, snapshots, nil
+}
+
+// PublishConversationSnapshot 以网关验证的调用方身份发布对话公开快照
+func (uc *ShareUsecase) PublishConversationSnapshot(ctx context.Context, conversationID int64, expiresIn time.Duration) (*model.ConversationSnapshot, error) {
+	operatorID := identity.FromContext(ctx)
+	conversation, err := uc.convRepo.GetConversation(ctx, conversationID)
+	if err != nil {
+		return nil, fmt.Errorf("conversation not found: %w", err)
+	}
+
+	role, err := uc.accessRole(ctx, model.ShareResourceConversation, conversationID, conversation.UserID, operatorID)
+	if err != nil {
+		return nil, err
+	}
+	if role < model.ShareRoleAdmin {
+		return nil, ErrShareForbidden
+	}
+
+	messages, err := uc.collectSnapshotMessages(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := generateSnapshotToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	snapshot := &model.ConversationSnapshot{
+		Token:          token,
+		ConversationID: conversationID,
+		OwnerID:        operatorID,
+		Title:          conversation.Title,
+		ModelName:      conversation.ModelName,
+		Messages:       messages,
+		MessageCount:   len(messages),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if expiresIn > 0 {
+		expiresAt := now.Add(expiresIn)
+		snapshot.ExpiresAt = &expiresAt
+	}
+
+	snapshot, err = uc.repo.CreateSnapshot(ctx, snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Infow("conversation snapshot published", "conversation_id", conversationID, "operator_id", operatorID, "messages", len(messages))
+
+	return snapshot, nil
+}
+
+// RevokeConversationSnapshot 以网关验证的调用方身份撤销对话公开快照
+func (uc *ShareUsecase) RevokeConversationSnapshot(ctx context.Context, token string) error {
+	operatorID := identity.FromContext(ctx)
+	if operatorID <= 0 {
+		return ErrShareForbidden
+	}
+
+	snapshot, err := uc.repo.GetSnapshotByToken(ctx, token)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return ErrSnapshotNotFound
+	}
+
+	// 发布者本人可以直接撤销，否则需要对话的管理员权限
+	if snapshot.OwnerID != operatorID {
+		conversation, err := uc.convRepo.GetConversation(ctx, snapshot.ConversationID)
+		if err != nil {
+			return fmt.Errorf("conversation not found: %w", err)
+		}
+		role, err := uc.accessRole(ctx, model.ShareResourceConversation, conversation.ID, conversation.UserID, operatorID)
+		if err != nil {
+			return err
+		}
+		if role < model.ShareRoleAdmin {
+			return ErrShareForbidden
+		}
+	}
+
+	return uc.repo.DeleteSnapshot(ctx, token)
+}
+
+// GetConversationSnapshot 通过令牌获取公开快照，无需调用者身份
+func (uc *ShareUsecase) GetConversationSnapshot(ctx context.Context, token string) (*model.ConversationSnapshot, error) {
+	if token == "" {
+		return nil, ErrSnapshotNotFound
+	}
+
+	snapshot, err := uc.repo.GetSnapshotByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == nil || (snapshot.ExpiresAt != nil && snapshot.ExpiresAt.Before(time.Now())) {
+		return nil, ErrSnapshotNotFound
+	}
+
+	if err := uc.repo.IncrementSnapshotViews(ctx, snapshot.ID); err != nil {
+		uc.logger.Warnw("failed to increment snapshot views", "snapshot_id", snapshot.ID, "error", err)
+	} else {
+		snapshot.ViewCount++
+	}
+
+	return snapshot, nil
+}
+
+// grant 以网关验证的调用方身份授予或更新共享权限
+func (uc *ShareUsecase) grant(ctx context.Context, resourceType string, resourceID, granteeID int64, role int, expiresAt *time.Time) (*model.ResourceShare, error) {
+	operatorID := identity.FromContext(ctx)
+	if granteeID <= 0 || role < model.ShareRoleViewer || role > model.ShareRoleAdmin {
+		return nil, ErrShareInvalid
+	}
+	if expiresAt != nil && expiresAt.Before(time.Now()) {
+		return nil, ErrShareInvalid
+	}
+
+	ownerID, err := uc.resourceOwner(ctx, resourceType, resourceID)
+	if err != nil {
+		return nil, err
+	}
+	if granteeID == ownerID {
+		return nil, ErrShareInvalid
+	}
+
+	operatorRole, err := uc.accessRole(ctx, resourceType, resourceID, ownerID, operatorID)
+	if err != nil {
+		return nil, err
+	}
+	// 管理员可以授予只读和编辑权限，授予管理员权限只能由所有者操作
+	if operatorRole < model.ShareRoleAdmin || (role >= model.ShareRoleAdmin && operatorRole < model.ShareRoleOwner) {
+		return nil, ErrShareForbidden
+	}
+
+	// 已有管理员授权只能由所有者调整
+	existing, err := uc.repo.GetShare(ctx, resourceType, resourceID, granteeID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && existing.Role >= model.ShareRoleAdmin && operatorRole < model.ShareRoleOwner {
+		return nil, ErrShareForbidden
+	}
+
+	share := &model.ResourceShare{
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		OwnerID:      ownerID,
+		GranteeID:    granteeID,
+		Role:         role,
+		GrantedBy:    operatorID,
+		ExpiresAt:    expiresAt,
+	}
+
+	share, err = uc.repo.SaveShare(ctx, share)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Infow("resource shared", "resource_type", resourceType, "resource_id", resourceID, "grantee_id", granteeID, "role", role)
+
+	return share, nil
+}
+
+// revoke 以网关验证的调用方身份撤销共享权限，被授权用户可以主动退出
+func (uc *ShareUsecase) revoke(ctx context.Context, resourceType string, resourceID, granteeID int64) error {
+	if granteeID <= 0 {
+		return ErrShareInvalid
+	}
+	operatorID := identity.FromContext(ctx)
+	if operatorID <= 0 {
+		return ErrShareForbidden
+	}
+
+	if operatorID != granteeID {
+		ownerID, err := uc.resourceOwner(ctx, resourceType, resourceID)
+		if err != nil {
+			return err
+		}
+		operatorRole, err := uc.accessRole(ctx, resourceType, resourceID, ownerID, operatorID)
+		if err != nil {
+			return err
+		}
+		if operatorRole < model.ShareRoleAdmin {
+			return ErrShareForbidden
+		}
+
+		existing, err := uc.repo.GetShare(ctx, resourceType, resourceID, granteeID)
+		if err != nil {
+			return err
+		}
+		if existing != nil && existing.Role >= model.ShareRoleAdmin && operatorRole < model.ShareRoleOwner {
+			return ErrShareForbidden
+		}
+	}
+
+	return uc.repo.DeleteShare(ctx, resourceType, resourceID, granteeID)
+}
+
+// list 获取资源的共享列表，任何有访问权限的调用方都可以查看
+func (uc *ShareUsecase) list(ctx context.Context, resourceType string, resourceID int64) ([]*model.ResourceShare, error) {
+	operatorID := identity.FromContext(ctx)
+	ownerID, err := uc.resourceOwner(ctx, resourceType, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	operatorRole, err := uc.accessRole(ctx, resourceType, resourceID, ownerID, operatorID)
+	if err != nil {
+		return nil, err
+	}
+	if operatorRole < model.ShareRoleViewer {
+		return nil, ErrShareForbidden
+	}
+
+	return uc.repo.ListShares(ctx, resourceType, resourceID)
+}
+
+// resourceOwner 获取资源所有者
+func (uc *ShareUsecase) resourceOwner(ctx context.Context, resourceType string, resourceID int64) (int64, error) {
+	switch resourceType {
+	case model.ShareResourceKnowledgeBase:
+		kb, err := uc.kbRepo.GetKnowledgeBase(ctx, resourceID)
+		if err != nil {
+			return 0, fmt.Errorf("knowledge base not found: %w", err)
+		}
+		return kb.UserID, nil
+	case model.ShareResourceConversation:
+		conversation, err := uc.convRepo.GetConversation(ctx, resourceID)
+		if err != nil {
+			return 0, fmt.Errorf("conversation not found: %w", err)
+		}
+		return conversation.UserID, nil
+	default:
+		return 0, ErrShareInvalid
+	}
+}
+
+// accessRole 计算用户对资源的权限，无权限时返回0
+func (uc *ShareUsecase) accessRole(ctx context.Context, resourceType string, resourceID, ownerID, userID int64) (int, error) {
+	if userID <= 0 {
+		return 0, nil
+	}
+	if userID == ownerID {
+		return model.ShareRoleOwner, nil
+	}
+
+	share, err := uc.repo.GetShare(ctx, resourceType, resourceID, userID)
+	if err != nil {
+		return 0, err
+	}
+	if share == nil {
+		return 0, nil
+	}
+	return share.Role, nil
+}
+
+// collectSnapshotMessages 读取对话消息并冻结为快照内容，跳过系统消息和已删除消息
+func (uc *ShareUsecase) collectSnapshotMessages(ctx context.Context, conversationID int64) (model.SnapshotMessages, error) {
+	var messages model.SnapshotMessages
+	for page := int32(1); len(messages) < snapshotMaxMessages; page++ {
+		batch, total, err := uc.convRepo.ListMessages(ctx, conversationID, page, snapshotPageSize, MessageFilter{})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, msg := range batch {
+			if msg.Status == 5 || msg.Role == "system" {
+				continue
+			}
+			messages = append(messages, model.SnapshotMessage{
+				ID:        msg.ID,
+				Role:      msg.Role,
+				Content:   msg.Content,
+				ModelUsed: msg.ModelUsed,
+				CreatedAt: msg.CreatedAt,
+			})
+			if len(messages) >= snapshotMaxMessages {
+				break
+			}
+		}
+
+		if len(batch) < snapshotPageSize || int64(page)*snapshotPageSize >= total {
+			break
+		}
+	}
+
+	return messages, nil
+}
+
+// generateSnapshotToken 生成不可猜测的快照访问令牌
+func generateSnapshotToken() (string, error) {
+	b := make([]byte, snapshotTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate snapshot token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
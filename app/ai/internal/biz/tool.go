@@ -5,24 +5,41 @@ import (
 	"time"
 
 	"universal/app/ai/internal/data/model"
+	"universal/pkg/identity"
+	"universal/pkg/idgen"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
+// ErrMcpServerNotFound MCP服务器不存在或不属于当前工作空间
+var ErrMcpServerNotFound = errors.NotFound("MCP_SERVER_NOT_FOUND", "mcp server not found")
+
 // ToolUsecase 工具业务逻辑
 type ToolUsecase struct {
-	repo   ToolRepo
-	logger *log.Helper
+	repo          ToolRepo
+	conversations ConversationRepo
+	quotaRepo     QuotaRepo
+	modelRepo     ModelRepo
+	workspaces    *WorkspaceUsecase
+	ids           *idgen.Generator
+	logger        *log.Helper
 }
 
-// ToolRepo 工具仓库接口
-type ToolRepo interface {
-	// MCP服务器管理
+// McpServerRepo MCP服务器仓库接口，查询只返回指定工作空间及全局可用的服务器
+type McpServerRepo interface {
 	CreateMcpServer(ctx context.Context, server *model.McpServer) (*model.McpServer, error)
-	GetMcpServer(ctx context.Context, id string) (*model.McpServer, error)
+	// GetMcpServer 获取服务器，不存在或不属于该工作空间时返回nil
+	GetMcpServer(ctx context.Context, id string, workspaceID int64) (*model.McpServer, error)
 	UpdateMcpServer(ctx context.Context, server *model.McpServer) (*model.McpServer, error)
 	DeleteMcpServer(ctx context.Context, id string, forceDelete bool) error
 	ListMcpServers(ctx context.Context, page, pageSize int32, filters McpServerFilter) ([]*model.McpServer, int64, error)
+}
+
+// ToolRepo 工具仓库接口
+type ToolRepo interface {
+	// MCP服务器管理
+	McpServerRepo
 	TestMcpServer(ctx context.Context, id string, testCases []string) ([]TestResult, error)
 
 	// 工具管理
@@ -62,6 +79,7 @@ type McpServerFilter struct {
 	Status       int32
 	Tags         []string
 	IncludeStats bool
+	WorkspaceID  int64 // 当前工作空间，结果包含该空间及全局可用的服务器
 }
 
 // ToolFilter 工具过滤条件
@@ -206,23 +224,59 @@ type BatchToolResult struct {
 }
 
 // NewToolUsecase 创建工具业务逻辑实例
-func NewToolUsecase(repo ToolRepo, logger log.Logger) *ToolUsecase {
+func NewToolUsecase(repo ToolRepo, conversations ConversationRepo, quotaRepo QuotaRepo, modelRepo ModelRepo, workspaces *WorkspaceUsecase, ids *idgen.Generator, logger log.Logger) *ToolUsecase {
 	return &ToolUsecase{
-		repo:   repo,
-		logger: log.NewHelper(logger),
+		repo:          repo,
+		conversations: conversations,
+		quotaRepo:     quotaRepo,
+		modelRepo:     modelRepo,
+		workspaces:    workspaces,
+		ids:           ids,
+		logger:        log.NewHelper(logger),
 	}
 }
 
+// activeWorkspaceID 当前工作空间ID，校验网关验证的调用方是该空间的成员，个人空间返回0
+func (uc *ToolUsecase) activeWorkspaceID(ctx context.Context) (int64, error) {
+	return uc.workspaces.ActiveID(ctx, identity.FromContext(ctx))
+}
+
+// getMcpServer 获取当前工作空间可见的服务器
+func (uc *ToolUsecase) getMcpServer(ctx context.Context, id string) (*model.McpServer, error) {
+	workspaceID, err := uc.activeWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	server, err := uc.repo.GetMcpServer(ctx, id, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if server == nil {
+		return nil, ErrMcpServerNotFound
+	}
+	return server, nil
+}
+
 // RegisterMcpServer 注册MCP服务器
 func (uc *ToolUsecase) RegisterMcpServer(ctx context.Context, name, description, endpoint string, config model.McpServerConfig, metadata map[string]string, tags []string) (*model.McpServer, error) {
-	now := time.Now()
+	// 归属当前工作空间，调用方须是该空间的成员
+	workspaceID, err := uc.activeWorkspaceID(ctx)
+	if err != nil {
+		return nil, err
+	}
 
+	serverID, err := uc.generateServerID()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
 	server := &model.McpServer{
-		ID:          uc.generateServerID(),
+		ID:          serverID,
 		Name:        name,
 		Description: description,
 		Endpoint:    endpoint,
 		Status:      1, // active
+		WorkspaceID: workspaceID,
 		Config:      config,
 		Metadata:    model.KeyValueMap(metadata),
 		Tags:        model.StringSlice(tags),
@@ -250,7 +304,7 @@ func (uc *ToolUsecase) RegisterMcpServer(ctx context.Context, name, description,
 
 // GetMcpServer 获取MCP服务器
 func (uc *ToolUsecase) GetMcpServer(ctx context.Context, id string, includeStats, includeHealth bool) (*model.McpServer, error) {
-	server, err := uc.repo.GetMcpServer(ctx, id)
+	server, err := uc.getMcpServer(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -262,10 +316,15 @@ func (uc *ToolUsecase) GetMcpServer(ctx context.Context, id string, includeStats
 
 // ListMcpServers 列出MCP服务器
 func (uc *ToolUsecase) ListMcpServers(ctx context.Context, page, pageSize int32, statusFilter int32, tags []string, includeStats bool) ([]*model.McpServer, int64, error) {
+	workspaceID, err := uc.activeWorkspaceID(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
 	filter := McpServerFilter{
 		Status:       statusFilter,
 		Tags:         tags,
 		IncludeStats: includeStats,
+		WorkspaceID:  workspaceID,
 	}
 
 	return uc.repo.ListMcpServers(ctx, page, pageSize, filter)
@@ -273,6 +332,9 @@ func (uc *ToolUsecase) ListMcpServers(ctx context.Context, page, pageSize int32,
 
 // TestMcpServer 测试MCP服务器
 func (uc *ToolUsecase) TestMcpServer(ctx context.Context, id string, testCases []string) ([]TestResult, *ServerHealthStatus, error) {
+	if _, err := uc.getMcpServer(ctx, id); err != nil {
+		return nil, nil, err
+	}
 	results, err := uc.repo.TestMcpServer(ctx, id, testCases)
 	if err != nil {
 		return nil, nil, err
@@ -352,8 +414,12 @@ func (uc *ToolUsecase) CallTool(ctx context.Context, req ToolCallRequest) (*Tool
 	}
 
 	// 创建执行记录
+	executionID, err := uc.generateExecutionID()
+	if err != nil {
+		return nil, err
+	}
 	execution := &model.ToolExecution{
-		ID:             uc.generateExecutionID(),
+		ID:             executionID,
 		ToolID:         tool.ID,
 		ToolName:       req.Name,
 		UserID:         req.UserID,
@@ -374,7 +440,7 @@ func (uc *ToolUsecase) CallTool(ctx context.Context, req ToolCallRequest) (*Tool
 	// 如果是异步执行
 	if req.Async {
 		// 启动异步执行
-		go uc.executeToolAsync(context.Background(), execution)
+		go uc.executeToolAsync(context.Background(), execution, tool)
 
 		return &ToolCallResponse{
 			ExecutionID: execution.ID,
@@ -383,7 +449,7 @@ func (uc *ToolUsecase) CallTool(ctx context.Context, req ToolCallRequest) (*Tool
 	}
 
 	// 同步执行
-	return uc.executeTool(ctx, execution)
+	return uc.executeTool(ctx, execution, tool)
 }
 
 // BatchCallTools 批量调用工具
@@ -456,7 +522,7 @@ func (uc *ToolUsecase) GetToolExecutionStats(ctx context.Context, toolName strin
 }
 
 // 私有方法
-func (uc *ToolUsecase) executeTool(ctx context.Context, execution *model.ToolExecution) (*ToolCallResponse, error) {
+func (uc *ToolUsecase) executeTool(ctx context.Context, execution *model.ToolExecution, tool *model.Tool) (*ToolCallResponse, error) {
 	startTime := time.Now()
 
 	// 更新状态为运行中
@@ -493,13 +559,14 @@ func (uc *ToolUsecase) executeTool(ctx context.Context, execution *model.ToolExe
 	execution.Metrics = model.ExecutionMetrics{
 		MemoryUsed: 1024 * 1024, // 1MB
 		CPUUsage:   0.1,         // 10%
-		Cost:       0.001,       // $0.001
+		Cost:       PriceToolCall(tool, execution.Status),
 	}
 
 	err = uc.repo.UpdateToolExecution(ctx, execution)
 	if err != nil {
 		uc.logger.Warnw("failed to update execution result", "execution_id", execution.ID, "error", err)
 	}
+	uc.chargeToolCall(ctx, execution)
 
 	return &ToolCallResponse{
 		ExecutionID: execution.ID,
@@ -509,19 +576,49 @@ func (uc *ToolUsecase) executeTool(ctx context.Context, execution *model.ToolExe
 	}, nil
 }
 
-func (uc *ToolUsecase) executeToolAsync(ctx context.Context, execution *model.ToolExecution) {
-	_, err := uc.executeTool(ctx, execution)
+// chargeToolCall 将对话中工具调用的费用计入对话总费用与使用统计，使用统计归属对话所用的模型
+func (uc *ToolUsecase) chargeToolCall(ctx context.Context, execution *model.ToolExecution) {
+	cost := execution.Metrics.Cost
+	if cost <= 0 || execution.ConversationID == 0 {
+		return
+	}
+	conversation, err := uc.conversations.GetConversation(ctx, execution.ConversationID)
+	if err != nil {
+		uc.logger.Warnw("failed to get conversation for tool cost", "execution_id", execution.ID, "error", err)
+		return
+	}
+	if err := uc.conversations.UpdateConversationStats(ctx, conversation.ID, 0, 0, 0, cost); err != nil {
+		uc.logger.Warnw("failed to add tool cost to conversation", "execution_id", execution.ID, "error", err)
+	}
+
+	m, err := uc.modelRepo.GetModelByName(ctx, conversation.ModelName)
+	if err != nil || m == nil {
+		uc.logger.Warnw("failed to resolve model for tool cost", "execution_id", execution.ID, "model", conversation.ModelName, "error", err)
+		return
+	}
+	err = uc.quotaRepo.RecordUsage(ctx, &UsageEvent{
+		UserID:      conversation.UserID,
+		WorkspaceID: conversation.WorkspaceID,
+		ModelID:     m.ID,
+		ToolCall:    true,
+		Cost:        cost,
+	})
+	if err != nil {
+		uc.logger.Warnw("failed to record tool usage", "execution_id", execution.ID, "error", err)
+	}
+}
+
+func (uc *ToolUsecase) executeToolAsync(ctx context.Context, execution *model.ToolExecution, tool *model.Tool) {
+	_, err := uc.executeTool(ctx, execution, tool)
 	if err != nil {
 		uc.logger.Errorw("async tool execution failed", "execution_id", execution.ID, "error", err)
 	}
 }
 
-func (uc *ToolUsecase) generateServerID() string {
-	// TODO: 生成唯一的服务器ID
-	return "server_" + time.Now().Format("20060102150405")
+func (uc *ToolUsecase) generateServerID() (string, error) {
+	return uc.ids.GenerateString("server_")
 }
 
-func (uc *ToolUsecase) generateExecutionID() string {
-	// TODO: 生成唯一的执行ID
-	return "exec_" + time.Now().Format("20060102150405000")
+func (uc *ToolUsecase) generateExecutionID() (string, error) {
+	return uc.ids.GenerateString("exec_")
 }
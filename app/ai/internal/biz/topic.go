@@ -0,0 +1,369 @@
+package biz
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "universal/api/ai/v1"
+	"universal/pkg/textseg"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultTopicCount = 10
+	maxTopicCount     = 50
+	// topicSyncRange 超过该时间范围的话题分析转为后台任务
+	topicSyncRange = 7 * 24 * time.Hour
+	// topicWindow 分析区间按该粒度对齐，使相同查询命中同一缓存
+	topicWindow = 15 * time.Minute
+	// topicSyncTTL / topicAsyncTTL 同步与后台分析结果的缓存时长
+	topicSyncTTL  = 5 * time.Minute
+	topicAsyncTTL = 6 * time.Hour
+	// topicJobTimeout 后台分析任务超时时间，同时作为任务锁有效期
+	topicJobTimeout = 30 * time.Minute
+	// topicMaxDocuments 参与建模的消息数上限，超出时均匀抽样
+	topicMaxDocuments = 20000
+	// topicRepresentatives 每个话题输出的代表性消息与对话数
+	topicRepresentatives = 3
+	topicMaxMessageRunes = 200
+	// topicSeed 随机数种子，保证相同语料得到相同结果
+	topicSeed = 20240601
+)
+
+// Embedder 文本向量化，用于知识库索引与语义话题聚类
+type Embedder interface {
+	// Embed 使用指定向量模型向量化，model 为空时使用配置的默认向量模型
+	Embed(ctx context.Context, model string, texts []string) ([][]float64, error)
+}
+
+// TopicCacheRepo 话题分析结果缓存
+type TopicCacheRepo interface {
+	// GetTopicReport 获取缓存结果，不存在时返回nil
+	GetTopicReport(ctx context.Context, key string) (*pb.GetTopicAnalysisReply, error)
+	SaveTopicReport(ctx context.Context, key string, report *pb.GetTopicAnalysisReply, ttl time.Duration) error
+}
+
+// TopicQuery 话题分析参数
+type TopicQuery struct {
+	Scope       *AnalyticsScope
+	Method      pb.TopicAnalysisMethod
+	TopN        int32
+	Granularity pb.AnalyticsGranularity
+	Refresh     bool // 忽略缓存重新分析
+}
+
+// key 缓存键，由对齐后的查询参数计算
+func (q *TopicQuery) key() string {
+	models := append([]string(nil), q.Scope.ModelNames...)
+	sort.Strings(models)
+	workspace := "*"
+	if q.Scope.WorkspaceID != nil {
+		workspace = strconv.FormatInt(*q.Scope.WorkspaceID, 10)
+	}
+	raw := strings.Join([]string{
+		q.Method.String(), strconv.FormatInt(q.Scope.UserID, 10), workspace, strings.Join(models, ","), q.Scope.Role,
+		strconv.FormatInt(q.Scope.Start.Unix(), 10), strconv.FormatInt(q.Scope.End.Unix(), 10),
+		strconv.Itoa(int(q.TopN)), q.Granularity.String(),
+	}, "|")
+	sum := sha1.Sum([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+// GetTopicAnalysis 话题分析，大时间范围转为后台任务，完成前返回PENDING，调用方以相同参数轮询获取结果
+func (uc *AiUsecase) GetTopicAnalysis(ctx context.Context, query *TopicQuery) (*pb.GetTopicAnalysisReply, error) {
+	if query.TopN <= 0 {
+		query.TopN = defaultTopicCount
+	}
+	if query.TopN > maxTopicCount {
+		return nil, errAnalyticsInvalidArgument(fmt.Sprintf("top_topics_count must not exceed %d", maxTopicCount))
+	}
+	if query.Method == pb.TopicAnalysisMethod_TOPIC_ANALYSIS_METHOD_UNSPECIFIED {
+		query.Method = pb.TopicAnalysisMethod_TOPIC_ANALYSIS_METHOD_KEYWORD
+	}
+	if query.Granularity == pb.AnalyticsGranularity_ANALYTICS_GRANULARITY_UNSPECIFIED {
+		query.Granularity = pb.AnalyticsGranularity_ANALYTICS_GRANULARITY_DAY
+	}
+
+	scope := *query.Scope
+	scope.Start = scope.Start.Truncate(topicWindow)
+	if end := scope.End.Truncate(topicWindow); end.Before(scope.End) {
+		scope.End = end.Add(topicWindow)
+	}
+	scope.Role = "user"
+	scope.WithContent = true
+	query.Scope = &scope
+	// 提前校验趋势分桶，避免后台任务失败
+	if _, err := newTimeSeries(scope.Start, scope.End, query.Granularity); err != nil {
+		return nil, err
+	}
+
+	key := query.key()
+	jobID := key[:16]
+	if !query.Refresh {
+		cached, err := uc.topicCache.GetTopicReport(ctx, key)
+		if err != nil {
+			uc.log.WithContext(ctx).Warnf("failed to get cached topic report %s: %v", jobID, err)
+		} else if cached != nil {
+			return cached, nil
+		}
+	}
+
+	if scope.End.Sub(scope.Start) <= topicSyncRange {
+		report, err := uc.analyzeTopics(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		report.JobId = jobID
+		uc.saveTopicReport(ctx, key, report, topicSyncTTL)
+		return report, nil
+	}
+
+	release, ok, err := uc.locker.TryLock(ctx, "topic:"+jobID, topicJobTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock topic job: %w", err)
+	}
+	if ok {
+		jobCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), topicJobTimeout)
+		go func() {
+			defer cancel()
+			defer release()
+
+			started := time.Now()
+			report, err := uc.analyzeTopics(jobCtx, query)
+			if err != nil {
+				uc.log.Errorf("topic job %s failed: %v", jobID, err)
+				return
+			}
+			report.JobId = jobID
+			uc.saveTopicReport(jobCtx, key, report, topicAsyncTTL)
+			uc.log.Infof("topic job %s finished in %s, %d topics", jobID, time.Since(started), len(report.Topics))
+		}()
+	}
+	return &pb.GetTopicAnalysisReply{
+		Status: pb.TopicAnalysisStatus_TOPIC_ANALYSIS_STATUS_PENDING,
+		JobId:  jobID,
+	}, nil
+}
+
+func (uc *AiUsecase) saveTopicReport(ctx context.Context, key string, report *pb.GetTopicAnalysisReply, ttl time.Duration) {
+	if err := uc.topicCache.SaveTopicReport(ctx, key, report, ttl); err != nil {
+		uc.log.WithContext(ctx).Warnf("failed to cache topic report %s: %v", report.JobId, err)
+	}
+}
+
+// analyzeTopics 加载用户消息并按指定方法建模
+func (uc *AiUsecase) analyzeTopics(ctx context.Context, query *TopicQuery) (*pb.GetTopicAnalysisReply, error) {
+	rng := rand.New(rand.NewSource(topicSeed))
+	seg := textseg.Default()
+
+	// 蓄水池抽样，限制内存占用
+	var docs []*topicDoc
+	var seen int
+	err := uc.repo.ScanMessages(ctx, query.Scope, func(m *MessageEvent) error {
+		tokens := seg.Keywords(m.Content)
+		if len(tokens) == 0 {
+			return nil
+		}
+		seen++
+		doc := &topicDoc{
+			messageID:      m.ID,
+			conversationID: m.ConversationID,
+			content:        m.Content,
+			createdAt:      m.CreatedAt,
+			tokens:         tokens,
+		}
+		if len(docs) < topicMaxDocuments {
+			docs = append(docs, doc)
+		} else if j := rng.Intn(seen); j < topicMaxDocuments {
+			docs[j] = doc
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan messages: %w", err)
+	}
+
+	corpus := newTopicCorpus(docs, ratio(float64(seen), float64(len(docs))))
+	k := int(query.TopN)
+	var model *topicModel
+	switch {
+	case len(corpus.docs) == 0:
+		model = newTopicModel(0, 0)
+	case query.Method == pb.TopicAnalysisMethod_TOPIC_ANALYSIS_METHOD_LDA:
+		model = ldaTopics(corpus, k, rng)
+	case query.Method == pb.TopicAnalysisMethod_TOPIC_ANALYSIS_METHOD_CLUSTERING:
+		model = tfidfClusterTopics(corpus, k, rng)
+	case query.Method == pb.TopicAnalysisMethod_TOPIC_ANALYSIS_METHOD_SEMANTIC:
+		texts := make([]string, len(corpus.docs))
+		for i, d := range corpus.docs {
+			texts[i] = d.content
+		}
+		embeddings, err := uc.embedder.Embed(ctx, "", texts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to embed messages: %w", err)
+		}
+		if len(embeddings) != len(texts) {
+			return nil, fmt.Errorf("embedder returned %d vectors for %d messages", len(embeddings), len(texts))
+		}
+		model = embeddingClusterTopics(corpus, embeddings, k, rng)
+	default:
+		model = keywordTopics(corpus, k)
+	}
+	return buildTopicReport(corpus, model, query)
+}
+
+// buildTopicReport 汇总话题的消息量、代表性消息、情感与趋势
+func buildTopicReport(c *topicCorpus, m *topicModel, query *TopicQuery) (*pb.GetTopicAnalysisReply, error) {
+	members := make([][]int, len(m.weights))
+	for i, topic := range m.assign {
+		if topic >= 0 {
+			members[topic] = append(members[topic], i)
+		}
+	}
+
+	type topicSummary struct {
+		insight *pb.TopicInsight
+		count   int
+		weight  float64
+	}
+	var summaries []*topicSummary
+	var assigned int
+	for topic, docs := range members {
+		if len(docs) == 0 {
+			continue
+		}
+		assigned += len(docs)
+		sort.SliceStable(docs, func(a, b int) bool { return m.score[docs[a]] > m.score[docs[b]] })
+
+		series, err := newTimeSeries(query.Scope.Start, query.Scope.End, query.Granularity)
+		if err != nil {
+			return nil, err
+		}
+		insight := &pb.TopicInsight{
+			TopicName:    strings.Join(m.keywords[topic][:min(3, len(m.keywords[topic]))], " / "),
+			Keywords:     m.keywords[topic],
+			MessageCount: int64(math.Round(float64(len(docs)) * c.scale)),
+		}
+		var first, last time.Time
+		var sentiment float64
+		conversations := make(map[int64]struct{})
+		for _, i := range docs {
+			d := c.docs[i]
+			series.add(d.createdAt, c.scale, "", 0)
+			if first.IsZero() || d.createdAt.Before(first) {
+				first = d.createdAt
+			}
+			if d.createdAt.After(last) {
+				last = d.createdAt
+			}
+			sentiment += sentimentScore(d.content)
+
+			if len(insight.RepresentativeMessages) < topicRepresentatives {
+				insight.RepresentativeMessages = append(insight.RepresentativeMessages, truncateRunes(d.content, topicMaxMessageRunes))
+			}
+			if _, ok := conversations[d.conversationID]; !ok && len(conversations) < topicRepresentatives {
+				conversations[d.conversationID] = struct{}{}
+				insight.RepresentativeConversationIds = append(insight.RepresentativeConversationIds, d.conversationID)
+			}
+		}
+		insight.SentimentScore = sentiment / float64(len(docs))
+		insight.FirstSeen = timestamppb.New(first)
+		insight.LastSeen = timestamppb.New(last)
+		insight.Trend = series.points(insight.TopicName)
+		summaries = append(summaries, &topicSummary{insight: insight, count: len(docs), weight: m.weights[topic]})
+	}
+
+	sort.SliceStable(summaries, func(i, j int) bool { return summaries[i].weight > summaries[j].weight })
+	report := &pb.GetTopicAnalysisReply{
+		Status: pb.TopicAnalysisStatus_TOPIC_ANALYSIS_STATUS_COMPLETED,
+		Distribution: &pb.TopicDistribution{
+			TopicPercentages:    make(map[string]float64, len(summaries)),
+			TotalTopicsDetected: int32(len(summaries)),
+		},
+		GeneratedAt: timestamppb.Now(),
+	}
+	if len(summaries) > int(query.TopN) {
+		summaries = summaries[:query.TopN]
+	}
+
+	counts := make(map[string]int64, len(summaries))
+	var weights float64
+	for _, s := range summaries {
+		weights += s.weight
+	}
+	for i, s := range summaries {
+		// 不同话题的前三个关键词可能相同
+		if _, ok := report.Distribution.TopicPercentages[s.insight.TopicName]; ok {
+			s.insight.TopicName = fmt.Sprintf("%s #%d", s.insight.TopicName, i+1)
+		}
+		s.insight.RelevanceScore = ratio(s.weight, weights)
+		report.Distribution.TopicPercentages[s.insight.TopicName] = 100 * ratio(float64(s.count), float64(assigned))
+		counts[s.insight.TopicName] = int64(s.count)
+		report.Topics = append(report.Topics, s.insight)
+	}
+	report.Distribution.TopicDiversityIndex = shannonIndex(counts, int64(assigned))
+	return report, nil
+}
+
+// 情感词典，用于估计话题整体情绪倾向
+var (
+	positiveWords = wordSet("好 棒 赞 喜欢 满意 感谢 谢谢 优秀 方便 好用 清楚 有用 成功 开心 高兴 不错 完美 推荐 " +
+		"good great nice love like thanks awesome excellent helpful perfect useful works happy")
+	negativeWords = wordSet("差 烂 慢 错 坏 失败 错误 报错 异常 崩溃 问题 无法 不能 不行 讨厌 失望 麻烦 难用 卡顿 投诉 " +
+		"bad wrong error fail failed failure broken bug crash slow hate terrible awful issue problem")
+	negationWords = wordSet("不 没 没有 别 无 非 not no never")
+)
+
+func wordSet(words string) map[string]struct{} {
+	set := make(map[string]struct{})
+	for _, w := range strings.Fields(words) {
+		set[w] = struct{}{}
+	}
+	return set
+}
+
+// sentimentScore 基于情感词典的情感分数，取值[-1, 1]，情感词紧跟否定词时反转
+func sentimentScore(text string) float64 {
+	var positive, negative float64
+	negated := false
+	for _, token := range textseg.Default().Cut(text) {
+		if _, ok := negationWords[token]; ok {
+			negated = true
+			continue
+		}
+		polarity := 0
+		if _, ok := positiveWords[token]; ok {
+			polarity = 1
+		} else if _, ok := negativeWords[token]; ok {
+			polarity = -1
+		}
+		if negated {
+			polarity = -polarity
+		}
+		negated = false
+		switch polarity {
+		case 1:
+			positive++
+		case -1:
+			negative++
+		}
+	}
+	return ratio(positive-negative, positive+negative)
+}
+
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n]) + "…"
+}
@@ -0,0 +1,518 @@
+package biz
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+const (
+	// topicMaxVocabulary 词表上限，按文档频率保留
+	topicMaxVocabulary = 5000
+	// topicKeywordCount 每个话题输出的关键词数
+	topicKeywordCount = 8
+	// keywordOverlapRatio 关键词法中候选词与已选话题文档的重合比例上限
+	keywordOverlapRatio = 0.5
+	// ldaAlpha / ldaBeta LDA先验，短文本取较小的文档-话题先验
+	ldaAlpha = 0.1
+	ldaBeta  = 0.01
+	// ldaSampleBudget 吉布斯采样的总采样次数预算，决定迭代轮数
+	ldaSampleBudget  = 20_000_000
+	ldaMinIterations = 30
+	ldaMaxIterations = 200
+	// kmeansMaxIterations k-means最大迭代轮数
+	kmeansMaxIterations = 30
+)
+
+// topicDoc 参与话题分析的用户消息
+type topicDoc struct {
+	messageID      int64
+	conversationID int64
+	content        string
+	createdAt      time.Time
+	tokens         []string // 分词后的关键词
+	terms          []int    // 词表内的词ID
+}
+
+// topicCorpus 话题分析语料
+type topicCorpus struct {
+	docs  []*topicDoc
+	vocab []string
+	df    []int
+	scale float64 // 抽样后每篇文档代表的消息数
+}
+
+// topicModel 话题模型结果，各方法统一输出
+type topicModel struct {
+	keywords [][]string // 每个话题的关键词，按权重降序
+	weights  []float64  // 话题权重
+	assign   []int      // 文档所属话题，-1表示未归类
+	score    []float64  // 文档对所属话题的隶属度
+}
+
+func newTopicModel(k, n int) *topicModel {
+	m := &topicModel{
+		keywords: make([][]string, k),
+		weights:  make([]float64, k),
+		assign:   make([]int, n),
+		score:    make([]float64, n),
+	}
+	for i := range m.assign {
+		m.assign[i] = -1
+	}
+	return m
+}
+
+// newTopicCorpus 构建词表，过滤只出现一次或过于普遍的词，无有效词的文档被丢弃
+func newTopicCorpus(docs []*topicDoc, scale float64) *topicCorpus {
+	df := make(map[string]int)
+	for _, d := range docs {
+		seen := make(map[string]struct{}, len(d.tokens))
+		for _, t := range d.tokens {
+			if _, ok := seen[t]; !ok {
+				seen[t] = struct{}{}
+				df[t]++
+			}
+		}
+	}
+
+	minDF, maxDF := 1, len(docs)
+	if len(docs) >= 50 {
+		minDF, maxDF = 2, len(docs)/2
+	}
+	terms := make([]string, 0, len(df))
+	for t, n := range df {
+		if n >= minDF && n <= maxDF {
+			terms = append(terms, t)
+		}
+	}
+	sort.Slice(terms, func(i, j int) bool {
+		if df[terms[i]] != df[terms[j]] {
+			return df[terms[i]] > df[terms[j]]
+		}
+		return terms[i] < terms[j]
+	})
+	if len(terms) > topicMaxVocabulary {
+		terms = terms[:topicMaxVocabulary]
+	}
+
+	c := &topicCorpus{vocab: terms, df: make([]int, len(terms)), scale: scale}
+	index := make(map[string]int, len(terms))
+	for i, t := range terms {
+		index[t] = i
+		c.df[i] = df[t]
+	}
+	for _, d := range docs {
+		d.terms = d.terms[:0]
+		for _, t := range d.tokens {
+			if id, ok := index[t]; ok {
+				d.terms = append(d.terms, id)
+			}
+		}
+		if len(d.terms) > 0 {
+			c.docs = append(c.docs, d)
+		}
+	}
+	return c
+}
+
+func (c *topicCorpus) idf(term int) float64 {
+	return math.Log(float64(1+len(c.docs))/float64(1+c.df[term])) + 1
+}
+
+// tfidf 文档的TF-IDF稀疏向量，已做L2归一化
+func (c *topicCorpus) tfidf(d *topicDoc) map[int]float64 {
+	vec := make(map[int]float64, len(d.terms))
+	for _, t := range d.terms {
+		vec[t]++
+	}
+	var norm float64
+	for t, tf := range vec {
+		w := (1 + math.Log(tf)) * c.idf(t)
+		vec[t] = w
+		norm += w * w
+	}
+	norm = math.Sqrt(norm)
+	for t := range vec {
+		vec[t] /= norm
+	}
+	return vec
+}
+
+// keywordTopics TF-IDF关键词话题，每个高分词为一个话题，以共现词补充关键词
+func keywordTopics(c *topicCorpus, k int) *topicModel {
+	vectors := make([]map[int]float64, len(c.docs))
+	scores := make([]float64, len(c.vocab))
+	for i, d := range c.docs {
+		vectors[i] = c.tfidf(d)
+		for t, w := range vectors[i] {
+			scores[t] += w
+		}
+	}
+
+	// 与已选词在多数文档中共现的词视为同一话题
+	postings := make(map[int][]int)
+	for i, vec := range vectors {
+		for t := range vec {
+			postings[t] = append(postings[t], i)
+		}
+	}
+	var selected []int
+	covered := make(map[int]struct{})
+	for _, t := range topIndices(scores, len(scores)) {
+		if len(selected) == k {
+			break
+		}
+		var overlap int
+		for _, i := range postings[t] {
+			if _, ok := covered[i]; ok {
+				overlap++
+			}
+		}
+		if float64(overlap) > keywordOverlapRatio*float64(len(postings[t])) {
+			continue
+		}
+		selected = append(selected, t)
+		for _, i := range postings[t] {
+			covered[i] = struct{}{}
+		}
+	}
+
+	m := newTopicModel(len(selected), len(c.docs))
+	topicOf := make(map[int]int, len(selected))
+	for i, t := range selected {
+		topicOf[t] = i
+	}
+
+	cooccur := make([]map[int]float64, len(selected))
+	for i := range cooccur {
+		cooccur[i] = make(map[int]float64)
+	}
+	for i, vec := range vectors {
+		for t, w := range vec {
+			topic, ok := topicOf[t]
+			if !ok {
+				continue
+			}
+			if w > m.score[i] {
+				m.assign[i], m.score[i] = topic, w
+			}
+			for other, ow := range vec {
+				if other != t {
+					cooccur[topic][other] += ow
+				}
+			}
+		}
+	}
+
+	var total float64
+	for _, t := range selected {
+		total += scores[t]
+	}
+	for i, t := range selected {
+		m.weights[i] = ratio(scores[t], total)
+		m.keywords[i] = append([]string{c.vocab[t]}, topTerms(c, cooccur[i], topicKeywordCount-1)...)
+	}
+	return m
+}
+
+// ldaTopics 基于折叠吉布斯采样的LDA主题模型
+func ldaTopics(c *topicCorpus, k int, rng *rand.Rand) *topicModel {
+	v := len(c.vocab)
+	var tokens int
+	for _, d := range c.docs {
+		tokens += len(d.terms)
+	}
+	iterations := ldaSampleBudget / max(tokens*k, 1)
+	iterations = min(max(iterations, ldaMinIterations), ldaMaxIterations)
+
+	nw := make([]int32, v*k) // 词-话题计数
+	nd := make([]int32, len(c.docs)*k)
+	nwsum := make([]int32, k)
+	z := make([][]int, len(c.docs))
+	for d, doc := range c.docs {
+		z[d] = make([]int, len(doc.terms))
+		for i, w := range doc.terms {
+			topic := rng.Intn(k)
+			z[d][i] = topic
+			nw[w*k+topic]++
+			nd[d*k+topic]++
+			nwsum[topic]++
+		}
+	}
+
+	p := make([]float64, k)
+	vBeta := float64(v) * ldaBeta
+	for iter := 0; iter < iterations; iter++ {
+		for d, doc := range c.docs {
+			for i, w := range doc.terms {
+				topic := z[d][i]
+				nw[w*k+topic]--
+				nd[d*k+topic]--
+				nwsum[topic]--
+
+				var sum float64
+				for t := 0; t < k; t++ {
+					sum += (float64(nw[w*k+t]) + ldaBeta) / (float64(nwsum[t]) + vBeta) * (float64(nd[d*k+t]) + ldaAlpha)
+					p[t] = sum
+				}
+				u := rng.Float64() * sum
+				topic = sort.SearchFloat64s(p, u)
+				if topic >= k {
+					topic = k - 1
+				}
+
+				z[d][i] = topic
+				nw[w*k+topic]++
+				nd[d*k+topic]++
+				nwsum[topic]++
+			}
+		}
+	}
+
+	m := newTopicModel(k, len(c.docs))
+	for d, doc := range c.docs {
+		denominator := float64(len(doc.terms)) + float64(k)*ldaAlpha
+		for t := 0; t < k; t++ {
+			theta := (float64(nd[d*k+t]) + ldaAlpha) / denominator
+			m.weights[t] += theta / float64(len(c.docs))
+			if theta > m.score[d] {
+				m.assign[d], m.score[d] = t, theta
+			}
+		}
+	}
+	phi := make([]float64, v)
+	for t := 0; t < k; t++ {
+		for w := 0; w < v; w++ {
+			phi[w] = 0
+			if nw[w*k+t] > 0 {
+				phi[w] = (float64(nw[w*k+t]) + ldaBeta) / (float64(nwsum[t]) + vBeta)
+			}
+		}
+		for _, w := range topIndices(phi, topicKeywordCount) {
+			m.keywords[t] = append(m.keywords[t], c.vocab[w])
+		}
+	}
+	return m
+}
+
+// tfidfClusterTopics 对TF-IDF稀疏向量做球面k-means
+func tfidfClusterTopics(c *topicCorpus, k int, rng *rand.Rand) *topicModel {
+	vectors := make([]map[int]float64, len(c.docs))
+	for i, d := range c.docs {
+		vectors[i] = c.tfidf(d)
+	}
+	dot := func(i int, centroid []float64) float64 {
+		var s float64
+		for t, w := range vectors[i] {
+			s += w * centroid[t]
+		}
+		return s
+	}
+	add := func(i int, centroid []float64) {
+		for t, w := range vectors[i] {
+			centroid[t] += w
+		}
+	}
+	assign, sim := sphericalKMeans(len(c.docs), len(c.vocab), k, dot, add, rng)
+	return clusterTopicModel(c, assign, sim, k)
+}
+
+// embeddingClusterTopics 对消息向量做球面k-means
+func embeddingClusterTopics(c *topicCorpus, embeddings [][]float64, k int, rng *rand.Rand) *topicModel {
+	var dim int
+	for _, e := range embeddings {
+		normalize(e)
+		dim = max(dim, len(e))
+	}
+	dot := func(i int, centroid []float64) float64 {
+		var s float64
+		for j, x := range embeddings[i] {
+			s += x * centroid[j]
+		}
+		return s
+	}
+	add := func(i int, centroid []float64) {
+		for j, x := range embeddings[i] {
+			centroid[j] += x
+		}
+	}
+	assign, sim := sphericalKMeans(len(c.docs), dim, k, dot, add, rng)
+	return clusterTopicModel(c, assign, sim, k)
+}
+
+// sphericalKMeans 余弦相似度下的k-means，以k-means++选取初始中心，向量需已归一化
+func sphericalKMeans(n, dim, k int, dot func(int, []float64) float64, add func(int, []float64), rng *rand.Rand) ([]int, []float64) {
+	assign := make([]int, n)
+	sim := make([]float64, n)
+	if n == 0 || dim == 0 {
+		return assign, sim
+	}
+	k = min(k, n)
+
+	centroids := make([][]float64, 0, k)
+	seed := func(i int) {
+		centroid := make([]float64, dim)
+		add(i, centroid)
+		centroids = append(centroids, centroid)
+	}
+	seed(rng.Intn(n))
+	distance := make([]float64, n)
+	for i := range distance {
+		distance[i] = math.Inf(1)
+	}
+	for len(centroids) < k {
+		var total float64
+		last := centroids[len(centroids)-1]
+		for i := 0; i < n; i++ {
+			d := math.Max(0, 1-dot(i, last))
+			distance[i] = math.Min(distance[i], d*d)
+			total += distance[i]
+		}
+		if total == 0 {
+			break
+		}
+		u := rng.Float64() * total
+		next := n - 1
+		for i, d := range distance {
+			if u -= d; u <= 0 {
+				next = i
+				break
+			}
+		}
+		seed(next)
+	}
+	k = len(centroids)
+
+	for i := range assign {
+		assign[i] = -1
+	}
+	for iter := 0; iter < kmeansMaxIterations; iter++ {
+		changed := false
+		for i := 0; i < n; i++ {
+			best, bestSim := 0, math.Inf(-1)
+			for j, centroid := range centroids {
+				if s := dot(i, centroid); s > bestSim {
+					best, bestSim = j, s
+				}
+			}
+			if assign[i] != best {
+				assign[i] = best
+				changed = true
+			}
+			sim[i] = bestSim
+		}
+		if !changed {
+			break
+		}
+
+		sums := make([][]float64, k)
+		for i := range sums {
+			sums[i] = make([]float64, dim)
+		}
+		sizes := make([]int, k)
+		for i, j := range assign {
+			add(i, sums[j])
+			sizes[j]++
+		}
+		for j := range centroids {
+			// 空簇保留原中心
+			if sizes[j] > 0 && normalize(sums[j]) {
+				centroids[j] = sums[j]
+			}
+		}
+	}
+	return assign, sim
+}
+
+// clusterTopicModel 以簇内c-TF-IDF提取聚类话题关键词
+func clusterTopicModel(c *topicCorpus, assign []int, sim []float64, k int) *topicModel {
+	m := newTopicModel(k, len(c.docs))
+	copy(m.assign, assign)
+	copy(m.score, sim)
+
+	counts := make([]map[int]float64, k)
+	for i := range counts {
+		counts[i] = make(map[int]float64)
+	}
+	totals := make([]float64, k)
+	termTotals := make([]float64, len(c.vocab))
+	var words float64
+	for i, d := range c.docs {
+		topic := assign[i]
+		m.weights[topic]++
+		for _, t := range d.terms {
+			counts[topic][t]++
+			totals[topic]++
+			termTotals[t]++
+			words++
+		}
+	}
+
+	avgWords := words / float64(k)
+	for topic, tf := range counts {
+		m.weights[topic] = ratio(m.weights[topic], float64(len(c.docs)))
+		scores := make(map[int]float64, len(tf))
+		for t, n := range tf {
+			scores[t] = n / totals[topic] * math.Log(1+avgWords/termTotals[t])
+		}
+		m.keywords[topic] = topTerms(c, scores, topicKeywordCount)
+	}
+	return m
+}
+
+// topIndices 返回分值最高的n个下标
+func topIndices(scores []float64, n int) []int {
+	indices := make([]int, 0, len(scores))
+	for i, s := range scores {
+		if s > 0 {
+			indices = append(indices, i)
+		}
+	}
+	sort.SliceStable(indices, func(a, b int) bool { return scores[indices[a]] > scores[indices[b]] })
+	if len(indices) > n {
+		indices = indices[:n]
+	}
+	return indices
+}
+
+// topTerms 返回分值最高的n个词
+func topTerms(c *topicCorpus, scores map[int]float64, n int) []string {
+	terms := make([]int, 0, len(scores))
+	for t, s := range scores {
+		if s > 0 {
+			terms = append(terms, t)
+		}
+	}
+	sort.Slice(terms, func(a, b int) bool {
+		if scores[terms[a]] != scores[terms[b]] {
+			return scores[terms[a]] > scores[terms[b]]
+		}
+		return terms[a] < terms[b]
+	})
+	if len(terms) > n {
+		terms = terms[:n]
+	}
+	words := make([]string, len(terms))
+	for i, t := range terms {
+		words[i] = c.vocab[t]
+	}
+	return words
+}
+
+// normalize L2归一化，零向量返回false
+func normalize(vec []float64) bool {
+	var norm float64
+	for _, x := range vec {
+		norm += x * x
+	}
+	if norm == 0 {
+		return false
+	}
+	norm = math.Sqrt(norm)
+	for i := range vec {
+		vec[i] /= norm
+	}
+	return true
+}
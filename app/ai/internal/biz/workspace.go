@@ -0,0 +1,111 @@
+package biz
+
+import (
+	"context"
+
+	"universal/app/ai/internal/data/model"
+	"universal/pkg/workspace"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 工作空间角色，与用户服务保持一致
+const (
+	WorkspaceRoleMember int32 = 1
+	WorkspaceRoleAdmin  int32 = 2
+	WorkspaceRoleOwner  int32 = 3
+)
+
+var (
+	// ErrWorkspaceForbidden 用户不是当前工作空间的成员
+	ErrWorkspaceForbidden = errors.Forbidden("WORKSPACE_FORBIDDEN", "user is not a member of the active workspace")
+)
+
+// Workspace 工作空间业务实体，成员关系与配置由用户服务维护
+type Workspace struct {
+	ID                      int64  `json:"id"`
+	Name                    string `json:"name"`
+	OwnerID                 int64  `json:"owner_id"`
+	DefaultChatModelID      int64  `json:"default_chat_model_id"`
+	DefaultEmbeddingModelID int64  `json:"default_embedding_model_id"`
+	DailyTokenLimit         int64  `json:"daily_token_limit"` // 成员共享配额，0表示不限
+	MonthlyTokenLimit       int64  `json:"monthly_token_limit"`
+	DailyRequestLimit       int64  `json:"daily_request_limit"`
+	MonthlyRequestLimit     int64  `json:"monthly_request_limit"`
+	Role                    int32  `json:"role"` // 当前用户在工作空间中的角色
+}
+
+// WorkspaceRepo 工作空间数据访问接口
+type WorkspaceRepo interface {
+	// GetMembership 获取用户在工作空间中的成员身份，非成员时返回nil
+	GetMembership(ctx context.Context, workspaceID, userID int64) (*Workspace, error)
+}
+
+// WorkspaceUsecase 工作空间业务用例，解析请求上下文中的当前工作空间
+type WorkspaceUsecase struct {
+	repo      WorkspaceRepo
+	modelRepo ModelRepo
+	log       *log.Helper
+}
+
+// NewWorkspaceUsecase 创建工作空间业务用例
+func NewWorkspaceUsecase(repo WorkspaceRepo, modelRepo ModelRepo, logger log.Logger) *WorkspaceUsecase {
+	return &WorkspaceUsecase{
+		repo:      repo,
+		modelRepo: modelRepo,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// Active 获取当前工作空间并校验用户成员身份，个人空间返回nil
+func (uc *WorkspaceUsecase) Active(ctx context.Context, userID int64) (*Workspace, error) {
+	workspaceID := workspace.FromContext(ctx)
+	if workspaceID <= 0 {
+		return nil, nil
+	}
+	return uc.Membership(ctx, workspaceID, userID)
+}
+
+// Membership 校验用户在指定工作空间中的成员身份
+func (uc *WorkspaceUsecase) Membership(ctx context.Context, workspaceID, userID int64) (*Workspace, error) {
+	ws, err := uc.repo.GetMembership(ctx, workspaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if ws == nil {
+		uc.log.WithContext(ctx).Warnf("user %d is not a member of workspace %d", userID, workspaceID)
+		return nil, ErrWorkspaceForbidden
+	}
+	return ws, nil
+}
+
+// ActiveID 获取当前工作空间ID并校验成员身份，个人空间返回0
+func (uc *WorkspaceUsecase) ActiveID(ctx context.Context, userID int64) (int64, error) {
+	ws, err := uc.Active(ctx, userID)
+	if err != nil || ws == nil {
+		return 0, err
+	}
+	return ws.ID, nil
+}
+
+// DefaultModelName 获取工作空间默认模型名称，未配置或模型不可用时返回空字符串
+func (uc *WorkspaceUsecase) DefaultModelName(ctx context.Context, modelID int64) string {
+	if modelID <= 0 {
+		return ""
+	}
+	m, err := uc.modelRepo.GetModel(ctx, modelID)
+	if err != nil || m == nil {
+		uc.log.WithContext(ctx).Warnf("workspace default model %d unavailable: %v", modelID, err)
+		return ""
+	}
+	return m.Name
+}
+
+// workspaceAccessRole 工作空间成员对空间内共享资源的默认权限
+func workspaceAccessRole(role int32) int {
+	if role >= WorkspaceRoleAdmin {
+		return model.ShareRoleAdmin
+	}
+	return model.ShareRoleViewer
+}
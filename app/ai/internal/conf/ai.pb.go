@@ -0,0 +1,1461 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v6.32.0
+// source: app/ai/internal/conf/ai.proto
+
+package conf
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Bootstrap struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Server        *Server                `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	Data          *Data                  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Registry      *Registry              `protobuf:"bytes,3,opt,name=registry,proto3" json:"registry,omitempty"`
+	Scheduler     *Scheduler             `protobuf:"bytes,4,opt,name=scheduler,proto3" json:"scheduler,omitempty"`
+	Router        *Router                `protobuf:"bytes,5,opt,name=router,proto3" json:"router,omitempty"`
+	Health        *Health                `protobuf:"bytes,6,opt,name=health,proto3" json:"health,omitempty"`
+	Trace         *Trace                 `protobuf:"bytes,7,opt,name=trace,proto3" json:"trace,omitempty"`
+	Embedding     *Embedding             `protobuf:"bytes,8,opt,name=embedding,proto3" json:"embedding,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Bootstrap) Reset() {
+	*x = Bootstrap{}
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Bootstrap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bootstrap) ProtoMessage() {}
+
+func (x *Bootstrap) ProtoReflect() protoreflect.Message {
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bootstrap.ProtoReflect.Descriptor instead.
+func (*Bootstrap) Descriptor() ([]byte, []int) {
+	return file_app_ai_internal_conf_ai_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Bootstrap) GetServer() *Server {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetData() *Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetRegistry() *Registry {
+	if x != nil {
+		return x.Registry
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetScheduler() *Scheduler {
+	if x != nil {
+		return x.Scheduler
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetRouter() *Router {
+	if x != nil {
+		return x.Router
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetHealth() *Health {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetTrace() *Trace {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetEmbedding() *Embedding {
+	if x != nil {
+		return x.Embedding
+	}
+	return nil
+}
+
+type Server struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
+	Grpc          *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server) Reset() {
+	*x = Server{}
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server) ProtoMessage() {}
+
+func (x *Server) ProtoReflect() protoreflect.Message {
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server.ProtoReflect.Descriptor instead.
+func (*Server) Descriptor() ([]byte, []int) {
+	return file_app_ai_internal_conf_ai_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Server) GetHttp() *Server_HTTP {
+	if x != nil {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *Server) GetGrpc() *Server_GRPC {
+	if x != nil {
+		return x.Grpc
+	}
+	return nil
+}
+
+type Data struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	Secret        *Data_Secret           `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data) Reset() {
+	*x = Data{}
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data) ProtoMessage() {}
+
+func (x *Data) ProtoReflect() protoreflect.Message {
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data.ProtoReflect.Descriptor instead.
+func (*Data) Descriptor() ([]byte, []int) {
+	return file_app_ai_internal_conf_ai_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Data) GetDatabase() *Data_Database {
+	if x != nil {
+		return x.Database
+	}
+	return nil
+}
+
+func (x *Data) GetRedis() *Data_Redis {
+	if x != nil {
+		return x.Redis
+	}
+	return nil
+}
+
+func (x *Data) GetSecret() *Data_Secret {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+// Registry 服务注册与发现，type 为 consul（默认）、etcd、static 或 memory
+type Registry struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Consul *Registry_Consul       `protobuf:"bytes,1,opt,name=consul,proto3" json:"consul,omitempty"`
+	Type   string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Etcd   *Registry_Etcd         `protobuf:"bytes,3,opt,name=etcd,proto3" json:"etcd,omitempty"`
+	// type 为 static 时使用的服务地址
+	Static        []*Registry_Service `protobuf:"bytes,4,rep,name=static,proto3" json:"static,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Registry) Reset() {
+	*x = Registry{}
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Registry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Registry) ProtoMessage() {}
+
+func (x *Registry) ProtoReflect() protoreflect.Message {
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Registry.ProtoReflect.Descriptor instead.
+func (*Registry) Descriptor() ([]byte, []int) {
+	return file_app_ai_internal_conf_ai_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Registry) GetConsul() *Registry_Consul {
+	if x != nil {
+		return x.Consul
+	}
+	return nil
+}
+
+func (x *Registry) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Registry) GetEtcd() *Registry_Etcd {
+	if x != nil {
+		return x.Etcd
+	}
+	return nil
+}
+
+func (x *Registry) GetStatic() []*Registry_Service {
+	if x != nil {
+		return x.Static
+	}
+	return nil
+}
+
+type Scheduler struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Disabled bool                   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// 配额重置与使用统计的日期边界时区，如 Asia/Shanghai，默认使用本地时区
+	Timezone            string               `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	QuotaResetInterval  *durationpb.Duration `protobuf:"bytes,3,opt,name=quota_reset_interval,json=quotaResetInterval,proto3" json:"quota_reset_interval,omitempty"`
+	UsageRollupInterval *durationpb.Duration `protobuf:"bytes,4,opt,name=usage_rollup_interval,json=usageRollupInterval,proto3" json:"usage_rollup_interval,omitempty"`
+	// 模型目录同步间隔，只同步 Provider.Config["model_sync"] 为 auto 的提供商，默认1h
+	ModelSyncInterval *durationpb.Duration `protobuf:"bytes,5,opt,name=model_sync_interval,json=modelSyncInterval,proto3" json:"model_sync_interval,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Scheduler) Reset() {
+	*x = Scheduler{}
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Scheduler) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Scheduler) ProtoMessage() {}
+
+func (x *Scheduler) ProtoReflect() protoreflect.Message {
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Scheduler.ProtoReflect.Descriptor instead.
+func (*Scheduler) Descriptor() ([]byte, []int) {
+	return file_app_ai_internal_conf_ai_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Scheduler) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Scheduler) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Scheduler) GetQuotaResetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.QuotaResetInterval
+	}
+	return nil
+}
+
+func (x *Scheduler) GetUsageRollupInterval() *durationpb.Duration {
+	if x != nil {
+		return x.UsageRollupInterval
+	}
+	return nil
+}
+
+func (x *Scheduler) GetModelSyncInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ModelSyncInterval
+	}
+	return nil
+}
+
+type Router struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Routes []*Router_Route        `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	// 单次生成最多尝试的候选模型数，默认3
+	MaxAttempts int32 `protobuf:"varint,2,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// 单次调用提供商的超时时间，默认60s
+	RequestTimeout *durationpb.Duration `protobuf:"bytes,3,opt,name=request_timeout,json=requestTimeout,proto3" json:"request_timeout,omitempty"`
+	// 熔断统计窗口内请求数不少于 breaker_min_requests 且错误率超过 breaker_error_rate 时熔断
+	BreakerWindow      *durationpb.Duration `protobuf:"bytes,4,opt,name=breaker_window,json=breakerWindow,proto3" json:"breaker_window,omitempty"`
+	BreakerMinRequests int32                `protobuf:"varint,5,opt,name=breaker_min_requests,json=breakerMinRequests,proto3" json:"breaker_min_requests,omitempty"`
+	BreakerErrorRate   float64              `protobuf:"fixed64,6,opt,name=breaker_error_rate,json=breakerErrorRate,proto3" json:"breaker_error_rate,omitempty"`
+	// 熔断后等待 breaker_cooldown 进入半开状态，放行一次试探请求
+	BreakerCooldown *durationpb.Duration `protobuf:"bytes,7,opt,name=breaker_cooldown,json=breakerCooldown,proto3" json:"breaker_cooldown,omitempty"`
+	// 健康检查结果的有效期，超过后不再参与熔断判断，默认5m
+	HealthMaxAge  *durationpb.Duration `protobuf:"bytes,8,opt,name=health_max_age,json=healthMaxAge,proto3" json:"health_max_age,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Router) Reset() {
+	*x = Router{}
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Router) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Router) ProtoMessage() {}
+
+func (x *Router) ProtoReflect() protoreflect.Message {
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Router.ProtoReflect.Descriptor instead.
+func (*Router) Descriptor() ([]byte, []int) {
+	return file_app_ai_internal_conf_ai_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Router) GetRoutes() []*Router_Route {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+func (x *Router) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *Router) GetRequestTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.RequestTimeout
+	}
+	return nil
+}
+
+func (x *Router) GetBreakerWindow() *durationpb.Duration {
+	if x != nil {
+		return x.BreakerWindow
+	}
+	return nil
+}
+
+func (x *Router) GetBreakerMinRequests() int32 {
+	if x != nil {
+		return x.BreakerMinRequests
+	}
+	return 0
+}
+
+func (x *Router) GetBreakerErrorRate() float64 {
+	if x != nil {
+		return x.BreakerErrorRate
+	}
+	return 0
+}
+
+func (x *Router) GetBreakerCooldown() *durationpb.Duration {
+	if x != nil {
+		return x.BreakerCooldown
+	}
+	return nil
+}
+
+func (x *Router) GetHealthMaxAge() *durationpb.Duration {
+	if x != nil {
+		return x.HealthMaxAge
+	}
+	return nil
+}
+
+type Health struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 主动探测间隔，默认1m
+	ProbeInterval *durationpb.Duration `protobuf:"bytes,1,opt,name=probe_interval,json=probeInterval,proto3" json:"probe_interval,omitempty"`
+	// 单次探测超时，默认15s
+	ProbeTimeout *durationpb.Duration `protobuf:"bytes,2,opt,name=probe_timeout,json=probeTimeout,proto3" json:"probe_timeout,omitempty"`
+	// 同时探测的模型数，默认4
+	ProbeConcurrency int32 `protobuf:"varint,3,opt,name=probe_concurrency,json=probeConcurrency,proto3" json:"probe_concurrency,omitempty"`
+	// 成功率与延迟分位数的统计窗口，包含探测与线上调用，默认15m
+	Window *durationpb.Duration `protobuf:"bytes,4,opt,name=window,proto3" json:"window,omitempty"`
+	// 窗口内成功率低于该值时判定为不健康，默认0.8
+	MinSuccessRate float64 `protobuf:"fixed64,5,opt,name=min_success_rate,json=minSuccessRate,proto3" json:"min_success_rate,omitempty"`
+	// 健康检查历史保留时长，默认7d
+	Retention     *durationpb.Duration `protobuf:"bytes,6,opt,name=retention,proto3" json:"retention,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Health) Reset() {
+	*x = Health{}
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Health) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Health) ProtoMessage() {}
+
+func (x *Health) ProtoReflect() protoreflect.Message {
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Health.ProtoReflect.Descriptor instead.
+func (*Health) Descriptor() ([]byte, []int) {
+	return file_app_ai_internal_conf_ai_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Health) GetProbeInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ProbeInterval
+	}
+	return nil
+}
+
+func (x *Health) GetProbeTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ProbeTimeout
+	}
+	return nil
+}
+
+func (x *Health) GetProbeConcurrency() int32 {
+	if x != nil {
+		return x.ProbeConcurrency
+	}
+	return 0
+}
+
+func (x *Health) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *Health) GetMinSuccessRate() float64 {
+	if x != nil {
+		return x.MinSuccessRate
+	}
+	return 0
+}
+
+func (x *Health) GetRetention() *durationpb.Duration {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+type Trace struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// OTLP/HTTP 追踪接收地址，如 http://localhost:4318/v1/traces，为空时只生成追踪ID不上报
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// 根请求的采样比例，默认1
+	SampleRatio   float64 `protobuf:"fixed64,2,opt,name=sample_ratio,json=sampleRatio,proto3" json:"sample_ratio,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Trace) Reset() {
+	*x = Trace{}
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Trace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trace) ProtoMessage() {}
+
+func (x *Trace) ProtoReflect() protoreflect.Message {
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trace.ProtoReflect.Descriptor instead.
+func (*Trace) Descriptor() ([]byte, []int) {
+	return file_app_ai_internal_conf_ai_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Trace) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Trace) GetSampleRatio() float64 {
+	if x != nil {
+		return x.SampleRatio
+	}
+	return 0
+}
+
+type Embedding struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 默认向量模型，话题分析与未指定向量模型的知识库使用，需在模型目录中配置且提供商支持 /v1/embeddings
+	Model string `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	// 使用本地特征哈希向量化，不调用模型提供商，仅用于开发与离线环境；切换后需重建知识库索引
+	Local bool `protobuf:"varint,2,opt,name=local,proto3" json:"local,omitempty"`
+	// 单次请求提供商的文本数，默认64
+	BatchSize     int32 `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Embedding) Reset() {
+	*x = Embedding{}
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Embedding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Embedding) ProtoMessage() {}
+
+func (x *Embedding) ProtoReflect() protoreflect.Message {
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Embedding.ProtoReflect.Descriptor instead.
+func (*Embedding) Descriptor() ([]byte, []int) {
+	return file_app_ai_internal_conf_ai_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Embedding) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *Embedding) GetLocal() bool {
+	if x != nil {
+		return x.Local
+	}
+	return false
+}
+
+func (x *Embedding) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type Server_HTTP struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_HTTP) Reset() {
+	*x = Server_HTTP{}
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_HTTP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_HTTP) ProtoMessage() {}
+
+func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_HTTP.ProtoReflect.Descriptor instead.
+func (*Server_HTTP) Descriptor() ([]byte, []int) {
+	return file_app_ai_internal_conf_ai_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *Server_HTTP) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *Server_HTTP) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Server_HTTP) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+type Server_GRPC struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_GRPC) Reset() {
+	*x = Server_GRPC{}
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_GRPC) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_GRPC) ProtoMessage() {}
+
+func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_GRPC.ProtoReflect.Descriptor instead.
+func (*Server_GRPC) Descriptor() ([]byte, []int) {
+	return file_app_ai_internal_conf_ai_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *Server_GRPC) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *Server_GRPC) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Server_GRPC) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+type Data_Database struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// mysql、postgres 或 sqlite，为空时使用 mysql
+	Driver string `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// 启动时执行未执行的迁移，关闭时只检查版本，需先通过 migrate 子命令迁移
+	AutoMigrate   bool `protobuf:"varint,3,opt,name=auto_migrate,json=autoMigrate,proto3" json:"auto_migrate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Database) Reset() {
+	*x = Data_Database{}
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Database) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Database) ProtoMessage() {}
+
+func (x *Data_Database) ProtoReflect() protoreflect.Message {
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Database.ProtoReflect.Descriptor instead.
+func (*Data_Database) Descriptor() ([]byte, []int) {
+	return file_app_ai_internal_conf_ai_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *Data_Database) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Data_Database) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Data_Database) GetAutoMigrate() bool {
+	if x != nil {
+		return x.AutoMigrate
+	}
+	return false
+}
+
+type Data_Redis struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	ReadTimeout   *durationpb.Duration   `protobuf:"bytes,3,opt,name=read_timeout,json=readTimeout,proto3" json:"read_timeout,omitempty"`
+	WriteTimeout  *durationpb.Duration   `protobuf:"bytes,4,opt,name=write_timeout,json=writeTimeout,proto3" json:"write_timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Redis) Reset() {
+	*x = Data_Redis{}
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Redis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Redis) ProtoMessage() {}
+
+func (x *Data_Redis) ProtoReflect() protoreflect.Message {
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Redis.ProtoReflect.Descriptor instead.
+func (*Data_Redis) Descriptor() ([]byte, []int) {
+	return file_app_ai_internal_conf_ai_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *Data_Redis) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *Data_Redis) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Data_Redis) GetReadTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ReadTimeout
+	}
+	return nil
+}
+
+func (x *Data_Redis) GetWriteTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.WriteTimeout
+	}
+	return nil
+}
+
+type Data_Secret struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 本地KMS的主密钥文件，每行 <版本>=<base64编码的32字节密钥>，配置后忽略 AI_SECRET_MASTER_KEY
+	KeyFile string `protobuf:"bytes,1,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`
+	// 已废弃，主密钥不得写入配置文件，请使用 key_file 或环境变量 AI_SECRET_MASTER_KEY；配置后拒绝启动
+	//
+	// Deprecated: Marked as deprecated in app/ai/internal/conf/ai.proto.
+	MasterKey string `protobuf:"bytes,2,opt,name=master_key,json=masterKey,proto3" json:"master_key,omitempty"`
+	// 加密使用的主密钥版本；使用 key_file 时默认为文件中最后一个版本，使用 AI_SECRET_MASTER_KEY 时默认为 v1
+	PrimaryKeyId  string `protobuf:"bytes,3,opt,name=primary_key_id,json=primaryKeyId,proto3" json:"primary_key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Secret) Reset() {
+	*x = Data_Secret{}
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Secret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Secret) ProtoMessage() {}
+
+func (x *Data_Secret) ProtoReflect() protoreflect.Message {
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Secret.ProtoReflect.Descriptor instead.
+func (*Data_Secret) Descriptor() ([]byte, []int) {
+	return file_app_ai_internal_conf_ai_proto_rawDescGZIP(), []int{2, 2}
+}
+
+func (x *Data_Secret) GetKeyFile() string {
+	if x != nil {
+		return x.KeyFile
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in app/ai/internal/conf/ai.proto.
+func (x *Data_Secret) GetMasterKey() string {
+	if x != nil {
+		return x.MasterKey
+	}
+	return ""
+}
+
+func (x *Data_Secret) GetPrimaryKeyId() string {
+	if x != nil {
+		return x.PrimaryKeyId
+	}
+	return ""
+}
+
+type Registry_Consul struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Address string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Scheme  string                 `protobuf:"bytes,2,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	// 关闭健康检查，开启时 Consul 检查实例端口与心跳，发现只返回健康的实例
+	DisableHealthCheck bool `protobuf:"varint,3,opt,name=disable_health_check,json=disableHealthCheck,proto3" json:"disable_health_check,omitempty"`
+	// 健康检查间隔，默认10秒
+	HealthCheckInterval *durationpb.Duration `protobuf:"bytes,4,opt,name=health_check_interval,json=healthCheckInterval,proto3" json:"health_check_interval,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Registry_Consul) Reset() {
+	*x = Registry_Consul{}
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Registry_Consul) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Registry_Consul) ProtoMessage() {}
+
+func (x *Registry_Consul) ProtoReflect() protoreflect.Message {
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Registry_Consul.ProtoReflect.Descriptor instead.
+func (*Registry_Consul) Descriptor() ([]byte, []int) {
+	return file_app_ai_internal_conf_ai_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *Registry_Consul) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Registry_Consul) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+func (x *Registry_Consul) GetDisableHealthCheck() bool {
+	if x != nil {
+		return x.DisableHealthCheck
+	}
+	return false
+}
+
+func (x *Registry_Consul) GetHealthCheckInterval() *durationpb.Duration {
+	if x != nil {
+		return x.HealthCheckInterval
+	}
+	return nil
+}
+
+type Registry_Etcd struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoints     []string               `protobuf:"bytes,1,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	DialTimeout   *durationpb.Duration   `protobuf:"bytes,2,opt,name=dial_timeout,json=dialTimeout,proto3" json:"dial_timeout,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Registry_Etcd) Reset() {
+	*x = Registry_Etcd{}
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Registry_Etcd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Registry_Etcd) ProtoMessage() {}
+
+func (x *Registry_Etcd) ProtoReflect() protoreflect.Message {
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Registry_Etcd.ProtoReflect.Descriptor instead.
+func (*Registry_Etcd) Descriptor() ([]byte, []int) {
+	return file_app_ai_internal_conf_ai_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *Registry_Etcd) GetEndpoints() []string {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+func (x *Registry_Etcd) GetDialTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.DialTimeout
+	}
+	return nil
+}
+
+func (x *Registry_Etcd) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Registry_Etcd) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// Service 静态服务地址，如 grpc://127.0.0.1:9001
+type Registry_Service struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Endpoints     []string               `protobuf:"bytes,2,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Registry_Service) Reset() {
+	*x = Registry_Service{}
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Registry_Service) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Registry_Service) ProtoMessage() {}
+
+func (x *Registry_Service) ProtoReflect() protoreflect.Message {
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Registry_Service.ProtoReflect.Descriptor instead.
+func (*Registry_Service) Descriptor() ([]byte, []int) {
+	return file_app_ai_internal_conf_ai_proto_rawDescGZIP(), []int{3, 2}
+}
+
+func (x *Registry_Service) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Registry_Service) GetEndpoints() []string {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+// Route 模型或别名的回退链
+type Router_Route struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 候选模型名，按顺序尝试
+	Models []string `protobuf:"bytes,2,rep,name=models,proto3" json:"models,omitempty"`
+	// 候选排序策略：ordered、cheapest、lowest_latency、capability_match，默认 ordered
+	Policy        string `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Router_Route) Reset() {
+	*x = Router_Route{}
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Router_Route) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Router_Route) ProtoMessage() {}
+
+func (x *Router_Route) ProtoReflect() protoreflect.Message {
+	mi := &file_app_ai_internal_conf_ai_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Router_Route.ProtoReflect.Descriptor instead.
+func (*Router_Route) Descriptor() ([]byte, []int) {
+	return file_app_ai_internal_conf_ai_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *Router_Route) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Router_Route) GetModels() []string {
+	if x != nil {
+		return x.Models
+	}
+	return nil
+}
+
+func (x *Router_Route) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+var File_app_ai_internal_conf_ai_proto protoreflect.FileDescriptor
+
+const file_app_ai_internal_conf_ai_proto_rawDesc = "" +
+	"\n" +
+	"\x1dapp/ai/internal/conf/ai.proto\x12\x11universal.ai.conf\x1a\x1egoogle/protobuf/duration.proto\"\xb2\x03\n" +
+	"\tBootstrap\x121\n" +
+	"\x06server\x18\x01 \x01(\v2\x19.universal.ai.conf.ServerR\x06server\x12+\n" +
+	"\x04data\x18\x02 \x01(\v2\x17.universal.ai.conf.DataR\x04data\x127\n" +
+	"\bregistry\x18\x03 \x01(\v2\x1b.universal.ai.conf.RegistryR\bregistry\x12:\n" +
+	"\tscheduler\x18\x04 \x01(\v2\x1c.universal.ai.conf.SchedulerR\tscheduler\x121\n" +
+	"\x06router\x18\x05 \x01(\v2\x19.universal.ai.conf.RouterR\x06router\x121\n" +
+	"\x06health\x18\x06 \x01(\v2\x19.universal.ai.conf.HealthR\x06health\x12.\n" +
+	"\x05trace\x18\a \x01(\v2\x18.universal.ai.conf.TraceR\x05trace\x12:\n" +
+	"\tembedding\x18\b \x01(\v2\x1c.universal.ai.conf.EmbeddingR\tembedding\"\xc6\x02\n" +
+	"\x06Server\x122\n" +
+	"\x04http\x18\x01 \x01(\v2\x1e.universal.ai.conf.Server.HTTPR\x04http\x122\n" +
+	"\x04grpc\x18\x02 \x01(\v2\x1e.universal.ai.conf.Server.GRPCR\x04grpc\x1ai\n" +
+	"\x04HTTP\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1ai\n" +
+	"\x04GRPC\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xb4\x04\n" +
+	"\x04Data\x12<\n" +
+	"\bdatabase\x18\x01 \x01(\v2 .universal.ai.conf.Data.DatabaseR\bdatabase\x123\n" +
+	"\x05redis\x18\x02 \x01(\v2\x1d.universal.ai.conf.Data.RedisR\x05redis\x126\n" +
+	"\x06secret\x18\x03 \x01(\v2\x1e.universal.ai.conf.Data.SecretR\x06secret\x1a]\n" +
+	"\bDatabase\x12\x16\n" +
+	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12!\n" +
+	"\fauto_migrate\x18\x03 \x01(\bR\vautoMigrate\x1a\xb3\x01\n" +
+	"\x05Redis\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04addr\x18\x02 \x01(\tR\x04addr\x12<\n" +
+	"\fread_timeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
+	"\rwrite_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\x1al\n" +
+	"\x06Secret\x12\x19\n" +
+	"\bkey_file\x18\x01 \x01(\tR\akeyFile\x12!\n" +
+	"\n" +
+	"master_key\x18\x02 \x01(\tB\x02\x18\x01R\tmasterKey\x12$\n" +
+	"\x0eprimary_key_id\x18\x03 \x01(\tR\fprimaryKeyId\"\xe5\x04\n" +
+	"\bRegistry\x12:\n" +
+	"\x06consul\x18\x01 \x01(\v2\".universal.ai.conf.Registry.ConsulR\x06consul\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x124\n" +
+	"\x04etcd\x18\x03 \x01(\v2 .universal.ai.conf.Registry.EtcdR\x04etcd\x12;\n" +
+	"\x06static\x18\x04 \x03(\v2#.universal.ai.conf.Registry.ServiceR\x06static\x1a\xbb\x01\n" +
+	"\x06Consul\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06scheme\x18\x02 \x01(\tR\x06scheme\x120\n" +
+	"\x14disable_health_check\x18\x03 \x01(\bR\x12disableHealthCheck\x12M\n" +
+	"\x15health_check_interval\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x13healthCheckInterval\x1a\x9a\x01\n" +
+	"\x04Etcd\x12\x1c\n" +
+	"\tendpoints\x18\x01 \x03(\tR\tendpoints\x12<\n" +
+	"\fdial_timeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\vdialTimeout\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\x1a;\n" +
+	"\aService\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tendpoints\x18\x02 \x03(\tR\tendpoints\"\xaa\x02\n" +
+	"\tScheduler\x12\x1a\n" +
+	"\bdisabled\x18\x01 \x01(\bR\bdisabled\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x12K\n" +
+	"\x14quota_reset_interval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x12quotaResetInterval\x12M\n" +
+	"\x15usage_rollup_interval\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x13usageRollupInterval\x12I\n" +
+	"\x13model_sync_interval\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x11modelSyncInterval\"\x9e\x04\n" +
+	"\x06Router\x127\n" +
+	"\x06routes\x18\x01 \x03(\v2\x1f.universal.ai.conf.Router.RouteR\x06routes\x12!\n" +
+	"\fmax_attempts\x18\x02 \x01(\x05R\vmaxAttempts\x12B\n" +
+	"\x0frequest_timeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x0erequestTimeout\x12@\n" +
+	"\x0ebreaker_window\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\rbreakerWindow\x120\n" +
+	"\x14breaker_min_requests\x18\x05 \x01(\x05R\x12breakerMinRequests\x12,\n" +
+	"\x12breaker_error_rate\x18\x06 \x01(\x01R\x10breakerErrorRate\x12D\n" +
+	"\x10breaker_cooldown\x18\a \x01(\v2\x19.google.protobuf.DurationR\x0fbreakerCooldown\x12?\n" +
+	"\x0ehealth_max_age\x18\b \x01(\v2\x19.google.protobuf.DurationR\fhealthMaxAge\x1aK\n" +
+	"\x05Route\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06models\x18\x02 \x03(\tR\x06models\x12\x16\n" +
+	"\x06policy\x18\x03 \x01(\tR\x06policy\"\xcd\x02\n" +
+	"\x06Health\x12@\n" +
+	"\x0eprobe_interval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\rprobeInterval\x12>\n" +
+	"\rprobe_timeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\fprobeTimeout\x12+\n" +
+	"\x11probe_concurrency\x18\x03 \x01(\x05R\x10probeConcurrency\x121\n" +
+	"\x06window\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x06window\x12(\n" +
+	"\x10min_success_rate\x18\x05 \x01(\x01R\x0eminSuccessRate\x127\n" +
+	"\tretention\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\tretention\"F\n" +
+	"\x05Trace\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12!\n" +
+	"\fsample_ratio\x18\x02 \x01(\x01R\vsampleRatio\"V\n" +
+	"\tEmbedding\x12\x14\n" +
+	"\x05model\x18\x01 \x01(\tR\x05model\x12\x14\n" +
+	"\x05local\x18\x02 \x01(\bR\x05local\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x03 \x01(\x05R\tbatchSizeB%Z#universal/app/ai/internal/conf;confb\x06proto3"
+
+var (
+	file_app_ai_internal_conf_ai_proto_rawDescOnce sync.Once
+	file_app_ai_internal_conf_ai_proto_rawDescData []byte
+)
+
+func file_app_ai_internal_conf_ai_proto_rawDescGZIP() []byte {
+	file_app_ai_internal_conf_ai_proto_rawDescOnce.Do(func() {
+		file_app_ai_internal_conf_ai_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_app_ai_internal_conf_ai_proto_rawDesc), len(file_app_ai_internal_conf_ai_proto_rawDesc)))
+	})
+	return file_app_ai_internal_conf_ai_proto_rawDescData
+}
+
+var file_app_ai_internal_conf_ai_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_app_ai_internal_conf_ai_proto_goTypes = []any{
+	(*Bootstrap)(nil),           // 0: universal.ai.conf.Bootstrap
+	(*Server)(nil),              // 1: universal.ai.conf.Server
+	(*Data)(nil),                // 2: universal.ai.conf.Data
+	(*Registry)(nil),            // 3: universal.ai.conf.Registry
+	(*Scheduler)(nil),           // 4: universal.ai.conf.Scheduler
+	(*Router)(nil),              // 5: universal.ai.conf.Router
+	(*Health)(nil),              // 6: universal.ai.conf.Health
+	(*Trace)(nil),               // 7: universal.ai.conf.Trace
+	(*Embedding)(nil),           // 8: universal.ai.conf.Embedding
+	(*Server_HTTP)(nil),         // 9: universal.ai.conf.Server.HTTP
+	(*Server_GRPC)(nil),         // 10: universal.ai.conf.Server.GRPC
+	(*Data_Database)(nil),       // 11: universal.ai.conf.Data.Database
+	(*Data_Redis)(nil),          // 12: universal.ai.conf.Data.Redis
+	(*Data_Secret)(nil),         // 13: universal.ai.conf.Data.Secret
+	(*Registry_Consul)(nil),     // 14: universal.ai.conf.Registry.Consul
+	(*Registry_Etcd)(nil),       // 15: universal.ai.conf.Registry.Etcd
+	(*Registry_Service)(nil),    // 16: universal.ai.conf.Registry.Service
+	(*Router_Route)(nil),        // 17: universal.ai.conf.Router.Route
+	(*durationpb.Duration)(nil), // 18: google.protobuf.Duration
+}
+var file_app_ai_internal_conf_ai_proto_depIdxs = []int32{
+	1,  // 0: universal.ai.conf.Bootstrap.server:type_name -> universal.ai.conf.Server
+	2,  // 1: universal.ai.conf.Bootstrap.data:type_name -> universal.ai.conf.Data
+	3,  // 2: universal.ai.conf.Bootstrap.registry:type_name -> universal.ai.conf.Registry
+	4,  // 3: universal.ai.conf.Bootstrap.scheduler:type_name -> universal.ai.conf.Scheduler
+	5,  // 4: universal.ai.conf.Bootstrap.router:type_name -> universal.ai.conf.Router
+	6,  // 5: universal.ai.conf.Bootstrap.health:type_name -> universal.ai.conf.Health
+	7,  // 6: universal.ai.conf.Bootstrap.trace:type_name -> universal.ai.conf.Trace
+	8,  // 7: universal.ai.conf.Bootstrap.embedding:type_name -> universal.ai.conf.Embedding
+	9,  // 8: universal.ai.conf.Server.http:type_name -> universal.ai.conf.Server.HTTP
+	10, // 9: universal.ai.conf.Server.grpc:type_name -> universal.ai.conf.Server.GRPC
+	11, // 10: universal.ai.conf.Data.database:type_name -> universal.ai.conf.Data.Database
+	12, // 11: universal.ai.conf.Data.redis:type_name -> universal.ai.conf.Data.Redis
+	13, // 12: universal.ai.conf.Data.secret:type_name -> universal.ai.conf.Data.Secret
+	14, // 13: universal.ai.conf.Registry.consul:type_name -> universal.ai.conf.Registry.Consul
+	15, // 14: universal.ai.conf.Registry.etcd:type_name -> universal.ai.conf.Registry.Etcd
+	16, // 15: universal.ai.conf.Registry.static:type_name -> universal.ai.conf.Registry.Service
+	18, // 16: universal.ai.conf.Scheduler.quota_reset_interval:type_name -> google.protobuf.Duration
+	18, // 17: universal.ai.conf.Scheduler.usage_rollup_interval:type_name -> google.protobuf.Duration
+	18, // 18: universal.ai.conf.Scheduler.model_sync_interval:type_name -> google.protobuf.Duration
+	17, // 19: universal.ai.conf.Router.routes:type_name -> universal.ai.conf.Router.Route
+	18, // 20: universal.ai.conf.Router.request_timeout:type_name -> google.protobuf.Duration
+	18, // 21: universal.ai.conf.Router.breaker_window:type_name -> google.protobuf.Duration
+	18, // 22: universal.ai.conf.Router.breaker_cooldown:type_name -> google.protobuf.Duration
+	18, // 23: universal.ai.conf.Router.health_max_age:type_name -> google.protobuf.Duration
+	18, // 24: universal.ai.conf.Health.probe_interval:type_name -> google.protobuf.Duration
+	18, // 25: universal.ai.conf.Health.probe_timeout:type_name -> google.protobuf.Duration
+	18, // 26: universal.ai.conf.Health.window:type_name -> google.protobuf.Duration
+	18, // 27: universal.ai.conf.Health.retention:type_name -> google.protobuf.Duration
+	18, // 28: universal.ai.conf.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	18, // 29: universal.ai.conf.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	18, // 30: universal.ai.conf.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	18, // 31: universal.ai.conf.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	18, // 32: universal.ai.conf.Registry.Consul.health_check_interval:type_name -> google.protobuf.Duration
+	18, // 33: universal.ai.conf.Registry.Etcd.dial_timeout:type_name -> google.protobuf.Duration
+	34, // [34:34] is the sub-list for method output_type
+	34, // [34:34] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
+}
+
+func init() { file_app_ai_internal_conf_ai_proto_init() }
+func file_app_ai_internal_conf_ai_proto_init() {
+	if File_app_ai_internal_conf_ai_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_app_ai_internal_conf_ai_proto_rawDesc), len(file_app_ai_internal_conf_ai_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_app_ai_internal_conf_ai_proto_goTypes,
+		DependencyIndexes: file_app_ai_internal_conf_ai_proto_depIdxs,
+		MessageInfos:      file_app_ai_internal_conf_ai_proto_msgTypes,
+	}.Build()
+	File_app_ai_internal_conf_ai_proto = out.File
+	file_app_ai_internal_conf_ai_proto_goTypes = nil
+	file_app_ai_internal_conf_ai_proto_depIdxs = nil
+}
@@ -2,17 +2,31 @@ package data
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"runtime"
+	"time"
+
+	pb "universal/api/ai/v1"
 	"universal/app/ai/internal/biz"
+	"universal/app/ai/internal/data/model"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
 )
 
+// messageModelExpr 消息实际使用的模型，未记录时取对话模型
+const messageModelExpr = "COALESCE(NULLIF(msg.model_used, ''), c.model_name)"
+
+// 统计分析仓库实现，统计包含已删除的记录以反映真实用量
 type aiRepo struct {
 	data *Data
 	log  *log.Helper
 }
 
-// NewAiRepo .
+// NewAiRepo 创建统计分析仓库
 func NewAiRepo(data *Data, logger log.Logger) biz.AiRepo {
 	return &aiRepo{
 		data: data,
@@ -20,6 +34,244 @@ func NewAiRepo(data *Data, logger log.Logger) biz.AiRepo {
 	}
 }
 
-func (r *aiRepo) CreateAi(ctx context.Context, g *biz.Ai) (*biz.Ai, error) {
-	return g, nil
+func (r *aiRepo) ScanConversations(ctx context.Context, scope *biz.AnalyticsScope, fn func(*biz.ConversationEvent) error) error {
+	query := r.data.db.WithContext(ctx).Table("conversations AS c").
+		Select("c.id, c.user_id, c.model_name, c.status, c.created_at, c.total_cost, c.workspace_id").
+		Where("c.created_at >= ? AND c.created_at < ?", scope.Start, scope.End)
+	query = applyConversationScope(query, scope)
+	if len(scope.ModelNames) > 0 {
+		query = query.Where("c.model_name IN ?", scope.ModelNames)
+	}
+
+	return scanRows(query, func(rows *sql.Rows) error {
+		e := &biz.ConversationEvent{}
+		if err := rows.Scan(&e.ID, &e.UserID, &e.ModelName, &e.Status, &e.CreatedAt, &e.TotalCost, &e.WorkspaceID); err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+func (r *aiRepo) ScanMessages(ctx context.Context, scope *biz.AnalyticsScope, fn func(*biz.MessageEvent) error) error {
+	content := "''"
+	if scope.WithContent {
+		content = "msg.content"
+	}
+	query := r.messageQuery(ctx, scope).
+		Select("msg.id, msg.conversation_id, c.user_id, " + messageModelExpr + ", msg.role, msg.status, " + content +
+			", msg.input_tokens, msg.output_tokens, msg.response_time, msg.cost, msg.created_at")
+
+	return scanRows(query, func(rows *sql.Rows) error {
+		e := &biz.MessageEvent{}
+		err := rows.Scan(&e.ID, &e.ConversationID, &e.UserID, &e.ModelName, &e.Role, &e.Status, &e.Content,
+			&e.InputTokens, &e.OutputTokens, &e.ResponseTime, &e.Cost, &e.CreatedAt)
+		if err != nil {
+			return err
+		}
+		return fn(e)
+	})
+}
+
+func (r *aiRepo) ScanToolExecutions(ctx context.Context, scope *biz.AnalyticsScope, fn func(*biz.ToolEvent) error) error {
+	// 工具服务未部署时执行记录表不存在
+	if !r.data.db.Migrator().HasTable(&model.ToolExecution{}) {
+		return nil
+	}
+
+	query := r.data.db.WithContext(ctx).Table("tool_executions AS t").
+		Select("COALESCE(t.user_id, 0), COALESCE(t.conversation_id, 0), t.tool_name, t.status, t.execution_time, t.metrics, t.created_at").
+		Joins("LEFT JOIN conversations AS c ON c.id = t.conversation_id").
+		Where("t.created_at >= ? AND t.created_at < ?", scope.Start, scope.End)
+	if scope.UserID > 0 {
+		query = query.Where("t.user_id = ?", scope.UserID)
+	}
+	if scope.WorkspaceID != nil {
+		query = query.Where("c.workspace_id = ?", *scope.WorkspaceID)
+	}
+
+	return scanRows(query, func(rows *sql.Rows) error {
+		e := &biz.ToolEvent{}
+		var metrics sql.NullString
+		if err := rows.Scan(&e.UserID, &e.ConversationID, &e.ToolName, &e.Status, &e.ExecutionTime, &metrics, &e.CreatedAt); err != nil {
+			return err
+		}
+		if metrics.Valid && metrics.String != "" {
+			var m model.ExecutionMetrics
+			if err := json.Unmarshal([]byte(metrics.String), &m); err == nil {
+				e.Cost = m.Cost
+			}
+		}
+		return fn(e)
+	})
+}
+
+func (r *aiRepo) Totals(ctx context.Context, scope *biz.AnalyticsScope) (*biz.AnalyticsTotals, error) {
+	totals := &biz.AnalyticsTotals{}
+	db := r.data.db.WithContext(ctx)
+
+	if err := applyConversationScope(db.Table("conversations AS c"), scope).
+		Select("COUNT(DISTINCT c.user_id)").Scan(&totals.Users).Error; err != nil {
+		return nil, err
+	}
+	if err := applyConversationScope(db.Table("conversations AS c"), scope).
+		Where("c.created_at >= ? AND c.created_at < ?", scope.Start, scope.End).
+		Count(&totals.Conversations).Error; err != nil {
+		return nil, err
+	}
+	if err := r.messageQuery(ctx, scope).Count(&totals.Messages).Error; err != nil {
+		return nil, err
+	}
+
+	var usage struct {
+		Tokens int64
+		Cost   float64
+	}
+	query := db.Model(&model.UsageStats{}).
+		Select("COALESCE(SUM(total_tokens), 0) AS tokens, COALESCE(SUM(total_cost), 0) AS cost").
+		Where("date BETWEEN ? AND ?", scope.Start.Format("2006-01-02"), scope.End.Format("2006-01-02"))
+	if scope.UserID > 0 {
+		query = query.Where("user_id = ?", scope.UserID)
+	}
+	if scope.WorkspaceID != nil {
+		query = query.Where("workspace_id = ?", *scope.WorkspaceID)
+	}
+	if err := query.Scan(&usage).Error; err != nil {
+		return nil, err
+	}
+	totals.Tokens = usage.Tokens
+	totals.Cost = usage.Cost
+	return totals, nil
+}
+
+func (r *aiRepo) CountActiveUsers(ctx context.Context, scope *biz.AnalyticsScope) (int64, error) {
+	userScope := *scope
+	userScope.Role = "user"
+	var count int64
+	err := r.messageQuery(ctx, &userScope).Select("COUNT(DISTINCT c.user_id)").Scan(&count).Error
+	return count, err
+}
+
+func (r *aiRepo) ListModelHealth(ctx context.Context) ([]*biz.ModelHealth, error) {
+	var pos []*model.ModelHealth
+	if err := r.data.db.WithContext(ctx).Find(&pos).Error; err != nil {
+		return nil, err
+	}
+
+	health := make([]*biz.ModelHealth, len(pos))
+	for i, po := range pos {
+		health[i] = &biz.ModelHealth{
+			ModelID:        po.ModelID,
+			IsHealthy:      po.IsHealthy,
+			ResponseTime:   po.ResponseTime,
+			SuccessRate:    po.SuccessRate,
+			TotalRequests:  po.TotalRequests,
+			FailedRequests: po.FailedRequests,
+			LatencyP50:     po.LatencyP50,
+			LatencyP95:     po.LatencyP95,
+			LatencyP99:     po.LatencyP99,
+			ErrorMessage:   po.ErrorMessage,
+			LastCheck:      po.LastCheck,
+		}
+	}
+	return health, nil
+}
+
+func (r *aiRepo) ResourceUsage(ctx context.Context) (*pb.ResourceUsageStats, error) {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	usage := &pb.ResourceUsageStats{
+		MemoryUsagePercentage: 100 * float64(mem.HeapInuse) / float64(mem.Sys),
+	}
+
+	if sqlDB, err := r.data.db.DB(); err == nil {
+		usage.DatabaseConnections = int64(sqlDB.Stats().OpenConnections)
+	}
+
+	var storageBytes int64
+	if err := r.data.db.WithContext(ctx).Model(&model.Document{}).
+		Select("COALESCE(SUM(file_size), 0)").Scan(&storageBytes).Error; err != nil {
+		return nil, err
+	}
+	usage.StorageUsageGb = float64(storageBytes) / (1 << 30)
+	return usage, nil
+}
+
+// messageQuery 消息统计查询，关联对话以获取用户与工作空间
+func (r *aiRepo) messageQuery(ctx context.Context, scope *biz.AnalyticsScope) *gorm.DB {
+	query := r.data.db.WithContext(ctx).Table("messages AS msg").
+		Joins("JOIN conversations AS c ON c.id = msg.conversation_id").
+		Where("msg.created_at >= ? AND msg.created_at < ?", scope.Start, scope.End)
+	query = applyConversationScope(query, scope)
+	if len(scope.ModelNames) > 0 {
+		query = query.Where(messageModelExpr+" IN ?", scope.ModelNames)
+	}
+	if scope.Role != "" {
+		query = query.Where("msg.role = ?", scope.Role)
+	}
+	return query
+}
+
+// applyConversationScope 按用户与工作空间过滤，查询需以 c 作为对话表别名
+func applyConversationScope(query *gorm.DB, scope *biz.AnalyticsScope) *gorm.DB {
+	if scope.UserID > 0 {
+		query = query.Where("c.user_id = ?", scope.UserID)
+	}
+	if scope.WorkspaceID != nil {
+		query = query.Where("c.workspace_id = ?", *scope.WorkspaceID)
+	}
+	return query
+}
+
+// scanRows 逐行读取查询结果
+func scanRows(query *gorm.DB, fn func(*sql.Rows) error) error {
+	rows, err := query.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := fn(rows); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// 话题分析结果缓存实现
+type topicCacheRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewTopicCacheRepo 创建话题分析结果缓存
+func NewTopicCacheRepo(data *Data, logger log.Logger) biz.TopicCacheRepo {
+	return &topicCacheRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *topicCacheRepo) GetTopicReport(ctx context.Context, key string) (*pb.GetTopicAnalysisReply, error) {
+	b, err := r.data.rdb.Get(ctx, "ai:topic:report:"+key).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	report := &pb.GetTopicAnalysisReply{}
+	if err := proto.Unmarshal(b, report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func (r *topicCacheRepo) SaveTopicReport(ctx context.Context, key string, report *pb.GetTopicAnalysisReply, ttl time.Duration) error {
+	b, err := proto.Marshal(report)
+	if err != nil {
+		return err
+	}
+	return r.data.rdb.Set(ctx, "ai:topic:report:"+key, b, ttl).Err()
 }
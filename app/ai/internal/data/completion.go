@@ -0,0 +1,400 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"universal/app/ai/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// maxCompletionBody 提供商响应体上限
+	maxCompletionBody = 8 << 20
+	// anthropicVersion Anthropic Messages API 版本
+	anthropicVersion = "2023-06-01"
+	// defaultAnthropicMaxTokens Anthropic 要求显式指定最大输出token数
+	defaultAnthropicMaxTokens = 4096
+)
+
+// 提供商协议类型，由 Provider.Config["api_type"] 指定，未指定时按提供商名称推断
+const (
+	apiTypeOpenAI    = "openai" // OpenAI 兼容的 Chat Completions 协议，含 vLLM 等
+	apiTypeAnthropic = "anthropic"
+	apiTypeOllama    = "ollama" // 生成走 OpenAI 兼容协议，模型列表使用 /api/tags
+)
+
+// 提供商HTTP调用实现
+type completionClient struct {
+	client *http.Client
+	log    *log.Helper
+}
+
+// NewCompletionClient 创建提供商调用客户端，超时由调用方的上下文控制
+func NewCompletionClient(logger log.Logger) biz.CompletionClient {
+	return &completionClient{
+		client: &http.Client{},
+		log:    log.NewHelper(logger),
+	}
+}
+
+func (c *completionClient) Complete(ctx context.Context, req *biz.CompletionRequest) (*biz.CompletionResult, error) {
+	if providerAPIType(req.Provider) == apiTypeAnthropic {
+		return c.completeAnthropic(ctx, req)
+	}
+	return c.completeOpenAI(ctx, req)
+}
+
+// providerAPIType 提供商协议类型
+func providerAPIType(p *biz.Provider) string {
+	if t := p.Config["api_type"]; t != "" {
+		return t
+	}
+	if p.Name == apiTypeAnthropic {
+		return apiTypeAnthropic
+	}
+	return apiTypeOpenAI
+}
+
+// authHeaders 按提供商协议生成鉴权请求头
+func authHeaders(p *biz.Provider, apiKey string) map[string]string {
+	if providerAPIType(p) == apiTypeAnthropic {
+		headers := map[string]string{"anthropic-version": anthropicVersion}
+		if apiKey != "" {
+			headers["x-api-key"] = apiKey
+		}
+		return headers
+	}
+	headers := map[string]string{}
+	if apiKey != "" {
+		headers["Authorization"] = "Bearer " + apiKey
+	}
+	return headers
+}
+
+// endpoint 拼接接口地址，基础地址可带或不带 /v1
+func endpoint(baseURL, path string) string {
+	base := strings.TrimRight(baseURL, "/")
+	if strings.HasSuffix(base, "/v1") {
+		return base + path
+	}
+	return base + "/v1" + path
+}
+
+type modelList struct {
+	Data []struct {
+		ID      string `json:"id"`
+		OwnedBy string `json:"owned_by"`
+	} `json:"data"`
+}
+
+type ollamaTags struct {
+	Models []struct {
+		Name    string `json:"name"`
+		Details struct {
+			Family        string `json:"family"`
+			ParameterSize string `json:"parameter_size"`
+		} `json:"details"`
+	} `json:"models"`
+}
+
+// ListModels 请求 /v1/models，OpenAI 兼容协议与 Anthropic 的响应格式一致，Ollama 请求 /api/tags
+func (c *completionClient) ListModels(ctx context.Context, provider *biz.Provider, apiKey string) ([]*biz.ProviderModel, error) {
+	apiType := providerAPIType(provider)
+	if apiType == apiTypeOllama {
+		return c.listOllamaModels(ctx, provider, apiKey)
+	}
+
+	url := endpoint(provider.APIBaseURL, "/models")
+	if apiType == apiTypeAnthropic {
+		url += "?limit=1000"
+	}
+	var resp modelList
+	if err := c.do(ctx, http.MethodGet, provider, url, authHeaders(provider, apiKey), nil, &resp); err != nil {
+		return nil, err
+	}
+	models := make([]*biz.ProviderModel, len(resp.Data))
+	for i, m := range resp.Data {
+		models[i] = &biz.ProviderModel{
+			ID:      m.ID,
+			OwnedBy: m.OwnedBy,
+		}
+	}
+	return models, nil
+}
+
+// listOllamaModels 请求 Ollama 原生接口，基础地址可带或不带 /v1
+func (c *completionClient) listOllamaModels(ctx context.Context, provider *biz.Provider, apiKey string) ([]*biz.ProviderModel, error) {
+	url := strings.TrimSuffix(strings.TrimRight(provider.APIBaseURL, "/"), "/v1") + "/api/tags"
+	var resp ollamaTags
+	if err := c.do(ctx, http.MethodGet, provider, url, authHeaders(provider, apiKey), nil, &resp); err != nil {
+		return nil, err
+	}
+	models := make([]*biz.ProviderModel, len(resp.Models))
+	for i, m := range resp.Models {
+		models[i] = &biz.ProviderModel{
+			ID:            m.Name,
+			OwnedBy:       "ollama",
+			Family:        m.Details.Family,
+			ParameterSize: m.Details.ParameterSize,
+		}
+	}
+	return models, nil
+}
+
+type openAIMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type openAIRequest struct {
+	Model       string          `json:"model"`
+	Messages    []openAIMessage `json:"messages"`
+	MaxTokens   int             `json:"max_tokens,omitempty"`
+	Temperature *float64        `json:"temperature,omitempty"`
+	TopP        *float64        `json:"top_p,omitempty"`
+	Stop        []string        `json:"stop,omitempty"`
+	Stream      bool            `json:"stream"`
+}
+
+type openAIResponse struct {
+	Choices []struct {
+		Message      openAIMessage `json:"message"`
+		FinishReason string        `json:"finish_reason"`
+	} `json:"choices"`
+	Usage struct {
+		PromptTokens        int64 `json:"prompt_tokens"`
+		CompletionTokens    int64 `json:"completion_tokens"`
+		PromptTokensDetails struct {
+			CachedTokens int64 `json:"cached_tokens"`
+		} `json:"prompt_tokens_details"`
+	} `json:"usage"`
+}
+
+func (c *completionClient) completeOpenAI(ctx context.Context, req *biz.CompletionRequest) (*biz.CompletionResult, error) {
+	body := openAIRequest{
+		Model:       req.Model.Name,
+		MaxTokens:   req.Params.MaxTokens,
+		Temperature: req.Params.Temperature,
+		TopP:        req.Params.TopP,
+		Stop:        req.Params.Stop,
+	}
+	for _, m := range req.Messages {
+		body.Messages = append(body.Messages, openAIMessage{Role: m.Role, Content: m.Content})
+	}
+
+	var resp openAIResponse
+	if err := c.do(ctx, http.MethodPost, req.Provider, endpoint(req.Provider.APIBaseURL, "/chat/completions"), authHeaders(req.Provider, req.APIKey), body, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Choices) == 0 {
+		return nil, fmt.Errorf("provider returned no choices")
+	}
+	return &biz.CompletionResult{
+		Content:      resp.Choices[0].Message.Content,
+		FinishReason: resp.Choices[0].FinishReason,
+		Usage: biz.TokenUsage{
+			InputTokens:       resp.Usage.PromptTokens,
+			CachedInputTokens: resp.Usage.PromptTokensDetails.CachedTokens,
+			OutputTokens:      resp.Usage.CompletionTokens,
+		},
+	}, nil
+}
+
+type openAIEmbeddingRequest struct {
+	Model string   `json:"model"`
+	Input []string `json:"input"`
+}
+
+type openAIEmbeddingResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float64 `json:"embedding"`
+	} `json:"data"`
+	Usage struct {
+		PromptTokens int64 `json:"prompt_tokens"`
+	} `json:"usage"`
+}
+
+// Embed 请求 OpenAI 兼容的 /v1/embeddings，Ollama 与 vLLM 同样支持，Anthropic 未提供向量化接口
+func (c *completionClient) Embed(ctx context.Context, req *biz.EmbeddingRequest) (*biz.EmbeddingResult, error) {
+	if providerAPIType(req.Provider) == apiTypeAnthropic {
+		return nil, fmt.Errorf("provider %s does not support embeddings", req.Provider.Name)
+	}
+	var resp openAIEmbeddingResponse
+	body := openAIEmbeddingRequest{Model: req.Model.Name, Input: req.Input}
+	if err := c.do(ctx, http.MethodPost, req.Provider, endpoint(req.Provider.APIBaseURL, "/embeddings"), authHeaders(req.Provider, req.APIKey), body, &resp); err != nil {
+		return nil, err
+	}
+	vectors := make([][]float64, len(req.Input))
+	for _, d := range resp.Data {
+		if d.Index < 0 || d.Index >= len(vectors) {
+			return nil, fmt.Errorf("provider returned embedding index %d for %d inputs", d.Index, len(vectors))
+		}
+		vectors[d.Index] = d.Embedding
+	}
+	for i, v := range vectors {
+		if len(v) == 0 {
+			return nil, fmt.Errorf("provider returned no embedding for input %d", i)
+		}
+	}
+	return &biz.EmbeddingResult{
+		Vectors: vectors,
+		Usage:   biz.TokenUsage{InputTokens: resp.Usage.PromptTokens},
+	}, nil
+}
+
+type anthropicRequest struct {
+	Model         string          `json:"model"`
+	System        string          `json:"system,omitempty"`
+	Messages      []openAIMessage `json:"messages"`
+	MaxTokens     int             `json:"max_tokens"`
+	Temperature   *float64        `json:"temperature,omitempty"`
+	TopP          *float64        `json:"top_p,omitempty"`
+	StopSequences []string        `json:"stop_sequences,omitempty"`
+}
+
+type anthropicResponse struct {
+	Content []struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	} `json:"content"`
+	StopReason string `json:"stop_reason"`
+	Usage      struct {
+		InputTokens              int64 `json:"input_tokens"`
+		OutputTokens             int64 `json:"output_tokens"`
+		CacheCreationInputTokens int64 `json:"cache_creation_input_tokens"`
+		CacheReadInputTokens     int64 `json:"cache_read_input_tokens"`
+	} `json:"usage"`
+}
+
+func (c *completionClient) completeAnthropic(ctx context.Context, req *biz.CompletionRequest) (*biz.CompletionResult, error) {
+	body := anthropicRequest{
+		Model:         req.Model.Name,
+		MaxTokens:     req.Params.MaxTokens,
+		Temperature:   req.Params.Temperature,
+		TopP:          req.Params.TopP,
+		StopSequences: req.Params.Stop,
+	}
+	if body.MaxTokens <= 0 && req.Model.Limits != nil {
+		body.MaxTokens = int(req.Model.Limits.MaxOutputTokens)
+	}
+	if body.MaxTokens <= 0 {
+		body.MaxTokens = defaultAnthropicMaxTokens
+	}
+	// 系统提示词通过独立字段传递
+	var system []string
+	for _, m := range req.Messages {
+		if m.Role == "system" {
+			system = append(system, m.Content)
+			continue
+		}
+		body.Messages = append(body.Messages, openAIMessage{Role: m.Role, Content: m.Content})
+	}
+	body.System = strings.Join(system, "\n\n")
+
+	var resp anthropicResponse
+	if err := c.do(ctx, http.MethodPost, req.Provider, endpoint(req.Provider.APIBaseURL, "/messages"), authHeaders(req.Provider, req.APIKey), body, &resp); err != nil {
+		return nil, err
+	}
+
+	var content strings.Builder
+	for _, block := range resp.Content {
+		if block.Type == "text" {
+			content.WriteString(block.Text)
+		}
+	}
+	// Anthropic 的 input_tokens 不含缓存部分
+	cached := resp.Usage.CacheReadInputTokens
+	return &biz.CompletionResult{
+		Content:      content.String(),
+		FinishReason: resp.StopReason,
+		Usage: biz.TokenUsage{
+			InputTokens:       resp.Usage.InputTokens + resp.Usage.CacheCreationInputTokens + cached,
+			CachedInputTokens: cached,
+			OutputTokens:      resp.Usage.OutputTokens,
+		},
+	}, nil
+}
+
+// do 发送请求并解析JSON响应，body 为nil时不带请求体，非2xx响应转换为 biz.ProviderError
+func (c *completionClient) do(ctx context.Context, method string, provider *biz.Provider, url string, headers map[string]string, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return err
+	}
+	for key, value := range provider.DefaultHeaders {
+		req.Header.Set(key, value)
+	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxCompletionBody))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &biz.ProviderError{
+			StatusCode: resp.StatusCode,
+			Message:    providerErrorMessage(data, resp.Status),
+			RetryAfter: retryAfter(resp.Header.Get("Retry-After")),
+		}
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("invalid provider response: %w", err)
+	}
+	return nil
+}
+
+// providerErrorMessage 提取 OpenAI 与 Anthropic 错误响应中的 error.message
+func providerErrorMessage(data []byte, status string) string {
+	var body struct {
+		Error struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(data, &body); err == nil && body.Error.Message != "" {
+		return body.Error.Message
+	}
+	return status
+}
+
+// retryAfter 解析以秒或HTTP日期表示的 Retry-After
+func retryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return time.Until(t)
+	}
+	return 0
+}
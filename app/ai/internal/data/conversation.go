@@ -7,6 +7,7 @@ import (
 
 	"universal/app/ai/internal/biz"
 	"universal/app/ai/internal/data/model"
+	"universal/pkg/database"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
@@ -67,11 +68,17 @@ func (r *conversationRepo) ListConversations(ctx context.Context, userID int64,
 	var conversations []*model.Conversation
 	var total int64
 
-	query := r.data.db.WithContext(ctx).Model(&model.Conversation{}).Where("user_id = ?", userID)
+	query := r.data.db.WithContext(ctx).Model(&model.Conversation{})
+	if filters.OwnedOnly {
+		query = query.Where("workspace_id = ? AND user_id = ?", filters.WorkspaceID, userID)
+	} else {
+		// 对话仅对创建者可见，另外包含共享给当前用户的记录
+		query = query.Where("(workspace_id = ? AND user_id = ?) OR id IN (?)", filters.WorkspaceID, userID, sharedResourceIDs(r.data.db.WithContext(ctx), model.ShareResourceConversation, userID))
+	}
 
 	// 应用过滤条件
 	if filters.Keyword != "" {
-		query = query.Where("title LIKE ? OR description LIKE ?",
+		query = query.Where(database.Like(r.data.db, "title", "description"),
 			fmt.Sprintf("%%%s%%", filters.Keyword),
 			fmt.Sprintf("%%%s%%", filters.Keyword))
 	}
@@ -83,7 +90,7 @@ func (r *conversationRepo) ListConversations(ctx context.Context, userID int64,
 	if len(filters.Tags) > 0 {
 		// 标签过滤 - 使用JSON查询
 		for _, tag := range filters.Tags {
-			query = query.Where("JSON_CONTAINS(tags, ?)", fmt.Sprintf(`"%s"`, tag))
+			query = query.Where(database.JSONArrayContains(r.data.db, "tags"), tag)
 		}
 	}
 
@@ -109,6 +116,25 @@ func (r *conversationRepo) ListConversations(ctx context.Context, userID int64,
 		return nil, 0, err
 	}
 
+	// 标记调用者对每条记录的权限
+	var sharedIDs []int64
+	for _, conversation := range conversations {
+		if conversation.UserID == userID {
+			conversation.AccessRole = model.ShareRoleOwner
+		} else {
+			sharedIDs = append(sharedIDs, conversation.ID)
+		}
+	}
+	roles, err := sharedRoles(ctx, r.data.db, model.ShareResourceConversation, userID, sharedIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, conversation := range conversations {
+		if role, ok := roles[conversation.ID]; ok {
+			conversation.AccessRole = role
+		}
+	}
+
 	return conversations, total, nil
 }
 
@@ -216,6 +242,24 @@ func (r *conversationRepo) ListMessages(ctx context.Context, conversationID int6
 	return messages, total, nil
 }
 
+// ListRecentMessages 获取早于指定消息的最近有效消息，按时间正序返回
+func (r *conversationRepo) ListRecentMessages(ctx context.Context, conversationID, beforeID int64, limit int) ([]*model.Message, error) {
+	var messages []*model.Message
+	query := r.data.db.WithContext(ctx).
+		Where("conversation_id = ? AND status NOT IN ? AND role IN ?", conversationID, []int{4, 5}, []string{"system", "user", "assistant"})
+	if beforeID > 0 {
+		query = query.Where("id < ?", beforeID)
+	}
+	if err := query.Order("id DESC").Limit(limit).Find(&messages).Error; err != nil {
+		return nil, err
+	}
+
+	for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 {
+		messages[i], messages[j] = messages[j], messages[i]
+	}
+	return messages, nil
+}
+
 // CreateToolCall 创建工具调用
 func (r *conversationRepo) CreateToolCall(ctx context.Context, toolCall *model.ToolCall) (*model.ToolCall, error) {
 	if err := r.data.db.WithContext(ctx).Create(toolCall).Error; err != nil {
@@ -252,11 +296,12 @@ func (r *conversationRepo) SetConversationMemory(ctx context.Context, memory *mo
 }
 
 // UpdateConversationStats 更新对话统计信息
-func (r *conversationRepo) UpdateConversationStats(ctx context.Context, conversationID int64, inputTokens, outputTokens int64, duration time.Duration) error {
+func (r *conversationRepo) UpdateConversationStats(ctx context.Context, conversationID int64, inputTokens, outputTokens int64, duration time.Duration, cost float64) error {
 	updates := map[string]interface{}{
 		"total_input_tokens":  gorm.Expr("total_input_tokens + ?", inputTokens),
 		"total_output_tokens": gorm.Expr("total_output_tokens + ?", outputTokens),
 		"total_duration":      gorm.Expr("total_duration + ?", duration.Milliseconds()),
+		"total_cost":          gorm.Expr("total_cost + ?", cost),
 		"updated_at":          time.Now(),
 	}
 
@@ -300,6 +345,7 @@ func (r *conversationRepo) GetConversationStats(ctx context.Context, conversatio
 		TotalInputTokens:      conversation.TotalInputTokens,
 		TotalOutputTokens:     conversation.TotalOutputTokens,
 		ToolCallCount:         conversation.ToolCallCount,
+		TotalCost:             conversation.TotalCost,
 		TotalDuration:         time.Duration(conversation.TotalDuration) * time.Millisecond,
 		AverageResponseTime:   avgResponseTime,
 		LastMessageAt:         lastMessageAt,
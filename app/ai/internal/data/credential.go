@@ -0,0 +1,108 @@
+package data
+
+import (
+	"context"
+
+	"universal/app/ai/internal/biz"
+	"universal/app/ai/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm/clause"
+)
+
+// 自有凭证仓库实现
+type credentialRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewCredentialRepo 创建自有凭证仓库
+func NewCredentialRepo(data *Data, logger log.Logger) biz.CredentialRepo {
+	return &credentialRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *credentialRepo) SaveCredential(ctx context.Context, credential *biz.ProviderCredential) (*biz.ProviderCredential, error) {
+	po := &model.ProviderCredential{
+		ProviderID:  credential.ProviderID,
+		UserID:      credential.UserID,
+		WorkspaceID: credential.WorkspaceID,
+		Name:        credential.Name,
+		APIKey:      model.SecretString(credential.APIKey),
+	}
+
+	// 同一归属下每个提供商只保留一个凭证，重复设置即替换
+	err := r.data.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "provider_id"}, {Name: "user_id"}, {Name: "workspace_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"name", "api_key", "updated_at"}),
+	}).Create(po).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// 冲突更新时不会回填主键，重新读取
+	saved := &model.ProviderCredential{}
+	if err := r.data.db.WithContext(ctx).
+		Where("provider_id = ? AND user_id = ? AND workspace_id = ?", credential.ProviderID, credential.UserID, credential.WorkspaceID).
+		First(saved).Error; err != nil {
+		return nil, err
+	}
+	return r.convertCredentialModelToBiz(saved), nil
+}
+
+func (r *credentialRepo) DeleteCredential(ctx context.Context, providerID, userID, workspaceID int64) error {
+	return r.data.db.WithContext(ctx).
+		Where("provider_id = ? AND user_id = ? AND workspace_id = ?", providerID, userID, workspaceID).
+		Delete(&model.ProviderCredential{}).Error
+}
+
+func (r *credentialRepo) ListCredentials(ctx context.Context, userID, workspaceID int64) ([]*biz.ProviderCredential, error) {
+	var pos []*model.ProviderCredential
+	if err := r.data.db.WithContext(ctx).
+		Where("user_id = ? AND workspace_id = ?", userID, workspaceID).
+		Order("provider_id").
+		Find(&pos).Error; err != nil {
+		return nil, err
+	}
+
+	credentials := make([]*biz.ProviderCredential, len(pos))
+	for i, po := range pos {
+		credentials[i] = r.convertCredentialModelToBiz(po)
+	}
+	return credentials, nil
+}
+
+func (r *credentialRepo) FindCredentials(ctx context.Context, providerID, userID, workspaceID int64) ([]*biz.ProviderCredential, error) {
+	query := r.data.db.WithContext(ctx).Where("provider_id = ?", providerID)
+	if workspaceID > 0 {
+		query = query.Where("(user_id = ? AND workspace_id = 0) OR (user_id = 0 AND workspace_id = ?)", userID, workspaceID)
+	} else {
+		query = query.Where("user_id = ? AND workspace_id = 0", userID)
+	}
+
+	var pos []*model.ProviderCredential
+	if err := query.Find(&pos).Error; err != nil {
+		return nil, err
+	}
+
+	credentials := make([]*biz.ProviderCredential, len(pos))
+	for i, po := range pos {
+		credentials[i] = r.convertCredentialModelToBiz(po)
+	}
+	return credentials, nil
+}
+
+func (r *credentialRepo) convertCredentialModelToBiz(po *model.ProviderCredential) *biz.ProviderCredential {
+	return &biz.ProviderCredential{
+		ID:          po.ID,
+		ProviderID:  po.ProviderID,
+		UserID:      po.UserID,
+		WorkspaceID: po.WorkspaceID,
+		Name:        po.Name,
+		APIKey:      string(po.APIKey),
+		CreatedAt:   po.CreatedAt,
+		UpdatedAt:   po.UpdatedAt,
+	}
+}
@@ -1,22 +1,39 @@
 package data
 
 import (
+	"context"
+	"fmt"
+
+	userv1 "universal/api/user/v1"
 	"universal/app/ai/internal/conf"
 	"universal/app/ai/internal/data/model"
+	"universal/pkg/database"
+	"universal/pkg/discovery"
+	"universal/pkg/envelope"
+	"universal/pkg/identity"
+	"universal/pkg/idgen"
+	"universal/pkg/telemetry"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/registry"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/go-redis/redis/v8"
 	"github.com/google/wire"
-	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	gormlogger "gorm.io/gorm/logger"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewAiRepo, NewProviderRepo, NewModelRepo, NewQuotaRepo, NewRateLimitRepo, NewHealthRepo, NewConversationRepo, NewKnowledgeRepo)
+var ProviderSet = wire.NewSet(NewData, NewAiRepo, NewProviderRepo, NewModelRepo, NewQuotaRepo, NewRateLimitRepo, NewHealthRepo, NewHealthOptions, NewHealthEventPublisher, NewCredentialRepo, NewConversationRepo, NewKnowledgeRepo, NewShareRepo, NewMcpServerRepo, NewLimiterRepo, NewSchedulerRepo, NewJobLocker,
+	NewTopicCacheRepo, NewLocalEmbedder, NewEmbeddingOptions, NewRouteRepo, NewRouterOptions, NewCompletionClient, NewDependencyChecker, NewRegistry, NewDiscovery, NewWorkspaceServiceClient, NewWorkspaceRepo, NewIDGenerator)
 
 // Data .
 type Data struct {
-	db *gorm.DB
+	db     *gorm.DB
+	rdb    *redis.Client
+	cipher *envelope.Cipher
+	ids    *idgen.Generator
 }
 
 // GetDB returns the database instance
@@ -25,49 +42,125 @@ func (d *Data) GetDB() *gorm.DB {
 }
 
 // NewTestData creates a test Data instance
-func NewTestData(db *gorm.DB) *Data {
-	return &Data{db: db}
+func NewTestData(db *gorm.DB, rdb *redis.Client) *Data {
+	return &Data{db: db, rdb: rdb}
 }
 
 // NewData .
 func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
 	helper := log.NewHelper(logger)
+	// 敏感字段加密器须在读写数据库之前注册
+	cipher, err := NewSecretCipher(c.Secret)
+	if err != nil {
+		helper.Fatalf("failed to init secret cipher: %v", err)
+		return nil, nil, err
+	}
+	model.SetSecretCipher(cipher)
 	// 初始化数据库连接
-	db, err := gorm.Open(mysql.Open(c.Database.Source), &gorm.Config{
-		Logger: gormlogger.Default.LogMode(gormlogger.Info),
-	})
+	db, err := openDB(c)
 	if err != nil {
 		helper.Fatalf("failed to connect database: %v", err)
 		return nil, nil, err
 	}
 	// 数据库迁移
-	if err = db.AutoMigrate(
-		&model.Provider{},
-		&model.Model{},
-		&model.UserQuota{},
-		&model.UsageStats{},
-		&model.RateLimitConfig{},
-		&model.ModelHealth{},
-		&model.UserDefaultModel{},
-		&model.Conversation{},
-		&model.ConversationMemory{},
-		&model.Message{},
-		&model.ToolCall{},
-		&model.MessageAttachment{},
-		&model.KnowledgeBase{},
-		&model.Document{},
-		&model.KnowledgeChunk{},
-		&model.ProcessingJob{},
-		&model.SearchHistory{},
-	); err != nil {
+	if err = migrateDB(context.Background(), db, c, logger); err != nil {
 		helper.Fatalf("failed to migrate database: %v", err)
 		return nil, nil, err
 	}
+	// 初始化Redis连接，限流窗口与并发槽位在多个副本间共享
+	rdb := redis.NewClient(&redis.Options{
+		Network:      c.Redis.Network,
+		Addr:         c.Redis.Addr,
+		ReadTimeout:  c.Redis.ReadTimeout.AsDuration(),
+		WriteTimeout: c.Redis.WriteTimeout.AsDuration(),
+	})
+	// 雪花ID生成器，节点号通过Redis租约保证各副本不同
+	ids, releaseNode, err := idgen.NewGenerator(context.Background(), rdb, "ai:idgen:node", logger)
+	if err != nil {
+		helper.Fatalf("failed to init id generator: %v", err)
+		return nil, nil, err
+	}
+	if err = db.Use(ids); err != nil {
+		helper.Fatalf("failed to register id generator: %v", err)
+		return nil, nil, err
+	}
 	cleanup := func() {
 		helper.Info("closing the data resources")
+		releaseNode()
+		if err := rdb.Close(); err != nil {
+			helper.Error(err)
+		}
 	}
 	d := &Data{
-		db: db,
+		db:     db,
+		rdb:    rdb,
+		cipher: cipher,
+		ids:    ids,
 	}
 	return d, cleanup, nil
 }
+
+// NewIDGenerator 返回进程内共享的ID生成器
+func NewIDGenerator(d *Data) *idgen.Generator {
+	return d.ids
+}
+
+func openDB(c *conf.Data) (*gorm.DB, error) {
+	return database.Open(c.Database.Driver, c.Database.Source, &gorm.Config{
+		Logger: gormlogger.Default.LogMode(gormlogger.Warn),
+	})
+}
+
+// NewRegistry 按配置创建服务注册与发现
+func NewRegistry(c *conf.Registry) (discovery.Registry, error) {
+	o := discovery.Options{
+		Type: c.GetType(),
+		Consul: discovery.ConsulOptions{
+			Address:             c.GetConsul().GetAddress(),
+			Scheme:              c.GetConsul().GetScheme(),
+			DisableHealthCheck:  c.GetConsul().GetDisableHealthCheck(),
+			HealthCheckInterval: c.GetConsul().GetHealthCheckInterval().AsDuration(),
+		},
+		Etcd: discovery.EtcdOptions{
+			Endpoints:   c.GetEtcd().GetEndpoints(),
+			DialTimeout: c.GetEtcd().GetDialTimeout().AsDuration(),
+			Username:    c.GetEtcd().GetUsername(),
+			Password:    c.GetEtcd().GetPassword(),
+		},
+		Static: make(map[string][]string),
+	}
+	for _, s := range c.GetStatic() {
+		o.Static[s.Name] = append(o.Static[s.Name], s.Endpoints...)
+	}
+	return discovery.New(o)
+}
+
+func NewDiscovery(r discovery.Registry) registry.Discovery {
+	return r
+}
+
+// NewWorkspaceServiceClient 工作空间服务客户端，用于校验成员身份；注册中心或用户服务暂不可用时不阻塞启动
+func NewWorkspaceServiceClient(r registry.Discovery, logger log.Logger) (userv1.WorkspaceClient, func(), error) {
+	opts := []grpc.ClientOption{
+		grpc.WithEndpoint("discovery:///universal.user.service"),
+		grpc.WithDiscovery(discovery.Lazy(r, logger)),
+		grpc.WithMiddleware(
+			recovery.Recovery(),
+			telemetry.Client(),
+			identity.Client(),
+		),
+	}
+	if discovery.InProcess(r) {
+		opts = append(opts, grpc.WithOptions(discovery.DialOption()))
+	}
+	conn, err := grpc.DialInsecure(context.Background(), opts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to dial user service: %w", err)
+	}
+	cleanup := func() {
+		if err := conn.Close(); err != nil {
+			log.NewHelper(logger).Errorf("failed to close user service connection: %v", err)
+		}
+	}
+	return userv1.NewWorkspaceClient(conn), cleanup, nil
+}
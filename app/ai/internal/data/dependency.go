@@ -0,0 +1,90 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"universal/app/ai/internal/data/model"
+	"universal/pkg/healthcheck"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// mcpServerActive MCP服务器启用状态
+const mcpServerActive = 1
+
+// NewDependencyChecker 创建AI服务的依赖健康检查：数据库与Redis为关键依赖，MCP服务器为非关键依赖
+func NewDependencyChecker(d *Data, logger log.Logger) *healthcheck.Checker {
+	return healthcheck.NewChecker(logger, []healthcheck.Check{
+		{Name: "db", Critical: true, Probe: d.pingDB},
+		{Name: "redis", Critical: true, Probe: d.pingRedis},
+		{Name: "mcp", Probe: d.probeMcpServers},
+	})
+}
+
+func (d *Data) pingDB(ctx context.Context) error {
+	sqlDB, err := d.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
+
+func (d *Data) pingRedis(ctx context.Context) error {
+	return d.rdb.Ping(ctx).Err()
+}
+
+// probeMcpServers 检查启用的HTTP类MCP服务器是否可连接，收到任意HTTP响应即视为可达
+func (d *Data) probeMcpServers(ctx context.Context) error {
+	if !d.db.Migrator().HasTable(&model.McpServer{}) {
+		return nil
+	}
+	var servers []*model.McpServer
+	if err := d.db.WithContext(ctx).Select("id", "endpoint").
+		Where("status = ?", mcpServerActive).Find(&servers).Error; err != nil {
+		return err
+	}
+
+	var (
+		mu          sync.Mutex
+		unreachable []string
+		wg          sync.WaitGroup
+	)
+	for _, s := range servers {
+		u, err := url.Parse(s.Endpoint)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			// stdio 等非网络传输无法从服务端探测
+			continue
+		}
+		wg.Add(1)
+		go func(s *model.McpServer) {
+			defer wg.Done()
+			if err := probeEndpoint(ctx, s.Endpoint); err != nil {
+				mu.Lock()
+				unreachable = append(unreachable, s.ID)
+				mu.Unlock()
+			}
+		}(s)
+	}
+	wg.Wait()
+	if len(unreachable) > 0 {
+		return fmt.Errorf("mcp servers unreachable: %s", strings.Join(unreachable, ", "))
+	}
+	return nil
+}
+
+func probeEndpoint(ctx context.Context, endpoint string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
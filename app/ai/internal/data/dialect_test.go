@@ -0,0 +1,338 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"universal/app/ai/internal/biz"
+	"universal/app/ai/internal/data/model"
+	"universal/pkg/database"
+	"universal/pkg/idgen"
+	"universal/pkg/migrate"
+
+	pb "universal/api/ai/v1"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// testDialects 仓库测试套件覆盖的方言。MySQL 与 PostgreSQL 通过环境变量提供连接串，未设置时跳过；
+// 连接串须指向专用的测试库，测试开始时会删除其中所有的表。
+var testDialects = []struct {
+	driver string
+	env    string
+}{
+	{database.DriverSQLite, ""},
+	{database.DriverMySQL, "AI_TEST_MYSQL_DSN"},
+	{database.DriverPostgres, "AI_TEST_POSTGRES_DSN"},
+}
+
+// forEachDialect 在每种方言上以执行完全部迁移的空库运行 fn
+func forEachDialect(t *testing.T, fn func(t *testing.T, data *Data)) {
+	for _, d := range testDialects {
+		t.Run(d.driver, func(t *testing.T) {
+			fn(t, newDialectTestData(t, d.driver, d.env))
+		})
+	}
+}
+
+func newDialectTestData(t *testing.T, driver, env string) *Data {
+	t.Helper()
+	source := filepath.Join(t.TempDir(), "ai.db") + "?_pragma=busy_timeout(5000)"
+	if env != "" {
+		source = os.Getenv(env)
+		if source == "" {
+			t.Skipf("%s not set", env)
+		}
+	}
+	db, err := database.Open(driver, source, &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatalf("open %s: %v", driver, err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("sql db: %v", err)
+	}
+	t.Cleanup(func() { _ = sqlDB.Close() })
+	if driver == database.DriverSQLite {
+		sqlDB.SetMaxOpenConns(1)
+	} else {
+		dropAllTables(t, db)
+	}
+
+	m, err := migrate.New(db, migrations, log.DefaultLogger)
+	if err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	if err := m.Up(context.Background()); err != nil {
+		t.Fatalf("migrate up: %v", err)
+	}
+
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	ids, release, err := idgen.NewGenerator(context.Background(), rdb, "ai:idgen:node", log.DefaultLogger)
+	if err != nil {
+		t.Fatalf("idgen: %v", err)
+	}
+	t.Cleanup(release)
+	if err := db.Use(ids); err != nil {
+		t.Fatalf("use idgen: %v", err)
+	}
+	return &Data{db: db, rdb: rdb, ids: ids}
+}
+
+func dropAllTables(t *testing.T, db *gorm.DB) {
+	t.Helper()
+	tables, err := db.Migrator().GetTables()
+	if err != nil {
+		t.Fatalf("list tables: %v", err)
+	}
+	for _, table := range tables {
+		if err := db.Migrator().DropTable(table); err != nil {
+			t.Fatalf("drop %s: %v", table, err)
+		}
+	}
+}
+
+func TestDialectMigrations(t *testing.T) {
+	forEachDialect(t, func(t *testing.T, data *Data) {
+		ctx := context.Background()
+		m, err := migrate.New(data.db, migrations, log.DefaultLogger)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := m.Check(ctx); err != nil {
+			t.Fatalf("check after up: %v", err)
+		}
+		// 基线之后的迁移都可以回滚并重新执行
+		if err := m.Down(ctx, len(migrations)-1); err != nil {
+			t.Fatalf("down: %v", err)
+		}
+		if err := m.Up(ctx); err != nil {
+			t.Fatalf("up again: %v", err)
+		}
+		if err := m.Check(ctx); err != nil {
+			t.Fatalf("check after round trip: %v", err)
+		}
+	})
+}
+
+func TestDialectConversationFilters(t *testing.T) {
+	forEachDialect(t, func(t *testing.T, data *Data) {
+		ctx := context.Background()
+		repo := NewConversationRepo(data, log.DefaultLogger)
+		for _, c := range []*model.Conversation{
+			{Title: "Travel Plan", ModelName: "gpt-4o-mini", Tags: model.StringSlice{"travel", "todo"}},
+			{Title: "Weekly plan", ModelName: "gpt-4o-mini", Tags: model.StringSlice{"work"}},
+			{Title: "Recipes", ModelName: "gpt-4o-mini", Tags: model.StringSlice{"todo"}},
+		} {
+			c.UserID, c.Status = 1, 1
+			if _, err := repo.CreateConversation(ctx, c); err != nil {
+				t.Fatalf("create %s: %v", c.Title, err)
+			}
+		}
+
+		cases := []struct {
+			name   string
+			filter biz.ConversationFilter
+			want   int64
+		}{
+			// 各方言的模糊匹配均不区分大小写
+			{"keyword", biz.ConversationFilter{Keyword: "PLAN"}, 2},
+			{"tag", biz.ConversationFilter{Tags: []string{"todo"}}, 2},
+			{"tags", biz.ConversationFilter{Tags: []string{"todo", "travel"}}, 1},
+			{"keyword and tag", biz.ConversationFilter{Keyword: "plan", Tags: []string{"work"}}, 1},
+			{"tag prefix", biz.ConversationFilter{Tags: []string{"to"}}, 0},
+		}
+		for _, tc := range cases {
+			tc.filter.OwnedOnly = true
+			_, total, err := repo.ListConversations(ctx, 1, 1, 10, tc.filter)
+			if err != nil {
+				t.Fatalf("%s: %v", tc.name, err)
+			}
+			if total != tc.want {
+				t.Errorf("%s: total = %d, want %d", tc.name, total, tc.want)
+			}
+		}
+	})
+}
+
+func TestDialectHybridSearchKeywords(t *testing.T) {
+	forEachDialect(t, func(t *testing.T, data *Data) {
+		ctx := context.Background()
+		repo := NewKnowledgeRepo(data, log.DefaultLogger).(*knowledgeRepo)
+		kb, err := repo.CreateKnowledgeBase(ctx, &model.KnowledgeBase{UserID: 1, Name: "kb", EmbeddingModel: "local", Status: 1})
+		if err != nil {
+			t.Fatalf("create knowledge base: %v", err)
+		}
+		doc := &model.Document{KnowledgeBaseID: kb.ID, Name: "doc", Content: "content", Status: 3}
+		if err := data.db.Create(doc).Error; err != nil {
+			t.Fatalf("create document: %v", err)
+		}
+		chunks := []*model.KnowledgeChunk{
+			{Content: "Kratos is a Go microservice framework", Keywords: model.StringSlice{"kratos", "golang"}},
+			{Content: "Unrelated text about cooking", Keywords: model.StringSlice{"Recipes"}},
+		}
+		for i, c := range chunks {
+			c.DocumentID, c.KnowledgeBaseID, c.ChunkIndex, c.IndexVersion = doc.ID, kb.ID, i, kb.IndexVersion
+		}
+		if err := repo.CreateKnowledgeChunks(ctx, chunks); err != nil {
+			t.Fatalf("create chunks: %v", err)
+		}
+
+		// 关键词只出现在 JSON 关键词列表中也能命中
+		results, err := repo.HybridSearch(ctx, kb.ID, "anything", []string{"GOLANG"}, 10, 0.5, 0.5, 0, nil)
+		if err != nil {
+			t.Fatalf("hybrid search: %v", err)
+		}
+		if len(results) != 1 || results[0].Chunk.ID != chunks[0].ID {
+			t.Fatalf("results = %+v, want chunk %d", results, chunks[0].ID)
+		}
+	})
+}
+
+func TestDialectDocumentHashUnique(t *testing.T) {
+	forEachDialect(t, func(t *testing.T, data *Data) {
+		ctx := context.Background()
+		repo := NewKnowledgeRepo(data, log.DefaultLogger)
+		kb, err := repo.CreateKnowledgeBase(ctx, &model.KnowledgeBase{UserID: 1, Name: "kb", EmbeddingModel: "local", Status: 1})
+		if err != nil {
+			t.Fatalf("create knowledge base: %v", err)
+		}
+		newDoc := func() *model.Document {
+			return &model.Document{KnowledgeBaseID: kb.ID, Name: "doc", Content: "content", Hash: "h", Status: 1}
+		}
+		first, err := repo.CreateDocument(ctx, newDoc())
+		if err != nil {
+			t.Fatalf("create document: %v", err)
+		}
+		// 各方言的唯一约束冲突都转换为重复文档错误
+		if _, err := repo.CreateDocument(ctx, newDoc()); !errors.Is(err, biz.ErrDocumentDuplicate) {
+			t.Fatalf("create duplicate: err = %v, want ErrDocumentDuplicate", err)
+		}
+		if err := repo.DeleteDocument(ctx, []int64{first.ID}, false); err != nil {
+			t.Fatalf("delete document: %v", err)
+		}
+		if _, err := repo.CreateDocument(ctx, newDoc()); err != nil {
+			t.Fatalf("create after delete: %v", err)
+		}
+	})
+}
+
+func TestDialectSaveDocumentVersionConflict(t *testing.T) {
+	forEachDialect(t, func(t *testing.T, data *Data) {
+		ctx := context.Background()
+		repo := NewKnowledgeRepo(data, log.DefaultLogger)
+		doc, err := repo.CreateDocument(ctx, &model.Document{KnowledgeBaseID: 1, Name: "doc", Content: "v1", Hash: "h1", Version: 1, Status: 1})
+		if err != nil {
+			t.Fatalf("create document: %v", err)
+		}
+		// 两个请求读取同一版本后各自更新，后保存的一方冲突，快照一并回滚
+		update := func(content string) (bool, error) {
+			d := *doc
+			snapshot := &model.DocumentVersion{DocumentID: d.ID, Version: d.Version, Name: d.Name, Content: d.Content, Hash: d.Hash}
+			d.Content, d.Hash, d.Version = content, content, d.Version+1
+			return repo.SaveDocumentVersion(ctx, &d, snapshot, doc.Version)
+		}
+		if ok, err := update("v2"); err != nil || !ok {
+			t.Fatalf("first update: ok = %v err = %v", ok, err)
+		}
+		if ok, err := update("v2 concurrent"); err != nil || ok {
+			t.Fatalf("second update: ok = %v err = %v, want a conflict", ok, err)
+		}
+		got, err := repo.GetDocument(ctx, doc.ID)
+		if err != nil {
+			t.Fatalf("get document: %v", err)
+		}
+		if got.Content != "v2" || got.Version != 2 {
+			t.Fatalf("document = %q version %d, want v2 version 2", got.Content, got.Version)
+		}
+	})
+}
+
+func TestDialectReindexFencing(t *testing.T) {
+	forEachDialect(t, func(t *testing.T, data *Data) {
+		ctx := context.Background()
+		repo := NewKnowledgeRepo(data, log.DefaultLogger)
+		kb, err := repo.CreateKnowledgeBase(ctx, &model.KnowledgeBase{UserID: 1, Name: "kb", EmbeddingModel: "local", Status: 1})
+		if err != nil {
+			t.Fatalf("create knowledge base: %v", err)
+		}
+		shadow := func() []*model.KnowledgeChunk {
+			return []*model.KnowledgeChunk{{DocumentID: 1, KnowledgeBaseID: kb.ID, Content: "c", IndexVersion: kb.IndexVersion + 1}}
+		}
+		mustOwn := func(name string, owned bool, err error, want bool) {
+			t.Helper()
+			if err != nil || owned != want {
+				t.Fatalf("%s: owned = %v err = %v, want %v", name, owned, err, want)
+			}
+		}
+
+		owned, err := repo.ClaimReindex(ctx, kb.ID, "job_a", []int{1, 3})
+		mustOwn("claim a", owned, err, true)
+		owned, err = repo.CreateIndexChunks(ctx, kb.ID, "job_a", shadow())
+		mustOwn("insert a", owned, err, true)
+		owned, err = repo.ClaimReindex(ctx, kb.ID, "job_b", []int{1, 3})
+		mustOwn("claim b without force", owned, err, false)
+
+		// 强制重建后原任务的写入、回滚与切换都被拒绝
+		owned, err = repo.ClaimReindex(ctx, kb.ID, "job_b", []int{1, 3, 2})
+		mustOwn("claim b with force", owned, err, true)
+		owned, err = repo.CreateIndexChunks(ctx, kb.ID, "job_a", shadow())
+		mustOwn("insert a after takeover", owned, err, false)
+		owned, err = repo.DeleteIndexChunks(ctx, kb.ID, "job_a", kb.IndexVersion+1, nil)
+		mustOwn("drop shadow a after takeover", owned, err, false)
+		owned, err = repo.ReleaseReindex(ctx, kb.ID, "job_a")
+		mustOwn("release a after takeover", owned, err, false)
+		swap := &model.KnowledgeBase{ID: kb.ID, IndexVersion: kb.IndexVersion + 1, Status: 1, EmbeddingModel: "local"}
+		owned, err = repo.SwapIndex(ctx, swap, kb.IndexVersion, "job_a")
+		mustOwn("swap a after takeover", owned, err, false)
+
+		owned, err = repo.DeleteIndexChunks(ctx, kb.ID, "job_b", kb.IndexVersion+1, nil)
+		mustOwn("drop leftovers b", owned, err, true)
+		owned, err = repo.SwapIndex(ctx, swap, kb.IndexVersion, "job_b")
+		mustOwn("swap b", owned, err, true)
+		got, err := repo.GetKnowledgeBase(ctx, kb.ID)
+		if err != nil {
+			t.Fatalf("get knowledge base: %v", err)
+		}
+		if got.Status != 1 || got.ReindexJobID != "" || got.IndexVersion != kb.IndexVersion+1 {
+			t.Fatalf("knowledge base = status %d owner %q version %d after swap", got.Status, got.ReindexJobID, got.IndexVersion)
+		}
+	})
+}
+
+func TestDialectModelCapabilities(t *testing.T) {
+	forEachDialect(t, func(t *testing.T, data *Data) {
+		ctx := context.Background()
+		provider := &model.Provider{Name: "openai", DisplayName: "OpenAI"}
+		if err := data.db.Create(provider).Error; err != nil {
+			t.Fatalf("create provider: %v", err)
+		}
+		repo := NewModelRepo(data, log.DefaultLogger)
+		for _, m := range []*biz.Model{
+			{Name: "gpt-4o", Capabilities: &pb.ModelCapabilities{SupportsVision: true}},
+			{Name: "text-embedding-3-small", Capabilities: &pb.ModelCapabilities{}},
+		} {
+			m.ProviderID, m.DisplayName = provider.ID, m.Name
+			if _, err := repo.CreateModel(ctx, m); err != nil {
+				t.Fatalf("create model %s: %v", m.Name, err)
+			}
+		}
+
+		models, total, err := repo.ListModels(ctx, provider.ID, -1, []string{"supports_vision"}, 1, 10)
+		if err != nil {
+			t.Fatalf("list models: %v", err)
+		}
+		if total != 1 || len(models) != 1 || models[0].Name != "gpt-4o" {
+			t.Fatalf("models = %d %+v, want gpt-4o", total, models)
+		}
+	})
+}
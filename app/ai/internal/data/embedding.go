@@ -0,0 +1,82 @@
+package data
+
+import (
+	"context"
+	"hash/fnv"
+	"math"
+
+	"universal/app/ai/internal/biz"
+	"universal/app/ai/internal/conf"
+	"universal/pkg/textseg"
+)
+
+// localEmbeddingDim 本地向量维度
+const localEmbeddingDim = 256
+
+// localEmbedder 离线文本向量化，对分词结果及词内字符二元组做带符号特征哈希，
+// 不依赖外部模型服务，字面相近的表达映射到相近的向量
+type localEmbedder struct {
+	seg *textseg.Segmenter
+}
+
+// NewLocalEmbedder 创建本地文本向量化实现，配置 embedding.local 时替代模型提供商
+func NewLocalEmbedder() biz.LocalEmbedder {
+	return &localEmbedder{seg: textseg.Default()}
+}
+
+// NewEmbeddingOptions 转换向量化配置
+func NewEmbeddingOptions(c *conf.Embedding) *biz.EmbeddingOptions {
+	return &biz.EmbeddingOptions{
+		Model:     c.GetModel(),
+		Local:     c.GetLocal(),
+		BatchSize: int(c.GetBatchSize()),
+	}
+}
+
+// Embed 本地向量化不区分模型
+func (e *localEmbedder) Embed(ctx context.Context, _ string, texts []string) ([][]float64, error) {
+	vectors := make([][]float64, len(texts))
+	for i, text := range texts {
+		if i%1000 == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+		vectors[i] = e.embed(text)
+	}
+	return vectors, nil
+}
+
+func (e *localEmbedder) embed(text string) []float64 {
+	features := make(map[string]float64)
+	for _, token := range e.seg.Keywords(text) {
+		features[token]++
+		runes := []rune(token)
+		for i := 0; i+1 < len(runes); i++ {
+			features["#"+string(runes[i:i+2])] += 0.5
+		}
+	}
+
+	vec := make([]float64, localEmbeddingDim)
+	var norm float64
+	for feature, tf := range features {
+		h := fnv.New64a()
+		h.Write([]byte(feature))
+		sum := h.Sum64()
+		weight := 1 + math.Log(tf+1)
+		if sum>>63 == 1 {
+			weight = -weight
+		}
+		vec[sum%localEmbeddingDim] += weight
+	}
+	for _, x := range vec {
+		norm += x * x
+	}
+	if norm > 0 {
+		norm = math.Sqrt(norm)
+		for i := range vec {
+			vec[i] /= norm
+		}
+	}
+	return vec
+}
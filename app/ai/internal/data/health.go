@@ -0,0 +1,54 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+
+	"universal/app/ai/internal/biz"
+	"universal/app/ai/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// healthEventChannel 健康状态变化事件的Redis发布频道
+const healthEventChannel = "ai:health:events"
+
+// 基于Redis发布订阅的健康事件实现
+type healthEventPublisher struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewHealthEventPublisher 创建健康事件发布器
+func NewHealthEventPublisher(data *Data, logger log.Logger) biz.HealthEventPublisher {
+	return &healthEventPublisher{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (p *healthEventPublisher) PublishHealthEvent(ctx context.Context, event *biz.HealthEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return p.data.rdb.Publish(ctx, healthEventChannel, payload).Err()
+}
+
+// NewHealthOptions 转换健康探测配置，未配置的项使用默认值
+func NewHealthOptions(c *conf.Health) *biz.HealthOptions {
+	opts := &biz.HealthOptions{
+		ProbeConcurrency: int(c.GetProbeConcurrency()),
+		MinSuccessRate:   c.GetMinSuccessRate(),
+	}
+	if c.GetProbeTimeout() != nil {
+		opts.ProbeTimeout = c.ProbeTimeout.AsDuration()
+	}
+	if c.GetWindow() != nil {
+		opts.Window = c.Window.AsDuration()
+	}
+	if c.GetRetention() != nil {
+		opts.Retention = c.Retention.AsDuration()
+	}
+	return opts
+}
@@ -2,16 +2,23 @@ package data
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"universal/app/ai/internal/biz"
 	"universal/app/ai/internal/data/model"
+	"universal/pkg/database"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// errVersionConflict 条件保存时文档已被修改，用于回滚事务
+var errVersionConflict = errors.New("document version conflict")
+
 type knowledgeRepo struct {
 	data   *Data
 	logger *log.Helper
@@ -43,9 +50,13 @@ func (r *knowledgeRepo) GetKnowledgeBase(ctx context.Context, id int64) (*model.
 	return &kb, nil
 }
 
-// UpdateKnowledgeBase 更新知识库
+// UpdateKnowledgeBase 更新知识库，状态、索引版本与统计信息由专门的方法维护，不随之覆盖
 func (r *knowledgeRepo) UpdateKnowledgeBase(ctx context.Context, kb *model.KnowledgeBase) (*model.KnowledgeBase, error) {
-	if err := r.data.db.WithContext(ctx).Save(kb).Error; err != nil {
+	err := r.data.db.WithContext(ctx).
+		Omit("status", "index_version", "last_indexed_at", "document_count", "chunk_count",
+			"total_characters", "total_tokens", "storage_size", "index_size").
+		Save(kb).Error
+	if err != nil {
 		return nil, err
 	}
 	return kb, nil
@@ -85,11 +96,21 @@ func (r *knowledgeRepo) ListKnowledgeBases(ctx context.Context, userID int64, pa
 	var kbs []*model.KnowledgeBase
 	var total int64
 
-	query := r.data.db.WithContext(ctx).Model(&model.KnowledgeBase{}).Where("user_id = ?", userID)
+	query := r.data.db.WithContext(ctx).Model(&model.KnowledgeBase{})
+	switch {
+	case filters.OwnedOnly:
+		query = query.Where("workspace_id = ? AND user_id = ?", filters.WorkspaceID, userID)
+	case filters.WorkspaceID > 0:
+		// 工作空间内的知识库对所有成员共享
+		query = query.Where("workspace_id = ?", filters.WorkspaceID)
+	default:
+		// 个人空间，包含共享给当前用户的记录
+		query = query.Where("(workspace_id = 0 AND user_id = ?) OR id IN (?)", userID, sharedResourceIDs(r.data.db.WithContext(ctx), model.ShareResourceKnowledgeBase, userID))
+	}
 
 	// 应用过滤条件
 	if filters.Keyword != "" {
-		query = query.Where("name LIKE ? OR description LIKE ?",
+		query = query.Where(database.Like(r.data.db, "name", "description"),
 			fmt.Sprintf("%%%s%%", filters.Keyword),
 			fmt.Sprintf("%%%s%%", filters.Keyword))
 	}
@@ -100,7 +121,7 @@ func (r *knowledgeRepo) ListKnowledgeBases(ctx context.Context, userID int64, pa
 
 	if len(filters.Tags) > 0 {
 		for _, tag := range filters.Tags {
-			query = query.Where("JSON_CONTAINS(tags, ?)", fmt.Sprintf(`"%s"`, tag))
+			query = query.Where(database.JSONArrayContains(r.data.db, "tags"), tag)
 		}
 	}
 
@@ -126,6 +147,25 @@ func (r *knowledgeRepo) ListKnowledgeBases(ctx context.Context, userID int64, pa
 		return nil, 0, err
 	}
 
+	// 标记调用者对每条记录的权限
+	var sharedIDs []int64
+	for _, kb := range kbs {
+		if kb.UserID == userID {
+			kb.AccessRole = model.ShareRoleOwner
+		} else {
+			sharedIDs = append(sharedIDs, kb.ID)
+		}
+	}
+	roles, err := sharedRoles(ctx, r.data.db, model.ShareResourceKnowledgeBase, userID, sharedIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, kb := range kbs {
+		if role, ok := roles[kb.ID]; ok {
+			kb.AccessRole = role
+		}
+	}
+
 	return kbs, total, nil
 }
 
@@ -136,6 +176,9 @@ func (r *knowledgeRepo) CreateDocument(ctx context.Context, doc *model.Document)
 
 func (r *knowledgeRepo) createDocumentWithTx(ctx context.Context, tx *gorm.DB, doc *model.Document) (*model.Document, error) {
 	if err := tx.WithContext(ctx).Create(doc).Error; err != nil {
+		if database.IsDuplicateKey(tx, err) {
+			return nil, biz.ErrDocumentDuplicate
+		}
 		return nil, err
 	}
 
@@ -172,6 +215,93 @@ func (r *knowledgeRepo) UpdateDocument(ctx context.Context, doc *model.Document)
 	return doc, nil
 }
 
+// FindDocumentByHash 查找知识库中内容哈希相同的有效文档，不含已归档与已删除的文档
+func (r *knowledgeRepo) FindDocumentByHash(ctx context.Context, kbID int64, hash string) (*model.Document, error) {
+	var doc model.Document
+	err := r.data.db.WithContext(ctx).
+		Where("knowledge_base_id = ? AND hash = ? AND status NOT IN ?", kbID, hash, []int{5, 6}).
+		Order("version DESC").
+		First(&doc).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// FindLatestDocumentByName 查找知识库中同名文档的最新有效版本
+func (r *knowledgeRepo) FindLatestDocumentByName(ctx context.Context, kbID int64, name string) (*model.Document, error) {
+	var doc model.Document
+	err := r.data.db.WithContext(ctx).
+		Where("knowledge_base_id = ? AND name = ? AND status NOT IN ?", kbID, name, []int{5, 6}).
+		Order("version DESC").
+		First(&doc).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// SaveDocumentVersion 文档版本号仍为 version 时保存文档，snapshot 不为空时在同一事务中写入上一版本的快照；
+// 文档已被其他请求修改时不保存，返回 false
+func (r *knowledgeRepo) SaveDocumentVersion(ctx context.Context, doc *model.Document, snapshot *model.DocumentVersion, version int) (bool, error) {
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if snapshot != nil {
+			if err := tx.Create(snapshot).Error; err != nil {
+				// 并发更新已写入同一版本的快照
+				if database.IsDuplicateKey(tx, err) {
+					return errVersionConflict
+				}
+				return err
+			}
+		}
+		result := tx.Model(doc).Where("version = ?", version).Select("*").Omit(clause.Associations).Updates(doc)
+		if result.Error != nil {
+			if database.IsDuplicateKey(tx, result.Error) {
+				return biz.ErrDocumentDuplicate
+			}
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errVersionConflict
+		}
+		return nil
+	})
+	if errors.Is(err, errVersionConflict) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// GetDocumentVersion 获取文档的历史版本
+func (r *knowledgeRepo) GetDocumentVersion(ctx context.Context, documentID int64, version int) (*model.DocumentVersion, error) {
+	var v model.DocumentVersion
+	err := r.data.db.WithContext(ctx).Where("document_id = ? AND version = ?", documentID, version).First(&v).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &v, nil
+}
+
+// ListDocumentHashes 获取知识库中有效文档的ID、名称与内容哈希
+func (r *knowledgeRepo) ListDocumentHashes(ctx context.Context, kbID int64) ([]*model.Document, error) {
+	var docs []*model.Document
+	err := r.data.db.WithContext(ctx).Model(&model.Document{}).
+		Select("id", "name", "hash", "version").
+		Where("knowledge_base_id = ? AND status NOT IN ?", kbID, []int{5, 6}).
+		Order("id ASC").
+		Find(&docs).Error
+	return docs, err
+}
+
 // DeleteDocument 删除文档
 func (r *knowledgeRepo) DeleteDocument(ctx context.Context, documentIDs []int64, hardDelete bool) error {
 	if len(documentIDs) == 0 {
@@ -181,7 +311,11 @@ func (r *knowledgeRepo) DeleteDocument(ctx context.Context, documentIDs []int64,
 	if hardDelete {
 		return r.data.db.WithContext(ctx).Unscoped().Delete(&model.Document{}, documentIDs).Error
 	} else {
-		return r.data.db.WithContext(ctx).Model(&model.Document{}).Where("id IN ?", documentIDs).Update("status", 6).Error
+		// 置空哈希，之后可以重新上传相同内容
+		return r.data.db.WithContext(ctx).Model(&model.Document{}).Where("id IN ?", documentIDs).Updates(map[string]interface{}{
+			"status": 6,
+			"hash":   gorm.Expr("NULL"),
+		}).Error
 	}
 }
 
@@ -194,7 +328,7 @@ func (r *knowledgeRepo) ListDocuments(ctx context.Context, kbID int64, page, pag
 
 	// 应用过滤条件
 	if filters.Keyword != "" {
-		query = query.Where("name LIKE ? OR content LIKE ?",
+		query = query.Where(database.Like(r.data.db, "name", "content"),
 			fmt.Sprintf("%%%s%%", filters.Keyword),
 			fmt.Sprintf("%%%s%%", filters.Keyword))
 	}
@@ -209,7 +343,7 @@ func (r *knowledgeRepo) ListDocuments(ctx context.Context, kbID int64, page, pag
 
 	if len(filters.Tags) > 0 {
 		for _, tag := range filters.Tags {
-			query = query.Where("JSON_CONTAINS(tags, ?)", fmt.Sprintf(`"%s"`, tag))
+			query = query.Where(database.JSONArrayContains(r.data.db, "tags"), tag)
 		}
 	}
 
@@ -305,7 +439,7 @@ func (r *knowledgeRepo) ListKnowledgeChunks(ctx context.Context, documentID int6
 	var chunks []*model.KnowledgeChunk
 	var total int64
 
-	query := r.data.db.WithContext(ctx).Model(&model.KnowledgeChunk{}).Where("document_id = ?", documentID)
+	query := r.data.db.WithContext(ctx).Model(&model.KnowledgeChunk{}).Scopes(activeDocumentIndex(documentID))
 
 	// 获取总数
 	if err := query.Count(&total).Error; err != nil {
@@ -321,11 +455,196 @@ func (r *knowledgeRepo) ListKnowledgeChunks(ctx context.Context, documentID int6
 	return chunks, total, nil
 }
 
+// ListChunkFingerprints 获取知识库中有效文档全部知识块的指纹，不加载内容
+func (r *knowledgeRepo) ListChunkFingerprints(ctx context.Context, kbID int64) ([]*model.KnowledgeChunk, error) {
+	var chunks []*model.KnowledgeChunk
+	err := r.data.db.WithContext(ctx).Model(&model.KnowledgeChunk{}).
+		Select("knowledge_chunks.id", "knowledge_chunks.document_id", "knowledge_chunks.chunk_index",
+			"knowledge_chunks.sim_hash", "knowledge_chunks.min_hash").
+		Joins("JOIN documents ON documents.id = knowledge_chunks.document_id").
+		Scopes(activeIndex(kbID)).
+		Where("documents.status NOT IN ? AND documents.deleted_at IS NULL", []int{5, 6}).
+		Order("knowledge_chunks.id ASC").
+		Find(&chunks).Error
+	return chunks, err
+}
+
+// ListDocumentChunks 获取文档的全部知识块
+func (r *knowledgeRepo) ListDocumentChunks(ctx context.Context, documentID int64) ([]*model.KnowledgeChunk, error) {
+	var chunks []*model.KnowledgeChunk
+	err := r.data.db.WithContext(ctx).Scopes(activeDocumentIndex(documentID)).Order("chunk_index ASC").Find(&chunks).Error
+	return chunks, err
+}
+
+// ApplyChunkChanges 在同一事务中删除、更新与新增文档的知识块，并更新文档的块数量
+func (r *knowledgeRepo) ApplyChunkChanges(ctx context.Context, documentID int64, changes biz.ChunkChanges) error {
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(changes.DeletedIDs) > 0 {
+			if err := tx.Where("id IN ?", changes.DeletedIDs).Delete(&model.KnowledgeChunk{}).Error; err != nil {
+				return err
+			}
+		}
+
+		// 沿用的知识块内容与向量不变，只更新序号与位置
+		now := time.Now()
+		for _, chunk := range changes.Updated {
+			if err := tx.Model(&model.KnowledgeChunk{}).Where("id = ?", chunk.ID).UpdateColumns(map[string]interface{}{
+				"chunk_index":    chunk.ChunkIndex,
+				"start_position": chunk.StartPosition,
+				"end_position":   chunk.EndPosition,
+				"updated_at":     now,
+			}).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(changes.Added) > 0 {
+			if err := tx.CreateInBatches(changes.Added, 100).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&model.Document{}).Where("id = ?", documentID).Updates(map[string]interface{}{
+			"chunk_count": len(changes.Added) + len(changes.Updated),
+			"updated_at":  now,
+		}).Error
+	})
+}
+
 // DeleteKnowledgeChunks 删除知识块
 func (r *knowledgeRepo) DeleteKnowledgeChunks(ctx context.Context, documentID int64) error {
 	return r.data.db.WithContext(ctx).Where("document_id = ?", documentID).Delete(&model.KnowledgeChunk{}).Error
 }
 
+// ListIndexedDocuments 获取知识库中已处理完成的文档的ID与内容哈希，用于重建索引
+func (r *knowledgeRepo) ListIndexedDocuments(ctx context.Context, kbID int64) ([]*model.Document, error) {
+	var docs []*model.Document
+	err := r.data.db.WithContext(ctx).Model(&model.Document{}).
+		Select("id", "hash").
+		Where("knowledge_base_id = ? AND status = ?", kbID, 3). // processed
+		Order("id ASC").
+		Find(&docs).Error
+	return docs, err
+}
+
+// CreateIndexChunks 创建影子索引的知识块，不更新文档与知识库统计；
+// 知识库的重建索引已不归 jobID 所有时不写入，返回 false
+func (r *knowledgeRepo) CreateIndexChunks(ctx context.Context, kbID int64, jobID string, chunks []*model.KnowledgeChunk) (bool, error) {
+	owned := false
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if owned, err = lockReindexOwner(tx, kbID, jobID); err != nil || !owned {
+			return err
+		}
+		if len(chunks) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(chunks, 100).Error
+	})
+	return owned, err
+}
+
+// DeleteIndexChunks 删除知识库指定索引版本的知识块，documentIDs 为空时删除该版本全部知识块；
+// 当前生效的索引版本不会被删除。jobID 不为空时只在该任务仍持有重建索引时删除，否则返回 false
+func (r *knowledgeRepo) DeleteIndexChunks(ctx context.Context, kbID int64, jobID string, indexVersion int, documentIDs []int64) (bool, error) {
+	owned := jobID == ""
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if jobID != "" {
+			var err error
+			if owned, err = lockReindexOwner(tx, kbID, jobID); err != nil || !owned {
+				return err
+			}
+		}
+		query := tx.Where("knowledge_base_id = ? AND index_version = ?", kbID, indexVersion).
+			Where("index_version <> (SELECT index_version FROM knowledge_bases WHERE id = ?)", kbID)
+		if len(documentIDs) > 0 {
+			query = query.Where("document_id IN ?", documentIDs)
+		}
+		return query.Delete(&model.KnowledgeChunk{}).Error
+	})
+	return owned, err
+}
+
+// lockReindexOwner 锁定知识库行并确认重建索引仍归 jobID 所有，强制重建改换归属须等待持锁的事务结束；
+// SQLite 不支持行锁，由数据库级的写锁串行化
+func lockReindexOwner(tx *gorm.DB, kbID int64, jobID string) (bool, error) {
+	var ids []int64
+	err := tx.Model(&model.KnowledgeBase{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND reindex_job_id = ?", kbID, jobID).
+		Pluck("id", &ids).Error
+	return len(ids) > 0, err
+}
+
+// ClaimReindex 知识库状态为 from 之一时置为索引中并由 jobID 持有重建索引，返回是否更新。
+// 强制重建时 from 包含索引中，原任务随之失去归属
+func (r *knowledgeRepo) ClaimReindex(ctx context.Context, id int64, jobID string, from []int) (bool, error) {
+	result := r.data.db.WithContext(ctx).Model(&model.KnowledgeBase{}).
+		Where("id = ? AND status IN ?", id, from).
+		Updates(map[string]interface{}{
+			"status":         2, // indexing
+			"reindex_job_id": jobID,
+			"updated_at":     time.Now(),
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// ReleaseReindex 重建索引失败时恢复知识库为可用，重建索引已不归 jobID 所有时不更新，返回是否更新
+func (r *knowledgeRepo) ReleaseReindex(ctx context.Context, id int64, jobID string) (bool, error) {
+	result := r.data.db.WithContext(ctx).Model(&model.KnowledgeBase{}).
+		Where("id = ? AND status = ? AND reindex_job_id = ?", id, 2, jobID).
+		Updates(map[string]interface{}{
+			"status":         1, // active
+			"reindex_job_id": "",
+			"updated_at":     time.Now(),
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// SwapIndex 切换到 kb.IndexVersion 指定的索引版本，同时写入新的索引配置与统计，
+// 并按新版本重新统计各文档的块数量；当前版本已不是 from 或重建索引已不归 jobID 所有时不切换，返回 false
+func (r *knowledgeRepo) SwapIndex(ctx context.Context, kb *model.KnowledgeBase, from int, jobID string) (bool, error) {
+	swapped := false
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		kb.ReindexJobID = ""
+		result := tx.Model(&model.KnowledgeBase{}).
+			Where("id = ? AND index_version = ? AND reindex_job_id = ?", kb.ID, from, jobID).
+			Select("index_version", "embedding_model", "chunk_size", "chunk_overlap", "config", "status",
+				"reindex_job_id", "chunk_count", "total_tokens", "index_size", "last_indexed_at", "updated_at").
+			Updates(kb)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		swapped = true
+		return tx.Model(&model.Document{}).
+			Where("knowledge_base_id = ?", kb.ID).
+			UpdateColumn("chunk_count", gorm.Expr(
+				"(SELECT COUNT(*) FROM knowledge_chunks WHERE knowledge_chunks.document_id = documents.id AND knowledge_chunks.index_version = ?)",
+				kb.IndexVersion)).Error
+	})
+	return swapped, err
+}
+
+// activeIndex 只查询知识库当前生效索引版本的知识块，重建索引期间影子索引的知识块不可见
+func activeIndex(kbID int64) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("knowledge_chunks.knowledge_base_id = ? AND knowledge_chunks.index_version = (SELECT index_version FROM knowledge_bases WHERE id = ?)",
+			kbID, kbID)
+	}
+}
+
+// activeDocumentIndex 只查询文档在所属知识库当前生效索引版本下的知识块
+func activeDocumentIndex(documentID int64) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("knowledge_chunks.document_id = ? AND knowledge_chunks.index_version = "+
+			"(SELECT knowledge_bases.index_version FROM knowledge_bases JOIN documents ON documents.knowledge_base_id = knowledge_bases.id WHERE documents.id = ?)",
+			documentID, documentID)
+	}
+}
+
 // SearchKnowledge 知识搜索
 func (r *knowledgeRepo) SearchKnowledge(ctx context.Context, kbID int64, query string, limit int32, threshold float64, filters map[string]string) ([]*model.KnowledgeChunk, error) {
 	// TODO: 实现向量搜索
@@ -335,8 +654,8 @@ func (r *knowledgeRepo) SearchKnowledge(ctx context.Context, kbID int64, query s
 	var chunks []*model.KnowledgeChunk
 
 	dbQuery := r.data.db.WithContext(ctx).Model(&model.KnowledgeChunk{}).
-		Where("knowledge_base_id = ?", kbID).
-		Where("content LIKE ?", fmt.Sprintf("%%%s%%", query)).
+		Scopes(activeIndex(kbID)).
+		Where(database.Like(r.data.db, "content"), fmt.Sprintf("%%%s%%", query)).
 		Order("created_at DESC").
 		Limit(int(limit))
 
@@ -363,7 +682,7 @@ func (r *knowledgeRepo) SearchKnowledge(ctx context.Context, kbID int64, query s
 }
 
 // HybridSearch 混合搜索
-func (r *knowledgeRepo) HybridSearch(ctx context.Context, kbID int64, query string, limit int32, semanticWeight, keywordWeight, threshold float64, filters map[string]string) ([]*biz.HybridSearchResult, error) {
+func (r *knowledgeRepo) HybridSearch(ctx context.Context, kbID int64, query string, keywords []string, limit int32, semanticWeight, keywordWeight, threshold float64, filters map[string]string) ([]*biz.HybridSearchResult, error) {
 	// TODO: 实现混合搜索（语义搜索 + 关键词搜索）
 	// 这里使用简化的实现
 
@@ -373,13 +692,20 @@ func (r *knowledgeRepo) HybridSearch(ctx context.Context, kbID int64, query stri
 		return nil, err
 	}
 
-	// 进行关键词搜索
+	// 进行关键词搜索，任一关键词出现在内容或关键词列表中即命中，无关键词时按整个查询匹配
+	if len(keywords) == 0 {
+		keywords = []string{query}
+	}
+	like := database.Like(r.data.db, "content", database.JSONText(r.data.db, "keywords"))
+	matchAny := r.data.db.Where(like, "%"+keywords[0]+"%", "%"+keywords[0]+"%")
+	for _, keyword := range keywords[1:] {
+		matchAny = matchAny.Or(like, "%"+keyword+"%", "%"+keyword+"%")
+	}
+
 	var keywordChunks []*model.KnowledgeChunk
 	keywordQuery := r.data.db.WithContext(ctx).Model(&model.KnowledgeChunk{}).
-		Where("knowledge_base_id = ?", kbID).
-		Where("content LIKE ? OR JSON_EXTRACT(keywords, '$') LIKE ?",
-			fmt.Sprintf("%%%s%%", query),
-			fmt.Sprintf("%%%s%%", query)).
+		Scopes(activeIndex(kbID)).
+		Where(matchAny).
 		Order("created_at DESC").
 		Limit(int(limit))
 
@@ -400,9 +726,9 @@ func (r *knowledgeRepo) HybridSearch(ctx context.Context, kbID int64, query stri
 		resultMap[chunk.ID] = result
 	}
 
-	// 处理关键词搜索结果
-	for i, chunk := range keywordChunks {
-		keywordScore := 0.9 - float64(i)*0.1
+	// 处理关键词搜索结果，分数为命中的关键词占比
+	for _, chunk := range keywordChunks {
+		keywordScore := keywordMatchRatio(chunk, keywords)
 		if result, exists := resultMap[chunk.ID]; exists {
 			result.KeywordScore = keywordScore
 		} else {
@@ -435,6 +761,29 @@ func (r *knowledgeRepo) HybridSearch(ctx context.Context, kbID int64, query stri
 	return results, nil
 }
 
+// keywordMatchRatio 关键词出现在知识块内容或关键词列表中的比例
+func keywordMatchRatio(chunk *model.KnowledgeChunk, keywords []string) float64 {
+	if len(keywords) == 0 {
+		return 0
+	}
+	content := strings.ToLower(chunk.Content)
+	matched := 0
+	for _, keyword := range keywords {
+		keyword = strings.ToLower(keyword)
+		if strings.Contains(content, keyword) {
+			matched++
+			continue
+		}
+		for _, k := range chunk.Keywords {
+			if strings.ToLower(k) == keyword {
+				matched++
+				break
+			}
+		}
+	}
+	return float64(matched) / float64(len(keywords))
+}
+
 // GetKnowledgeBaseStats 获取知识库统计信息
 func (r *knowledgeRepo) GetKnowledgeBaseStats(ctx context.Context, kbID int64) (*biz.KnowledgeBaseStats, error) {
 	var kb model.KnowledgeBase
@@ -477,7 +826,7 @@ func (r *knowledgeRepo) GetKnowledgeBaseStats(ctx context.Context, kbID int64) (
 	// 计算平均块大小
 	var avgChunkSize float64
 	r.data.db.WithContext(ctx).Model(&model.KnowledgeChunk{}).
-		Where("knowledge_base_id = ?", kbID).
+		Scopes(activeIndex(kbID)).
 		Select("AVG(character_count)").
 		Scan(&avgChunkSize)
 
@@ -0,0 +1,189 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"universal/app/ai/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+)
+
+// limitWindow 分钟级滑动窗口长度
+const limitWindow = time.Minute
+
+// acquireScript 原子地检查并占用滑动窗口与并发槽位，时间取自Redis避免副本间时钟偏差
+//
+// KEYS: 请求窗口、token窗口、并发槽位，均为有序集合
+// ARGV: 预留ID、预留token数、RPM、TPM、突发上限、并发上限、槽位租约(毫秒)、窗口长度(毫秒)
+// 返回: {是否允许, 限流原因, 建议重试毫秒数}
+var acquireScript = redis.NewScript(`
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+local id = ARGV[1]
+local tokens = tonumber(ARGV[2])
+local rpm = tonumber(ARGV[3])
+local tpm = tonumber(ARGV[4])
+local burst = tonumber(ARGV[5])
+local concurrency = tonumber(ARGV[6])
+local lease = tonumber(ARGV[7])
+local window = tonumber(ARGV[8])
+
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now - window)
+redis.call('ZREMRANGEBYSCORE', KEYS[2], '-inf', now - window)
+redis.call('ZREMRANGEBYSCORE', KEYS[3], '-inf', now)
+
+if concurrency > 0 and redis.call('ZCARD', KEYS[3]) >= concurrency then
+	return {0, 'concurrent_requests', 1000}
+end
+
+if rpm > 0 and redis.call('ZCARD', KEYS[1]) >= rpm then
+	local oldest = redis.call('ZRANGE', KEYS[1], 0, 0, 'WITHSCORES')
+	return {0, 'requests_per_minute', tonumber(oldest[2]) + window - now}
+end
+
+if burst > 0 and redis.call('ZCOUNT', KEYS[1], now - 1000, '+inf') >= burst then
+	local recent = redis.call('ZRANGEBYSCORE', KEYS[1], now - 1000, '+inf', 'WITHSCORES', 'LIMIT', 0, 1)
+	return {0, 'burst_limit', tonumber(recent[2]) + 1000 - now}
+end
+
+if tpm > 0 then
+	local entries = redis.call('ZRANGE', KEYS[2], 0, -1, 'WITHSCORES')
+	local used = 0
+	for i = 1, #entries, 2 do
+		used = used + tonumber(string.match(entries[i], ':(%d+)$'))
+	end
+	if used + tokens > tpm then
+		local retry = window
+		for i = 1, #entries, 2 do
+			used = used - tonumber(string.match(entries[i], ':(%d+)$'))
+			if used + tokens <= tpm then
+				retry = tonumber(entries[i + 1]) + window - now
+				break
+			end
+		end
+		return {0, 'tokens_per_minute', retry}
+	end
+end
+
+redis.call('ZADD', KEYS[1], now, id)
+redis.call('ZADD', KEYS[2], now, id .. ':' .. tokens)
+redis.call('PEXPIRE', KEYS[1], window)
+redis.call('PEXPIRE', KEYS[2], window)
+if concurrency > 0 then
+	redis.call('ZADD', KEYS[3], now + lease, id)
+	redis.call('PEXPIRE', KEYS[3], lease)
+end
+return {1, '', 0}
+`)
+
+// releaseScript 以实际token数替换预留记录并释放并发槽位，窗口内的时间戳保持不变
+//
+// KEYS: token窗口、并发槽位
+// ARGV: 预留ID、预留token数、实际token数
+var releaseScript = redis.NewScript(`
+local reserved = ARGV[1] .. ':' .. ARGV[2]
+local score = redis.call('ZSCORE', KEYS[1], reserved)
+if score then
+	redis.call('ZREM', KEYS[1], reserved)
+	if tonumber(ARGV[3]) > 0 then
+		redis.call('ZADD', KEYS[1], score, ARGV[1] .. ':' .. ARGV[3])
+	end
+end
+redis.call('ZREM', KEYS[2], ARGV[1])
+return 1
+`)
+
+// recordScript 将已完成的调用计入请求与token窗口，不检查限额
+//
+// KEYS: 请求窗口、token窗口
+// ARGV: 记录ID、实际token数、窗口长度(毫秒)
+var recordScript = redis.NewScript(`
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+redis.call('ZADD', KEYS[1], now, ARGV[1])
+redis.call('ZADD', KEYS[2], now, ARGV[1] .. ':' .. ARGV[2])
+redis.call('PEXPIRE', KEYS[1], ARGV[3])
+redis.call('PEXPIRE', KEYS[2], ARGV[3])
+return 1
+`)
+
+// 分布式限流仓库实现
+type limiterRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewLimiterRepo 创建基于Redis的限流仓库
+func NewLimiterRepo(data *Data, logger log.Logger) biz.LimiterRepo {
+	return &limiterRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *limiterRepo) Acquire(ctx context.Context, reservation *biz.Reservation, rule *biz.RateLimitConfig) (*biz.LimitDecision, error) {
+	keys := limiterKeys(reservation)
+	result, err := acquireScript.Run(ctx, r.data.rdb, keys,
+		reservation.ID,
+		reservation.Tokens,
+		rule.RequestsPerMinute,
+		rule.TokensPerMinute,
+		rule.BurstLimit,
+		rule.ConcurrentRequests,
+		biz.ReservationLease.Milliseconds(),
+		limitWindow.Milliseconds(),
+	).Slice()
+	if err != nil {
+		return nil, err
+	}
+	if len(result) != 3 {
+		return nil, fmt.Errorf("unexpected limiter result: %v", result)
+	}
+
+	allowed, _ := result[0].(int64)
+	reason, _ := result[1].(string)
+	retryAfter, _ := result[2].(int64)
+	return &biz.LimitDecision{
+		Allowed:    allowed == 1,
+		Reason:     reason,
+		RetryAfter: time.Duration(retryAfter) * time.Millisecond,
+	}, nil
+}
+
+func (r *limiterRepo) Release(ctx context.Context, reservation *biz.Reservation, actualTokens int64) error {
+	keys := limiterKeys(reservation)
+	return releaseScript.Run(ctx, r.data.rdb, keys[1:],
+		reservation.ID,
+		reservation.Tokens,
+		actualTokens,
+	).Err()
+}
+
+func (r *limiterRepo) Cancel(ctx context.Context, reservation *biz.Reservation) error {
+	keys := limiterKeys(reservation)
+	_, err := r.data.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRem(ctx, keys[0], reservation.ID)
+		pipe.ZRem(ctx, keys[1], fmt.Sprintf("%s:%d", reservation.ID, reservation.Tokens))
+		pipe.ZRem(ctx, keys[2], reservation.ID)
+		return nil
+	})
+	return err
+}
+
+func (r *limiterRepo) Record(ctx context.Context, reservation *biz.Reservation, actualTokens int64) error {
+	keys := limiterKeys(reservation)
+	return recordScript.Run(ctx, r.data.rdb, keys[:2],
+		reservation.ID,
+		actualTokens,
+		limitWindow.Milliseconds(),
+	).Err()
+}
+
+// limiterKeys 用户+模型维度的限流键，哈希标签保证集群模式下落在同一槽位
+func limiterKeys(reservation *biz.Reservation) []string {
+	prefix := fmt.Sprintf("ai:limit:{%d:%d}", reservation.UserID, reservation.ModelID)
+	return []string{prefix + ":req", prefix + ":tok", prefix + ":slots"}
+}
@@ -0,0 +1,390 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"universal/app/ai/internal/biz"
+	"universal/app/ai/internal/data/model"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/glebarez/sqlite"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// testClock 控制脚本中 TIME 命令返回的时间
+type testClock struct {
+	mr  *miniredis.Miniredis
+	now time.Time
+}
+
+func (c *testClock) advance(d time.Duration) {
+	c.now = c.now.Add(d)
+	c.mr.SetTime(c.now)
+}
+
+func newTestLimiter(t *testing.T) (*limiterRepo, *miniredis.Miniredis, *testClock) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	clock := &testClock{mr: mr, now: time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)}
+	mr.SetTime(clock.now)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	return NewLimiterRepo(NewTestData(nil, rdb), log.DefaultLogger).(*limiterRepo), mr, clock
+}
+
+func newTestReservation(id string, tokens int64) *biz.Reservation {
+	return &biz.Reservation{ID: id, UserID: 1, ModelID: 2, Tokens: tokens}
+}
+
+func mustAcquire(t *testing.T, repo *limiterRepo, res *biz.Reservation, rule *biz.RateLimitConfig) *biz.LimitDecision {
+	t.Helper()
+	decision, err := repo.Acquire(context.Background(), res, rule)
+	if err != nil {
+		t.Fatalf("Acquire(%s): %v", res.ID, err)
+	}
+	return decision
+}
+
+func TestLimiterAcquireRequestsPerMinute(t *testing.T) {
+	repo, _, clock := newTestLimiter(t)
+	rule := &biz.RateLimitConfig{RequestsPerMinute: 2}
+
+	for i := 0; i < 2; i++ {
+		if d := mustAcquire(t, repo, newTestReservation(fmt.Sprintf("r%d", i), 10), rule); !d.Allowed {
+			t.Fatalf("request %d rejected: %s", i, d.Reason)
+		}
+		clock.advance(10 * time.Second)
+	}
+
+	d := mustAcquire(t, repo, newTestReservation("r2", 10), rule)
+	if d.Allowed || d.Reason != "requests_per_minute" {
+		t.Fatalf("third request = %+v, want requests_per_minute rejection", d)
+	}
+	// 最早的请求在40秒后滑出窗口
+	if d.RetryAfter != 40*time.Second {
+		t.Fatalf("RetryAfter = %v, want 40s", d.RetryAfter)
+	}
+
+	clock.advance(40 * time.Second)
+	if d := mustAcquire(t, repo, newTestReservation("r3", 10), rule); !d.Allowed {
+		t.Fatalf("request after window expiry rejected: %s", d.Reason)
+	}
+}
+
+func TestLimiterAcquireBurst(t *testing.T) {
+	repo, _, clock := newTestLimiter(t)
+	rule := &biz.RateLimitConfig{RequestsPerMinute: 100, BurstLimit: 1}
+
+	if d := mustAcquire(t, repo, newTestReservation("a", 1), rule); !d.Allowed {
+		t.Fatalf("first request rejected: %s", d.Reason)
+	}
+	if d := mustAcquire(t, repo, newTestReservation("b", 1), rule); d.Allowed || d.Reason != "burst_limit" {
+		t.Fatalf("second request in the same second = %+v, want burst_limit rejection", d)
+	}
+	clock.advance(1500 * time.Millisecond)
+	if d := mustAcquire(t, repo, newTestReservation("c", 1), rule); !d.Allowed {
+		t.Fatalf("request after burst window rejected: %s", d.Reason)
+	}
+}
+
+func TestLimiterReleaseReplacesReservedTokens(t *testing.T) {
+	repo, mr, _ := newTestLimiter(t)
+	ctx := context.Background()
+	rule := &biz.RateLimitConfig{TokensPerMinute: 100}
+
+	first := newTestReservation("a", 80)
+	if d := mustAcquire(t, repo, first, rule); !d.Allowed {
+		t.Fatalf("first request rejected: %s", d.Reason)
+	}
+	if d := mustAcquire(t, repo, newTestReservation("b", 30), rule); d.Allowed || d.Reason != "tokens_per_minute" {
+		t.Fatalf("over-budget request = %+v, want tokens_per_minute rejection", d)
+	}
+
+	// 实际只消耗20个token，释放后窗口内剩余80
+	if err := repo.Release(ctx, first, 20); err != nil {
+		t.Fatalf("Release: %v", err)
+	}
+	keys := limiterKeys(first)
+	members, err := mr.ZMembers(keys[1])
+	if err != nil {
+		t.Fatalf("ZMembers: %v", err)
+	}
+	if len(members) != 1 || members[0] != "a:20" {
+		t.Fatalf("token window = %v, want [a:20]", members)
+	}
+	if d := mustAcquire(t, repo, newTestReservation("b", 30), rule); !d.Allowed {
+		t.Fatalf("request after release rejected: %s", d.Reason)
+	}
+}
+
+func TestLimiterConcurrencySlots(t *testing.T) {
+	repo, _, clock := newTestLimiter(t)
+	ctx := context.Background()
+	rule := &biz.RateLimitConfig{ConcurrentRequests: 1}
+
+	first := newTestReservation("a", 1)
+	if d := mustAcquire(t, repo, first, rule); !d.Allowed {
+		t.Fatalf("first request rejected: %s", d.Reason)
+	}
+	if d := mustAcquire(t, repo, newTestReservation("b", 1), rule); d.Allowed || d.Reason != "concurrent_requests" {
+		t.Fatalf("second concurrent request = %+v, want concurrent_requests rejection", d)
+	}
+
+	if err := repo.Release(ctx, first, 1); err != nil {
+		t.Fatalf("Release: %v", err)
+	}
+	second := newTestReservation("b", 1)
+	if d := mustAcquire(t, repo, second, rule); !d.Allowed {
+		t.Fatalf("request after release rejected: %s", d.Reason)
+	}
+
+	// 未释放的槽位在租约到期后自动回收
+	clock.advance(biz.ReservationLease + time.Second)
+	if d := mustAcquire(t, repo, newTestReservation("c", 1), rule); !d.Allowed {
+		t.Fatalf("request after lease expiry rejected: %s", d.Reason)
+	}
+}
+
+func TestLimiterCancel(t *testing.T) {
+	repo, mr, _ := newTestLimiter(t)
+	ctx := context.Background()
+	rule := &biz.RateLimitConfig{RequestsPerMinute: 1, TokensPerMinute: 50, ConcurrentRequests: 1}
+
+	res := newTestReservation("a", 50)
+	if d := mustAcquire(t, repo, res, rule); !d.Allowed {
+		t.Fatalf("first request rejected: %s", d.Reason)
+	}
+	if err := repo.Cancel(ctx, res); err != nil {
+		t.Fatalf("Cancel: %v", err)
+	}
+	for _, key := range limiterKeys(res) {
+		if mr.Exists(key) {
+			members, _ := mr.ZMembers(key)
+			if len(members) > 0 {
+				t.Fatalf("%s still holds %v after cancel", key, members)
+			}
+		}
+	}
+	if d := mustAcquire(t, repo, newTestReservation("b", 50), rule); !d.Allowed {
+		t.Fatalf("request after cancel rejected: %s", d.Reason)
+	}
+}
+
+func TestLimiterRecord(t *testing.T) {
+	repo, mr, _ := newTestLimiter(t)
+	ctx := context.Background()
+	rule := &biz.RateLimitConfig{RequestsPerMinute: 1, TokensPerMinute: 100}
+
+	// 回退模型的调用已完成，超出限额也要计入
+	fallback := newTestReservation("a", 0)
+	for i := 0; i < 2; i++ {
+		if err := repo.Record(ctx, fallback, 60); err != nil {
+			t.Fatalf("Record: %v", err)
+		}
+		fallback = newTestReservation("b", 0)
+	}
+	keys := limiterKeys(fallback)
+	if members, _ := mr.ZMembers(keys[1]); len(members) != 2 || members[0] != "a:60" || members[1] != "b:60" {
+		t.Fatalf("token window = %v, want [a:60 b:60]", members)
+	}
+	if mr.Exists(keys[2]) {
+		t.Fatalf("Record must not occupy a concurrency slot")
+	}
+	if d := mustAcquire(t, repo, newTestReservation("c", 1), rule); d.Allowed {
+		t.Fatalf("request after recorded usage allowed, want rejection")
+	}
+}
+
+func newTestQuotaDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	dsn := filepath.Join(t.TempDir(), "quota.db") + "?_pragma=busy_timeout(5000)"
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("sql db: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+	if err := db.AutoMigrate(&model.UserQuota{}); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	return db
+}
+
+func createTestQuota(t *testing.T, db *gorm.DB, quota *model.UserQuota) *model.UserQuota {
+	t.Helper()
+	quota.UserID, quota.ModelID = 1, 2
+	if err := db.Create(quota).Error; err != nil {
+		t.Fatalf("create quota: %v", err)
+	}
+	return quota
+}
+
+func reloadTestQuota(t *testing.T, db *gorm.DB, id int64) *model.UserQuota {
+	t.Helper()
+	var quota model.UserQuota
+	if err := db.First(&quota, id).Error; err != nil {
+		t.Fatalf("reload quota: %v", err)
+	}
+	return &quota
+}
+
+func TestQuotaReserveAndSettle(t *testing.T) {
+	db := newTestQuotaDB(t)
+	repo := NewQuotaRepo(NewTestData(db, nil), log.DefaultLogger)
+	ctx := context.Background()
+	quota := createTestQuota(t, db, &model.UserQuota{
+		DailyTokenLimit:     100,
+		MonthlyTokenLimit:   1000,
+		DailyRequestLimit:   2,
+		MonthlyRequestLimit: 100,
+	})
+
+	ok, err := repo.ReserveQuota(ctx, quota.ID, 80, false)
+	if err != nil || !ok {
+		t.Fatalf("ReserveQuota(80) = %v, %v; want true", ok, err)
+	}
+	if ok, err = repo.ReserveQuota(ctx, quota.ID, 30, false); err != nil || ok {
+		t.Fatalf("ReserveQuota(30) over token limit = %v, %v; want false", ok, err)
+	}
+	// 被拒绝的预扣不得改动任何计数
+	if got := reloadTestQuota(t, db, quota.ID); got.DailyTokensUsed != 80 || got.DailyRequestsUsed != 1 {
+		t.Fatalf("after rejection used = %d tokens / %d requests, want 80 / 1", got.DailyTokensUsed, got.DailyRequestsUsed)
+	}
+
+	// 实际只消耗50个token，退回30
+	if err := repo.SettleQuota(ctx, quota.ID, -30, 0); err != nil {
+		t.Fatalf("SettleQuota: %v", err)
+	}
+	if ok, err = repo.ReserveQuota(ctx, quota.ID, 30, false); err != nil || !ok {
+		t.Fatalf("ReserveQuota(30) after settle = %v, %v; want true", ok, err)
+	}
+	if ok, err = repo.ReserveQuota(ctx, quota.ID, 1, false); err != nil || ok {
+		t.Fatalf("ReserveQuota over request limit = %v, %v; want false", ok, err)
+	}
+
+	// 退回超过已用量时截断为0
+	if err := repo.SettleQuota(ctx, quota.ID, -1000, -10); err != nil {
+		t.Fatalf("SettleQuota: %v", err)
+	}
+	got := reloadTestQuota(t, db, quota.ID)
+	if got.DailyTokensUsed != 0 || got.MonthlyTokensUsed != 0 || got.DailyRequestsUsed != 0 || got.MonthlyRequestsUsed != 0 {
+		t.Fatalf("after over-refund used = %+v, want all zero", got)
+	}
+}
+
+func TestQuotaReserveUnlimitedZero(t *testing.T) {
+	db := newTestQuotaDB(t)
+	repo := NewQuotaRepo(NewTestData(db, nil), log.DefaultLogger)
+	ctx := context.Background()
+	quota := createTestQuota(t, db, &model.UserQuota{DailyRequestLimit: 1})
+
+	if ok, err := repo.ReserveQuota(ctx, quota.ID, 10, false); err != nil || ok {
+		t.Fatalf("zero token limit without unlimitedZero = %v, %v; want false", ok, err)
+	}
+	if ok, err := repo.ReserveQuota(ctx, quota.ID, 10, true); err != nil || !ok {
+		t.Fatalf("zero token limit with unlimitedZero = %v, %v; want true", ok, err)
+	}
+	if ok, err := repo.ReserveQuota(ctx, quota.ID, 10, true); err != nil || ok {
+		t.Fatalf("request limit still applies = %v, %v; want false", ok, err)
+	}
+}
+
+func TestQuotaReserveAfterReset(t *testing.T) {
+	db := newTestQuotaDB(t)
+	repo := NewQuotaRepo(NewTestData(db, nil), log.DefaultLogger)
+	scheduler := NewSchedulerRepo(NewTestData(db, nil), log.DefaultLogger)
+	ctx := context.Background()
+	now := time.Now()
+	quota := createTestQuota(t, db, &model.UserQuota{
+		DailyTokenLimit:     100,
+		MonthlyTokenLimit:   1000,
+		DailyRequestLimit:   10,
+		MonthlyRequestLimit: 100,
+		ResetDailyAt:        now.Add(time.Hour),
+		ResetMonthlyAt:      now.AddDate(0, 1, 0),
+	})
+
+	if ok, err := repo.ReserveQuota(ctx, quota.ID, 100, false); err != nil || !ok {
+		t.Fatalf("ReserveQuota(100) = %v, %v; want true", ok, err)
+	}
+	if ok, err := repo.ReserveQuota(ctx, quota.ID, 1, false); err != nil || ok {
+		t.Fatalf("ReserveQuota over daily limit = %v, %v; want false", ok, err)
+	}
+
+	// 重置时间未到时不生效
+	if n, err := scheduler.ResetExpiredQuotas(ctx, biz.ResetPeriodDaily, now, now.AddDate(0, 0, 1)); err != nil || n != 0 {
+		t.Fatalf("early reset = %d, %v; want 0", n, err)
+	}
+	later := now.Add(2 * time.Hour)
+	if n, err := scheduler.ResetExpiredQuotas(ctx, biz.ResetPeriodDaily, later, later.AddDate(0, 0, 1)); err != nil || n != 1 {
+		t.Fatalf("daily reset = %d, %v; want 1", n, err)
+	}
+	if ok, err := repo.ReserveQuota(ctx, quota.ID, 100, false); err != nil || !ok {
+		t.Fatalf("ReserveQuota after daily reset = %v, %v; want true", ok, err)
+	}
+	if got := reloadTestQuota(t, db, quota.ID); got.MonthlyTokensUsed != 200 {
+		t.Fatalf("monthly tokens = %d, want 200 (monthly counter survives daily reset)", got.MonthlyTokensUsed)
+	}
+}
+
+func TestQuotaConcurrentReserveAndSettle(t *testing.T) {
+	db := newTestQuotaDB(t)
+	repo := NewQuotaRepo(NewTestData(db, nil), log.DefaultLogger)
+	ctx := context.Background()
+	quota := createTestQuota(t, db, &model.UserQuota{
+		DailyTokenLimit:     10000,
+		MonthlyTokenLimit:   10000,
+		DailyRequestLimit:   20,
+		MonthlyRequestLimit: 20,
+	})
+
+	// 40个并发请求争抢20次额度，每个成功的请求预扣100个token、实际消耗60
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		granted int
+	)
+	for i := 0; i < 40; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ok, err := repo.ReserveQuota(ctx, quota.ID, 100, false)
+			if err != nil {
+				t.Errorf("ReserveQuota: %v", err)
+				return
+			}
+			if !ok {
+				return
+			}
+			if err := repo.SettleQuota(ctx, quota.ID, -40, 0); err != nil {
+				t.Errorf("SettleQuota: %v", err)
+				return
+			}
+			mu.Lock()
+			granted++
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	if granted != 20 {
+		t.Fatalf("granted = %d, want 20", granted)
+	}
+	got := reloadTestQuota(t, db, quota.ID)
+	if got.DailyRequestsUsed != 20 || got.DailyTokensUsed != 20*60 || got.MonthlyTokensUsed != 20*60 {
+		t.Fatalf("used = %d requests / %d daily tokens / %d monthly tokens, want 20 / 1200 / 1200",
+			got.DailyRequestsUsed, got.DailyTokensUsed, got.MonthlyTokensUsed)
+	}
+}
@@ -0,0 +1,376 @@
+package data
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"universal/app/ai/internal/conf"
+	"universal/app/ai/internal/data/model"
+	"universal/pkg/fingerprint"
+	"universal/pkg/langdetect"
+	"universal/pkg/migrate"
+	"universal/pkg/textseg"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// migrations AI服务的数据库迁移，按版本号顺序执行，已发布的迁移不可修改
+var migrations = []migrate.Migration{
+	{
+		// 基线：此前由启动时 AutoMigrate 建立的表结构，已有数据库执行时只补齐缺失的表、列与索引。
+		// 使用冻结的快照而不是当前模型，之后新增的列由各自的迁移添加
+		Version: 2026101901,
+		Name:    "baseline",
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(
+				&baselineProvider{},
+				&baselineModel{},
+				&baselineUserQuota{},
+				&baselineUsageStats{},
+				&baselineRateLimitConfig{},
+				&baselineModelHealth{},
+				&baselineModelHealthCheck{},
+				&baselineProviderCredential{},
+				&baselineUserDefaultModel{},
+				&baselineConversation{},
+				&baselineConversationMemory{},
+				&baselineMessage{},
+				&baselineToolCall{},
+				&baselineMessageAttachment{},
+				&baselineKnowledgeBase{},
+				&baselineDocument{},
+				&baselineKnowledgeChunk{},
+				&baselineProcessingJob{},
+				&baselineSearchHistory{},
+				&baselineResourceShare{},
+				&baselineConversationSnapshot{},
+			)
+		},
+	},
+	{
+		// 文档内容哈希与知识块指纹，用于重复检测：此前文档哈希为占位值，需按内容重新计算，
+		// 并为已有知识块补算指纹。哈希在知识库内唯一，已删除文档的哈希置空；此前重复上传的文档只保留最早一份的哈希，
+		// 其余文档的知识块仍可通过相似度分析发现
+		Version: 2026101902,
+		Name:    "content_fingerprints",
+		Up: func(tx *gorm.DB) error {
+			for _, field := range []string{"SimHash", "MinHash"} {
+				if tx.Migrator().HasColumn(&contentFingerprintsChunk{}, field) {
+					continue
+				}
+				if err := tx.Migrator().AddColumn(&contentFingerprintsChunk{}, field); err != nil {
+					return err
+				}
+			}
+			db := tx.Session(&gorm.Session{NewDB: true})
+			var docs []*contentFingerprintsDocument
+			if err := tx.Unscoped().Select("id", "content").FindInBatches(&docs, 500, func(_ *gorm.DB, _ int) error {
+				for _, doc := range docs {
+					sum := sha256.Sum256([]byte(doc.Content))
+					if err := db.Unscoped().Model(doc).UpdateColumn("hash", hex.EncodeToString(sum[:])).Error; err != nil {
+						return err
+					}
+				}
+				return nil
+			}).Error; err != nil {
+				return err
+			}
+			if err := dedupDocumentHashes(db); err != nil {
+				return err
+			}
+			if !tx.Migrator().HasIndex(&contentFingerprintsDocument{}, "idx_document_kb_hash") {
+				if err := tx.Migrator().CreateIndex(&contentFingerprintsDocument{}, "idx_document_kb_hash"); err != nil {
+					return err
+				}
+			}
+			var chunks []*contentFingerprintsChunk
+			return tx.Select("id", "content").FindInBatches(&chunks, 500, func(_ *gorm.DB, _ int) error {
+				for _, chunk := range chunks {
+					fp := fingerprint.Of(chunk.Content)
+					minHash, err := jsonArray(fp.MinHash)
+					if err != nil {
+						return err
+					}
+					if err := db.Model(chunk).UpdateColumns(map[string]interface{}{
+						"sim_hash": int64(fp.SimHash),
+						"min_hash": minHash,
+					}).Error; err != nil {
+						return err
+					}
+				}
+				return nil
+			}).Error
+		},
+		Down: func(tx *gorm.DB) error {
+			if tx.Migrator().HasIndex(&contentFingerprintsDocument{}, "idx_document_kb_hash") {
+				if err := tx.Migrator().DropIndex(&contentFingerprintsDocument{}, "idx_document_kb_hash"); err != nil {
+					return err
+				}
+			}
+			for _, field := range []string{"SimHash", "MinHash"} {
+				if !tx.Migrator().HasColumn(&contentFingerprintsChunk{}, field) {
+					continue
+				}
+				if err := tx.Migrator().DropColumn(&contentFingerprintsChunk{}, field); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	{
+		// 此前文档语言固定为 zh，按内容重新识别文档与知识块的语言，并为没有关键词的知识块按语言提取关键词
+		Version: 2026101903,
+		Name:    "detect_languages",
+		Up: func(tx *gorm.DB) error {
+			db := tx.Session(&gorm.Session{NewDB: true})
+			var docs []*detectLanguagesDocument
+			if err := tx.Unscoped().Select("id", "content").FindInBatches(&docs, 500, func(_ *gorm.DB, _ int) error {
+				for _, doc := range docs {
+					lang := langdetect.Detect(doc.Content)
+					if lang == "" {
+						continue
+					}
+					if err := db.Unscoped().Model(doc).UpdateColumn("language", lang).Error; err != nil {
+						return err
+					}
+				}
+				return nil
+			}).Error; err != nil {
+				return err
+			}
+			var chunks []*detectLanguagesChunk
+			return tx.Select("id", "content", "language", "keywords").FindInBatches(&chunks, 500, func(_ *gorm.DB, _ int) error {
+				for _, chunk := range chunks {
+					if lang := langdetect.Detect(chunk.Content); lang != "" {
+						chunk.Language = lang
+					}
+					var keywords []string
+					if chunk.Keywords != "" {
+						if err := json.Unmarshal([]byte(chunk.Keywords), &keywords); err != nil {
+							return fmt.Errorf("chunk %d keywords: %w", chunk.ID, err)
+						}
+					}
+					if len(keywords) == 0 {
+						extracted, err := jsonArray(textseg.Default().TopKeywordsFor(chunk.Language, chunk.Content, maxMigratedChunkKeywords))
+						if err != nil {
+							return err
+						}
+						chunk.Keywords = extracted
+					}
+					if err := db.Model(chunk).UpdateColumns(map[string]interface{}{
+						"language": chunk.Language,
+						"keywords": chunk.Keywords,
+					}).Error; err != nil {
+						return err
+					}
+				}
+				return nil
+			}).Error
+		},
+		// 只修正数据，回滚时无需处理
+		Down: func(tx *gorm.DB) error {
+			return nil
+		},
+	},
+	{
+		// 文档历史版本，文档内容更新时保存上一版本
+		Version: 2026101904,
+		Name:    "document_versions",
+		Up: func(tx *gorm.DB) error {
+			if tx.Migrator().HasTable(&baselineDocumentVersion{}) {
+				return nil
+			}
+			return tx.Migrator().CreateTable(&baselineDocumentVersion{})
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&baselineDocumentVersion{})
+		},
+	},
+	{
+		// 索引版本，重建索引时在新版本下构建影子索引，完成后切换，已有知识块属于版本 1
+		Version: 2026101905,
+		Name:    "index_versions",
+		Up: func(tx *gorm.DB) error {
+			for _, m := range []interface{}{&indexVersionsKnowledgeBase{}, &indexVersionsChunk{}} {
+				if tx.Migrator().HasColumn(m, "IndexVersion") {
+					continue
+				}
+				if err := tx.Migrator().AddColumn(m, "IndexVersion"); err != nil {
+					return err
+				}
+			}
+			if tx.Migrator().HasIndex(&indexVersionsChunk{}, "IndexVersion") {
+				return nil
+			}
+			return tx.Migrator().CreateIndex(&indexVersionsChunk{}, "IndexVersion")
+		},
+		Down: func(tx *gorm.DB) error {
+			if tx.Migrator().HasIndex(&indexVersionsChunk{}, "IndexVersion") {
+				if err := tx.Migrator().DropIndex(&indexVersionsChunk{}, "IndexVersion"); err != nil {
+					return err
+				}
+			}
+			for _, m := range []interface{}{&indexVersionsKnowledgeBase{}, &indexVersionsChunk{}} {
+				if !tx.Migrator().HasColumn(m, "IndexVersion") {
+					continue
+				}
+				if err := tx.Migrator().DropColumn(m, "IndexVersion"); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	{
+		// 模型调用使用事件，使用统计改为从事件汇总以计入失败的调用；
+		// 已有的助手消息回填为事件，避免重新汇总时覆盖已统计的用量
+		Version: 2026101906,
+		Name:    "usage_events",
+		Up: func(tx *gorm.DB) error {
+			if tx.Migrator().HasTable(&baselineUsageEvent{}) {
+				return nil
+			}
+			if err := tx.Migrator().CreateTable(&baselineUsageEvent{}); err != nil {
+				return err
+			}
+			return tx.Exec(`INSERT INTO ai_usage_events
+				(user_id, workspace_id, model_id, status, input_tokens, output_tokens, cost, response_time, created_at)
+				SELECT c.user_id, c.workspace_id, m.id, CASE WHEN msg.status = 4 THEN 2 ELSE 1 END,
+					msg.input_tokens, msg.output_tokens, msg.cost, msg.response_time, msg.created_at
+				FROM messages AS msg
+				JOIN conversations AS c ON c.id = msg.conversation_id
+				JOIN ai_models AS m ON m.name = COALESCE(NULLIF(msg.model_used, ''), c.model_name)
+				WHERE msg.role = 'assistant'`).Error
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&baselineUsageEvent{})
+		},
+	},
+	{
+		// 使用事件区分模型调用与工具调用，已有事件均为模型调用
+		Version: 2026101907,
+		Name:    "usage_event_kinds",
+		Up: func(tx *gorm.DB) error {
+			if tx.Migrator().HasColumn(&usageEventKindsEvent{}, "Kind") {
+				return nil
+			}
+			return tx.Migrator().AddColumn(&usageEventKindsEvent{}, "Kind")
+		},
+		Down: func(tx *gorm.DB) error {
+			if !tx.Migrator().HasColumn(&usageEventKindsEvent{}, "Kind") {
+				return nil
+			}
+			return tx.Migrator().DropColumn(&usageEventKindsEvent{}, "Kind")
+		},
+	},
+	{
+		// 重建索引任务的归属，强制重建时旧任务的写入与切换以此隔离；已有知识库没有进行中的任务
+		Version: 2026101908,
+		Name:    "reindex_owner",
+		Up: func(tx *gorm.DB) error {
+			if tx.Migrator().HasColumn(&reindexOwnerKnowledgeBase{}, "ReindexJobID") {
+				return nil
+			}
+			return tx.Migrator().AddColumn(&reindexOwnerKnowledgeBase{}, "ReindexJobID")
+		},
+		Down: func(tx *gorm.DB) error {
+			if !tx.Migrator().HasColumn(&reindexOwnerKnowledgeBase{}, "ReindexJobID") {
+				return nil
+			}
+			return tx.Migrator().DropColumn(&reindexOwnerKnowledgeBase{}, "ReindexJobID")
+		},
+	},
+}
+
+// migrateDB 启动时迁移或检查数据库版本，数据库版本高于当前二进制时拒绝启动
+func migrateDB(ctx context.Context, db *gorm.DB, c *conf.Data, logger log.Logger) error {
+	m, err := migrate.New(db, migrations, logger)
+	if err != nil {
+		return err
+	}
+	if c.Database.AutoMigrate {
+		return m.Up(ctx)
+	}
+	err = m.Check(ctx)
+	if errors.Is(err, migrate.ErrPending) {
+		return fmt.Errorf("%w, run the migrate command first", err)
+	}
+	return err
+}
+
+// Migrate 执行 migrate 子命令
+func Migrate(ctx context.Context, c *conf.Data, args []string, w io.Writer, logger log.Logger) error {
+	// 迁移可能读写加密字段
+	cipher, err := NewSecretCipher(c.Secret)
+	if err != nil {
+		return err
+	}
+	model.SetSecretCipher(cipher)
+	db, err := openDB(c)
+	if err != nil {
+		return err
+	}
+	if sqlDB, err := db.DB(); err == nil {
+		defer sqlDB.Close()
+	}
+	m, err := migrate.New(db, migrations, logger)
+	if err != nil {
+		return err
+	}
+	return m.Run(ctx, args, w)
+}
+
+// dedupDocumentHashes 置空已删除文档与重复文档的哈希，使 (knowledge_base_id, hash) 可以建立唯一索引，
+// 同一知识库中内容相同的有效文档只保留ID最小的一份的哈希
+func dedupDocumentHashes(db *gorm.DB) error {
+	if err := db.Unscoped().Model(&contentFingerprintsDocument{}).
+		Where("status IN ? OR deleted_at IS NOT NULL", []int{5, 6}).
+		UpdateColumn("hash", gorm.Expr("NULL")).Error; err != nil {
+		return err
+	}
+	var groups []struct {
+		KnowledgeBaseID int64
+		Hash            string
+	}
+	if err := db.Unscoped().Model(&contentFingerprintsDocument{}).
+		Select("knowledge_base_id", "hash").
+		Where("hash IS NOT NULL").
+		Group("knowledge_base_id, hash").
+		Having("COUNT(*) > 1").
+		Scan(&groups).Error; err != nil {
+		return err
+	}
+	for _, g := range groups {
+		var keep int64
+		if err := db.Unscoped().Model(&contentFingerprintsDocument{}).
+			Where("knowledge_base_id = ? AND hash = ?", g.KnowledgeBaseID, g.Hash).
+			Select("MIN(id)").Scan(&keep).Error; err != nil {
+			return err
+		}
+		if err := db.Unscoped().Model(&contentFingerprintsDocument{}).
+			Where("knowledge_base_id = ? AND hash = ? AND id <> ?", g.KnowledgeBaseID, g.Hash, keep).
+			UpdateColumn("hash", gorm.Expr("NULL")).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// maxMigratedChunkKeywords 2026101903 为知识块提取的关键词上限，与当时的 model.MaxChunkKeywords 相同
+const maxMigratedChunkKeywords = 20
+
+// jsonArray 按 JSON 数组序列化列值，空值写入 []，与模型中切片类型的 Valuer 一致
+func jsonArray[T any](s []T) (string, error) {
+	if len(s) == 0 {
+		return "[]", nil
+	}
+	b, err := json.Marshal(s)
+	return string(b), err
+}
@@ -0,0 +1,597 @@
+package data
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 已发布迁移建表时的表结构快照，以及后续迁移读写与新增的列的快照。模型之后新增的列与索引由后续迁移添加，
+// 快照冻结后不再修改，以保证同一迁移在任何时候执行都得到相同的结构；自定义类型的列以字符串声明，列类型由标签决定
+
+// 2026101901 baseline
+type baselineProvider struct {
+	ID             int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	Name           string    `gorm:"column:name;type:varchar(100);not null;uniqueIndex"`
+	DisplayName    string    `gorm:"column:display_name;type:varchar(200);not null"`
+	Description    string    `gorm:"column:description;type:text"`
+	APIBaseURL     string    `gorm:"column:api_base_url;type:varchar(500)"`
+	DefaultAPIKey  string    `gorm:"column:default_api_key;type:text"`
+	DefaultHeaders string    `gorm:"column:default_headers;type:json"`
+	Config         string    `gorm:"column:config;type:json"`
+	Status         int32     `gorm:"column:status;type:tinyint;default:0;comment:'0:启用 1:禁用 2:维护中'"`
+	CreatedAt      time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt      time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (baselineProvider) TableName() string {
+	return "ai_providers"
+}
+
+type baselineModel struct {
+	ID            int64      `gorm:"column:id;primaryKey;autoIncrement"`
+	ProviderID    int64      `gorm:"column:provider_id;not null;index"`
+	Name          string     `gorm:"column:name;type:varchar(100);not null;uniqueIndex"`
+	DisplayName   string     `gorm:"column:display_name;type:varchar(200);not null"`
+	Description   string     `gorm:"column:description;type:text"`
+	Version       string     `gorm:"column:version;type:varchar(50)"`
+	Capabilities  string     `gorm:"column:capabilities;type:json"`
+	Limits        string     `gorm:"column:limits;type:json"`
+	Pricing       string     `gorm:"column:pricing;type:json"`
+	DefaultParams string     `gorm:"column:default_params;type:json"`
+	Status        int32      `gorm:"column:status;type:tinyint;default:0;comment:'0:可用 1:不可用 2:维护中'"`
+	MissingSince  *time.Time `gorm:"column:missing_since;comment:'同步时发现提供商已下线该模型的时间'"`
+	CreatedAt     time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt     time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (baselineModel) TableName() string {
+	return "ai_models"
+}
+
+type baselineUserQuota struct {
+	ID                  int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID              int64     `gorm:"column:user_id;not null;index"`
+	WorkspaceID         int64     `gorm:"column:workspace_id;default:0;index"`
+	ModelID             int64     `gorm:"column:model_id;not null;index"`
+	DailyTokenLimit     int64     `gorm:"column:daily_token_limit;default:0"`
+	MonthlyTokenLimit   int64     `gorm:"column:monthly_token_limit;default:0"`
+	DailyRequestLimit   int64     `gorm:"column:daily_request_limit;default:0"`
+	MonthlyRequestLimit int64     `gorm:"column:monthly_request_limit;default:0"`
+	DailyTokensUsed     int64     `gorm:"column:daily_tokens_used;default:0"`
+	MonthlyTokensUsed   int64     `gorm:"column:monthly_tokens_used;default:0"`
+	DailyRequestsUsed   int64     `gorm:"column:daily_requests_used;default:0"`
+	MonthlyRequestsUsed int64     `gorm:"column:monthly_requests_used;default:0"`
+	ResetDailyAt        time.Time `gorm:"column:reset_daily_at"`
+	ResetMonthlyAt      time.Time `gorm:"column:reset_monthly_at"`
+	CreatedAt           time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt           time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (baselineUserQuota) TableName() string {
+	return "ai_user_quotas"
+}
+
+type baselineUsageStats struct {
+	ID                 int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID             int64     `gorm:"column:user_id;not null;index;uniqueIndex:idx_usage_stats_scope"`
+	WorkspaceID        int64     `gorm:"column:workspace_id;default:0;index;uniqueIndex:idx_usage_stats_scope"`
+	ModelID            int64     `gorm:"column:model_id;not null;index;uniqueIndex:idx_usage_stats_scope"`
+	Date               string    `gorm:"column:date;type:date;not null;index;uniqueIndex:idx_usage_stats_scope"`
+	TotalRequests      int64     `gorm:"column:total_requests;default:0"`
+	SuccessfulRequests int64     `gorm:"column:successful_requests;default:0"`
+	FailedRequests     int64     `gorm:"column:failed_requests;default:0"`
+	TotalTokens        int64     `gorm:"column:total_tokens;default:0"`
+	InputTokens        int64     `gorm:"column:input_tokens;default:0"`
+	OutputTokens       int64     `gorm:"column:output_tokens;default:0"`
+	TotalCost          float64   `gorm:"column:total_cost;type:decimal(12,6);default:0"`
+	AvgResponseTime    float64   `gorm:"column:avg_response_time;type:decimal(10,2);default:0"`
+	CreatedAt          time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt          time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (baselineUsageStats) TableName() string {
+	return "ai_usage_stats"
+}
+
+type baselineRateLimitConfig struct {
+	ID                 int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	ModelID            int64     `gorm:"column:model_id;not null;index"`
+	UserLevel          string    `gorm:"column:user_level;type:varchar(50);not null;index;comment:'free/pro/enterprise'"`
+	RequestsPerMinute  int32     `gorm:"column:requests_per_minute;default:0"`
+	RequestsPerHour    int32     `gorm:"column:requests_per_hour;default:0"`
+	RequestsPerDay     int32     `gorm:"column:requests_per_day;default:0"`
+	TokensPerMinute    int32     `gorm:"column:tokens_per_minute;default:0"`
+	ConcurrentRequests int32     `gorm:"column:concurrent_requests;default:0"`
+	BurstLimit         int32     `gorm:"column:burst_limit;default:0"`
+	CreatedAt          time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt          time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (baselineRateLimitConfig) TableName() string {
+	return "ai_rate_limit_configs"
+}
+
+type baselineModelHealth struct {
+	ID             int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	ModelID        int64     `gorm:"column:model_id;not null;uniqueIndex"`
+	IsHealthy      bool      `gorm:"column:is_healthy;default:false"`
+	ResponseTime   float64   `gorm:"column:response_time;type:decimal(10,2);default:0"`
+	SuccessRate    float64   `gorm:"column:success_rate;type:decimal(5,4);default:0"`
+	TotalRequests  int64     `gorm:"column:total_requests;default:0"`
+	FailedRequests int64     `gorm:"column:failed_requests;default:0"`
+	LatencyP50     float64   `gorm:"column:latency_p50;type:decimal(10,2);default:0"`
+	LatencyP95     float64   `gorm:"column:latency_p95;type:decimal(10,2);default:0"`
+	LatencyP99     float64   `gorm:"column:latency_p99;type:decimal(10,2);default:0"`
+	ErrorMessage   string    `gorm:"column:error_message;type:text"`
+	LastCheck      time.Time `gorm:"column:last_check"`
+	CreatedAt      time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt      time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (baselineModelHealth) TableName() string {
+	return "ai_model_health"
+}
+
+type baselineModelHealthCheck struct {
+	ID           int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	ModelID      int64     `gorm:"column:model_id;not null;index:idx_model_checked,priority:1"`
+	ProviderID   int64     `gorm:"column:provider_id;not null"`
+	Source       string    `gorm:"column:source;size:20;not null"`
+	Success      bool      `gorm:"column:success;not null"`
+	Latency      float64   `gorm:"column:latency;type:decimal(10,2);default:0"`
+	StatusCode   int       `gorm:"column:status_code;default:0"`
+	ErrorMessage string    `gorm:"column:error_message;type:text"`
+	CheckedAt    time.Time `gorm:"column:checked_at;not null;index:idx_model_checked,priority:2;index"`
+}
+
+func (baselineModelHealthCheck) TableName() string {
+	return "ai_model_health_checks"
+}
+
+type baselineProviderCredential struct {
+	ID          int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	ProviderID  int64     `gorm:"column:provider_id;not null;uniqueIndex:idx_credential_owner,priority:1"`
+	UserID      int64     `gorm:"column:user_id;not null;default:0;uniqueIndex:idx_credential_owner,priority:2"`
+	WorkspaceID int64     `gorm:"column:workspace_id;not null;default:0;uniqueIndex:idx_credential_owner,priority:3"`
+	Name        string    `gorm:"column:name;type:varchar(100)"`
+	APIKey      string    `gorm:"column:api_key;type:text;not null"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (baselineProviderCredential) TableName() string {
+	return "ai_provider_credentials"
+}
+
+type baselineUserDefaultModel struct {
+	ID        int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID    int64     `gorm:"column:user_id;not null;uniqueIndex"`
+	ModelID   int64     `gorm:"column:model_id;not null"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (baselineUserDefaultModel) TableName() string {
+	return "ai_user_default_models"
+}
+
+type baselineConversation struct {
+	ID               int64  `gorm:"primarykey"`
+	UserID           int64  `gorm:"not null;index"`
+	WorkspaceID      int64  `gorm:"default:0;index"`
+	Title            string `gorm:"size:255;not null"`
+	ModelName        string `gorm:"size:100;not null"`
+	SystemPrompt     string `gorm:"type:text"`
+	Config           string `gorm:"type:json"`
+	Status           int    `gorm:"default:1;index"`
+	Description      string `gorm:"type:text"`
+	Tags             string `gorm:"type:json"`
+	Priority         int    `gorm:"default:0"`
+	AutoArchiveAfter int64  `gorm:"default:0"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	LastActiveAt     time.Time      `gorm:"index"`
+	DeletedAt        gorm.DeletedAt `gorm:"index"`
+
+	MessageCount      int64   `gorm:"default:0"`
+	TotalInputTokens  int64   `gorm:"default:0"`
+	TotalOutputTokens int64   `gorm:"default:0"`
+	ToolCallCount     int64   `gorm:"default:0"`
+	TotalDuration     int64   `gorm:"default:0"`
+	TotalCost         float64 `gorm:"type:decimal(12,6);default:0"`
+
+	Messages []baselineMessage `gorm:"foreignKey:ConversationID"`
+}
+
+func (baselineConversation) TableName() string {
+	return "conversations"
+}
+
+type baselineConversationMemory struct {
+	ID              int64  `gorm:"primarykey"`
+	ConversationID  int64  `gorm:"not null;uniqueIndex"`
+	Summary         string `gorm:"type:text"`
+	KeyPoints       string `gorm:"type:json"`
+	UserPreferences string `gorm:"type:json"`
+	ImportantFacts  string `gorm:"type:json"`
+	MemoryVersion   int    `gorm:"default:1"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+
+	Conversation baselineConversation `gorm:"foreignKey:ConversationID"`
+}
+
+func (baselineConversationMemory) TableName() string {
+	return "conversation_memories"
+}
+
+type baselineMessage struct {
+	ID              int64  `gorm:"primarykey"`
+	ConversationID  int64  `gorm:"not null;index"`
+	Role            string `gorm:"size:20;not null;index"`
+	Content         string `gorm:"type:longtext;not null"`
+	Status          int    `gorm:"default:1;index"`
+	ParentMessageID *int64 `gorm:"index"`
+	IsEdited        bool   `gorm:"default:false"`
+	EditReason      string `gorm:"size:255"`
+	EditedAt        *time.Time
+
+	InputTokens       int     `gorm:"default:0"`
+	CachedInputTokens int     `gorm:"default:0"`
+	OutputTokens      int     `gorm:"default:0"`
+	ResponseTime      float64 `gorm:"default:0"`
+	Cost              float64 `gorm:"default:0"`
+	ModelUsed         string  `gorm:"size:100"`
+	CredentialSource  string  `gorm:"size:20"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+
+	Conversation baselineConversation        `gorm:"foreignKey:ConversationID"`
+	ToolCalls    []baselineToolCall          `gorm:"foreignKey:MessageID"`
+	Attachments  []baselineMessageAttachment `gorm:"foreignKey:MessageID"`
+}
+
+func (baselineMessage) TableName() string {
+	return "messages"
+}
+
+type baselineToolCall struct {
+	ID            string `gorm:"primarykey"`
+	MessageID     int64  `gorm:"not null;index"`
+	Name          string `gorm:"size:100;not null"`
+	Arguments     string `gorm:"type:text"`
+	Result        string `gorm:"type:text"`
+	Status        int    `gorm:"default:1;index"`
+	ErrorMessage  string `gorm:"type:text"`
+	ExecutionTime int64  `gorm:"default:0"`
+	RetryCount    int    `gorm:"default:0"`
+	Metadata      string `gorm:"type:json"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+
+	Message baselineMessage `gorm:"foreignKey:MessageID"`
+}
+
+func (baselineToolCall) TableName() string {
+	return "tool_calls"
+}
+
+type baselineMessageAttachment struct {
+	ID        int64  `gorm:"primarykey"`
+	MessageID int64  `gorm:"not null;index"`
+	Name      string `gorm:"size:255;not null"`
+	URL       string `gorm:"size:500;not null"`
+	MimeType  string `gorm:"size:100"`
+	Size      int64  `gorm:"default:0"`
+	Type      int    `gorm:"default:1"`
+	Metadata  string `gorm:"type:json"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	Message baselineMessage `gorm:"foreignKey:MessageID"`
+}
+
+func (baselineMessageAttachment) TableName() string {
+	return "message_attachments"
+}
+
+type baselineKnowledgeBase struct {
+	ID                 int64  `gorm:"primarykey"`
+	UserID             int64  `gorm:"not null;index"`
+	WorkspaceID        int64  `gorm:"default:0;index"`
+	Name               string `gorm:"size:255;not null"`
+	Description        string `gorm:"type:text"`
+	EmbeddingModel     string `gorm:"size:100;not null"`
+	ChunkSize          int    `gorm:"default:1000"`
+	ChunkOverlap       int    `gorm:"default:200"`
+	Status             int    `gorm:"default:1;index"`
+	Tags               string `gorm:"type:json"`
+	Version            int    `gorm:"default:1"`
+	Language           string `gorm:"size:10;default:'zh'"`
+	SupportedFileTypes string `gorm:"type:json"`
+	MaxFileSize        int64  `gorm:"default:104857600"`
+	AutoProcess        bool   `gorm:"default:true"`
+	Config             string `gorm:"type:json"`
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	LastIndexedAt      *time.Time
+	DeletedAt          gorm.DeletedAt `gorm:"index"`
+
+	DocumentCount   int64   `gorm:"default:0"`
+	ChunkCount      int64   `gorm:"default:0"`
+	TotalCharacters int64   `gorm:"default:0"`
+	TotalTokens     int64   `gorm:"default:0"`
+	StorageSize     float64 `gorm:"default:0"`
+	IndexSize       float64 `gorm:"default:0"`
+
+	Documents []baselineDocument `gorm:"foreignKey:KnowledgeBaseID"`
+}
+
+func (baselineKnowledgeBase) TableName() string {
+	return "knowledge_bases"
+}
+
+type baselineDocument struct {
+	ID                 int64   `gorm:"primarykey"`
+	KnowledgeBaseID    int64   `gorm:"not null;index"`
+	Name               string  `gorm:"size:255;not null"`
+	Content            string  `gorm:"type:longtext"`
+	FilePath           string  `gorm:"size:500"`
+	MimeType           string  `gorm:"size:100"`
+	FileSize           int64   `gorm:"default:0"`
+	ChunkCount         int     `gorm:"default:0"`
+	Status             int     `gorm:"default:1;index"`
+	Tags               string  `gorm:"type:json"`
+	Language           string  `gorm:"size:10"`
+	SourceURL          string  `gorm:"size:500"`
+	Hash               string  `gorm:"size:64;index"`
+	Version            int     `gorm:"default:1"`
+	ProcessingProgress float64 `gorm:"default:0"`
+	ProcessingError    string  `gorm:"type:text"`
+	Metadata           string  `gorm:"type:json"`
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	LastProcessedAt    *time.Time
+	DeletedAt          gorm.DeletedAt `gorm:"index"`
+
+	KnowledgeBase baselineKnowledgeBase    `gorm:"foreignKey:KnowledgeBaseID"`
+	Chunks        []baselineKnowledgeChunk `gorm:"foreignKey:DocumentID"`
+}
+
+func (baselineDocument) TableName() string {
+	return "documents"
+}
+
+type baselineKnowledgeChunk struct {
+	ID              int64  `gorm:"primarykey"`
+	DocumentID      int64  `gorm:"not null;index"`
+	KnowledgeBaseID int64  `gorm:"not null;index"`
+	Content         string `gorm:"type:text;not null"`
+	ChunkIndex      int    `gorm:"not null"`
+	StartPosition   int    `gorm:"default:0"`
+	EndPosition     int    `gorm:"default:0"`
+	Language        string `gorm:"size:10"`
+	Keywords        string `gorm:"type:json"`
+	ChunkType       int    `gorm:"default:1"`
+	CharacterCount  int    `gorm:"default:0"`
+	TokenCount      int    `gorm:"default:0"`
+	Embedding       string `gorm:"type:json"`
+	Metadata        string `gorm:"type:json"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+
+	Document      baselineDocument      `gorm:"foreignKey:DocumentID"`
+	KnowledgeBase baselineKnowledgeBase `gorm:"foreignKey:KnowledgeBaseID"`
+}
+
+func (baselineKnowledgeChunk) TableName() string {
+	return "knowledge_chunks"
+}
+
+type baselineProcessingJob struct {
+	ID              string  `gorm:"primarykey"`
+	DocumentID      int64   `gorm:"not null;index"`
+	KnowledgeBaseID int64   `gorm:"not null;index"`
+	JobType         string  `gorm:"size:50;not null"`
+	Status          int     `gorm:"default:1;index"`
+	Progress        float64 `gorm:"default:0"`
+	ErrorMessage    string  `gorm:"type:text"`
+	Result          string  `gorm:"type:json"`
+	Options         string  `gorm:"type:json"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	StartedAt       *time.Time
+	CompletedAt     *time.Time
+
+	Document      baselineDocument      `gorm:"foreignKey:DocumentID"`
+	KnowledgeBase baselineKnowledgeBase `gorm:"foreignKey:KnowledgeBaseID"`
+}
+
+func (baselineProcessingJob) TableName() string {
+	return "processing_jobs"
+}
+
+type baselineSearchHistory struct {
+	ID              int64   `gorm:"primarykey"`
+	UserID          int64   `gorm:"not null;index"`
+	KnowledgeBaseID int64   `gorm:"not null;index"`
+	Query           string  `gorm:"type:text;not null"`
+	SearchType      string  `gorm:"size:50;not null"`
+	ResultCount     int     `gorm:"default:0"`
+	MaxScore        float64 `gorm:"default:0"`
+	ResponseTime    float64 `gorm:"default:0"`
+	Filters         string  `gorm:"type:json"`
+	CreatedAt       time.Time
+
+	KnowledgeBase baselineKnowledgeBase `gorm:"foreignKey:KnowledgeBaseID"`
+}
+
+func (baselineSearchHistory) TableName() string {
+	return "search_histories"
+}
+
+type baselineResourceShare struct {
+	ID           int64      `gorm:"primarykey"`
+	ResourceType string     `gorm:"size:32;not null;uniqueIndex:idx_resource_share_grantee"`
+	ResourceID   int64      `gorm:"not null;uniqueIndex:idx_resource_share_grantee"`
+	OwnerID      int64      `gorm:"not null;index"`
+	GranteeID    int64      `gorm:"not null;uniqueIndex:idx_resource_share_grantee;index"`
+	Role         int        `gorm:"not null;default:1"`
+	GrantedBy    int64      `gorm:"not null"`
+	ExpiresAt    *time.Time `gorm:"index"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (baselineResourceShare) TableName() string {
+	return "resource_shares"
+}
+
+type baselineConversationSnapshot struct {
+	ID             int64  `gorm:"primarykey"`
+	Token          string `gorm:"size:64;not null;uniqueIndex"`
+	ConversationID int64  `gorm:"not null;index"`
+	OwnerID        int64  `gorm:"not null;index"`
+	Title          string `gorm:"size:255"`
+	ModelName      string `gorm:"size:100"`
+	Messages       string `gorm:"type:json"`
+	MessageCount   int    `gorm:"default:0"`
+	ViewCount      int64  `gorm:"default:0"`
+	ExpiresAt      *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      gorm.DeletedAt `gorm:"index"`
+}
+
+func (baselineConversationSnapshot) TableName() string {
+	return "conversation_snapshots"
+}
+
+// baselineDocumentVersion 2026101904 document_versions
+type baselineDocumentVersion struct {
+	ID         int64  `gorm:"primarykey"`
+	DocumentID int64  `gorm:"not null;uniqueIndex:idx_document_version"`
+	Version    int    `gorm:"not null;uniqueIndex:idx_document_version"`
+	Name       string `gorm:"size:255;not null"`
+	Content    string `gorm:"type:longtext"`
+	Hash       string `gorm:"size:64"`
+	FileSize   int64  `gorm:"default:0"`
+	Language   string `gorm:"size:10"`
+	Tags       string `gorm:"type:json"`
+	Metadata   string `gorm:"type:json"`
+	CreatedAt  time.Time
+}
+
+func (baselineDocumentVersion) TableName() string {
+	return "document_versions"
+}
+
+// baselineUsageEvent 2026101906 usage_events，kind 列由 2026101907 添加
+type baselineUsageEvent struct {
+	ID           int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID       int64     `gorm:"column:user_id;not null;index"`
+	WorkspaceID  int64     `gorm:"column:workspace_id;default:0;index"`
+	ModelID      int64     `gorm:"column:model_id;not null;index"`
+	Status       int       `gorm:"column:status;not null"`
+	InputTokens  int64     `gorm:"column:input_tokens;default:0"`
+	OutputTokens int64     `gorm:"column:output_tokens;default:0"`
+	Cost         float64   `gorm:"column:cost;type:decimal(12,6);default:0"`
+	ResponseTime float64   `gorm:"column:response_time;type:decimal(10,2);default:0"`
+	CreatedAt    time.Time `gorm:"column:created_at;autoCreateTime;index"`
+}
+
+func (baselineUsageEvent) TableName() string {
+	return "ai_usage_events"
+}
+
+// contentFingerprintsDocument 2026101902 content_fingerprints，重新计算哈希并建立知识库内唯一索引
+type contentFingerprintsDocument struct {
+	ID              int64          `gorm:"primarykey"`
+	KnowledgeBaseID int64          `gorm:"not null;index;uniqueIndex:idx_document_kb_hash,priority:1"`
+	Content         string         `gorm:"type:longtext"`
+	Status          int            `gorm:"default:1;index"`
+	Hash            string         `gorm:"size:64;index;uniqueIndex:idx_document_kb_hash,priority:2"`
+	DeletedAt       gorm.DeletedAt `gorm:"index"`
+}
+
+func (contentFingerprintsDocument) TableName() string {
+	return "documents"
+}
+
+// contentFingerprintsChunk 2026101902 content_fingerprints，新增 sim_hash 与 min_hash 列
+type contentFingerprintsChunk struct {
+	ID      int64  `gorm:"primarykey"`
+	Content string `gorm:"type:text;not null"`
+	SimHash int64  `gorm:"default:0"`
+	MinHash string `gorm:"type:json"`
+}
+
+func (contentFingerprintsChunk) TableName() string {
+	return "knowledge_chunks"
+}
+
+// detectLanguagesDocument 2026101903 detect_languages
+type detectLanguagesDocument struct {
+	ID       int64  `gorm:"primarykey"`
+	Content  string `gorm:"type:longtext"`
+	Language string `gorm:"size:10"`
+}
+
+func (detectLanguagesDocument) TableName() string {
+	return "documents"
+}
+
+// detectLanguagesChunk 2026101903 detect_languages
+type detectLanguagesChunk struct {
+	ID       int64  `gorm:"primarykey"`
+	Content  string `gorm:"type:text;not null"`
+	Language string `gorm:"size:10"`
+	Keywords string `gorm:"type:json"`
+}
+
+func (detectLanguagesChunk) TableName() string {
+	return "knowledge_chunks"
+}
+
+// indexVersionsKnowledgeBase 2026101905 index_versions，新增 index_version 列
+type indexVersionsKnowledgeBase struct {
+	ID           int64 `gorm:"primarykey"`
+	IndexVersion int   `gorm:"default:1"`
+}
+
+func (indexVersionsKnowledgeBase) TableName() string {
+	return "knowledge_bases"
+}
+
+// indexVersionsChunk 2026101905 index_versions，新增 index_version 列及其索引
+type indexVersionsChunk struct {
+	ID           int64 `gorm:"primarykey"`
+	IndexVersion int   `gorm:"default:1;index"`
+}
+
+func (indexVersionsChunk) TableName() string {
+	return "knowledge_chunks"
+}
+
+// usageEventKindsEvent 2026101907 usage_event_kinds，新增 kind 列
+type usageEventKindsEvent struct {
+	ID   int64 `gorm:"column:id;primaryKey;autoIncrement"`
+	Kind int   `gorm:"column:kind;default:1"`
+}
+
+func (usageEventKindsEvent) TableName() string {
+	return "ai_usage_events"
+}
+
+// reindexOwnerKnowledgeBase 2026101908 reindex_owner，新增 reindex_job_id 列
+type reindexOwnerKnowledgeBase struct {
+	ID           int64  `gorm:"primarykey"`
+	ReindexJobID string `gorm:"size:64"`
+}
+
+func (reindexOwnerKnowledgeBase) TableName() string {
+	return "knowledge_bases"
+}
@@ -2,14 +2,18 @@ package data
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"time"
 
 	pb "universal/api/ai/v1"
 	"universal/app/ai/internal/biz"
 	"universal/app/ai/internal/data/model"
+	"universal/pkg/database"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type providerRepo struct {
@@ -31,7 +35,7 @@ func (r *providerRepo) CreateProvider(ctx context.Context, provider *biz.Provide
 		DisplayName:   provider.DisplayName,
 		Description:   provider.Description,
 		APIBaseURL:    provider.APIBaseURL,
-		DefaultAPIKey: provider.DefaultAPIKey,
+		DefaultAPIKey: model.SecretString(provider.DefaultAPIKey),
 		Status:        provider.Status,
 	}
 	po.SetDefaultHeaders(provider.DefaultHeaders)
@@ -53,7 +57,7 @@ func (r *providerRepo) UpdateProvider(ctx context.Context, provider *biz.Provide
 	po.DisplayName = provider.DisplayName
 	po.Description = provider.Description
 	po.APIBaseURL = provider.APIBaseURL
-	po.DefaultAPIKey = provider.DefaultAPIKey
+	po.DefaultAPIKey = model.SecretString(provider.DefaultAPIKey)
 	po.Status = provider.Status
 	po.SetDefaultHeaders(provider.DefaultHeaders)
 	po.SetConfig(provider.Config)
@@ -121,7 +125,7 @@ func (r *providerRepo) convertProviderModelToBiz(po *model.Provider) *biz.Provid
 		DisplayName:    po.DisplayName,
 		Description:    po.Description,
 		APIBaseURL:     po.APIBaseURL,
-		DefaultAPIKey:  po.DefaultAPIKey,
+		DefaultAPIKey:  string(po.DefaultAPIKey),
 		DefaultHeaders: po.GetDefaultHeaders(),
 		Config:         po.GetConfig(),
 		Status:         po.Status,
@@ -226,7 +230,7 @@ func (r *modelRepo) ListModels(ctx context.Context, providerID int64, status int
 	// 能力过滤（简单实现，生产环境可能需要更复杂的JSON查询）
 	if len(capabilities) > 0 {
 		for _, capability := range capabilities {
-			query = query.Where("capabilities LIKE ?", "%"+capability+"%")
+			query = query.Where(database.JSONText(r.data.db, "capabilities")+" LIKE ?", "%"+capability+"%")
 		}
 	}
 
@@ -264,6 +268,31 @@ func (r *modelRepo) SwitchUserDefaultModel(ctx context.Context, userID, modelID
 	})
 }
 
+func (r *modelRepo) MarkModelsMissing(ctx context.Context, ids []int64, at time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.data.db.WithContext(ctx).Model(&model.Model{}).
+		Where("id IN ?", ids).
+		Updates(map[string]interface{}{
+			"status":        1, // 不可用
+			"missing_since": at,
+		}).Error
+}
+
+func (r *modelRepo) RestoreModels(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	// 期间被手动改为维护中的模型保持原状态
+	return r.data.db.WithContext(ctx).Model(&model.Model{}).
+		Where("id IN ?", ids).
+		Updates(map[string]interface{}{
+			"status":        gorm.Expr("CASE WHEN status = 1 THEN 0 ELSE status END"),
+			"missing_since": nil,
+		}).Error
+}
+
 func (r *modelRepo) convertModelModelToBiz(po *model.Model) *biz.Model {
 	return &biz.Model{
 		ID:            po.ID,
@@ -277,6 +306,7 @@ func (r *modelRepo) convertModelModelToBiz(po *model.Model) *biz.Model {
 		Pricing:       po.GetPricing(),
 		DefaultParams: po.GetDefaultParams(),
 		Status:        po.Status,
+		MissingSince:  po.MissingSince,
 		CreatedAt:     po.CreatedAt,
 		UpdatedAt:     po.UpdatedAt,
 	}
@@ -298,7 +328,7 @@ func NewQuotaRepo(data *Data, logger log.Logger) biz.QuotaRepo {
 
 func (r *quotaRepo) GetUserQuota(ctx context.Context, userID, modelID int64) (*biz.UserQuota, error) {
 	po := &model.UserQuota{}
-	if err := r.data.db.WithContext(ctx).Where("user_id = ? AND model_id = ?", userID, modelID).First(po).Error; err != nil {
+	if err := r.data.db.WithContext(ctx).Where("workspace_id = 0 AND user_id = ? AND model_id = ?", userID, modelID).First(po).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			// 创建默认配额
 			po = &model.UserQuota{
@@ -345,10 +375,10 @@ func (r *quotaRepo) UpdateUserQuota(ctx context.Context, quota *biz.UserQuota) (
 	return r.convertUserQuotaModelToBiz(po), nil
 }
 
-func (r *quotaRepo) GetUsageStats(ctx context.Context, userID, modelID int64, startDate, endDate, groupBy string) ([]*biz.UsageStats, error) {
+func (r *quotaRepo) GetUsageStats(ctx context.Context, workspaceID, userID, modelID int64, startDate, endDate, groupBy string) ([]*biz.UsageStats, error) {
 	var stats []*model.UsageStats
 
-	query := r.data.db.WithContext(ctx).Model(&model.UsageStats{})
+	query := r.data.db.WithContext(ctx).Model(&model.UsageStats{}).Where("workspace_id = ?", workspaceID)
 
 	if userID > 0 {
 		query = query.Where("user_id = ?", userID)
@@ -442,9 +472,166 @@ func (r *quotaRepo) CheckRateLimit(ctx context.Context, userID, modelID int64, r
 	return true, "", 0, int32(quota.DailyRequestLimit - quota.DailyRequestsUsed), int32(quota.DailyTokenLimit - quota.DailyTokensUsed), nil
 }
 
+// GetWorkspaceQuota 获取工作空间共享配额，记录不存在时创建，限额与工作空间配置同步
+func (r *quotaRepo) GetWorkspaceQuota(ctx context.Context, workspace *biz.Workspace, modelID int64) (*biz.UserQuota, error) {
+	po := &model.UserQuota{}
+	err := r.data.db.WithContext(ctx).Where("workspace_id = ? AND user_id = 0 AND model_id = ?", workspace.ID, modelID).First(po).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+
+	if err == gorm.ErrRecordNotFound {
+		po = &model.UserQuota{
+			WorkspaceID:         workspace.ID,
+			ModelID:             modelID,
+			DailyTokenLimit:     workspace.DailyTokenLimit,
+			MonthlyTokenLimit:   workspace.MonthlyTokenLimit,
+			DailyRequestLimit:   workspace.DailyRequestLimit,
+			MonthlyRequestLimit: workspace.MonthlyRequestLimit,
+			ResetDailyAt:        time.Now().AddDate(0, 0, 1),
+			ResetMonthlyAt:      time.Now().AddDate(0, 1, 0),
+		}
+		if err := r.data.db.WithContext(ctx).Create(po).Error; err != nil {
+			return nil, err
+		}
+	} else if po.DailyTokenLimit != workspace.DailyTokenLimit || po.MonthlyTokenLimit != workspace.MonthlyTokenLimit ||
+		po.DailyRequestLimit != workspace.DailyRequestLimit || po.MonthlyRequestLimit != workspace.MonthlyRequestLimit {
+		po.DailyTokenLimit = workspace.DailyTokenLimit
+		po.MonthlyTokenLimit = workspace.MonthlyTokenLimit
+		po.DailyRequestLimit = workspace.DailyRequestLimit
+		po.MonthlyRequestLimit = workspace.MonthlyRequestLimit
+		err := r.data.db.WithContext(ctx).Model(po).Updates(map[string]interface{}{
+			"daily_token_limit":     po.DailyTokenLimit,
+			"monthly_token_limit":   po.MonthlyTokenLimit,
+			"daily_request_limit":   po.DailyRequestLimit,
+			"monthly_request_limit": po.MonthlyRequestLimit,
+		}).Error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return r.convertUserQuotaModelToBiz(po), nil
+}
+
+// CheckWorkspaceRateLimit 按工作空间共享配额检查，限额为0表示不限
+func (r *quotaRepo) CheckWorkspaceRateLimit(ctx context.Context, workspace *biz.Workspace, modelID int64, requestedTokens int32) (bool, string, int32, int32, int32, error) {
+	quota, err := r.GetWorkspaceQuota(ctx, workspace, modelID)
+	if err != nil {
+		return false, "failed to get workspace quota", 0, 0, 0, err
+	}
+
+	remainingRequests := remainingQuota(quota.DailyRequestLimit, quota.DailyRequestsUsed)
+	remainingTokens := remainingQuota(quota.DailyTokenLimit, quota.DailyTokensUsed)
+
+	if quota.DailyTokenLimit > 0 && quota.DailyTokensUsed+int64(requestedTokens) > quota.DailyTokenLimit {
+		return false, "workspace daily token limit exceeded", 0, remainingRequests, remainingTokens, nil
+	}
+	if quota.DailyRequestLimit > 0 && quota.DailyRequestsUsed >= quota.DailyRequestLimit {
+		return false, "workspace daily request limit exceeded", 0, 0, remainingTokens, nil
+	}
+	if quota.MonthlyTokenLimit > 0 && quota.MonthlyTokensUsed+int64(requestedTokens) > quota.MonthlyTokenLimit {
+		return false, "workspace monthly token limit exceeded", 0, remainingRequests, remainingTokens, nil
+	}
+	if quota.MonthlyRequestLimit > 0 && quota.MonthlyRequestsUsed >= quota.MonthlyRequestLimit {
+		return false, "workspace monthly request limit exceeded", 0, remainingRequests, remainingTokens, nil
+	}
+
+	return true, "", 0, remainingRequests, remainingTokens, nil
+}
+
+// remainingQuota 计算剩余额度，限额为0时视为不限
+func remainingQuota(limit, used int64) int32 {
+	if limit <= 0 {
+		return math.MaxInt32
+	}
+	remaining := limit - used
+	if remaining < 0 {
+		return 0
+	}
+	if remaining > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int32(remaining)
+}
+
+// ReserveQuota 原子地预扣一次请求与预估token，任一日/月限额不足时不更新并返回false
+func (r *quotaRepo) ReserveQuota(ctx context.Context, quotaID, tokens int64, unlimitedZero bool) (bool, error) {
+	checks := []struct {
+		used   string
+		limit  string
+		amount int64
+	}{
+		{"daily_requests_used", "daily_request_limit", 1},
+		{"daily_tokens_used", "daily_token_limit", tokens},
+		{"monthly_requests_used", "monthly_request_limit", 1},
+		{"monthly_tokens_used", "monthly_token_limit", tokens},
+	}
+
+	query := r.data.db.WithContext(ctx).Model(&model.UserQuota{}).Where("id = ?", quotaID)
+	updates := make(map[string]interface{}, len(checks))
+	for _, c := range checks {
+		cond := fmt.Sprintf("%s + ? <= %s", c.used, c.limit)
+		if unlimitedZero {
+			cond = fmt.Sprintf("(%s = 0 OR %s)", c.limit, cond)
+		}
+		query = query.Where(cond, c.amount)
+		updates[c.used] = gorm.Expr(c.used+" + ?", c.amount)
+	}
+
+	result := query.Updates(updates)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// SettleQuota 按实际消耗修正预扣的token数与请求数
+func (r *quotaRepo) SettleQuota(ctx context.Context, quotaID, tokenDelta, requestDelta int64) error {
+	if tokenDelta == 0 && requestDelta == 0 {
+		return nil
+	}
+	updates := make(map[string]interface{}, 4)
+	for column, delta := range map[string]int64{
+		"daily_tokens_used":     tokenDelta,
+		"monthly_tokens_used":   tokenDelta,
+		"daily_requests_used":   requestDelta,
+		"monthly_requests_used": requestDelta,
+	} {
+		if delta != 0 {
+			updates[column] = gorm.Expr(fmt.Sprintf("CASE WHEN %s + ? < 0 THEN 0 ELSE %s + ? END", column, column), delta, delta)
+		}
+	}
+	return r.data.db.WithContext(ctx).Model(&model.UserQuota{}).Where("id = ?", quotaID).Updates(updates).Error
+}
+
+// RecordUsage 记录一次模型调用的使用事件
+func (r *quotaRepo) RecordUsage(ctx context.Context, event *biz.UsageEvent) error {
+	kind, status := 1, 1 // 模型调用, 成功
+	if event.ToolCall {
+		kind = 2 // 工具调用
+	}
+	if event.Failed {
+		status = 2 // 失败
+	}
+	return r.data.db.WithContext(ctx).Create(&model.UsageEvent{
+		UserID:       event.UserID,
+		WorkspaceID:  event.WorkspaceID,
+		ModelID:      event.ModelID,
+		Kind:         kind,
+		Status:       status,
+		InputTokens:  event.InputTokens,
+		OutputTokens: event.OutputTokens,
+		Cost:         event.Cost,
+		ResponseTime: event.ResponseTime.Seconds(),
+	}).Error
+}
+
 func (r *quotaRepo) convertUserQuotaModelToBiz(po *model.UserQuota) *biz.UserQuota {
 	return &biz.UserQuota{
+		ID:                  po.ID,
 		UserID:              po.UserID,
+		WorkspaceID:         po.WorkspaceID,
 		ModelID:             po.ModelID,
 		DailyTokenLimit:     po.DailyTokenLimit,
 		MonthlyTokenLimit:   po.MonthlyTokenLimit,
@@ -462,6 +649,7 @@ func (r *quotaRepo) convertUserQuotaModelToBiz(po *model.UserQuota) *biz.UserQuo
 func (r *quotaRepo) convertUsageStatsModelToBiz(po *model.UsageStats) *biz.UsageStats {
 	return &biz.UsageStats{
 		UserID:             po.UserID,
+		WorkspaceID:        po.WorkspaceID,
 		ModelID:            po.ModelID,
 		Date:               po.Date,
 		TotalRequests:      po.TotalRequests,
@@ -595,11 +783,71 @@ func (r *healthRepo) UpdateModelHealth(ctx context.Context, health *biz.ModelHea
 		SuccessRate:    health.SuccessRate,
 		TotalRequests:  health.TotalRequests,
 		FailedRequests: health.FailedRequests,
+		LatencyP50:     health.LatencyP50,
+		LatencyP95:     health.LatencyP95,
+		LatencyP99:     health.LatencyP99,
 		ErrorMessage:   health.ErrorMessage,
 		LastCheck:      health.LastCheck,
 	}
 
-	return r.data.db.WithContext(ctx).Where("model_id = ?", health.ModelID).Save(po).Error
+	// 每个模型只保留一行当前状态
+	return r.data.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "model_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"is_healthy", "response_time", "success_rate", "total_requests", "failed_requests",
+			"latency_p50", "latency_p95", "latency_p99", "error_message", "last_check", "updated_at",
+		}),
+	}).Create(po).Error
+}
+
+func (r *healthRepo) CreateHealthCheck(ctx context.Context, record *biz.HealthCheckRecord) error {
+	po := &model.ModelHealthCheck{
+		ModelID:      record.ModelID,
+		ProviderID:   record.ProviderID,
+		Source:       record.Source,
+		Success:      record.Success,
+		Latency:      record.Latency,
+		StatusCode:   record.StatusCode,
+		ErrorMessage: record.ErrorMessage,
+		CheckedAt:    record.CheckedAt,
+	}
+	if err := r.data.db.WithContext(ctx).Create(po).Error; err != nil {
+		return err
+	}
+	record.ID = po.ID
+	return nil
+}
+
+func (r *healthRepo) ListHealthChecks(ctx context.Context, modelID int64, since time.Time, limit int) ([]*biz.HealthCheckRecord, error) {
+	var pos []*model.ModelHealthCheck
+	if err := r.data.db.WithContext(ctx).
+		Where("model_id = ? AND checked_at >= ?", modelID, since).
+		Order("checked_at DESC").
+		Limit(limit).
+		Find(&pos).Error; err != nil {
+		return nil, err
+	}
+
+	records := make([]*biz.HealthCheckRecord, len(pos))
+	for i, po := range pos {
+		records[i] = &biz.HealthCheckRecord{
+			ID:           po.ID,
+			ModelID:      po.ModelID,
+			ProviderID:   po.ProviderID,
+			Source:       po.Source,
+			Success:      po.Success,
+			Latency:      po.Latency,
+			StatusCode:   po.StatusCode,
+			ErrorMessage: po.ErrorMessage,
+			CheckedAt:    po.CheckedAt,
+		}
+	}
+	return records, nil
+}
+
+func (r *healthRepo) DeleteHealthChecksBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := r.data.db.WithContext(ctx).Where("checked_at < ?", before).Delete(&model.ModelHealthCheck{})
+	return result.RowsAffected, result.Error
 }
 
 func (r *healthRepo) GetModelMetrics(ctx context.Context, modelID int64, startTime, endTime string) (*pb.GetModelMetricsReply, error) {
@@ -615,7 +863,7 @@ func (r *healthRepo) GetModelMetrics(ctx context.Context, modelID int64, startTi
 	query := `
 		SELECT 
 			AVG(avg_response_time) as avg_response_time,
-			SUM(successful_requests) / SUM(total_requests) as success_rate,
+			SUM(successful_requests) * 1.0 / NULLIF(SUM(total_requests), 0) as success_rate,
 			SUM(total_requests) as total_requests,
 			SUM(failed_requests) as error_count
 		FROM ai_usage_stats 
@@ -655,6 +903,9 @@ func (r *healthRepo) convertModelHealthModelToBiz(po *model.ModelHealth) *biz.Mo
 		SuccessRate:    po.SuccessRate,
 		TotalRequests:  po.TotalRequests,
 		FailedRequests: po.FailedRequests,
+		LatencyP50:     po.LatencyP50,
+		LatencyP95:     po.LatencyP95,
+		LatencyP99:     po.LatencyP99,
 		ErrorMessage:   po.ErrorMessage,
 		LastCheck:      po.LastCheck,
 	}
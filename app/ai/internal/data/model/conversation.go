@@ -1,6 +1,7 @@
 package model
 
 import (
+	"database/sql/driver"
 	"encoding/json"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 type Conversation struct {
 	ID               int64              `gorm:"primarykey" json:"id"`
 	UserID           int64              `gorm:"not null;index" json:"user_id"`
+	WorkspaceID      int64              `gorm:"default:0;index" json:"workspace_id"` // 所属工作空间，0表示个人
 	Title            string             `gorm:"size:255;not null" json:"title"`
 	ModelName        string             `gorm:"size:100;not null" json:"model_name"`
 	SystemPrompt     string             `gorm:"type:text" json:"system_prompt"`
@@ -26,14 +28,18 @@ type Conversation struct {
 	DeletedAt        gorm.DeletedAt     `gorm:"index" json:"deleted_at"`
 
 	// 统计信息
-	MessageCount      int64 `gorm:"default:0" json:"message_count"`
-	TotalInputTokens  int64 `gorm:"default:0" json:"total_input_tokens"`
-	TotalOutputTokens int64 `gorm:"default:0" json:"total_output_tokens"`
-	ToolCallCount     int64 `gorm:"default:0" json:"tool_call_count"`
-	TotalDuration     int64 `gorm:"default:0" json:"total_duration"` // milliseconds
+	MessageCount      int64   `gorm:"default:0" json:"message_count"`
+	TotalInputTokens  int64   `gorm:"default:0" json:"total_input_tokens"`
+	TotalOutputTokens int64   `gorm:"default:0" json:"total_output_tokens"`
+	ToolCallCount     int64   `gorm:"default:0" json:"tool_call_count"`
+	TotalDuration     int64   `gorm:"default:0" json:"total_duration"` // milliseconds
+	TotalCost         float64 `gorm:"type:decimal(12,6);default:0" json:"total_cost"`
 
 	// 关联关系
 	Messages []Message `gorm:"foreignKey:ConversationID" json:"messages,omitempty"`
+
+	// 调用者对该对话的权限（运行时字段，不存储）
+	AccessRole int `gorm:"-" json:"access_role,omitempty"`
 }
 
 // ConversationConfig 对话配置
@@ -76,11 +82,13 @@ type Message struct {
 	EditedAt        *time.Time `json:"edited_at"`
 
 	// Token统计
-	InputTokens  int     `gorm:"default:0" json:"input_tokens"`
-	OutputTokens int     `gorm:"default:0" json:"output_tokens"`
-	ResponseTime float64 `gorm:"default:0" json:"response_time"` // seconds
-	Cost         float64 `gorm:"default:0" json:"cost"`
-	ModelUsed    string  `gorm:"size:100" json:"model_used"`
+	InputTokens       int     `gorm:"default:0" json:"input_tokens"`
+	CachedInputTokens int     `gorm:"default:0" json:"cached_input_tokens"` // 输入中命中缓存的部分
+	OutputTokens      int     `gorm:"default:0" json:"output_tokens"`
+	ResponseTime      float64 `gorm:"default:0" json:"response_time"` // seconds
+	Cost              float64 `gorm:"default:0" json:"cost"`
+	ModelUsed         string  `gorm:"size:100" json:"model_used"`
+	CredentialSource  string  `gorm:"size:20" json:"credential_source"` // provider, user, workspace，自有凭证的用量不计入平台配额
 
 	CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
@@ -128,10 +136,33 @@ type MessageAttachment struct {
 	Message Message `gorm:"foreignKey:MessageID" json:"message,omitempty"`
 }
 
+func (c ConversationConfig) Value() (driver.Value, error) {
+	b, err := json.Marshal(c)
+	return string(b), err
+}
+
+func (c *ConversationConfig) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return nil
+	}
+
+	return json.Unmarshal(bytes, c)
+}
+
 // StringSlice 字符串切片的自定义类型，用于JSON序列化
 type StringSlice []string
 
-func (s StringSlice) Value() (interface{}, error) {
+func (s StringSlice) Value() (driver.Value, error) {
 	if len(s) == 0 {
 		return "[]", nil
 	}
@@ -161,7 +192,7 @@ func (s *StringSlice) Scan(value interface{}) error {
 // KeyValueMap 键值对映射的自定义类型
 type KeyValueMap map[string]string
 
-func (kv KeyValueMap) Value() (interface{}, error) {
+func (kv KeyValueMap) Value() (driver.Value, error) {
 	if len(kv) == 0 {
 		return "{}", nil
 	}
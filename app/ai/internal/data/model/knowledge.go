@@ -1,6 +1,7 @@
 package model
 
 import (
+	"database/sql/driver"
 	"encoding/json"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 type KnowledgeBase struct {
 	ID                 int64               `gorm:"primarykey" json:"id"`
 	UserID             int64               `gorm:"not null;index" json:"user_id"`
+	WorkspaceID        int64               `gorm:"default:0;index" json:"workspace_id"` // 所属工作空间，0表示个人
 	Name               string              `gorm:"size:255;not null" json:"name"`
 	Description        string              `gorm:"type:text" json:"description"`
 	EmbeddingModel     string              `gorm:"size:100;not null" json:"embedding_model"`
@@ -24,6 +26,8 @@ type KnowledgeBase struct {
 	MaxFileSize        int64               `gorm:"default:104857600" json:"max_file_size"` // 100MB default
 	AutoProcess        bool                `gorm:"default:true" json:"auto_process"`
 	Config             KnowledgeBaseConfig `gorm:"type:json" json:"config"`
+	IndexVersion       int                 `gorm:"default:1" json:"index_version"` // 当前生效的索引版本，重建索引成功后切换
+	ReindexJobID       string              `gorm:"size:64" json:"reindex_job_id"`  // 持有重建索引的任务，强制重建时改为新任务，被取代的任务据此中止
 	CreatedAt          time.Time           `json:"created_at"`
 	UpdatedAt          time.Time           `json:"updated_at"`
 	LastIndexedAt      *time.Time          `json:"last_indexed_at"`
@@ -39,6 +43,9 @@ type KnowledgeBase struct {
 
 	// 关联关系
 	Documents []Document `gorm:"foreignKey:KnowledgeBaseID" json:"documents,omitempty"`
+
+	// 调用者对该知识库的权限（运行时字段，不存储）
+	AccessRole int `gorm:"-" json:"access_role,omitempty"`
 }
 
 // KnowledgeBaseConfig 知识库配置
@@ -56,7 +63,7 @@ type KnowledgeBaseConfig struct {
 // Document 文档模型
 type Document struct {
 	ID                 int64            `gorm:"primarykey" json:"id"`
-	KnowledgeBaseID    int64            `gorm:"not null;index" json:"knowledge_base_id"`
+	KnowledgeBaseID    int64            `gorm:"not null;index;uniqueIndex:idx_document_kb_hash,priority:1" json:"knowledge_base_id"`
 	Name               string           `gorm:"size:255;not null" json:"name"`
 	Content            string           `gorm:"type:longtext" json:"content"`
 	FilePath           string           `gorm:"size:500" json:"file_path"`
@@ -67,7 +74,7 @@ type Document struct {
 	Tags               StringSlice      `gorm:"type:json" json:"tags"`
 	Language           string           `gorm:"size:10" json:"language"`
 	SourceURL          string           `gorm:"size:500" json:"source_url"`
-	Hash               string           `gorm:"size:64;index" json:"hash"`
+	Hash               string           `gorm:"size:64;index;uniqueIndex:idx_document_kb_hash,priority:2" json:"hash"` // 内容 SHA-256，知识库内唯一，删除的文档置空
 	Version            int              `gorm:"default:1" json:"version"`
 	ProcessingProgress float64          `gorm:"default:0" json:"processing_progress"`
 	ProcessingError    string           `gorm:"type:text" json:"processing_error"`
@@ -96,24 +103,42 @@ type DocumentMetadata struct {
 	CustomFields map[string]string `json:"custom_fields,omitempty"`
 }
 
+// DocumentVersion 文档历史版本，文档内容变更前保存上一版本，用于回滚
+type DocumentVersion struct {
+	ID         int64            `gorm:"primarykey" json:"id"`
+	DocumentID int64            `gorm:"not null;uniqueIndex:idx_document_version" json:"document_id"`
+	Version    int              `gorm:"not null;uniqueIndex:idx_document_version" json:"version"`
+	Name       string           `gorm:"size:255;not null" json:"name"`
+	Content    string           `gorm:"type:longtext" json:"content"`
+	Hash       string           `gorm:"size:64" json:"hash"`
+	FileSize   int64            `gorm:"default:0" json:"file_size"`
+	Language   string           `gorm:"size:10" json:"language"`
+	Tags       StringSlice      `gorm:"type:json" json:"tags"`
+	Metadata   DocumentMetadata `gorm:"type:json" json:"metadata"`
+	CreatedAt  time.Time        `json:"created_at"`
+}
+
 // KnowledgeChunk 知识块模型
 type KnowledgeChunk struct {
-	ID              int64           `gorm:"primarykey" json:"id"`
-	DocumentID      int64           `gorm:"not null;index" json:"document_id"`
-	KnowledgeBaseID int64           `gorm:"not null;index" json:"knowledge_base_id"`
-	Content         string          `gorm:"type:text;not null" json:"content"`
-	ChunkIndex      int             `gorm:"not null" json:"chunk_index"`
-	StartPosition   int             `gorm:"default:0" json:"start_position"`
-	EndPosition     int             `gorm:"default:0" json:"end_position"`
-	Language        string          `gorm:"size:10" json:"language"`
-	Keywords        StringSlice     `gorm:"type:json" json:"keywords"`
-	ChunkType       int             `gorm:"default:1" json:"chunk_type"` // 1:text, 2:title, 3:paragraph, 4:list, 5:table, 6:code
-	CharacterCount  int             `gorm:"default:0" json:"character_count"`
-	TokenCount      int             `gorm:"default:0" json:"token_count"`
-	Embedding       EmbeddingVector `gorm:"type:json" json:"embedding"`
-	Metadata        ChunkMetadata   `gorm:"type:json" json:"metadata"`
-	CreatedAt       time.Time       `json:"created_at"`
-	UpdatedAt       time.Time       `json:"updated_at"`
+	ID              int64            `gorm:"primarykey" json:"id"`
+	DocumentID      int64            `gorm:"not null;index" json:"document_id"`
+	KnowledgeBaseID int64            `gorm:"not null;index" json:"knowledge_base_id"`
+	Content         string           `gorm:"type:text;not null" json:"content"`
+	ChunkIndex      int              `gorm:"not null" json:"chunk_index"`
+	StartPosition   int              `gorm:"default:0" json:"start_position"`
+	EndPosition     int              `gorm:"default:0" json:"end_position"`
+	Language        string           `gorm:"size:10" json:"language"`
+	Keywords        StringSlice      `gorm:"type:json" json:"keywords"`
+	ChunkType       int              `gorm:"default:1" json:"chunk_type"` // 1:text, 2:title, 3:paragraph, 4:list, 5:table, 6:code
+	CharacterCount  int              `gorm:"default:0" json:"character_count"`
+	TokenCount      int              `gorm:"default:0" json:"token_count"`
+	Embedding       EmbeddingVector  `gorm:"type:json" json:"embedding"`
+	Metadata        ChunkMetadata    `gorm:"type:json" json:"metadata"`
+	SimHash         int64            `gorm:"default:0" json:"sim_hash"` // 内容 SimHash，按位存储为有符号整数
+	MinHash         MinHashSignature `gorm:"type:json" json:"min_hash"`
+	IndexVersion    int              `gorm:"default:1;index" json:"index_version"` // 所属索引版本，重建索引期间新旧版本并存
+	CreatedAt       time.Time        `json:"created_at"`
+	UpdatedAt       time.Time        `json:"updated_at"`
 
 	// 搜索相关字段（运行时使用）
 	Score float64 `gorm:"-" json:"score,omitempty"`
@@ -123,6 +148,9 @@ type KnowledgeChunk struct {
 	KnowledgeBase KnowledgeBase `gorm:"foreignKey:KnowledgeBaseID" json:"knowledge_base,omitempty"`
 }
 
+// MaxChunkKeywords 自动提取的知识块关键词上限
+const MaxChunkKeywords = 20
+
 // ChunkMetadata 块元数据
 type ChunkMetadata struct {
 	Section    string            `json:"section,omitempty"`
@@ -136,7 +164,7 @@ type ChunkMetadata struct {
 // EmbeddingVector 向量嵌入类型
 type EmbeddingVector []float64
 
-func (e EmbeddingVector) Value() (interface{}, error) {
+func (e EmbeddingVector) Value() (driver.Value, error) {
 	if len(e) == 0 {
 		return "[]", nil
 	}
@@ -163,6 +191,36 @@ func (e *EmbeddingVector) Scan(value interface{}) error {
 	return json.Unmarshal(bytes, e)
 }
 
+// MinHashSignature 内容 MinHash 签名
+type MinHashSignature []uint32
+
+func (m MinHashSignature) Value() (driver.Value, error) {
+	if len(m) == 0 {
+		return "[]", nil
+	}
+	b, err := json.Marshal(m)
+	return string(b), err
+}
+
+func (m *MinHashSignature) Scan(value interface{}) error {
+	if value == nil {
+		*m = MinHashSignature{}
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return nil
+	}
+
+	return json.Unmarshal(bytes, m)
+}
+
 // ProcessingJob 处理任务模型
 type ProcessingJob struct {
 	ID              string                 `gorm:"primarykey" json:"id"`
@@ -173,7 +231,7 @@ type ProcessingJob struct {
 	Progress        float64                `gorm:"default:0" json:"progress"`
 	ErrorMessage    string                 `gorm:"type:text" json:"error_message"`
 	Result          JobResult              `gorm:"type:json" json:"result"`
-	Options         map[string]interface{} `gorm:"type:json" json:"options"`
+	Options         map[string]interface{} `gorm:"type:json;serializer:json" json:"options"`
 	CreatedAt       time.Time              `json:"created_at"`
 	UpdatedAt       time.Time              `json:"updated_at"`
 	StartedAt       *time.Time             `json:"started_at"`
@@ -211,7 +269,7 @@ type SearchHistory struct {
 }
 
 // 自定义GORM类型转换器实现
-func (c KnowledgeBaseConfig) Value() (interface{}, error) {
+func (c KnowledgeBaseConfig) Value() (driver.Value, error) {
 	b, err := json.Marshal(c)
 	return string(b), err
 }
@@ -234,7 +292,7 @@ func (c *KnowledgeBaseConfig) Scan(value interface{}) error {
 	return json.Unmarshal(bytes, c)
 }
 
-func (m DocumentMetadata) Value() (interface{}, error) {
+func (m DocumentMetadata) Value() (driver.Value, error) {
 	b, err := json.Marshal(m)
 	return string(b), err
 }
@@ -257,7 +315,7 @@ func (m *DocumentMetadata) Scan(value interface{}) error {
 	return json.Unmarshal(bytes, m)
 }
 
-func (m ChunkMetadata) Value() (interface{}, error) {
+func (m ChunkMetadata) Value() (driver.Value, error) {
 	b, err := json.Marshal(m)
 	return string(b), err
 }
@@ -280,7 +338,7 @@ func (m *ChunkMetadata) Scan(value interface{}) error {
 	return json.Unmarshal(bytes, m)
 }
 
-func (r JobResult) Value() (interface{}, error) {
+func (r JobResult) Value() (driver.Value, error) {
 	b, err := json.Marshal(r)
 	return string(b), err
 }
@@ -312,6 +370,10 @@ func (Document) TableName() string {
 	return "documents"
 }
 
+func (DocumentVersion) TableName() string {
+	return "document_versions"
+}
+
 func (KnowledgeChunk) TableName() string {
 	return "knowledge_chunks"
 }
@@ -9,17 +9,17 @@ import (
 
 // Provider 提供商模型
 type Provider struct {
-	ID             int64     `gorm:"column:id;primaryKey;autoIncrement"`
-	Name           string    `gorm:"column:name;type:varchar(100);not null;uniqueIndex"`
-	DisplayName    string    `gorm:"column:display_name;type:varchar(200);not null"`
-	Description    string    `gorm:"column:description;type:text"`
-	APIBaseURL     string    `gorm:"column:api_base_url;type:varchar(500)"`
-	DefaultAPIKey  string    `gorm:"column:default_api_key;type:varchar(500)"`
-	DefaultHeaders string    `gorm:"column:default_headers;type:json"`
-	Config         string    `gorm:"column:config;type:json"`
-	Status         int32     `gorm:"column:status;type:tinyint;default:0;comment:'0:启用 1:禁用 2:维护中'"`
-	CreatedAt      time.Time `gorm:"column:created_at;autoCreateTime"`
-	UpdatedAt      time.Time `gorm:"column:updated_at;autoUpdateTime"`
+	ID             int64        `gorm:"column:id;primaryKey;autoIncrement"`
+	Name           string       `gorm:"column:name;type:varchar(100);not null;uniqueIndex"`
+	DisplayName    string       `gorm:"column:display_name;type:varchar(200);not null"`
+	Description    string       `gorm:"column:description;type:text"`
+	APIBaseURL     string       `gorm:"column:api_base_url;type:varchar(500)"`
+	DefaultAPIKey  SecretString `gorm:"column:default_api_key;type:text"`
+	DefaultHeaders string       `gorm:"column:default_headers;type:json"`
+	Config         string       `gorm:"column:config;type:json"`
+	Status         int32        `gorm:"column:status;type:tinyint;default:0;comment:'0:启用 1:禁用 2:维护中'"`
+	CreatedAt      time.Time    `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt      time.Time    `gorm:"column:updated_at;autoUpdateTime"`
 }
 
 func (Provider) TableName() string {
@@ -68,19 +68,20 @@ func (p *Provider) SetConfig(config map[string]string) {
 
 // Model 模型
 type Model struct {
-	ID            int64     `gorm:"column:id;primaryKey;autoIncrement"`
-	ProviderID    int64     `gorm:"column:provider_id;not null;index"`
-	Name          string    `gorm:"column:name;type:varchar(100);not null;uniqueIndex"`
-	DisplayName   string    `gorm:"column:display_name;type:varchar(200);not null"`
-	Description   string    `gorm:"column:description;type:text"`
-	Version       string    `gorm:"column:version;type:varchar(50)"`
-	Capabilities  string    `gorm:"column:capabilities;type:json"`
-	Limits        string    `gorm:"column:limits;type:json"`
-	Pricing       string    `gorm:"column:pricing;type:json"`
-	DefaultParams string    `gorm:"column:default_params;type:json"`
-	Status        int32     `gorm:"column:status;type:tinyint;default:0;comment:'0:可用 1:不可用 2:维护中'"`
-	CreatedAt     time.Time `gorm:"column:created_at;autoCreateTime"`
-	UpdatedAt     time.Time `gorm:"column:updated_at;autoUpdateTime"`
+	ID            int64      `gorm:"column:id;primaryKey;autoIncrement"`
+	ProviderID    int64      `gorm:"column:provider_id;not null;index"`
+	Name          string     `gorm:"column:name;type:varchar(100);not null;uniqueIndex"`
+	DisplayName   string     `gorm:"column:display_name;type:varchar(200);not null"`
+	Description   string     `gorm:"column:description;type:text"`
+	Version       string     `gorm:"column:version;type:varchar(50)"`
+	Capabilities  string     `gorm:"column:capabilities;type:json"`
+	Limits        string     `gorm:"column:limits;type:json"`
+	Pricing       string     `gorm:"column:pricing;type:json"`
+	DefaultParams string     `gorm:"column:default_params;type:json"`
+	Status        int32      `gorm:"column:status;type:tinyint;default:0;comment:'0:可用 1:不可用 2:维护中'"`
+	MissingSince  *time.Time `gorm:"column:missing_since;comment:'同步时发现提供商已下线该模型的时间'"`
+	CreatedAt     time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt     time.Time  `gorm:"column:updated_at;autoUpdateTime"`
 }
 
 func (Model) TableName() string {
@@ -170,7 +171,8 @@ func (m *Model) SetDefaultParams(params map[string]string) {
 // UserQuota 用户配额
 type UserQuota struct {
 	ID                  int64     `gorm:"column:id;primaryKey;autoIncrement"`
-	UserID              int64     `gorm:"column:user_id;not null;index"`
+	UserID              int64     `gorm:"column:user_id;not null;index"` // 工作空间共享配额时为0
+	WorkspaceID         int64     `gorm:"column:workspace_id;default:0;index"`
 	ModelID             int64     `gorm:"column:model_id;not null;index"`
 	DailyTokenLimit     int64     `gorm:"column:daily_token_limit;default:0"`
 	MonthlyTokenLimit   int64     `gorm:"column:monthly_token_limit;default:0"`
@@ -193,16 +195,17 @@ func (UserQuota) TableName() string {
 // UsageStats 使用统计
 type UsageStats struct {
 	ID                 int64     `gorm:"column:id;primaryKey;autoIncrement"`
-	UserID             int64     `gorm:"column:user_id;not null;index"`
-	ModelID            int64     `gorm:"column:model_id;not null;index"`
-	Date               string    `gorm:"column:date;type:date;not null;index"`
+	UserID             int64     `gorm:"column:user_id;not null;index;uniqueIndex:idx_usage_stats_scope"`
+	WorkspaceID        int64     `gorm:"column:workspace_id;default:0;index;uniqueIndex:idx_usage_stats_scope"`
+	ModelID            int64     `gorm:"column:model_id;not null;index;uniqueIndex:idx_usage_stats_scope"`
+	Date               string    `gorm:"column:date;type:date;not null;index;uniqueIndex:idx_usage_stats_scope"`
 	TotalRequests      int64     `gorm:"column:total_requests;default:0"`
 	SuccessfulRequests int64     `gorm:"column:successful_requests;default:0"`
 	FailedRequests     int64     `gorm:"column:failed_requests;default:0"`
 	TotalTokens        int64     `gorm:"column:total_tokens;default:0"`
 	InputTokens        int64     `gorm:"column:input_tokens;default:0"`
 	OutputTokens       int64     `gorm:"column:output_tokens;default:0"`
-	TotalCost          float64   `gorm:"column:total_cost;type:decimal(10,4);default:0"`
+	TotalCost          float64   `gorm:"column:total_cost;type:decimal(12,6);default:0"`
 	AvgResponseTime    float64   `gorm:"column:avg_response_time;type:decimal(10,2);default:0"`
 	CreatedAt          time.Time `gorm:"column:created_at;autoCreateTime"`
 	UpdatedAt          time.Time `gorm:"column:updated_at;autoUpdateTime"`
@@ -212,6 +215,25 @@ func (UsageStats) TableName() string {
 	return "ai_usage_stats"
 }
 
+// UsageEvent 模型调用使用事件，每次调用一条，包含失败的调用
+type UsageEvent struct {
+	ID           int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID       int64     `gorm:"column:user_id;not null;index"`
+	WorkspaceID  int64     `gorm:"column:workspace_id;default:0;index"`
+	ModelID      int64     `gorm:"column:model_id;not null;index"`
+	Kind         int       `gorm:"column:kind;default:1"`  // 1:模型调用 2:工具调用
+	Status       int       `gorm:"column:status;not null"` // 1:成功 2:失败
+	InputTokens  int64     `gorm:"column:input_tokens;default:0"`
+	OutputTokens int64     `gorm:"column:output_tokens;default:0"`
+	Cost         float64   `gorm:"column:cost;type:decimal(12,6);default:0"`
+	ResponseTime float64   `gorm:"column:response_time;type:decimal(10,2);default:0"` // 秒
+	CreatedAt    time.Time `gorm:"column:created_at;autoCreateTime;index"`
+}
+
+func (UsageEvent) TableName() string {
+	return "ai_usage_events"
+}
+
 // RateLimitConfig 限流配置
 type RateLimitConfig struct {
 	ID                 int64     `gorm:"column:id;primaryKey;autoIncrement"`
@@ -240,6 +262,9 @@ type ModelHealth struct {
 	SuccessRate    float64   `gorm:"column:success_rate;type:decimal(5,4);default:0"`
 	TotalRequests  int64     `gorm:"column:total_requests;default:0"`
 	FailedRequests int64     `gorm:"column:failed_requests;default:0"`
+	LatencyP50     float64   `gorm:"column:latency_p50;type:decimal(10,2);default:0"`
+	LatencyP95     float64   `gorm:"column:latency_p95;type:decimal(10,2);default:0"`
+	LatencyP99     float64   `gorm:"column:latency_p99;type:decimal(10,2);default:0"`
 	ErrorMessage   string    `gorm:"column:error_message;type:text"`
 	LastCheck      time.Time `gorm:"column:last_check"`
 	CreatedAt      time.Time `gorm:"column:created_at;autoCreateTime"`
@@ -250,6 +275,39 @@ func (ModelHealth) TableName() string {
 	return "ai_model_health"
 }
 
+// ModelHealthCheck 模型健康检查历史样本，来自主动探测与线上调用
+type ModelHealthCheck struct {
+	ID           int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	ModelID      int64     `gorm:"column:model_id;not null;index:idx_model_checked,priority:1"`
+	ProviderID   int64     `gorm:"column:provider_id;not null"`
+	Source       string    `gorm:"column:source;size:20;not null"` // probe, traffic
+	Success      bool      `gorm:"column:success;not null"`
+	Latency      float64   `gorm:"column:latency;type:decimal(10,2);default:0"`
+	StatusCode   int       `gorm:"column:status_code;default:0"`
+	ErrorMessage string    `gorm:"column:error_message;type:text"`
+	CheckedAt    time.Time `gorm:"column:checked_at;not null;index:idx_model_checked,priority:2;index"`
+}
+
+func (ModelHealthCheck) TableName() string {
+	return "ai_model_health_checks"
+}
+
+// ProviderCredential 用户或工作空间自有的提供商凭证
+type ProviderCredential struct {
+	ID          int64        `gorm:"column:id;primaryKey;autoIncrement"`
+	ProviderID  int64        `gorm:"column:provider_id;not null;uniqueIndex:idx_credential_owner,priority:1"`
+	UserID      int64        `gorm:"column:user_id;not null;default:0;uniqueIndex:idx_credential_owner,priority:2"`      // 工作空间凭证为0
+	WorkspaceID int64        `gorm:"column:workspace_id;not null;default:0;uniqueIndex:idx_credential_owner,priority:3"` // 个人凭证为0
+	Name        string       `gorm:"column:name;type:varchar(100)"`
+	APIKey      SecretString `gorm:"column:api_key;type:text;not null"`
+	CreatedAt   time.Time    `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt   time.Time    `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (ProviderCredential) TableName() string {
+	return "ai_provider_credentials"
+}
+
 // UserDefaultModel 用户默认模型
 type UserDefaultModel struct {
 	ID        int64     `gorm:"column:id;primaryKey;autoIncrement"`
@@ -0,0 +1,115 @@
+package model
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+
+	"universal/pkg/envelope"
+)
+
+// SecretCipher 敏感字段加解密实现，由数据层初始化时注册
+type SecretCipher interface {
+	Encrypt(ctx context.Context, plaintext string) (string, error)
+	Decrypt(ctx context.Context, value string) (string, error)
+}
+
+// errNoSecretCipher 未注册加密器时拒绝读写密文，避免明文落库
+var errNoSecretCipher = errors.New("secret cipher is not configured")
+
+var secretCipher SecretCipher
+
+// SetSecretCipher 注册敏感字段加密器
+func SetSecretCipher(c SecretCipher) {
+	secretCipher = c
+}
+
+func encryptSecret(plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+	if secretCipher == nil {
+		return "", errNoSecretCipher
+	}
+	return secretCipher.Encrypt(context.Background(), plaintext)
+}
+
+// decryptSecret 解密敏感字段，加密前写入的明文原样返回
+func decryptSecret(value string) (string, error) {
+	if !envelope.IsEncrypted(value) {
+		return value, nil
+	}
+	if secretCipher == nil {
+		return "", errNoSecretCipher
+	}
+	return secretCipher.Decrypt(context.Background(), value)
+}
+
+// SecretString 落库时加密的字符串列
+type SecretString string
+
+func (s SecretString) Value() (driver.Value, error) {
+	return encryptSecret(string(s))
+}
+
+func (s *SecretString) Scan(value interface{}) error {
+	var raw string
+	switch v := value.(type) {
+	case nil:
+		*s = ""
+		return nil
+	case []byte:
+		raw = string(v)
+	case string:
+		raw = v
+	default:
+		return nil
+	}
+
+	plaintext, err := decryptSecret(raw)
+	if err != nil {
+		return err
+	}
+	*s = SecretString(plaintext)
+	return nil
+}
+
+// SecretMap 序列化为JSON时逐值加密的映射，用于嵌套在JSON列中的凭证
+type SecretMap map[string]string
+
+func (m SecretMap) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return []byte("null"), nil
+	}
+	encrypted := make(map[string]string, len(m))
+	for key, value := range m {
+		ciphertext, err := encryptSecret(value)
+		if err != nil {
+			return nil, err
+		}
+		encrypted[key] = ciphertext
+	}
+	return json.Marshal(encrypted)
+}
+
+func (m *SecretMap) UnmarshalJSON(data []byte) error {
+	var encrypted map[string]string
+	if err := json.Unmarshal(data, &encrypted); err != nil {
+		return err
+	}
+	if encrypted == nil {
+		*m = nil
+		return nil
+	}
+	decrypted := make(SecretMap, len(encrypted))
+	for key, value := range encrypted {
+		plaintext, err := decryptSecret(value)
+		if err != nil {
+			return err
+		}
+		decrypted[key] = plaintext
+	}
+	*m = decrypted
+	return nil
+}
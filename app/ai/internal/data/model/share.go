@@ -0,0 +1,102 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 共享资源类型
+const (
+	ShareResourceKnowledgeBase = "knowledge_base"
+	ShareResourceConversation  = "conversation"
+)
+
+// 共享角色，数值越大权限越高
+const (
+	ShareRoleViewer = 1
+	ShareRoleEditor = 2
+	ShareRoleAdmin  = 3
+	ShareRoleOwner  = 4 // 仅用于表示调用者是所有者，不落库
+)
+
+// ResourceShare 资源共享授权记录
+type ResourceShare struct {
+	ID           int64      `gorm:"primarykey" json:"id"`
+	ResourceType string     `gorm:"size:32;not null;uniqueIndex:idx_resource_share_grantee" json:"resource_type"` // knowledge_base, conversation
+	ResourceID   int64      `gorm:"not null;uniqueIndex:idx_resource_share_grantee" json:"resource_id"`
+	OwnerID      int64      `gorm:"not null;index" json:"owner_id"`
+	GranteeID    int64      `gorm:"not null;uniqueIndex:idx_resource_share_grantee;index" json:"grantee_id"`
+	Role         int        `gorm:"not null;default:1" json:"role"` // 1:viewer, 2:editor, 3:admin
+	GrantedBy    int64      `gorm:"not null" json:"granted_by"`
+	ExpiresAt    *time.Time `gorm:"index" json:"expires_at"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// ConversationSnapshot 对话公开快照，发布时冻结消息内容
+type ConversationSnapshot struct {
+	ID             int64            `gorm:"primarykey" json:"id"`
+	Token          string           `gorm:"size:64;not null;uniqueIndex" json:"token"`
+	ConversationID int64            `gorm:"not null;index" json:"conversation_id"`
+	OwnerID        int64            `gorm:"not null;index" json:"owner_id"` // 发布者ID
+	Title          string           `gorm:"size:255" json:"title"`
+	ModelName      string           `gorm:"size:100" json:"model_name"`
+	Messages       SnapshotMessages `gorm:"type:json" json:"messages"`
+	MessageCount   int              `gorm:"default:0" json:"message_count"`
+	ViewCount      int64            `gorm:"default:0" json:"view_count"`
+	ExpiresAt      *time.Time       `json:"expires_at"`
+	CreatedAt      time.Time        `json:"created_at"`
+	UpdatedAt      time.Time        `json:"updated_at"`
+	DeletedAt      gorm.DeletedAt   `gorm:"index" json:"deleted_at"`
+}
+
+// SnapshotMessage 快照中的消息
+type SnapshotMessage struct {
+	ID        int64     `json:"id"`
+	Role      string    `json:"role"`
+	Content   string    `json:"content"`
+	ModelUsed string    `json:"model_used,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// SnapshotMessages 快照消息列表
+type SnapshotMessages []SnapshotMessage
+
+func (s SnapshotMessages) Value() (driver.Value, error) {
+	if len(s) == 0 {
+		return "[]", nil
+	}
+	b, err := json.Marshal(s)
+	return string(b), err
+}
+
+func (s *SnapshotMessages) Scan(value interface{}) error {
+	if value == nil {
+		*s = SnapshotMessages{}
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return nil
+	}
+
+	return json.Unmarshal(bytes, s)
+}
+
+// TableName 返回表名
+func (ResourceShare) TableName() string {
+	return "resource_shares"
+}
+
+func (ConversationSnapshot) TableName() string {
+	return "conversation_snapshots"
+}
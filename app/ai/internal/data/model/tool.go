@@ -1,6 +1,7 @@
 package model
 
 import (
+	"database/sql/driver"
 	"encoding/json"
 	"time"
 
@@ -18,6 +19,7 @@ type McpServer struct {
 	SupportedProtocols StringSlice     `gorm:"type:json" json:"supported_protocols"`
 	Capabilities       StringSlice     `gorm:"type:json" json:"capabilities"`
 	Owner              string          `gorm:"size:100" json:"owner"`
+	WorkspaceID        int64           `gorm:"default:0;index" json:"workspace_id"` // 所属工作空间，0表示全局可用
 	Tags               StringSlice     `gorm:"type:json" json:"tags"`
 	Config             McpServerConfig `gorm:"type:json" json:"config"`
 	Metadata           KeyValueMap     `gorm:"type:json" json:"metadata"`
@@ -50,7 +52,7 @@ type McpServerConfig struct {
 	SSLEnabled             bool                   `json:"ssl_enabled,omitempty"`
 	SSLCertPath            string                 `json:"ssl_cert_path,omitempty"`
 	AuthenticationRequired bool                   `json:"authentication_required,omitempty"`
-	AuthConfig             map[string]string      `json:"auth_config,omitempty"`
+	AuthConfig             SecretMap              `json:"auth_config,omitempty"`
 	RateLimit              int                    `json:"rate_limit,omitempty"`
 	CustomConfig           map[string]interface{} `json:"custom_config,omitempty"`
 }
@@ -112,6 +114,7 @@ type ToolConfig struct {
 	RequiredPermissions []string               `json:"required_permissions,omitempty"`
 	ExecutionContext    string                 `json:"execution_context,omitempty"`
 	CustomConfig        map[string]interface{} `json:"custom_config,omitempty"`
+	PricePerCall        float64                `json:"price_per_call,omitempty"` // 每次成功调用的费用
 }
 
 // ToolDependencies 工具依赖关系
@@ -251,7 +254,7 @@ type ToolAuditLog struct {
 }
 
 // 自定义GORM类型转换器实现
-func (c McpServerConfig) Value() (interface{}, error) {
+func (c McpServerConfig) Value() (driver.Value, error) {
 	b, err := json.Marshal(c)
 	return string(b), err
 }
@@ -274,7 +277,7 @@ func (c *McpServerConfig) Scan(value interface{}) error {
 	return json.Unmarshal(bytes, c)
 }
 
-func (s SecurityPolicy) Value() (interface{}, error) {
+func (s SecurityPolicy) Value() (driver.Value, error) {
 	b, err := json.Marshal(s)
 	return string(b), err
 }
@@ -297,7 +300,7 @@ func (s *SecurityPolicy) Scan(value interface{}) error {
 	return json.Unmarshal(bytes, s)
 }
 
-func (c ToolConfig) Value() (interface{}, error) {
+func (c ToolConfig) Value() (driver.Value, error) {
 	b, err := json.Marshal(c)
 	return string(b), err
 }
@@ -320,7 +323,7 @@ func (c *ToolConfig) Scan(value interface{}) error {
 	return json.Unmarshal(bytes, c)
 }
 
-func (d ToolDependencies) Value() (interface{}, error) {
+func (d ToolDependencies) Value() (driver.Value, error) {
 	b, err := json.Marshal(d)
 	return string(b), err
 }
@@ -344,7 +347,7 @@ func (d *ToolDependencies) Scan(value interface{}) error {
 	return json.Unmarshal(bytes, d)
 }
 
-func (v ToolVersion) Value() (interface{}, error) {
+func (v ToolVersion) Value() (driver.Value, error) {
 	b, err := json.Marshal(v)
 	return string(b), err
 }
@@ -367,7 +370,7 @@ func (v *ToolVersion) Scan(value interface{}) error {
 	return json.Unmarshal(bytes, v)
 }
 
-func (p ResourcePermissions) Value() (interface{}, error) {
+func (p ResourcePermissions) Value() (driver.Value, error) {
 	b, err := json.Marshal(p)
 	return string(b), err
 }
@@ -390,7 +393,7 @@ func (p *ResourcePermissions) Scan(value interface{}) error {
 	return json.Unmarshal(bytes, p)
 }
 
-func (c ExecutionContext) Value() (interface{}, error) {
+func (c ExecutionContext) Value() (driver.Value, error) {
 	b, err := json.Marshal(c)
 	return string(b), err
 }
@@ -413,7 +416,7 @@ func (c *ExecutionContext) Scan(value interface{}) error {
 	return json.Unmarshal(bytes, c)
 }
 
-func (m ExecutionMetrics) Value() (interface{}, error) {
+func (m ExecutionMetrics) Value() (driver.Value, error) {
 	b, err := json.Marshal(m)
 	return string(b), err
 }
@@ -0,0 +1,56 @@
+package data
+
+import (
+	"context"
+
+	"universal/app/ai/internal/biz"
+	"universal/app/ai/internal/conf"
+)
+
+// 基于配置文件的路由实现
+type routeRepo struct {
+	routes map[string]*biz.Route
+}
+
+// NewRouteRepo 创建路由配置仓库
+func NewRouteRepo(c *conf.Router) biz.RouteRepo {
+	r := &routeRepo{routes: make(map[string]*biz.Route)}
+	for _, route := range c.GetRoutes() {
+		policy := route.Policy
+		if policy == "" {
+			policy = biz.RoutePolicyOrdered
+		}
+		r.routes[route.Name] = &biz.Route{
+			Name:   route.Name,
+			Models: route.Models,
+			Policy: policy,
+		}
+	}
+	return r
+}
+
+func (r *routeRepo) GetRoute(ctx context.Context, name string) (*biz.Route, error) {
+	return r.routes[name], nil
+}
+
+// NewRouterOptions 转换路由重试与熔断配置，未配置的项使用默认值
+func NewRouterOptions(c *conf.Router) *biz.RouterOptions {
+	opts := &biz.RouterOptions{
+		MaxAttempts:      int(c.GetMaxAttempts()),
+		BreakerRequests:  int(c.GetBreakerMinRequests()),
+		BreakerErrorRate: c.GetBreakerErrorRate(),
+	}
+	if c.GetRequestTimeout() != nil {
+		opts.RequestTimeout = c.RequestTimeout.AsDuration()
+	}
+	if c.GetBreakerWindow() != nil {
+		opts.BreakerWindow = c.BreakerWindow.AsDuration()
+	}
+	if c.GetBreakerCooldown() != nil {
+		opts.BreakerCooldown = c.BreakerCooldown.AsDuration()
+	}
+	if c.GetHealthMaxAge() != nil {
+		opts.HealthMaxAge = c.HealthMaxAge.AsDuration()
+	}
+	return opts
+}
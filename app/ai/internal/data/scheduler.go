@@ -0,0 +1,158 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"universal/app/ai/internal/biz"
+	"universal/app/ai/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// unlockScript 仅在锁仍由自己持有时释放，避免误删其他副本续上的锁
+var unlockScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// 定时任务仓库实现
+type schedulerRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewSchedulerRepo 创建定时任务仓库
+func NewSchedulerRepo(data *Data, logger log.Logger) biz.SchedulerRepo {
+	return &schedulerRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *schedulerRepo) ResetExpiredQuotas(ctx context.Context, period string, now, next time.Time) (int64, error) {
+	var column string
+	var updates map[string]interface{}
+	switch period {
+	case biz.ResetPeriodDaily:
+		column = "reset_daily_at"
+		updates = map[string]interface{}{
+			"daily_tokens_used":   0,
+			"daily_requests_used": 0,
+			"reset_daily_at":      next,
+		}
+	case biz.ResetPeriodMonthly:
+		column = "reset_monthly_at"
+		updates = map[string]interface{}{
+			"monthly_tokens_used":   0,
+			"monthly_requests_used": 0,
+			"reset_monthly_at":      next,
+		}
+	default:
+		return 0, fmt.Errorf("unknown reset period: %s", period)
+	}
+
+	// 条件更新保证多次执行只生效一次
+	result := r.data.db.WithContext(ctx).Model(&model.UserQuota{}).Where(column+" <= ?", now).Updates(updates)
+	return result.RowsAffected, result.Error
+}
+
+func (r *schedulerRepo) AggregateUsage(ctx context.Context, start, end time.Time) ([]*biz.UsageRollup, error) {
+	var rollups []*biz.UsageRollup
+	// 每次模型调用对应一条使用事件，失败的调用同样计入；事件按模型ID记录，模型改名或删除后不会丢失。
+	// 工具调用事件(kind=2)只计入费用
+	err := r.data.db.WithContext(ctx).Table("ai_usage_events AS e").
+		Select(`e.user_id, e.workspace_id, e.model_id,
+			SUM(CASE WHEN e.kind = 1 THEN 1 ELSE 0 END) AS total_requests,
+			SUM(CASE WHEN e.kind = 1 AND e.status = 1 THEN 1 ELSE 0 END) AS successful_requests,
+			SUM(CASE WHEN e.kind = 1 AND e.status = 2 THEN 1 ELSE 0 END) AS failed_requests,
+			SUM(e.input_tokens) AS input_tokens,
+			SUM(e.output_tokens) AS output_tokens,
+			SUM(e.cost) AS total_cost,
+			COALESCE(AVG(CASE WHEN e.kind = 1 AND e.status = 1 THEN e.response_time END), 0) AS avg_response_time`).
+		Where("e.created_at >= ? AND e.created_at < ?", start, end).
+		Group("e.user_id, e.workspace_id, e.model_id").
+		Scan(&rollups).Error
+	if err != nil {
+		return nil, err
+	}
+	return rollups, nil
+}
+
+func (r *schedulerRepo) SaveUsageStats(ctx context.Context, date string, rollups []*biz.UsageRollup) error {
+	if len(rollups) == 0 {
+		return nil
+	}
+
+	stats := make([]*model.UsageStats, len(rollups))
+	for i, u := range rollups {
+		stats[i] = &model.UsageStats{
+			UserID:             u.UserID,
+			WorkspaceID:        u.WorkspaceID,
+			ModelID:            u.ModelID,
+			Date:               date,
+			TotalRequests:      u.TotalRequests,
+			SuccessfulRequests: u.SuccessfulRequests,
+			FailedRequests:     u.FailedRequests,
+			TotalTokens:        u.InputTokens + u.OutputTokens,
+			InputTokens:        u.InputTokens,
+			OutputTokens:       u.OutputTokens,
+			TotalCost:          u.TotalCost,
+			AvgResponseTime:    u.AvgResponseTime,
+		}
+	}
+
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "user_id"}, {Name: "workspace_id"}, {Name: "model_id"}, {Name: "date"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"total_requests", "successful_requests", "failed_requests",
+				"total_tokens", "input_tokens", "output_tokens",
+				"total_cost", "avg_response_time", "updated_at",
+			}),
+		}).CreateInBatches(stats, 100).Error
+	})
+}
+
+// 基于Redis的任务锁实现
+type jobLocker struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewJobLocker 创建任务锁
+func NewJobLocker(data *Data, logger log.Logger) biz.JobLocker {
+	return &jobLocker{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (l *jobLocker) TryLock(ctx context.Context, name string, ttl time.Duration) (func(), bool, error) {
+	key := "ai:job:lock:" + name
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, false, err
+	}
+	token := hex.EncodeToString(b)
+
+	ok, err := l.data.rdb.SetNX(ctx, key, token, ttl).Result()
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	release := func() {
+		if err := unlockScript.Run(context.Background(), l.data.rdb, []string{key}, token).Err(); err != nil {
+			l.log.Warnf("failed to release job lock %s: %v", name, err)
+		}
+	}
+	return release, true, nil
+}
@@ -0,0 +1,207 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"universal/app/ai/internal/conf"
+	"universal/pkg/database"
+	"universal/pkg/envelope"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// masterKeyEnv 主密钥环境变量，未配置密钥文件时必须提供
+	masterKeyEnv = "AI_SECRET_MASTER_KEY"
+	// defaultMasterKeyID 单一主密钥的默认版本
+	defaultMasterKeyID = "v1"
+	// rotateBatchSize 重新加密时每批读取的行数
+	rotateBatchSize = 100
+)
+
+// NewSecretCipher 按配置创建信封加密器，密钥文件优先于环境变量中的单一主密钥。
+// 主密钥不再从配置文件读取，空密钥或公开过的密钥拒绝启动。
+func NewSecretCipher(c *conf.Data_Secret) (*envelope.Cipher, error) {
+	if c.GetMasterKey() != "" {
+		return nil, fmt.Errorf("data.secret.master_key is no longer supported, set data.secret.key_file or %s", masterKeyEnv)
+	}
+	if c.GetKeyFile() != "" {
+		kms, err := envelope.LoadLocalKMS(c.KeyFile, c.PrimaryKeyId)
+		if err != nil {
+			return nil, err
+		}
+		return envelope.NewCipher(kms), nil
+	}
+
+	encoded := os.Getenv(masterKeyEnv)
+	if encoded == "" {
+		return nil, fmt.Errorf("no master key configured, set data.secret.key_file or %s", masterKeyEnv)
+	}
+	key, err := envelope.DecodeKey(encoded)
+	if err != nil {
+		return nil, err
+	}
+	keyID := c.GetPrimaryKeyId()
+	if keyID == "" {
+		keyID = defaultMasterKeyID
+	}
+	kms, err := envelope.NewLocalKMS(map[string][]byte{keyID: key}, keyID)
+	if err != nil {
+		return nil, err
+	}
+	return envelope.NewCipher(kms), nil
+}
+
+// SecretRotator 将全部敏感字段重新加密为当前主密钥版本，明文旧数据同时完成加密。
+// 逐行按原值条件更新，可与线上服务同时运行；被并发修改的行跳过，再次执行即可。
+type SecretRotator struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewSecretRotator 创建敏感字段重新加密任务
+func NewSecretRotator(data *Data, logger log.Logger) *SecretRotator {
+	return &SecretRotator{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// RotateResult 重新加密结果
+type RotateResult struct {
+	Rotated int64 // 已重新加密的行数
+	Skipped int64 // 被并发修改而跳过的行数
+}
+
+// Rotate 重新加密提供商密钥、自有凭证与MCP服务器认证配置
+func (r *SecretRotator) Rotate(ctx context.Context) (*RotateResult, error) {
+	result := &RotateResult{}
+	if err := r.rotateColumn(ctx, "ai_providers", "default_api_key", "default_api_key = ?", r.rotateValue, result); err != nil {
+		return result, fmt.Errorf("failed to rotate provider keys: %w", err)
+	}
+	if err := r.rotateColumn(ctx, "ai_provider_credentials", "api_key", "api_key = ?", r.rotateValue, result); err != nil {
+		return result, fmt.Errorf("failed to rotate provider credentials: %w", err)
+	}
+	if err := r.rotateColumn(ctx, "mcp_servers", "config", database.JSONEqual(r.data.db, "config"), r.rotateMcpConfig, result); err != nil {
+		return result, fmt.Errorf("failed to rotate mcp server credentials: %w", err)
+	}
+	return result, nil
+}
+
+// rotateColumn 按主键分批扫描一列，rotate 返回新值与是否需要更新，match 为原值比较条件
+func (r *SecretRotator) rotateColumn(ctx context.Context, table, column, match string, rotate func(context.Context, string) (string, bool, error), result *RotateResult) error {
+	if !r.data.db.Migrator().HasTable(table) {
+		return nil
+	}
+
+	// 主键统一按字符串读取，mcp_servers 为字符串主键，其余为整数主键
+	lastID := "0"
+	if table == "mcp_servers" {
+		lastID = ""
+	}
+	for {
+		var rows []struct {
+			ID    string
+			Value *string
+		}
+		err := r.data.db.WithContext(ctx).Table(table).
+			Select("id, "+column+" AS value").
+			Where("id > ?", lastID).
+			Order("id").
+			Limit(rotateBatchSize).
+			Scan(&rows).Error
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+
+		for _, row := range rows {
+			if row.Value == nil {
+				continue
+			}
+			updated, changed, err := rotate(ctx, *row.Value)
+			if err != nil {
+				return fmt.Errorf("%s %s: %w", table, row.ID, err)
+			}
+			if !changed {
+				continue
+			}
+			res := r.data.db.WithContext(ctx).Table(table).
+				Where("id = ?", row.ID).
+				Where(match, *row.Value).
+				Update(column, updated)
+			if res.Error != nil {
+				return fmt.Errorf("%s %s: %w", table, row.ID, res.Error)
+			}
+			if res.RowsAffected == 0 {
+				r.log.WithContext(ctx).Warnf("%s %s was modified concurrently, skipped", table, row.ID)
+				result.Skipped++
+				continue
+			}
+			result.Rotated++
+		}
+		lastID = rows[len(rows)-1].ID
+	}
+}
+
+// rotateValue 重新加密单个值
+func (r *SecretRotator) rotateValue(ctx context.Context, value string) (string, bool, error) {
+	if !r.data.cipher.NeedsRotation(value) {
+		return value, false, nil
+	}
+	plaintext, err := r.data.cipher.Decrypt(ctx, value)
+	if err != nil {
+		return "", false, err
+	}
+	ciphertext, err := r.data.cipher.Encrypt(ctx, plaintext)
+	if err != nil {
+		return "", false, err
+	}
+	return ciphertext, true, nil
+}
+
+// rotateMcpConfig 重新加密配置JSON中 auth_config 的各个值，其余字段保持原样
+func (r *SecretRotator) rotateMcpConfig(ctx context.Context, value string) (string, bool, error) {
+	var config map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(value), &config); err != nil {
+		return "", false, err
+	}
+	raw, ok := config["auth_config"]
+	if !ok {
+		return value, false, nil
+	}
+	var auth map[string]string
+	if err := json.Unmarshal(raw, &auth); err != nil {
+		return "", false, err
+	}
+
+	changed := false
+	for key, secret := range auth {
+		rotated, ok, err := r.rotateValue(ctx, secret)
+		if err != nil {
+			return "", false, err
+		}
+		if ok {
+			auth[key] = rotated
+			changed = true
+		}
+	}
+	if !changed {
+		return value, false, nil
+	}
+	encoded, err := json.Marshal(auth)
+	if err != nil {
+		return "", false, err
+	}
+	config["auth_config"] = encoded
+	updated, err := json.Marshal(config)
+	if err != nil {
+		return "", false, err
+	}
+	return string(updated), true, nil
+}
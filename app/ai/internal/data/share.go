@@ -0,0 +1,167 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"universal/app/ai/internal/biz"
+	"universal/app/ai/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type shareRepo struct {
+	data   *Data
+	logger *log.Helper
+}
+
+// NewShareRepo 创建共享仓库实例
+func NewShareRepo(data *Data, logger log.Logger) biz.ShareRepo {
+	return &shareRepo{
+		data:   data,
+		logger: log.NewHelper(logger),
+	}
+}
+
+// SaveShare 创建或更新授权记录，同一资源对同一用户只保留一条
+func (r *shareRepo) SaveShare(ctx context.Context, share *model.ResourceShare) (*model.ResourceShare, error) {
+	now := time.Now()
+	share.UpdatedAt = now
+
+	var existing model.ResourceShare
+	err := r.data.db.WithContext(ctx).
+		Where("resource_type = ? AND resource_id = ? AND grantee_id = ?", share.ResourceType, share.ResourceID, share.GranteeID).
+		First(&existing).Error
+	switch {
+	case err == nil:
+		share.ID = existing.ID
+		share.CreatedAt = existing.CreatedAt
+		if err := r.data.db.WithContext(ctx).Save(share).Error; err != nil {
+			return nil, err
+		}
+	case err == gorm.ErrRecordNotFound:
+		share.CreatedAt = now
+		if err := r.data.db.WithContext(ctx).Create(share).Error; err != nil {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+
+	return share, nil
+}
+
+// GetShare 获取有效的授权记录，不存在或已过期时返回nil
+func (r *shareRepo) GetShare(ctx context.Context, resourceType string, resourceID, granteeID int64) (*model.ResourceShare, error) {
+	var share model.ResourceShare
+	err := r.data.db.WithContext(ctx).
+		Where("resource_type = ? AND resource_id = ? AND grantee_id = ?", resourceType, resourceID, granteeID).
+		Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+		First(&share).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &share, nil
+}
+
+// DeleteShare 删除授权记录
+func (r *shareRepo) DeleteShare(ctx context.Context, resourceType string, resourceID, granteeID int64) error {
+	return r.data.db.WithContext(ctx).
+		Where("resource_type = ? AND resource_id = ? AND grantee_id = ?", resourceType, resourceID, granteeID).
+		Delete(&model.ResourceShare{}).Error
+}
+
+// ListShares 获取资源的有效授权列表
+func (r *shareRepo) ListShares(ctx context.Context, resourceType string, resourceID int64) ([]*model.ResourceShare, error) {
+	var shares []*model.ResourceShare
+	err := r.data.db.WithContext(ctx).
+		Where("resource_type = ? AND resource_id = ?", resourceType, resourceID).
+		Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+		Order("created_at ASC").
+		Find(&shares).Error
+	if err != nil {
+		return nil, err
+	}
+	return shares, nil
+}
+
+// CreateSnapshot 创建对话快照
+func (r *shareRepo) CreateSnapshot(ctx context.Context, snapshot *model.ConversationSnapshot) (*model.ConversationSnapshot, error) {
+	if err := r.data.db.WithContext(ctx).Create(snapshot).Error; err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// GetSnapshotByToken 根据令牌获取快照，不存在时返回nil
+func (r *shareRepo) GetSnapshotByToken(ctx context.Context, token string) (*model.ConversationSnapshot, error) {
+	var snapshot model.ConversationSnapshot
+	err := r.data.db.WithContext(ctx).Where("token = ?", token).First(&snapshot).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+// ListSnapshots 获取对话已发布的快照，不加载消息内容
+func (r *shareRepo) ListSnapshots(ctx context.Context, conversationID int64) ([]*model.ConversationSnapshot, error) {
+	var snapshots []*model.ConversationSnapshot
+	err := r.data.db.WithContext(ctx).
+		Omit("messages").
+		Where("conversation_id = ?", conversationID).
+		Order("created_at DESC").
+		Find(&snapshots).Error
+	if err != nil {
+		return nil, err
+	}
+	return snapshots, nil
+}
+
+// DeleteSnapshot 删除快照
+func (r *shareRepo) DeleteSnapshot(ctx context.Context, token string) error {
+	return r.data.db.WithContext(ctx).Where("token = ?", token).Delete(&model.ConversationSnapshot{}).Error
+}
+
+// IncrementSnapshotViews 增加快照访问次数
+func (r *shareRepo) IncrementSnapshotViews(ctx context.Context, id int64) error {
+	return r.data.db.WithContext(ctx).Model(&model.ConversationSnapshot{}).
+		Where("id = ?", id).
+		UpdateColumn("view_count", gorm.Expr("view_count + 1")).Error
+}
+
+// sharedResourceIDs 构造共享给指定用户且未过期的资源ID子查询
+func sharedResourceIDs(db *gorm.DB, resourceType string, granteeID int64) *gorm.DB {
+	return db.Model(&model.ResourceShare{}).
+		Select("resource_id").
+		Where("resource_type = ? AND grantee_id = ?", resourceType, granteeID).
+		Where("expires_at IS NULL OR expires_at > ?", time.Now())
+}
+
+// sharedRoles 批量获取用户对一组资源的共享角色
+func sharedRoles(ctx context.Context, db *gorm.DB, resourceType string, granteeID int64, resourceIDs []int64) (map[int64]int, error) {
+	roles := make(map[int64]int, len(resourceIDs))
+	if len(resourceIDs) == 0 {
+		return roles, nil
+	}
+
+	var shares []*model.ResourceShare
+	err := db.WithContext(ctx).
+		Where("resource_type = ? AND grantee_id = ? AND resource_id IN ?", resourceType, granteeID, resourceIDs).
+		Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+		Find(&shares).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, share := range shares {
+		roles[share.ResourceID] = share.Role
+	}
+	return roles, nil
+}
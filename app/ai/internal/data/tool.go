@@ -0,0 +1,105 @@
+package data
+
+import (
+	"context"
+
+	"universal/app/ai/internal/biz"
+	"universal/app/ai/internal/data/model"
+	"universal/pkg/database"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type mcpServerRepo struct {
+	data   *Data
+	logger *log.Helper
+}
+
+// NewMcpServerRepo 创建MCP服务器仓库实例
+func NewMcpServerRepo(data *Data, logger log.Logger) biz.McpServerRepo {
+	return &mcpServerRepo{
+		data:   data,
+		logger: log.NewHelper(logger),
+	}
+}
+
+// visibleInWorkspace 工作空间内可见的服务器：属于该空间或全局可用
+func visibleInWorkspace(workspaceID int64) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if workspaceID > 0 {
+			return db.Where("workspace_id IN ?", []int64{0, workspaceID})
+		}
+		return db.Where("workspace_id = 0")
+	}
+}
+
+// CreateMcpServer 创建MCP服务器
+func (r *mcpServerRepo) CreateMcpServer(ctx context.Context, server *model.McpServer) (*model.McpServer, error) {
+	if err := r.data.db.WithContext(ctx).Create(server).Error; err != nil {
+		return nil, err
+	}
+	return server, nil
+}
+
+// GetMcpServer 获取工作空间内可见的MCP服务器，不存在时返回nil
+func (r *mcpServerRepo) GetMcpServer(ctx context.Context, id string, workspaceID int64) (*model.McpServer, error) {
+	var server model.McpServer
+	err := r.data.db.WithContext(ctx).Scopes(visibleInWorkspace(workspaceID)).Where("id = ?", id).First(&server).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &server, nil
+}
+
+// UpdateMcpServer 更新MCP服务器，所属工作空间不随之修改
+func (r *mcpServerRepo) UpdateMcpServer(ctx context.Context, server *model.McpServer) (*model.McpServer, error) {
+	if err := r.data.db.WithContext(ctx).Omit("workspace_id").Save(server).Error; err != nil {
+		return nil, err
+	}
+	return server, nil
+}
+
+// DeleteMcpServer 删除MCP服务器，forceDelete 时连同工具与资源一起删除
+func (r *mcpServerRepo) DeleteMcpServer(ctx context.Context, id string, forceDelete bool) error {
+	if !forceDelete {
+		return r.data.db.WithContext(ctx).Where("id = ?", id).Delete(&model.McpServer{}).Error
+	}
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("mcp_server_id = ?", id).Delete(&model.Tool{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("mcp_server_id = ?", id).Delete(&model.Resource{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ?", id).Delete(&model.McpServer{}).Error
+	})
+}
+
+// ListMcpServers 获取当前工作空间及全局可用的MCP服务器列表
+func (r *mcpServerRepo) ListMcpServers(ctx context.Context, page, pageSize int32, filters biz.McpServerFilter) ([]*model.McpServer, int64, error) {
+	var servers []*model.McpServer
+	var total int64
+
+	query := r.data.db.WithContext(ctx).Model(&model.McpServer{}).Scopes(visibleInWorkspace(filters.WorkspaceID))
+	if filters.Status > 0 {
+		query = query.Where("status = ?", filters.Status)
+	}
+	for _, tag := range filters.Tags {
+		query = query.Where(database.JSONArrayContains(r.data.db, "tags"), tag)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	if err := query.Order("created_at DESC").Offset(int(offset)).Limit(int(pageSize)).Find(&servers).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return servers, total, nil
+}
@@ -0,0 +1,91 @@
+package data
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	userv1 "universal/api/user/v1"
+	"universal/app/ai/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// membershipCacheTTL 成员身份缓存时间，避免每次请求都访问用户服务
+const membershipCacheTTL = 30 * time.Second
+
+type membershipKey struct {
+	workspaceID int64
+	userID      int64
+}
+
+type membershipEntry struct {
+	workspace *biz.Workspace // nil表示非成员
+	expiresAt time.Time
+}
+
+type workspaceRepo struct {
+	client userv1.WorkspaceClient
+	log    *log.Helper
+
+	mu    sync.Mutex
+	cache map[membershipKey]membershipEntry
+}
+
+// NewWorkspaceRepo 创建工作空间仓库，成员关系由用户服务提供
+func NewWorkspaceRepo(client userv1.WorkspaceClient, logger log.Logger) biz.WorkspaceRepo {
+	return &workspaceRepo{
+		client: client,
+		log:    log.NewHelper(logger),
+		cache:  make(map[membershipKey]membershipEntry),
+	}
+}
+
+func (r *workspaceRepo) GetMembership(ctx context.Context, workspaceID, userID int64) (*biz.Workspace, error) {
+	key := membershipKey{workspaceID: workspaceID, userID: userID}
+	now := time.Now()
+
+	r.mu.Lock()
+	entry, ok := r.cache[key]
+	r.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.workspace, nil
+	}
+
+	reply, err := r.client.GetWorkspaceMembership(ctx, &userv1.GetWorkspaceMembershipRequest{
+		WorkspaceId: workspaceID,
+		UserId:      userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var ws *biz.Workspace
+	if reply.IsMember && reply.Workspace != nil {
+		info := reply.Workspace
+		ws = &biz.Workspace{
+			ID:                      info.Id,
+			Name:                    info.Name,
+			OwnerID:                 info.OwnerId,
+			DefaultChatModelID:      info.DefaultChatModelId,
+			DefaultEmbeddingModelID: info.DefaultEmbeddingModelId,
+			DailyTokenLimit:         info.DailyTokenLimit,
+			MonthlyTokenLimit:       info.MonthlyTokenLimit,
+			DailyRequestLimit:       info.DailyRequestLimit,
+			MonthlyRequestLimit:     info.MonthlyRequestLimit,
+			Role:                    int32(info.Role),
+		}
+	}
+
+	r.mu.Lock()
+	// 顺带清理过期条目，防止缓存无限增长
+	for k, e := range r.cache {
+		if now.After(e.expiresAt) {
+			delete(r.cache, k)
+		}
+	}
+	r.cache[key] = membershipEntry{workspace: ws, expiresAt: now.Add(membershipCacheTTL)}
+	r.mu.Unlock()
+
+	return ws, nil
+}
@@ -4,6 +4,11 @@ import (
 	v1 "universal/api/ai/v1"
 	"universal/app/ai/internal/conf"
 	"universal/app/ai/internal/service"
+	"universal/pkg/discovery"
+	"universal/pkg/healthcheck"
+	"universal/pkg/identity"
+	"universal/pkg/telemetry"
+	"universal/pkg/workspace"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
@@ -11,13 +16,22 @@ import (
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, ai *service.AiService, model *service.ModelService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, ai *service.AiService, model *service.ModelService, conversation *service.ConversationService, knowledge *service.KnowledgeService, checker *healthcheck.Checker, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
+		// 健康状态由依赖检查决定，替代默认只反映进程存活的健康服务
+		grpc.CustomHealth(),
 		grpc.Middleware(
 			recovery.Recovery(),
+			telemetry.Server(logger),
+			workspace.Server(),
+			identity.Server(),
 		),
 	}
-	if c.Grpc.Network != "" {
+	if c.Grpc.Network == discovery.NetworkInProc {
+		// 单进程模式：不监听端口，以 addr 为名称注册进程内连接
+		lis, endpoint := discovery.Listen(c.Grpc.Addr)
+		opts = append(opts, grpc.Listener(lis), grpc.Endpoint(endpoint))
+	} else if c.Grpc.Network != "" {
 		opts = append(opts, grpc.Network(c.Grpc.Network))
 	}
 	if c.Grpc.Addr != "" {
@@ -29,5 +43,8 @@ func NewGRPCServer(c *conf.Server, ai *service.AiService, model *service.ModelSe
 	srv := grpc.NewServer(opts...)
 	v1.RegisterAiServer(srv, ai)
 	v1.RegisterModelServer(srv, model)
+	v1.RegisterConversationServer(srv, conversation)
+	v1.RegisterKnowledgeServer(srv, knowledge)
+	checker.Register(srv)
 	return srv
 }
@@ -3,6 +3,9 @@ package server
 import (
 	"universal/app/ai/internal/conf"
 	"universal/app/ai/internal/service"
+	"universal/pkg/identity"
+	"universal/pkg/telemetry"
+	"universal/pkg/workspace"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
@@ -14,6 +17,9 @@ func NewHTTPServer(c *conf.Server, ai *service.AiService, logger log.Logger) *ht
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
+			telemetry.Server(logger),
+			workspace.Server(),
+			identity.Server(),
 		),
 	}
 	if c.Http.Network != "" {
@@ -26,5 +32,6 @@ func NewHTTPServer(c *conf.Server, ai *service.AiService, logger log.Logger) *ht
 		opts = append(opts, http.Timeout(c.Http.Timeout.AsDuration()))
 	}
 	srv := http.NewServer(opts...)
+	srv.Handle(telemetry.MetricsPath, telemetry.MetricsHandler())
 	return srv
 }
@@ -0,0 +1,147 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"universal/app/ai/internal/biz"
+	"universal/app/ai/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+const (
+	defaultQuotaResetInterval  = time.Minute
+	defaultUsageRollupInterval = 10 * time.Minute
+	defaultHealthProbeInterval = time.Minute
+	defaultModelSyncInterval   = time.Hour
+)
+
+var _ transport.Server = (*Scheduler)(nil)
+
+// Scheduler 进程内定时任务，随应用启动与停止，多副本间通过任务锁互斥
+type Scheduler struct {
+	uc       *biz.SchedulerUsecase
+	loc      *time.Location
+	disabled bool
+	jobs     []scheduledJob
+	stop     chan struct{}
+	log      *log.Helper
+}
+
+type scheduledJob struct {
+	name     string
+	interval time.Duration
+	run      func(ctx context.Context, now time.Time, loc *time.Location) error
+}
+
+// NewScheduler 创建定时任务服务
+func NewScheduler(c *conf.Scheduler, hc *conf.Health, uc *biz.SchedulerUsecase, health *biz.HealthUsecase, modelSync *biz.ModelSyncUsecase, logger log.Logger) *Scheduler {
+	helper := log.NewHelper(logger)
+	if c == nil {
+		c = &conf.Scheduler{}
+	}
+
+	loc := time.Local
+	if c.Timezone != "" {
+		l, err := time.LoadLocation(c.Timezone)
+		if err != nil {
+			helper.Fatalf("invalid scheduler timezone %q: %v", c.Timezone, err)
+		}
+		loc = l
+	}
+
+	resetInterval := defaultQuotaResetInterval
+	if c.QuotaResetInterval != nil && c.QuotaResetInterval.AsDuration() > 0 {
+		resetInterval = c.QuotaResetInterval.AsDuration()
+	}
+	rollupInterval := defaultUsageRollupInterval
+	if c.UsageRollupInterval != nil && c.UsageRollupInterval.AsDuration() > 0 {
+		rollupInterval = c.UsageRollupInterval.AsDuration()
+	}
+	probeInterval := defaultHealthProbeInterval
+	if hc.GetProbeInterval() != nil && hc.ProbeInterval.AsDuration() > 0 {
+		probeInterval = hc.ProbeInterval.AsDuration()
+	}
+	syncInterval := defaultModelSyncInterval
+	if c.ModelSyncInterval != nil && c.ModelSyncInterval.AsDuration() > 0 {
+		syncInterval = c.ModelSyncInterval.AsDuration()
+	}
+
+	return &Scheduler{
+		uc:       uc,
+		loc:      loc,
+		disabled: c.Disabled,
+		jobs: []scheduledJob{
+			{name: biz.JobQuotaReset, interval: resetInterval, run: uc.ResetQuotas},
+			{name: biz.JobUsageRollup, interval: rollupInterval, run: uc.RollupUsage},
+			{name: biz.JobHealthProbe, interval: probeInterval, run: health.RunProbes},
+			{name: biz.JobModelSync, interval: syncInterval, run: modelSync.RunSync},
+		},
+		stop: make(chan struct{}),
+		log:  helper,
+	}
+}
+
+// Start 启动全部任务并阻塞至停止
+func (s *Scheduler) Start(ctx context.Context) error {
+	if s.disabled {
+		s.log.Info("[Scheduler] disabled")
+		return nil
+	}
+	s.log.Infof("[Scheduler] started, timezone: %s", s.loc)
+
+	done := make(chan struct{}, len(s.jobs))
+	for _, job := range s.jobs {
+		go func(job scheduledJob) {
+			defer func() { done <- struct{}{} }()
+			s.loop(ctx, job)
+		}(job)
+	}
+	for range s.jobs {
+		<-done
+	}
+	return nil
+}
+
+// Stop 停止全部任务
+func (s *Scheduler) Stop(ctx context.Context) error {
+	select {
+	case <-s.stop:
+	default:
+		close(s.stop)
+	}
+	s.log.Info("[Scheduler] stopping")
+	return nil
+}
+
+// loop 启动时立即执行一次，之后按间隔执行
+func (s *Scheduler) loop(ctx context.Context, job scheduledJob) {
+	ticker := time.NewTicker(job.interval)
+	defer ticker.Stop()
+
+	for {
+		s.execute(ctx, job)
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) execute(ctx context.Context, job scheduledJob) {
+	// 单次执行不超过间隔，避免与下一个窗口的执行重叠
+	ctx, cancel := context.WithTimeout(ctx, job.interval)
+	defer cancel()
+
+	err := s.uc.RunExclusive(ctx, job.name, job.interval, func(ctx context.Context) error {
+		return job.run(ctx, time.Now(), s.loc)
+	})
+	if err != nil {
+		s.log.Errorf("[Scheduler] job %s failed: %v", job.name, err)
+	}
+}
@@ -1,9 +1,14 @@
 package service
 
 import (
+	"context"
+	"time"
+
 	"universal/app/ai/internal/biz"
 
 	pb "universal/api/ai/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type AiService struct {
@@ -14,3 +19,93 @@ type AiService struct {
 func NewAiService(uc *biz.AiUsecase) *AiService {
 	return &AiService{uc: uc}
 }
+
+func (s *AiService) GetConversationAnalytics(ctx context.Context, req *pb.GetConversationAnalyticsRequest) (*pb.GetConversationAnalyticsReply, error) {
+	scope, err := s.uc.NewAnalyticsScope(ctx, req.UserId, analyticsTime(req.StartTime), analyticsTime(req.EndTime), req.Filters)
+	if err != nil {
+		return nil, err
+	}
+
+	analytics, err := s.uc.GetConversationAnalytics(ctx, scope, req.Granularity, req.Metrics)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetConversationAnalyticsReply{Analytics: analytics}, nil
+}
+
+func (s *AiService) GetUserUsageStats(ctx context.Context, req *pb.GetUserUsageStatsRequest) (*pb.GetUserUsageStatsReply, error) {
+	scope, err := s.uc.NewAnalyticsScope(ctx, req.UserId, analyticsTime(req.StartTime), analyticsTime(req.EndTime), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.uc.GetUserUsageStats(ctx, scope, req.IncludeCostBreakdown, req.IncludeModelUsage)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetUserUsageStatsReply{UsageStats: stats}, nil
+}
+
+func (s *AiService) GetModelPerformanceStats(ctx context.Context, req *pb.GetModelPerformanceStatsRequest) (*pb.GetModelPerformanceStatsReply, error) {
+	scope, err := s.uc.NewAnalyticsScope(ctx, 0, analyticsTime(req.StartTime), analyticsTime(req.EndTime), nil)
+	if err != nil {
+		return nil, err
+	}
+	scope.ModelNames = req.ModelNames
+
+	stats, err := s.uc.GetModelPerformanceStats(ctx, scope, req.IncludeErrorAnalysis)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetModelPerformanceStatsReply{ModelStats: stats}, nil
+}
+
+func (s *AiService) GetConversationTrends(ctx context.Context, req *pb.GetConversationTrendsRequest) (*pb.GetConversationTrendsReply, error) {
+	scope, err := s.uc.NewAnalyticsScope(ctx, req.UserId, analyticsTime(req.StartTime), analyticsTime(req.EndTime), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	trends, err := s.uc.GetConversationTrends(ctx, scope, req.Granularity, req.TrendMetrics)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetConversationTrendsReply{Trends: trends}, nil
+}
+
+func (s *AiService) GetTopicAnalysis(ctx context.Context, req *pb.GetTopicAnalysisRequest) (*pb.GetTopicAnalysisReply, error) {
+	scope, err := s.uc.NewAnalyticsScope(ctx, req.UserId, analyticsTime(req.StartTime), analyticsTime(req.EndTime), req.Filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.uc.GetTopicAnalysis(ctx, &biz.TopicQuery{
+		Scope:       scope,
+		Method:      req.Method,
+		TopN:        req.TopTopicsCount,
+		Granularity: req.TrendGranularity,
+		Refresh:     req.Refresh,
+	})
+}
+
+func (s *AiService) GetSystemOverview(ctx context.Context, req *pb.GetSystemOverviewRequest) (*pb.GetSystemOverviewReply, error) {
+	scope, err := s.uc.NewAnalyticsScope(ctx, 0, analyticsTime(req.StartTime), analyticsTime(req.EndTime), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	overview, err := s.uc.GetSystemOverview(ctx, scope, req.IncludeHealthMetrics, req.IncludeResourceUsage)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetSystemOverviewReply{Overview: overview}, nil
+}
+
+// analyticsTime 转换可选的时间参数
+func analyticsTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
@@ -15,12 +15,14 @@ type ConversationService struct {
 	pb.UnimplementedConversationServer
 
 	uc     *biz.ConversationUsecase
+	share  *biz.ShareUsecase
 	logger *log.Helper
 }
 
-func NewConversationService(uc *biz.ConversationUsecase, logger log.Logger) *ConversationService {
+func NewConversationService(uc *biz.ConversationUsecase, share *biz.ShareUsecase, logger log.Logger) *ConversationService {
 	return &ConversationService{
 		uc:     uc,
+		share:  share,
 		logger: log.NewHelper(logger),
 	}
 }
@@ -115,6 +117,7 @@ func (s *ConversationService) ListConversations(ctx context.Context, req *pb.Lis
 		req.Tags,
 		req.SortBy,
 		req.SortDesc,
+		req.OwnedOnly,
 	)
 	if err != nil {
 		return nil, err
@@ -197,7 +200,11 @@ func (s *ConversationService) DeleteMessage(ctx context.Context, req *pb.DeleteM
 	return &pb.DeleteMessageReply{}, nil
 }
 func (s *ConversationService) RegenerateMessage(ctx context.Context, req *pb.RegenerateMessageRequest) (*pb.RegenerateMessageReply, error) {
-	return &pb.RegenerateMessageReply{}, nil
+	message, err := s.uc.RegenerateMessage(ctx, req.MessageId, req.Options)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RegenerateMessageReply{NewMessage: s.convertMessageToProto(message)}, nil
 }
 func (s *ConversationService) GetConversationContext(ctx context.Context, req *pb.GetConversationContextRequest) (*pb.GetConversationContextReply, error) {
 	return &pb.GetConversationContextReply{}, nil
@@ -226,6 +233,72 @@ func (s *ConversationService) ExportConversation(ctx context.Context, req *pb.Ex
 func (s *ConversationService) ImportConversation(ctx context.Context, req *pb.ImportConversationRequest) (*pb.ImportConversationReply, error) {
 	return &pb.ImportConversationReply{}, nil
 }
+func (s *ConversationService) ShareConversation(ctx context.Context, req *pb.ShareConversationRequest) (*pb.ShareConversationReply, error) {
+	share, err := s.share.ShareConversation(
+		ctx,
+		req.ConversationId,
+		req.GranteeUserId,
+		int(req.Role),
+		shareExpiresAt(req.ExpiresAt),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ShareConversationReply{
+		Grant: convertShareToProto(share),
+	}, nil
+}
+func (s *ConversationService) RevokeConversationShare(ctx context.Context, req *pb.RevokeConversationShareRequest) (*pb.RevokeConversationShareReply, error) {
+	if err := s.share.RevokeConversationShare(ctx, req.ConversationId, req.GranteeUserId); err != nil {
+		return nil, err
+	}
+
+	return &pb.RevokeConversationShareReply{}, nil
+}
+func (s *ConversationService) ListConversationShares(ctx context.Context, req *pb.ListConversationSharesRequest) (*pb.ListConversationSharesReply, error) {
+	shares, snapshots, err := s.share.ListConversationShares(ctx, req.ConversationId)
+	if err != nil {
+		return nil, err
+	}
+
+	protoSnapshots := make([]*pb.ConversationSnapshot, len(snapshots))
+	for i, snapshot := range snapshots {
+		protoSnapshots[i] = s.convertSnapshotToProto(snapshot, false)
+	}
+
+	return &pb.ListConversationSharesReply{
+		Grants:    convertSharesToProto(shares),
+		Snapshots: protoSnapshots,
+	}, nil
+}
+func (s *ConversationService) PublishConversationSnapshot(ctx context.Context, req *pb.PublishConversationSnapshotRequest) (*pb.PublishConversationSnapshotReply, error) {
+	snapshot, err := s.share.PublishConversationSnapshot(ctx, req.ConversationId, req.ExpiresIn.AsDuration())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.PublishConversationSnapshotReply{
+		Snapshot: s.convertSnapshotToProto(snapshot, false),
+	}, nil
+}
+func (s *ConversationService) RevokeConversationSnapshot(ctx context.Context, req *pb.RevokeConversationSnapshotRequest) (*pb.RevokeConversationSnapshotReply, error) {
+	if err := s.share.RevokeConversationSnapshot(ctx, req.Token); err != nil {
+		return nil, err
+	}
+
+	return &pb.RevokeConversationSnapshotReply{}, nil
+}
+func (s *ConversationService) GetConversationSnapshot(ctx context.Context, req *pb.GetConversationSnapshotRequest) (*pb.GetConversationSnapshotReply, error) {
+	snapshot, err := s.share.GetConversationSnapshot(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetConversationSnapshotReply{
+		Snapshot: s.convertSnapshotToProto(snapshot, true),
+	}, nil
+}
 
 // convertConversationToProto 将模型转换为Proto消息
 func (s *ConversationService) convertConversationToProto(conv *model.Conversation) *pb.ConversationInfo {
@@ -243,6 +316,7 @@ func (s *ConversationService) convertConversationToProto(conv *model.Conversatio
 		Description:  conv.Description,
 		Tags:         []string(conv.Tags),
 		Priority:     int32(conv.Priority),
+		AccessRole:   pb.ShareRole(conv.AccessRole),
 	}
 
 	// 转换配置
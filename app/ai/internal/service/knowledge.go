@@ -16,34 +16,21 @@ type KnowledgeService struct {
 	pb.UnimplementedKnowledgeServer
 
 	uc     *biz.KnowledgeUsecase
+	share  *biz.ShareUsecase
 	logger *log.Helper
 }
 
-func NewKnowledgeService(uc *biz.KnowledgeUsecase, logger log.Logger) *KnowledgeService {
+func NewKnowledgeService(uc *biz.KnowledgeUsecase, share *biz.ShareUsecase, logger log.Logger) *KnowledgeService {
 	return &KnowledgeService{
 		uc:     uc,
+		share:  share,
 		logger: log.NewHelper(logger),
 	}
 }
 
 func (s *KnowledgeService) CreateKnowledgeBase(ctx context.Context, req *pb.CreateKnowledgeBaseRequest) (*pb.CreateKnowledgeBaseReply, error) {
 	// 构建配置
-	config := model.KnowledgeBaseConfig{
-		EmbeddingDimension:   int(req.Config.EmbeddingDimension),
-		ChunkingStrategy:     s.convertChunkingStrategy(req.Config.ChunkingStrategy),
-		SimilarityThreshold:  req.Config.SimilarityThreshold,
-		MaxChunksPerQuery:    int(req.Config.MaxChunksPerQuery),
-		EnableMetadataFilter: req.Config.EnableMetadataFilter,
-		StopWords:            req.Config.StopWords,
-		TextSplitter:         req.Config.TextSplitter,
-	}
-
-	if req.Config.AdvancedOptions != nil {
-		config.AdvancedOptions = make(map[string]interface{})
-		//for k, v := range req.Config.AdvancedOptions {
-		//config.AdvancedOptions[k] = v.String()
-		//}
-	}
+	config := s.convertKnowledgeBaseConfig(req.Config)
 
 	kb, err := s.uc.CreateKnowledgeBase(
 		ctx,
@@ -66,19 +53,87 @@ func (s *KnowledgeService) CreateKnowledgeBase(ctx context.Context, req *pb.Crea
 	}, nil
 }
 func (s *KnowledgeService) UpdateKnowledgeBase(ctx context.Context, req *pb.UpdateKnowledgeBaseRequest) (*pb.UpdateKnowledgeBaseReply, error) {
-	return &pb.UpdateKnowledgeBaseReply{}, nil
+	kb, err := s.uc.UpdateKnowledgeBase(
+		ctx,
+		req.Id,
+		req.Name,
+		req.Description,
+		req.EmbeddingModel,
+		req.ChunkSize,
+		req.ChunkOverlap,
+		s.convertKnowledgeBaseConfig(req.Config),
+		req.Tags,
+		req.ReindexAfterUpdate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateKnowledgeBaseReply{
+		KnowledgeBase: s.convertKnowledgeBaseToProto(kb),
+	}, nil
 }
 func (s *KnowledgeService) DeleteKnowledgeBase(ctx context.Context, req *pb.DeleteKnowledgeBaseRequest) (*pb.DeleteKnowledgeBaseReply, error) {
 	return &pb.DeleteKnowledgeBaseReply{}, nil
 }
 func (s *KnowledgeService) ListKnowledgeBases(ctx context.Context, req *pb.ListKnowledgeBasesRequest) (*pb.ListKnowledgeBasesReply, error) {
-	return &pb.ListKnowledgeBasesReply{}, nil
+	kbs, total, err := s.uc.ListKnowledgeBases(
+		ctx,
+		req.UserId,
+		req.Page,
+		req.PageSize,
+		req.Keyword,
+		int32(req.Status),
+		req.Tags,
+		req.SortBy,
+		req.SortDesc,
+		req.OwnedOnly,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// 转换为Proto消息
+	protoKbs := make([]*pb.KnowledgeBase, len(kbs))
+	for i, kb := range kbs {
+		protoKbs[i] = s.convertKnowledgeBaseToProto(kb)
+	}
+
+	return &pb.ListKnowledgeBasesReply{
+		KnowledgeBases: protoKbs,
+		Total:          total,
+		Page:           req.Page,
+		PageSize:       req.PageSize,
+	}, nil
 }
 func (s *KnowledgeService) GetKnowledgeBase(ctx context.Context, req *pb.GetKnowledgeBaseRequest) (*pb.GetKnowledgeBaseReply, error) {
-	return &pb.GetKnowledgeBaseReply{}, nil
+	kb, err := s.uc.GetKnowledgeBase(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetKnowledgeBaseReply{
+		KnowledgeBase: s.convertKnowledgeBaseToProto(kb),
+	}, nil
 }
 func (s *KnowledgeService) UploadDocument(ctx context.Context, req *pb.UploadDocumentRequest) (*pb.UploadDocumentReply, error) {
-	return &pb.UploadDocumentReply{}, nil
+	doc, duplicate, err := s.uc.UploadDocument(ctx, req.KnowledgeBaseId, biz.DocumentUploadInfo{
+		Name:            req.Name,
+		Content:         req.Content,
+		MimeType:        req.MimeType,
+		Metadata:        s.convertDocumentMetadata(req.Metadata),
+		Tags:            req.Tags,
+		AutoProcess:     req.AutoProcess,
+		DuplicatePolicy: s.convertDuplicatePolicy(req.DuplicatePolicy),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UploadDocumentReply{
+		Document:  s.convertDocumentToProto(doc),
+		Duplicate: duplicate,
+	}, nil
 }
 func (s *KnowledgeService) BatchUploadDocuments(conn pb.Knowledge_BatchUploadDocumentsServer) error {
 	for {
@@ -97,7 +152,29 @@ func (s *KnowledgeService) BatchUploadDocuments(conn pb.Knowledge_BatchUploadDoc
 	}
 }
 func (s *KnowledgeService) UpdateDocument(ctx context.Context, req *pb.UpdateDocumentRequest) (*pb.UpdateDocumentReply, error) {
-	return &pb.UpdateDocumentReply{}, nil
+	info := biz.DocumentUpdateInfo{
+		Name:              req.Name,
+		Content:           req.Content,
+		Tags:              req.Tags,
+		Reprocess:         req.Reprocess,
+		RollbackToVersion: int(req.RollbackToVersion),
+	}
+	if req.Metadata != nil {
+		metadata := s.convertDocumentMetadata(req.Metadata)
+		info.Metadata = &metadata
+	}
+
+	doc, result, err := s.uc.UpdateDocument(ctx, req.Id, info)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateDocumentReply{
+		Document:        s.convertDocumentToProto(doc),
+		ChunksAdded:     int32(result.Added),
+		ChunksRemoved:   int32(result.Removed),
+		ChunksUnchanged: int32(result.Unchanged),
+	}, nil
 }
 func (s *KnowledgeService) DeleteDocument(ctx context.Context, req *pb.DeleteDocumentRequest) (*pb.DeleteDocumentReply, error) {
 	return &pb.DeleteDocumentReply{}, nil
@@ -130,13 +207,58 @@ func (s *KnowledgeService) ListKnowledgeChunks(ctx context.Context, req *pb.List
 	return &pb.ListKnowledgeChunksReply{}, nil
 }
 func (s *KnowledgeService) ReindexKnowledgeBase(ctx context.Context, req *pb.ReindexKnowledgeBaseRequest) (*pb.ReindexKnowledgeBaseReply, error) {
-	return &pb.ReindexKnowledgeBaseReply{}, nil
+	jobID, err := s.uc.ReindexKnowledgeBase(ctx, req.Id, req.ForceReindex)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReindexKnowledgeBaseReply{
+		JobId:  jobID,
+		Status: "pending",
+	}, nil
 }
 func (s *KnowledgeService) GetKnowledgeBaseStats(ctx context.Context, req *pb.GetKnowledgeBaseStatsRequest) (*pb.GetKnowledgeBaseStatsReply, error) {
 	return &pb.GetKnowledgeBaseStatsReply{}, nil
 }
 func (s *KnowledgeService) AnalyzeKnowledgeBase(ctx context.Context, req *pb.AnalyzeKnowledgeBaseRequest) (*pb.AnalyzeKnowledgeBaseReply, error) {
-	return &pb.AnalyzeKnowledgeBaseReply{}, nil
+	results, err := s.uc.AnalyzeKnowledgeBase(ctx, req.Id, req.AnalysisTypes)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AnalyzeKnowledgeBaseReply{Results: results}, nil
+}
+func (s *KnowledgeService) ShareKnowledgeBase(ctx context.Context, req *pb.ShareKnowledgeBaseRequest) (*pb.ShareKnowledgeBaseReply, error) {
+	share, err := s.share.ShareKnowledgeBase(
+		ctx,
+		req.KnowledgeBaseId,
+		req.GranteeUserId,
+		int(req.Role),
+		shareExpiresAt(req.ExpiresAt),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ShareKnowledgeBaseReply{
+		Grant: convertShareToProto(share),
+	}, nil
+}
+func (s *KnowledgeService) RevokeKnowledgeBaseShare(ctx context.Context, req *pb.RevokeKnowledgeBaseShareRequest) (*pb.RevokeKnowledgeBaseShareReply, error) {
+	if err := s.share.RevokeKnowledgeBaseShare(ctx, req.KnowledgeBaseId, req.GranteeUserId); err != nil {
+		return nil, err
+	}
+
+	return &pb.RevokeKnowledgeBaseShareReply{}, nil
+}
+func (s *KnowledgeService) ListKnowledgeBaseShares(ctx context.Context, req *pb.ListKnowledgeBaseSharesRequest) (*pb.ListKnowledgeBaseSharesReply, error) {
+	shares, err := s.share.ListKnowledgeBaseShares(ctx, req.KnowledgeBaseId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListKnowledgeBaseSharesReply{
+		Grants: convertSharesToProto(shares),
+	}, nil
 }
 
 // 转换函数
@@ -156,6 +278,7 @@ func (s *KnowledgeService) convertKnowledgeBaseToProto(kb *model.KnowledgeBase)
 		SupportedFileTypes: []string(kb.SupportedFileTypes),
 		MaxFileSize:        kb.MaxFileSize,
 		AutoProcess:        kb.AutoProcess,
+		AccessRole:         pb.ShareRole(kb.AccessRole),
 		CreatedAt:          timestamppb.New(kb.CreatedAt),
 		UpdatedAt:          timestamppb.New(kb.UpdatedAt),
 	}
@@ -244,6 +367,60 @@ func (s *KnowledgeService) convertDocumentToProto(doc *model.Document) *pb.Docum
 	return proto
 }
 
+func (s *KnowledgeService) convertKnowledgeBaseConfig(config *pb.KnowledgeBaseConfig) model.KnowledgeBaseConfig {
+	if config == nil {
+		return model.KnowledgeBaseConfig{}
+	}
+
+	result := model.KnowledgeBaseConfig{
+		EmbeddingDimension:   int(config.EmbeddingDimension),
+		ChunkingStrategy:     s.convertChunkingStrategy(config.ChunkingStrategy),
+		SimilarityThreshold:  config.SimilarityThreshold,
+		MaxChunksPerQuery:    int(config.MaxChunksPerQuery),
+		EnableMetadataFilter: config.EnableMetadataFilter,
+		StopWords:            config.StopWords,
+		TextSplitter:         config.TextSplitter,
+	}
+
+	if config.AdvancedOptions != nil {
+		result.AdvancedOptions = make(map[string]interface{})
+		//for k, v := range config.AdvancedOptions {
+		//result.AdvancedOptions[k] = v.String()
+		//}
+	}
+
+	return result
+}
+
+func (s *KnowledgeService) convertDocumentMetadata(metadata *pb.DocumentMetadata) model.DocumentMetadata {
+	if metadata == nil {
+		return model.DocumentMetadata{}
+	}
+
+	result := model.DocumentMetadata{
+		Title:        metadata.Title,
+		Author:       metadata.Author,
+		Subject:      metadata.Subject,
+		Keywords:     metadata.Keywords,
+		Category:     metadata.Category,
+		PageCount:    int(metadata.PageCount),
+		Encoding:     metadata.Encoding,
+		CustomFields: metadata.CustomFields,
+	}
+
+	if metadata.CreatedDate != nil {
+		t := metadata.CreatedDate.AsTime()
+		result.CreatedDate = &t
+	}
+
+	if metadata.ModifiedDate != nil {
+		t := metadata.ModifiedDate.AsTime()
+		result.ModifiedDate = &t
+	}
+
+	return result
+}
+
 func (s *KnowledgeService) convertKnowledgeChunkToProto(chunk *model.KnowledgeChunk) *pb.KnowledgeChunk {
 	proto := &pb.KnowledgeChunk{
 		Id:              chunk.ID,
@@ -287,6 +464,17 @@ func (s *KnowledgeService) convertChunkingStrategy(strategy pb.ChunkingStrategy)
 	}
 }
 
+func (s *KnowledgeService) convertDuplicatePolicy(policy pb.DuplicatePolicy) biz.DuplicatePolicy {
+	switch policy {
+	case pb.DuplicatePolicy_DUPLICATE_POLICY_SKIP:
+		return biz.DuplicateSkip
+	case pb.DuplicatePolicy_DUPLICATE_POLICY_NEW_VERSION:
+		return biz.DuplicateNewVersion
+	default:
+		return biz.DuplicateReject
+	}
+}
+
 func (s *KnowledgeService) convertChunkingStrategyToProto(strategy string) pb.ChunkingStrategy {
 	switch strategy {
 	case "fixed_size":
@@ -7,16 +7,21 @@ import (
 
 	pb "universal/api/ai/v1"
 	"universal/app/ai/internal/biz"
+	"universal/pkg/envelope"
 )
 
 type ModelService struct {
 	pb.UnimplementedModelServer
-	modelUc *biz.ModelUsecase
+	modelUc      *biz.ModelUsecase
+	credentialUc *biz.CredentialUsecase
+	syncUc       *biz.ModelSyncUsecase
 }
 
-func NewModelService(modelUc *biz.ModelUsecase) *ModelService {
+func NewModelService(modelUc *biz.ModelUsecase, credentialUc *biz.CredentialUsecase, syncUc *biz.ModelSyncUsecase) *ModelService {
 	return &ModelService{
-		modelUc: modelUc,
+		modelUc:      modelUc,
+		credentialUc: credentialUc,
+		syncUc:       syncUc,
 	}
 }
 
@@ -76,6 +81,53 @@ func (s *ModelService) TestProvider(ctx context.Context, req *pb.TestProviderReq
 		ErrorMessage: errorMessage,
 	}, nil
 }
+func (s *ModelService) SyncProviderModels(ctx context.Context, req *pb.SyncProviderModelsRequest) (*pb.SyncProviderModelsReply, error) {
+	result, err := s.syncUc.SyncProvider(ctx, req.ProviderId, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SyncProviderModelsReply{
+		Added:     result.Added,
+		Restored:  result.Restored,
+		Removed:   result.Removed,
+		Conflicts: result.Conflicts,
+		Unchanged: result.Unchanged,
+	}, nil
+}
+func (s *ModelService) SetProviderCredential(ctx context.Context, req *pb.SetProviderCredentialRequest) (*pb.SetProviderCredentialReply, error) {
+	credential, err := s.credentialUc.SetCredential(ctx, req.UserId, req.WorkspaceId, req.ProviderId, req.ApiKey, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SetProviderCredentialReply{
+		Credential: convertBizCredentialToPb(credential),
+	}, nil
+}
+func (s *ModelService) DeleteProviderCredential(ctx context.Context, req *pb.DeleteProviderCredentialRequest) (*pb.DeleteProviderCredentialReply, error) {
+	if err := s.credentialUc.DeleteCredential(ctx, req.UserId, req.WorkspaceId, req.ProviderId); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteProviderCredentialReply{
+		Success: true,
+	}, nil
+}
+func (s *ModelService) ListProviderCredentials(ctx context.Context, req *pb.ListProviderCredentialsRequest) (*pb.ListProviderCredentialsReply, error) {
+	credentials, err := s.credentialUc.ListCredentials(ctx, req.UserId, req.WorkspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	pbCredentials := make([]*pb.ProviderCredential, len(credentials))
+	for i, credential := range credentials {
+		pbCredentials[i] = convertBizCredentialToPb(credential)
+	}
+	return &pb.ListProviderCredentialsReply{
+		Credentials: pbCredentials,
+	}, nil
+}
 func (s *ModelService) CreateModel(ctx context.Context, req *pb.CreateModelRequest) (*pb.CreateModelReply, error) {
 	model, err := s.modelUc.CreateModel(ctx, req)
 	if err != nil {
@@ -257,13 +309,17 @@ func (s *ModelService) UpdateRateLimitConfig(ctx context.Context, req *pb.Update
 	}, nil
 }
 func (s *ModelService) HealthCheck(ctx context.Context, req *pb.HealthCheckRequest) (*pb.HealthCheckReply, error) {
-	health, err := s.modelUc.HealthCheck(ctx, req.ModelId)
+	healths, err := s.modelUc.HealthCheck(ctx, req.ModelId)
 	if err != nil {
 		return nil, err
 	}
 
+	status := make([]*pb.ModelHealth, len(healths))
+	for i, health := range healths {
+		status[i] = convertBizModelHealthToPb(health)
+	}
 	return &pb.HealthCheckReply{
-		HealthStatus: []*pb.ModelHealth{convertBizModelHealthToPb(health)},
+		HealthStatus: status,
 	}, nil
 }
 func (s *ModelService) GetModelMetrics(ctx context.Context, req *pb.GetModelMetricsRequest) (*pb.GetModelMetricsReply, error) {
@@ -279,7 +335,7 @@ func convertBizProviderToPb(provider *biz.Provider) *pb.Provider {
 		DisplayName:    provider.DisplayName,
 		Description:    provider.Description,
 		ApiBaseUrl:     provider.APIBaseURL,
-		DefaultApiKey:  provider.DefaultAPIKey,
+		DefaultApiKey:  envelope.Redact(provider.DefaultAPIKey),
 		DefaultHeaders: provider.DefaultHeaders,
 		Config:         provider.Config,
 		Status:         provider.Status,
@@ -288,6 +344,19 @@ func convertBizProviderToPb(provider *biz.Provider) *pb.Provider {
 	}
 }
 
+func convertBizCredentialToPb(credential *biz.ProviderCredential) *pb.ProviderCredential {
+	return &pb.ProviderCredential{
+		Id:          credential.ID,
+		ProviderId:  credential.ProviderID,
+		UserId:      credential.UserID,
+		WorkspaceId: credential.WorkspaceID,
+		Name:        credential.Name,
+		ApiKey:      envelope.Redact(credential.APIKey),
+		CreatedAt:   timestamppb.New(credential.CreatedAt),
+		UpdatedAt:   timestamppb.New(credential.UpdatedAt),
+	}
+}
+
 func convertBizModelToPb(model *biz.Model) *pb.ModelInfo {
 	return &pb.ModelInfo{
 		Id:            model.ID,
@@ -365,5 +434,8 @@ func convertBizModelHealthToPb(health *biz.ModelHealth) *pb.ModelHealth {
 		FailedRequests: health.FailedRequests,
 		ErrorMessage:   health.ErrorMessage,
 		LastCheck:      timestamppb.New(health.LastCheck),
+		LatencyP50:     health.LatencyP50,
+		LatencyP95:     health.LatencyP95,
+		LatencyP99:     health.LatencyP99,
 	}
 }
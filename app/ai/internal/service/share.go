@@ -0,0 +1,83 @@
+package service
+
+import (
+	"time"
+
+	pb "universal/api/ai/v1"
+	"universal/app/ai/internal/data/model"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// convertShareToProto 将授权记录转换为Proto消息
+func convertShareToProto(share *model.ResourceShare) *pb.ShareGrant {
+	proto := &pb.ShareGrant{
+		Id:            share.ID,
+		ResourceType:  share.ResourceType,
+		ResourceId:    share.ResourceID,
+		OwnerId:       share.OwnerID,
+		GranteeUserId: share.GranteeID,
+		Role:          pb.ShareRole(share.Role),
+		GrantedBy:     share.GrantedBy,
+		CreatedAt:     timestamppb.New(share.CreatedAt),
+		UpdatedAt:     timestamppb.New(share.UpdatedAt),
+	}
+
+	if share.ExpiresAt != nil {
+		proto.ExpiresAt = timestamppb.New(*share.ExpiresAt)
+	}
+
+	return proto
+}
+
+// convertSharesToProto 批量转换授权记录
+func convertSharesToProto(shares []*model.ResourceShare) []*pb.ShareGrant {
+	grants := make([]*pb.ShareGrant, len(shares))
+	for i, share := range shares {
+		grants[i] = convertShareToProto(share)
+	}
+	return grants
+}
+
+// convertSnapshotToProto 将对话快照转换为Proto消息，includeMessages控制是否返回消息内容
+func (s *ConversationService) convertSnapshotToProto(snapshot *model.ConversationSnapshot, includeMessages bool) *pb.ConversationSnapshot {
+	proto := &pb.ConversationSnapshot{
+		Token:          snapshot.Token,
+		ConversationId: snapshot.ConversationID,
+		OwnerId:        snapshot.OwnerID,
+		Title:          snapshot.Title,
+		ModelName:      snapshot.ModelName,
+		ViewCount:      snapshot.ViewCount,
+		CreatedAt:      timestamppb.New(snapshot.CreatedAt),
+	}
+
+	if snapshot.ExpiresAt != nil {
+		proto.ExpiresAt = timestamppb.New(*snapshot.ExpiresAt)
+	}
+
+	if includeMessages {
+		proto.Messages = make([]*pb.Message, len(snapshot.Messages))
+		for i, msg := range snapshot.Messages {
+			proto.Messages[i] = &pb.Message{
+				Id:             msg.ID,
+				ConversationId: snapshot.ConversationID,
+				Role:           pb.MessageRole(s.roleStringToEnum(msg.Role)),
+				Content:        msg.Content,
+				Status:         pb.MessageStatus_MESSAGE_STATUS_COMPLETED,
+				CreatedAt:      timestamppb.New(msg.CreatedAt),
+				Metrics:        &pb.MessageMetrics{ModelUsed: msg.ModelUsed},
+			}
+		}
+	}
+
+	return proto
+}
+
+// shareExpiresAt 解析授权过期时间
+func shareExpiresAt(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
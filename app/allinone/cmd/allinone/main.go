@@ -0,0 +1,214 @@
+// allinone 在同一进程内运行用户服务、AI服务与网关，用于本地开发与集成测试。
+// 服务之间通过进程内网络与 memory 注册中心通信，数据库使用 SQLite，Redis 使用内嵌的 miniredis。
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io/fs"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	aibootstrap "universal/app/ai/bootstrap"
+	gatewaybootstrap "universal/app/gateway/bootstrap"
+	userbootstrap "universal/app/user/bootstrap"
+	"universal/pkg/envelope"
+	"universal/pkg/idgen"
+	"universal/pkg/requestid"
+	"universal/pkg/telemetry"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+
+	_ "go.uber.org/automaxprocs"
+)
+
+// go build -ldflags "-X main.Version=x.y.z"
+var (
+	// Name is the name of the compiled software.
+	Name = "universal.allinone"
+	// Version is the version of the compiled software.
+	Version = "1.0.0"
+	// flagconf is the config flag.
+	flagconf string
+
+	id = idgen.GenerateServiceID(Name)
+)
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+}
+
+func newLogger(id, name string) log.Logger {
+	return log.With(log.NewStdLogger(os.Stdout),
+		"ts", log.DefaultTimestamp,
+		"caller", log.DefaultCaller,
+		"service.id", id,
+		"service.name", name,
+		"service.version", Version,
+		"trace.id", tracing.TraceID(),
+		"span.id", tracing.SpanID(),
+		"request.id", requestid.Valuer(),
+	)
+}
+
+func main() {
+	flag.Parse()
+	c := config.New(
+		config.WithSource(
+			file.NewSource(flagconf),
+		),
+	)
+	defer c.Close()
+
+	if err := c.Load(); err != nil {
+		panic(err)
+	}
+
+	apps, cleanup, err := newApps(c)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGQUIT, syscall.SIGINT)
+	defer stop()
+	if err := run(ctx, apps...); err != nil {
+		panic(err)
+	}
+}
+
+// newApps 按配置创建用户服务、AI服务与网关，返回顺序与之相同
+func newApps(c config.Config) ([]*kratos.App, func(), error) {
+	// 各服务的配置与独立部署时相同，分别位于 user、ai、gateway 下
+	var (
+		ubc userbootstrap.Config
+		abc aibootstrap.Config
+		gbc gatewaybootstrap.Config
+	)
+	if err := c.Value("user").Scan(&ubc); err != nil {
+		return nil, nil, err
+	}
+	if err := c.Value("ai").Scan(&abc); err != nil {
+		return nil, nil, err
+	}
+	if err := c.Value("gateway").Scan(&gbc); err != nil {
+		return nil, nil, err
+	}
+
+	var cleanups []func()
+	cleanup := func() {
+		for i := len(cleanups) - 1; i >= 0; i-- {
+			cleanups[i]()
+		}
+	}
+	fail := func(err error) ([]*kratos.App, func(), error) {
+		cleanup()
+		return nil, nil, err
+	}
+
+	// 内嵌 Redis，进程退出后数据即丢失
+	mr, err := miniredis.Run()
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanups = append(cleanups, mr.Close)
+	ubc.Data.Redis.Addr = mr.Addr()
+	abc.Data.Redis.Addr = mr.Addr()
+	gbc.Data.Redis.Addr = mr.Addr()
+
+	if err := ensureKeyFile(abc.Data.GetSecret().GetKeyFile()); err != nil {
+		return fail(err)
+	}
+
+	shutdown, err := telemetry.Setup(context.Background(), telemetry.Options{
+		Name:        Name,
+		Version:     Version,
+		ID:          id,
+		Endpoint:    gbc.Trace.GetEndpoint(),
+		SampleRatio: gbc.Trace.GetSampleRatio(),
+	})
+	if err != nil {
+		return fail(err)
+	}
+	cleanups = append(cleanups, shutdown)
+
+	userInfo := userbootstrap.Info{ID: idgen.GenerateServiceID(userbootstrap.Name), Name: userbootstrap.Name, Version: Version}
+	userApp, userCleanup, err := userbootstrap.NewApp(&ubc, userInfo, newLogger(userInfo.ID, userInfo.Name))
+	if err != nil {
+		return fail(err)
+	}
+	cleanups = append(cleanups, userCleanup)
+
+	aiInfo := aibootstrap.Info{ID: idgen.GenerateServiceID(aibootstrap.Name), Name: aibootstrap.Name, Version: Version}
+	aiApp, aiCleanup, err := aibootstrap.NewApp(&abc, aiInfo, newLogger(aiInfo.ID, aiInfo.Name))
+	if err != nil {
+		return fail(err)
+	}
+	cleanups = append(cleanups, aiCleanup)
+
+	gatewayInfo := gatewaybootstrap.Info{ID: idgen.GenerateServiceID(gatewaybootstrap.Name), Name: gatewaybootstrap.Name, Version: Version}
+	gatewayLogger := newLogger(gatewayInfo.ID, gatewayInfo.Name)
+	// 限流配置热加载
+	rateLimit, err := gatewaybootstrap.WatchRateLimit(c, "gateway.rate_limit", &gbc, gatewayLogger)
+	if err != nil {
+		return fail(err)
+	}
+	gatewayApp, gatewayCleanup, err := gatewaybootstrap.NewApp(&gbc, rateLimit, gatewayInfo, gatewayLogger)
+	if err != nil {
+		return fail(err)
+	}
+	cleanups = append(cleanups, gatewayCleanup)
+
+	return []*kratos.App{userApp, aiApp, gatewayApp}, cleanup, nil
+}
+
+// ensureKeyFile 密钥文件不存在时生成随机主密钥，避免本地开发使用公开的固定密钥
+func ensureKeyFile(path string) error {
+	if path == "" {
+		return nil
+	}
+	if _, err := os.Stat(path); !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	key, err := envelope.GenerateKey()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte("v1="+key+"\n"), 0o600)
+}
+
+// run 并发启动所有服务，ctx 结束或任一服务退出时停止全部服务
+func run(ctx context.Context, apps ...*kratos.App) error {
+	ctx, stop := context.WithCancel(ctx)
+	defer stop()
+
+	var (
+		wg    sync.WaitGroup
+		once  sync.Once
+		first error
+	)
+	for _, app := range apps {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := app.Run()
+			once.Do(func() {
+				first = err
+				stop()
+			})
+		}()
+	}
+	<-ctx.Done()
+	for _, app := range apps {
+		_ = app.Stop()
+	}
+	wg.Wait()
+	return first
+}
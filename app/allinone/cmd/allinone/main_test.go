@@ -0,0 +1,214 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"universal/pkg/identity"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+)
+
+const testAuthSecret = "allinone-integration-secret"
+
+// testGateway 以 SQLite 与内嵌 Redis 启动的单进程服务
+type testGateway struct {
+	t    *testing.T
+	base string
+}
+
+func startAllInOne(t *testing.T) *testGateway {
+	t.Helper()
+	dir := t.TempDir()
+	port := freePort(t)
+
+	// 在仓库配置之上覆盖数据文件、密钥文件与监听地址，测试之间互不影响
+	override := fmt.Sprintf(`
+user:
+  data:
+    database:
+      source: file:%[1]s/user.db?_pragma=busy_timeout(5000)
+ai:
+  data:
+    database:
+      source: file:%[1]s/ai.db?_pragma=busy_timeout(5000)
+    secret:
+      key_file: %[1]s/ai.keys
+gateway:
+  server:
+    http:
+      addr: 127.0.0.1:%[2]d
+      timeout: 10s
+    grpc:
+      addr: 127.0.0.1:0
+    admin:
+      addr: 127.0.0.1:0
+  auth:
+    secret: %[3]s
+`, filepath.ToSlash(dir), port, testAuthSecret)
+	overridePath := filepath.Join(dir, "override.yaml")
+	if err := os.WriteFile(overridePath, []byte(override), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	c := config.New(config.WithSource(
+		file.NewSource("../../configs/config.yaml"),
+		file.NewSource(overridePath),
+	))
+	t.Cleanup(func() { _ = c.Close() })
+	if err := c.Load(); err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+
+	apps, cleanup, err := newApps(c)
+	if err != nil {
+		t.Fatalf("newApps: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- run(ctx, apps...) }()
+	t.Cleanup(func() {
+		cancel()
+		select {
+		case <-done:
+		case <-time.After(10 * time.Second):
+			t.Error("services did not stop")
+		}
+		cleanup()
+	})
+
+	g := &testGateway{t: t, base: fmt.Sprintf("http://127.0.0.1:%d", port)}
+	g.waitHealthy()
+	return g
+}
+
+func freePort(t *testing.T) int {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+	return lis.Addr().(*net.TCPAddr).Port
+}
+
+// waitHealthy 等待网关开始监听并能访问后端服务
+func (g *testGateway) waitHealthy() {
+	g.t.Helper()
+	deadline := time.Now().Add(15 * time.Second)
+	for {
+		status, body, err := g.send(http.MethodGet, "/api/gateway/v1/health", 0, nil)
+		if err == nil && status == http.StatusOK {
+			return
+		}
+		if time.Now().After(deadline) {
+			g.t.Fatalf("gateway not healthy: status %d body %s err %v", status, body, err)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// send 发送请求，userID 大于0时按认证代理的方式签名身份
+func (g *testGateway) send(method, path string, userID int64, body any) (int, []byte, error) {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return 0, nil, err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, g.base+path, reader)
+	if err != nil {
+		return 0, nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if userID > 0 {
+		req.Header.Set(identity.HeaderKey, strconv.FormatInt(userID, 10))
+		req.Header.Set(identity.SignatureHeaderKey, identity.Sign([]byte(testAuthSecret), userID, "", time.Now()))
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	return resp.StatusCode, data, err
+}
+
+func (g *testGateway) mustSend(t *testing.T, method, path string, userID int64, body any, wantStatus int, reply any) {
+	t.Helper()
+	status, data, err := g.send(method, path, userID, body)
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, path, err)
+	}
+	if status != wantStatus {
+		t.Fatalf("%s %s: status %d, want %d: %s", method, path, status, wantStatus, data)
+	}
+	if reply != nil {
+		if err := json.Unmarshal(data, reply); err != nil {
+			t.Fatalf("%s %s: decode %s: %v", method, path, data, err)
+		}
+	}
+}
+
+func TestAllInOne(t *testing.T) {
+	g := startAllInOne(t)
+
+	t.Run("gateway", func(t *testing.T) {
+		var info map[string]any
+		g.mustSend(t, http.MethodGet, "/api/gateway/v1/info", 0, nil, http.StatusOK, &info)
+	})
+
+	t.Run("user", func(t *testing.T) {
+		var reply struct {
+			Users []json.RawMessage `json:"users"`
+		}
+		g.mustSend(t, http.MethodGet, "/api/user/v1/users", 0, nil, http.StatusOK, &reply)
+	})
+
+	t.Run("ai", func(t *testing.T) {
+		const owner, other = 7, 8
+		var created struct {
+			Conversation struct {
+				ID    string `json:"id"`
+				Title string `json:"title"`
+			} `json:"conversation"`
+		}
+		g.mustSend(t, http.MethodPost, "/api/ai/v1/conversations", owner, map[string]any{
+			"user_id":    strconv.Itoa(owner),
+			"title":      "integration",
+			"model_name": "gpt-4o-mini",
+		}, http.StatusOK, &created)
+		if created.Conversation.ID == "" || created.Conversation.ID == "0" {
+			t.Fatalf("conversation not created: %+v", created)
+		}
+
+		path := "/api/ai/v1/conversations/" + created.Conversation.ID
+		var got struct {
+			Conversation struct {
+				Title string `json:"title"`
+			} `json:"conversation"`
+		}
+		g.mustSend(t, http.MethodGet, path, owner, nil, http.StatusOK, &got)
+		if got.Conversation.Title != "integration" {
+			t.Fatalf("title = %q, want integration", got.Conversation.Title)
+		}
+
+		// 身份经网关转发到AI服务，其他用户无权读取
+		if status, body, err := g.send(http.MethodGet, path, other, nil); err != nil || status == http.StatusOK {
+			t.Fatalf("other user read the conversation: status %d body %s err %v", status, body, err)
+		}
+	})
+}
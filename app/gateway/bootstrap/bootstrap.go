@@ -0,0 +1,75 @@
+// Package bootstrap 组装网关，独立部署与单进程模式共用。
+package bootstrap
+
+import (
+	"universal/app/gateway/internal/conf"
+	"universal/app/gateway/internal/server"
+
+	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/registry"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// Name 网关在注册中心的名称
+const Name = "universal.gateway.service"
+
+// Config 网关配置
+type Config = conf.Bootstrap
+
+// Info 服务实例信息
+type Info struct {
+	ID      string
+	Name    string
+	Version string
+}
+
+// RateLimitPolicy 可热加载的入口限流配置
+type RateLimitPolicy = server.RateLimitPolicy
+
+// NewApp 创建网关，限流配置由 rateLimit 提供以便热加载
+func NewApp(bc *Config, rateLimit *RateLimitPolicy, info Info, logger log.Logger) (*kratos.App, func(), error) {
+	return wireApp(bc.Server, bc.Data, bc.Registry, bc.Upstream, bc.Auth, rateLimit, info, logger)
+}
+
+// WatchRateLimit 创建限流配置并监听配置中 key 处的变更，新配置校验失败时沿用原配置
+func WatchRateLimit(c config.Config, key string, bc *Config, logger log.Logger) (*RateLimitPolicy, error) {
+	rateLimit, err := server.NewRateLimitPolicy(bc.RateLimit)
+	if err != nil {
+		return nil, err
+	}
+	helper := log.NewHelper(logger)
+	if err := c.Watch(key, func(key string, value config.Value) {
+		var rl conf.RateLimit
+		if err := value.Scan(&rl); err != nil {
+			helper.Errorf("failed to reload %s: %v", key, err)
+			return
+		}
+		if err := rateLimit.Update(&rl); err != nil {
+			helper.Errorf("failed to reload %s: %v", key, err)
+			return
+		}
+		helper.Infof("%s reloaded", key)
+	}); err != nil {
+		return nil, err
+	}
+	return rateLimit, nil
+}
+
+func newApp(info Info, logger log.Logger, gs *grpc.Server, hs *http.Server, as *server.AdminServer, r registry.Registrar) *kratos.App {
+	return kratos.New(
+		kratos.ID(info.ID),
+		kratos.Name(info.Name),
+		kratos.Version(info.Version),
+		kratos.Metadata(map[string]string{}),
+		kratos.Logger(logger),
+		kratos.Server(
+			gs,
+			hs,
+			as,
+		),
+		kratos.Registrar(r),
+	)
+}
@@ -0,0 +1,70 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run -mod=mod github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package bootstrap
+
+import (
+	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"universal/app/gateway/internal/biz"
+	"universal/app/gateway/internal/conf"
+	"universal/app/gateway/internal/data"
+	"universal/app/gateway/internal/server"
+	"universal/app/gateway/internal/service"
+)
+
+// Injectors from wire.go:
+
+// wireApp init kratos application.
+func wireApp(confServer *conf.Server, confData *conf.Data, registry *conf.Registry, upstream *conf.Upstream, auth *conf.Auth, rateLimitPolicy *server.RateLimitPolicy, info Info, logger log.Logger) (*kratos.App, func(), error) {
+	discoveryRegistry, err := data.NewRegistry(registry)
+	if err != nil {
+		return nil, nil, err
+	}
+	discovery := data.NewDiscovery(discoveryRegistry)
+	clientPool, cleanup, err := data.NewClientPool(upstream, discovery, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	userClient := data.NewUserServiceClient(clientPool)
+	workspaceClient := data.NewWorkspaceServiceClient(clientPool)
+	aiClient := data.NewAiServiceClient(clientPool)
+	conversationClient := data.NewConversationServiceClient(clientPool)
+	modelClient := data.NewModelServiceClient(clientPool)
+	knowledgeClient := data.NewKnowledgeServiceClient(clientPool)
+	toolClient := data.NewToolServiceClient(clientPool)
+	dataData, cleanup2, err := data.NewData(confData, logger, discovery, userClient, workspaceClient, aiClient, conversationClient, modelClient, knowledgeClient, toolClient)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	greeterRepo := data.NewGreeterRepo(dataData, logger)
+	greeterUsecase := biz.NewGreeterUsecase(greeterRepo, logger)
+	greeterService := service.NewGreeterService(greeterUsecase)
+	gatewayRepo := data.NewGatewayRepo(dataData, logger)
+	gatewayUsecase := biz.NewGatewayUsecase(gatewayRepo, logger)
+	gatewayService := service.NewGatewayService(gatewayUsecase, logger)
+	userRepo := data.NewUserRepo(dataData, logger)
+	userUsecase := biz.NewUserUsecase(userRepo, logger)
+	userService := service.NewUserService(userUsecase)
+	workspaceService := service.NewWorkspaceService(dataData, logger)
+	aiService := service.NewAiService(dataData, userUsecase, logger)
+	conversationService := service.NewConversationService(dataData, userUsecase, logger)
+	knowledgeService := service.NewKnowledgeService(dataData, logger)
+	toolService := service.NewToolService()
+	verifier := server.NewIdentityVerifier(auth, logger)
+	grpcServer := server.NewGRPCServer(confServer, greeterService, gatewayService, userService, workspaceService, aiService, conversationService, knowledgeService, toolService, verifier, logger)
+	edgeLimitRepo := data.NewEdgeLimitRepo(dataData, logger)
+	edgeLimitUsecase := biz.NewEdgeLimitUsecase(edgeLimitRepo, logger)
+	httpServer := server.NewHTTPServer(confServer, greeterService, gatewayService, userService, workspaceService, aiService, conversationService, knowledgeService, toolService, rateLimitPolicy, edgeLimitUsecase, verifier, logger)
+	adminServer := server.NewAdminServer(confServer)
+	registrar := data.NewRegistrar(discoveryRegistry)
+	app := newApp(info, logger, grpcServer, httpServer, adminServer, registrar)
+	return app, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
@@ -1,19 +1,18 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"os"
-	"universal/app/gateway/internal/conf"
+	"universal/app/gateway/bootstrap"
 	"universal/pkg/idgen"
+	"universal/pkg/requestid"
+	"universal/pkg/telemetry"
 
-	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
 	"github.com/go-kratos/kratos/v2/config/file"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
-	"github.com/go-kratos/kratos/v2/registry"
-	"github.com/go-kratos/kratos/v2/transport/grpc"
-	"github.com/go-kratos/kratos/v2/transport/http"
 
 	_ "go.uber.org/automaxprocs"
 )
@@ -21,7 +20,7 @@ import (
 // go build -ldflags "-X main.Version=x.y.z"
 var (
 	// Name is the name of the compiled software.
-	Name = "universal.gateway.service"
+	Name = bootstrap.Name
 	// Version is the version of the compiled software.
 	Version = "1.0.0"
 	// flagconf is the config flag.
@@ -34,21 +33,6 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, r registry.Registrar) *kratos.App {
-	return kratos.New(
-		kratos.ID(id),
-		kratos.Name(Name),
-		kratos.Version(Version),
-		kratos.Metadata(map[string]string{}),
-		kratos.Logger(logger),
-		kratos.Server(
-			gs,
-			hs,
-		),
-		kratos.Registrar(r),
-	)
-}
-
 func main() {
 	flag.Parse()
 	logger := log.With(log.NewStdLogger(os.Stdout),
@@ -59,6 +43,7 @@ func main() {
 		"service.version", Version,
 		"trace.id", tracing.TraceID(),
 		"span.id", tracing.SpanID(),
+		"request.id", requestid.Valuer(),
 	)
 	c := config.New(
 		config.WithSource(
@@ -71,12 +56,30 @@ func main() {
 		panic(err)
 	}
 
-	var bc conf.Bootstrap
+	var bc bootstrap.Config
 	if err := c.Scan(&bc); err != nil {
 		panic(err)
 	}
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Registry, logger)
+	shutdown, err := telemetry.Setup(context.Background(), telemetry.Options{
+		Name:        Name,
+		Version:     Version,
+		ID:          id,
+		Endpoint:    bc.Trace.GetEndpoint(),
+		SampleRatio: bc.Trace.GetSampleRatio(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	defer shutdown()
+
+	// 限流配置热加载
+	rateLimit, err := bootstrap.WatchRateLimit(c, "rate_limit", &bc, logger)
+	if err != nil {
+		panic(err)
+	}
+
+	app, cleanup, err := bootstrap.NewApp(&bc, rateLimit, bootstrap.Info{ID: id, Name: Name, Version: Version}, logger)
 	if err != nil {
 		panic(err)
 	}
@@ -73,21 +73,24 @@ func (uc *GatewayUsecase) GetGatewayHealth(ctx context.Context) (*pb.GetGatewayH
 	status := "healthy"
 	message := "All services are healthy"
 	unhealthyCount := 0
+	degradedCount := 0
 
 	for _, service := range services {
-		if service.Status != "healthy" {
+		switch service.Status {
+		case "healthy":
+		case "degraded":
+			degradedCount++
+		default:
 			unhealthyCount++
 		}
 	}
 
-	if unhealthyCount > 0 {
-		if unhealthyCount == len(services) {
-			status = "unhealthy"
-			message = "All backend services are unhealthy"
-		} else {
-			status = "degraded"
-			message = "Some backend services are unhealthy"
-		}
+	if unhealthyCount > 0 && unhealthyCount == len(services) {
+		status = "unhealthy"
+		message = "All backend services are unhealthy"
+	} else if unhealthyCount > 0 || degradedCount > 0 {
+		status = "degraded"
+		message = "Some backend services are unhealthy"
 	}
 
 	return &pb.GetGatewayHealthReply{
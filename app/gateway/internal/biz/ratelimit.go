@@ -0,0 +1,106 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// TokenBucket 一个限流维度的令牌桶
+type TokenBucket struct {
+	Key   string
+	Rate  float64 // 每秒补充的令牌数
+	Burst int64   // 桶容量
+}
+
+// BucketState 本次取令牌后最紧张的令牌桶状态
+type BucketState struct {
+	Allowed    bool
+	Limit      int64
+	Remaining  int64
+	Reset      time.Duration // 令牌桶恢复满的时间
+	RetryAfter time.Duration // 被拒绝时可重试的等待时间
+}
+
+// EdgeLimitRepo 入口限流状态存储，多个网关副本共享
+type EdgeLimitRepo interface {
+	// Take 原子地从全部令牌桶各取一个令牌，任一桶不足时都不扣减
+	Take(ctx context.Context, buckets []*TokenBucket, now time.Time) (*BucketState, error)
+	// LockedFor 返回锁定剩余时间，未锁定时返回0
+	LockedFor(ctx context.Context, key string) (time.Duration, error)
+	// RecordFailure 记录一次失败，窗口内达到上限时锁定并返回true
+	RecordFailure(ctx context.Context, key string, maxFailures int64, window, lockout time.Duration) (bool, error)
+	// ResetFailures 认证成功后清除失败计数
+	ResetFailures(ctx context.Context, key string) error
+}
+
+// AuthOutcome 受暴力破解防护的请求结果
+type AuthOutcome int
+
+const (
+	// AuthOther 与认证无关的结果，如参数错误、限流或服务错误，不影响失败计数
+	AuthOther AuthOutcome = iota
+	// AuthFailed 认证失败，计入失败次数
+	AuthFailed
+	// AuthSucceeded 认证成功，清除该账户的失败计数
+	AuthSucceeded
+)
+
+// EdgeLimitUsecase 网关入口限流，存储不可用时放行请求，避免限流成为单点故障
+type EdgeLimitUsecase struct {
+	repo EdgeLimitRepo
+	log  *log.Helper
+}
+
+// NewEdgeLimitUsecase 创建入口限流用例
+func NewEdgeLimitUsecase(repo EdgeLimitRepo, logger log.Logger) *EdgeLimitUsecase {
+	return &EdgeLimitUsecase{
+		repo: repo,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Allow 检查并扣减令牌，没有令牌桶或存储不可用时返回nil表示放行
+func (uc *EdgeLimitUsecase) Allow(ctx context.Context, buckets []*TokenBucket) *BucketState {
+	if len(buckets) == 0 {
+		return nil
+	}
+	state, err := uc.repo.Take(ctx, buckets, time.Now())
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("rate limit store unavailable, request allowed: %v", err)
+		return nil
+	}
+	return state
+}
+
+// Locked 返回暴力破解防护的锁定剩余时间
+func (uc *EdgeLimitUsecase) Locked(ctx context.Context, key string) time.Duration {
+	ttl, err := uc.repo.LockedFor(ctx, key)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("failed to check lockout %s: %v", key, err)
+		return 0
+	}
+	return ttl
+}
+
+// Observe 按请求结果更新失败计数，只有认证成功才清零，其他结果不能用来重置计数
+func (uc *EdgeLimitUsecase) Observe(ctx context.Context, key string, outcome AuthOutcome, maxFailures int64, window, lockout time.Duration) {
+	switch outcome {
+	case AuthSucceeded:
+		if err := uc.repo.ResetFailures(ctx, key); err != nil {
+			uc.log.WithContext(ctx).Warnf("failed to reset failures %s: %v", key, err)
+		}
+		return
+	case AuthOther:
+		return
+	}
+	locked, err := uc.repo.RecordFailure(ctx, key, maxFailures, window, lockout)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("failed to record failure %s: %v", key, err)
+		return
+	}
+	if locked {
+		uc.log.WithContext(ctx).Warnf("%s locked for %s after %d failures", key, lockout, maxFailures)
+	}
+}
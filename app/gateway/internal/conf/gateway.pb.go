@@ -0,0 +1,1739 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v6.32.0
+// source: app/gateway/internal/conf/gateway.proto
+
+package conf
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Bootstrap struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Server        *Server                `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	Data          *Data                  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Registry      *Registry              `protobuf:"bytes,3,opt,name=registry,proto3" json:"registry,omitempty"`
+	Trace         *Trace                 `protobuf:"bytes,4,opt,name=trace,proto3" json:"trace,omitempty"`
+	RateLimit     *RateLimit             `protobuf:"bytes,5,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	Upstream      *Upstream              `protobuf:"bytes,6,opt,name=upstream,proto3" json:"upstream,omitempty"`
+	Auth          *Auth                  `protobuf:"bytes,7,opt,name=auth,proto3" json:"auth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Bootstrap) Reset() {
+	*x = Bootstrap{}
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Bootstrap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bootstrap) ProtoMessage() {}
+
+func (x *Bootstrap) ProtoReflect() protoreflect.Message {
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bootstrap.ProtoReflect.Descriptor instead.
+func (*Bootstrap) Descriptor() ([]byte, []int) {
+	return file_app_gateway_internal_conf_gateway_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Bootstrap) GetServer() *Server {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetData() *Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetRegistry() *Registry {
+	if x != nil {
+		return x.Registry
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetTrace() *Trace {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetRateLimit() *RateLimit {
+	if x != nil {
+		return x.RateLimit
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetUpstream() *Upstream {
+	if x != nil {
+		return x.Upstream
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetAuth() *Auth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+type Server struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
+	Grpc          *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	Admin         *Server_Admin          `protobuf:"bytes,3,opt,name=admin,proto3" json:"admin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server) Reset() {
+	*x = Server{}
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server) ProtoMessage() {}
+
+func (x *Server) ProtoReflect() protoreflect.Message {
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server.ProtoReflect.Descriptor instead.
+func (*Server) Descriptor() ([]byte, []int) {
+	return file_app_gateway_internal_conf_gateway_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Server) GetHttp() *Server_HTTP {
+	if x != nil {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *Server) GetGrpc() *Server_GRPC {
+	if x != nil {
+		return x.Grpc
+	}
+	return nil
+}
+
+func (x *Server) GetAdmin() *Server_Admin {
+	if x != nil {
+		return x.Admin
+	}
+	return nil
+}
+
+type Data struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data) Reset() {
+	*x = Data{}
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data) ProtoMessage() {}
+
+func (x *Data) ProtoReflect() protoreflect.Message {
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data.ProtoReflect.Descriptor instead.
+func (*Data) Descriptor() ([]byte, []int) {
+	return file_app_gateway_internal_conf_gateway_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Data) GetDatabase() *Data_Database {
+	if x != nil {
+		return x.Database
+	}
+	return nil
+}
+
+func (x *Data) GetRedis() *Data_Redis {
+	if x != nil {
+		return x.Redis
+	}
+	return nil
+}
+
+// Registry 服务注册与发现，type 为 consul（默认）、etcd、static 或 memory
+type Registry struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Consul *Registry_Consul       `protobuf:"bytes,1,opt,name=consul,proto3" json:"consul,omitempty"`
+	Type   string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Etcd   *Registry_Etcd         `protobuf:"bytes,3,opt,name=etcd,proto3" json:"etcd,omitempty"`
+	// type 为 static 时使用的服务地址
+	Static        []*Registry_Service `protobuf:"bytes,4,rep,name=static,proto3" json:"static,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Registry) Reset() {
+	*x = Registry{}
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Registry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Registry) ProtoMessage() {}
+
+func (x *Registry) ProtoReflect() protoreflect.Message {
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Registry.ProtoReflect.Descriptor instead.
+func (*Registry) Descriptor() ([]byte, []int) {
+	return file_app_gateway_internal_conf_gateway_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Registry) GetConsul() *Registry_Consul {
+	if x != nil {
+		return x.Consul
+	}
+	return nil
+}
+
+func (x *Registry) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Registry) GetEtcd() *Registry_Etcd {
+	if x != nil {
+		return x.Etcd
+	}
+	return nil
+}
+
+func (x *Registry) GetStatic() []*Registry_Service {
+	if x != nil {
+		return x.Static
+	}
+	return nil
+}
+
+type Trace struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// OTLP/HTTP 追踪接收地址，如 http://localhost:4318/v1/traces，为空时只生成追踪ID不上报
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// 根请求的采样比例，默认1
+	SampleRatio   float64 `protobuf:"fixed64,2,opt,name=sample_ratio,json=sampleRatio,proto3" json:"sample_ratio,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Trace) Reset() {
+	*x = Trace{}
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Trace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trace) ProtoMessage() {}
+
+func (x *Trace) ProtoReflect() protoreflect.Message {
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trace.ProtoReflect.Descriptor instead.
+func (*Trace) Descriptor() ([]byte, []int) {
+	return file_app_gateway_internal_conf_gateway_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Trace) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Trace) GetSampleRatio() float64 {
+	if x != nil {
+		return x.SampleRatio
+	}
+	return 0
+}
+
+// RateLimit 网关入口限流，修改配置文件后热加载
+type RateLimit struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Disabled bool                   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	PerIp    *RateLimit_Bucket      `protobuf:"bytes,2,opt,name=per_ip,json=perIp,proto3" json:"per_ip,omitempty"`
+	PerUser  *RateLimit_Bucket      `protobuf:"bytes,3,opt,name=per_user,json=perUser,proto3" json:"per_user,omitempty"`
+	PerKey   *RateLimit_Bucket      `protobuf:"bytes,4,opt,name=per_key,json=perKey,proto3" json:"per_key,omitempty"`
+	Routes   []*RateLimit_Route     `protobuf:"bytes,5,rep,name=routes,proto3" json:"routes,omitempty"`
+	// 请求体上限，默认1MB
+	MaxBodyBytes int64 `protobuf:"varint,6,opt,name=max_body_bytes,json=maxBodyBytes,proto3" json:"max_body_bytes,omitempty"`
+	// 可信代理的CIDR，来自这些地址的请求按 X-Forwarded-For 识别客户端IP
+	TrustedProxies []string `protobuf:"bytes,7,rep,name=trusted_proxies,json=trustedProxies,proto3" json:"trusted_proxies,omitempty"`
+	// 已废弃，用户预算按身份校验后的用户计数，匿名请求按IP计数；配置后忽略
+	//
+	// Deprecated: Marked as deprecated in app/gateway/internal/conf/gateway.proto.
+	UserHeader    string `protobuf:"bytes,8,opt,name=user_header,json=userHeader,proto3" json:"user_header,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimit) Reset() {
+	*x = RateLimit{}
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimit) ProtoMessage() {}
+
+func (x *RateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimit.ProtoReflect.Descriptor instead.
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return file_app_gateway_internal_conf_gateway_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RateLimit) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *RateLimit) GetPerIp() *RateLimit_Bucket {
+	if x != nil {
+		return x.PerIp
+	}
+	return nil
+}
+
+func (x *RateLimit) GetPerUser() *RateLimit_Bucket {
+	if x != nil {
+		return x.PerUser
+	}
+	return nil
+}
+
+func (x *RateLimit) GetPerKey() *RateLimit_Bucket {
+	if x != nil {
+		return x.PerKey
+	}
+	return nil
+}
+
+func (x *RateLimit) GetRoutes() []*RateLimit_Route {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+func (x *RateLimit) GetMaxBodyBytes() int64 {
+	if x != nil {
+		return x.MaxBodyBytes
+	}
+	return 0
+}
+
+func (x *RateLimit) GetTrustedProxies() []string {
+	if x != nil {
+		return x.TrustedProxies
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in app/gateway/internal/conf/gateway.proto.
+func (x *RateLimit) GetUserHeader() string {
+	if x != nil {
+		return x.UserHeader
+	}
+	return ""
+}
+
+// Auth 调用方身份校验，认证代理写入 X-User-Id 与 X-User-Signature 请求头
+type Auth struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 与认证代理共享的HMAC密钥，环境变量 GATEWAY_AUTH_SECRET 优先；为空时所有请求视为匿名
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// 签名时间戳允许的最大时钟偏差，默认5分钟
+	MaxSkew       *durationpb.Duration `protobuf:"bytes,2,opt,name=max_skew,json=maxSkew,proto3" json:"max_skew,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Auth) Reset() {
+	*x = Auth{}
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth) ProtoMessage() {}
+
+func (x *Auth) ProtoReflect() protoreflect.Message {
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth.ProtoReflect.Descriptor instead.
+func (*Auth) Descriptor() ([]byte, []int) {
+	return file_app_gateway_internal_conf_gateway_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Auth) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Auth) GetMaxSkew() *durationpb.Duration {
+	if x != nil {
+		return x.MaxSkew
+	}
+	return nil
+}
+
+// Upstream 网关到后端服务的gRPC连接，每个后端服务共用一个连接
+type Upstream struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 负载均衡策略：wrr（默认）、p2c、random
+	Balancer  string              `protobuf:"bytes,1,opt,name=balancer,proto3" json:"balancer,omitempty"`
+	Deadlines *Upstream_Deadlines `protobuf:"bytes,2,opt,name=deadlines,proto3" json:"deadlines,omitempty"`
+	Retry     *Upstream_Retry     `protobuf:"bytes,3,opt,name=retry,proto3" json:"retry,omitempty"`
+	Breaker   *Upstream_Breaker   `protobuf:"bytes,4,opt,name=breaker,proto3" json:"breaker,omitempty"`
+	// 额外按长耗时处理的方法名，如 SendMessage
+	LongMethods   []string `protobuf:"bytes,5,rep,name=long_methods,json=longMethods,proto3" json:"long_methods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Upstream) Reset() {
+	*x = Upstream{}
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Upstream) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Upstream) ProtoMessage() {}
+
+func (x *Upstream) ProtoReflect() protoreflect.Message {
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Upstream.ProtoReflect.Descriptor instead.
+func (*Upstream) Descriptor() ([]byte, []int) {
+	return file_app_gateway_internal_conf_gateway_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Upstream) GetBalancer() string {
+	if x != nil {
+		return x.Balancer
+	}
+	return ""
+}
+
+func (x *Upstream) GetDeadlines() *Upstream_Deadlines {
+	if x != nil {
+		return x.Deadlines
+	}
+	return nil
+}
+
+func (x *Upstream) GetRetry() *Upstream_Retry {
+	if x != nil {
+		return x.Retry
+	}
+	return nil
+}
+
+func (x *Upstream) GetBreaker() *Upstream_Breaker {
+	if x != nil {
+		return x.Breaker
+	}
+	return nil
+}
+
+func (x *Upstream) GetLongMethods() []string {
+	if x != nil {
+		return x.LongMethods
+	}
+	return nil
+}
+
+type Server_HTTP struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_HTTP) Reset() {
+	*x = Server_HTTP{}
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_HTTP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_HTTP) ProtoMessage() {}
+
+func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_HTTP.ProtoReflect.Descriptor instead.
+func (*Server_HTTP) Descriptor() ([]byte, []int) {
+	return file_app_gateway_internal_conf_gateway_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *Server_HTTP) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *Server_HTTP) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Server_HTTP) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+type Server_GRPC struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_GRPC) Reset() {
+	*x = Server_GRPC{}
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_GRPC) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_GRPC) ProtoMessage() {}
+
+func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_GRPC.ProtoReflect.Descriptor instead.
+func (*Server_GRPC) Descriptor() ([]byte, []int) {
+	return file_app_gateway_internal_conf_gateway_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *Server_GRPC) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *Server_GRPC) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Server_GRPC) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+// 管理端口，只提供指标接口，不应对公网开放；未配置地址时不暴露指标
+type Server_Admin struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Addr          string                 `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_Admin) Reset() {
+	*x = Server_Admin{}
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_Admin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_Admin) ProtoMessage() {}
+
+func (x *Server_Admin) ProtoReflect() protoreflect.Message {
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_Admin.ProtoReflect.Descriptor instead.
+func (*Server_Admin) Descriptor() ([]byte, []int) {
+	return file_app_gateway_internal_conf_gateway_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *Server_Admin) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+type Data_Database struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Driver        string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Database) Reset() {
+	*x = Data_Database{}
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Database) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Database) ProtoMessage() {}
+
+func (x *Data_Database) ProtoReflect() protoreflect.Message {
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Database.ProtoReflect.Descriptor instead.
+func (*Data_Database) Descriptor() ([]byte, []int) {
+	return file_app_gateway_internal_conf_gateway_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *Data_Database) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Data_Database) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type Data_Redis struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	ReadTimeout   *durationpb.Duration   `protobuf:"bytes,3,opt,name=read_timeout,json=readTimeout,proto3" json:"read_timeout,omitempty"`
+	WriteTimeout  *durationpb.Duration   `protobuf:"bytes,4,opt,name=write_timeout,json=writeTimeout,proto3" json:"write_timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Redis) Reset() {
+	*x = Data_Redis{}
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Redis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Redis) ProtoMessage() {}
+
+func (x *Data_Redis) ProtoReflect() protoreflect.Message {
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Redis.ProtoReflect.Descriptor instead.
+func (*Data_Redis) Descriptor() ([]byte, []int) {
+	return file_app_gateway_internal_conf_gateway_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *Data_Redis) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *Data_Redis) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Data_Redis) GetReadTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ReadTimeout
+	}
+	return nil
+}
+
+func (x *Data_Redis) GetWriteTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.WriteTimeout
+	}
+	return nil
+}
+
+type Registry_Consul struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Address string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Scheme  string                 `protobuf:"bytes,2,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	// 关闭健康检查，开启时 Consul 检查实例端口与心跳，发现只返回健康的实例
+	DisableHealthCheck bool `protobuf:"varint,3,opt,name=disable_health_check,json=disableHealthCheck,proto3" json:"disable_health_check,omitempty"`
+	// 健康检查间隔，默认10秒
+	HealthCheckInterval *durationpb.Duration `protobuf:"bytes,4,opt,name=health_check_interval,json=healthCheckInterval,proto3" json:"health_check_interval,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Registry_Consul) Reset() {
+	*x = Registry_Consul{}
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Registry_Consul) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Registry_Consul) ProtoMessage() {}
+
+func (x *Registry_Consul) ProtoReflect() protoreflect.Message {
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Registry_Consul.ProtoReflect.Descriptor instead.
+func (*Registry_Consul) Descriptor() ([]byte, []int) {
+	return file_app_gateway_internal_conf_gateway_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *Registry_Consul) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Registry_Consul) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+func (x *Registry_Consul) GetDisableHealthCheck() bool {
+	if x != nil {
+		return x.DisableHealthCheck
+	}
+	return false
+}
+
+func (x *Registry_Consul) GetHealthCheckInterval() *durationpb.Duration {
+	if x != nil {
+		return x.HealthCheckInterval
+	}
+	return nil
+}
+
+type Registry_Etcd struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoints     []string               `protobuf:"bytes,1,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	DialTimeout   *durationpb.Duration   `protobuf:"bytes,2,opt,name=dial_timeout,json=dialTimeout,proto3" json:"dial_timeout,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Registry_Etcd) Reset() {
+	*x = Registry_Etcd{}
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Registry_Etcd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Registry_Etcd) ProtoMessage() {}
+
+func (x *Registry_Etcd) ProtoReflect() protoreflect.Message {
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Registry_Etcd.ProtoReflect.Descriptor instead.
+func (*Registry_Etcd) Descriptor() ([]byte, []int) {
+	return file_app_gateway_internal_conf_gateway_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *Registry_Etcd) GetEndpoints() []string {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+func (x *Registry_Etcd) GetDialTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.DialTimeout
+	}
+	return nil
+}
+
+func (x *Registry_Etcd) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Registry_Etcd) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// Service 静态服务地址，如 grpc://127.0.0.1:9001
+type Registry_Service struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Endpoints     []string               `protobuf:"bytes,2,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Registry_Service) Reset() {
+	*x = Registry_Service{}
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Registry_Service) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Registry_Service) ProtoMessage() {}
+
+func (x *Registry_Service) ProtoReflect() protoreflect.Message {
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Registry_Service.ProtoReflect.Descriptor instead.
+func (*Registry_Service) Descriptor() ([]byte, []int) {
+	return file_app_gateway_internal_conf_gateway_proto_rawDescGZIP(), []int{3, 2}
+}
+
+func (x *Registry_Service) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Registry_Service) GetEndpoints() []string {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+// Bucket 令牌桶，rate 为每秒补充的令牌数，burst 为桶容量，rate 为0时不限制
+type RateLimit_Bucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rate          float64                `protobuf:"fixed64,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	Burst         int64                  `protobuf:"varint,2,opt,name=burst,proto3" json:"burst,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimit_Bucket) Reset() {
+	*x = RateLimit_Bucket{}
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimit_Bucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimit_Bucket) ProtoMessage() {}
+
+func (x *RateLimit_Bucket) ProtoReflect() protoreflect.Message {
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimit_Bucket.ProtoReflect.Descriptor instead.
+func (*RateLimit_Bucket) Descriptor() ([]byte, []int) {
+	return file_app_gateway_internal_conf_gateway_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *RateLimit_Bucket) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *RateLimit_Bucket) GetBurst() int64 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+// BruteForce 暴力破解防护：窗口内同一账户在同一IP的失败次数达到 max_failures，
+// 或同一账户在所有IP的失败次数达到 max_account_failures 后锁定，该账户认证成功后清零。
+// 目标账户取自路径，未配置 account_segment 时取验证后的身份；都没有时只按IP计数
+type RateLimit_BruteForce struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	MaxFailures int32                  `protobuf:"varint,1,opt,name=max_failures,json=maxFailures,proto3" json:"max_failures,omitempty"`
+	Window      *durationpb.Duration   `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	Lockout     *durationpb.Duration   `protobuf:"bytes,3,opt,name=lockout,proto3" json:"lockout,omitempty"`
+	// 计为失败的响应状态码，默认 401、403
+	FailureCodes []int32 `protobuf:"varint,4,rep,packed,name=failure_codes,json=failureCodes,proto3" json:"failure_codes,omitempty"`
+	// 路径中标识目标账户的 * 段序号，从1开始，如 /api/user/v1/users/*/password 为1
+	AccountSegment int32 `protobuf:"varint,5,opt,name=account_segment,json=accountSegment,proto3" json:"account_segment,omitempty"`
+	// 单个账户不区分IP的失败上限，防止轮换IP绕过锁定，默认为 max_failures 的4倍
+	MaxAccountFailures int32 `protobuf:"varint,6,opt,name=max_account_failures,json=maxAccountFailures,proto3" json:"max_account_failures,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RateLimit_BruteForce) Reset() {
+	*x = RateLimit_BruteForce{}
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimit_BruteForce) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimit_BruteForce) ProtoMessage() {}
+
+func (x *RateLimit_BruteForce) ProtoReflect() protoreflect.Message {
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimit_BruteForce.ProtoReflect.Descriptor instead.
+func (*RateLimit_BruteForce) Descriptor() ([]byte, []int) {
+	return file_app_gateway_internal_conf_gateway_proto_rawDescGZIP(), []int{5, 1}
+}
+
+func (x *RateLimit_BruteForce) GetMaxFailures() int32 {
+	if x != nil {
+		return x.MaxFailures
+	}
+	return 0
+}
+
+func (x *RateLimit_BruteForce) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *RateLimit_BruteForce) GetLockout() *durationpb.Duration {
+	if x != nil {
+		return x.Lockout
+	}
+	return nil
+}
+
+func (x *RateLimit_BruteForce) GetFailureCodes() []int32 {
+	if x != nil {
+		return x.FailureCodes
+	}
+	return nil
+}
+
+func (x *RateLimit_BruteForce) GetAccountSegment() int32 {
+	if x != nil {
+		return x.AccountSegment
+	}
+	return 0
+}
+
+func (x *RateLimit_BruteForce) GetMaxAccountFailures() int32 {
+	if x != nil {
+		return x.MaxAccountFailures
+	}
+	return 0
+}
+
+// Route 路由级预算，按顺序匹配第一条，未匹配的路由使用全局预算
+type RateLimit_Route struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// HTTP方法，为空时匹配全部方法
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// 路径模式，* 匹配单个路径段，如 /api/ai/v1/conversations/*/messages/stream
+	Path    string            `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	PerIp   *RateLimit_Bucket `protobuf:"bytes,4,opt,name=per_ip,json=perIp,proto3" json:"per_ip,omitempty"`
+	PerUser *RateLimit_Bucket `protobuf:"bytes,5,opt,name=per_user,json=perUser,proto3" json:"per_user,omitempty"`
+	PerKey  *RateLimit_Bucket `protobuf:"bytes,6,opt,name=per_key,json=perKey,proto3" json:"per_key,omitempty"`
+	// 请求体上限，0时使用全局上限
+	MaxBodyBytes  int64                 `protobuf:"varint,7,opt,name=max_body_bytes,json=maxBodyBytes,proto3" json:"max_body_bytes,omitempty"`
+	BruteForce    *RateLimit_BruteForce `protobuf:"bytes,8,opt,name=brute_force,json=bruteForce,proto3" json:"brute_force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimit_Route) Reset() {
+	*x = RateLimit_Route{}
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimit_Route) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimit_Route) ProtoMessage() {}
+
+func (x *RateLimit_Route) ProtoReflect() protoreflect.Message {
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimit_Route.ProtoReflect.Descriptor instead.
+func (*RateLimit_Route) Descriptor() ([]byte, []int) {
+	return file_app_gateway_internal_conf_gateway_proto_rawDescGZIP(), []int{5, 2}
+}
+
+func (x *RateLimit_Route) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RateLimit_Route) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *RateLimit_Route) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RateLimit_Route) GetPerIp() *RateLimit_Bucket {
+	if x != nil {
+		return x.PerIp
+	}
+	return nil
+}
+
+func (x *RateLimit_Route) GetPerUser() *RateLimit_Bucket {
+	if x != nil {
+		return x.PerUser
+	}
+	return nil
+}
+
+func (x *RateLimit_Route) GetPerKey() *RateLimit_Bucket {
+	if x != nil {
+		return x.PerKey
+	}
+	return nil
+}
+
+func (x *RateLimit_Route) GetMaxBodyBytes() int64 {
+	if x != nil {
+		return x.MaxBodyBytes
+	}
+	return 0
+}
+
+func (x *RateLimit_Route) GetBruteForce() *RateLimit_BruteForce {
+	if x != nil {
+		return x.BruteForce
+	}
+	return nil
+}
+
+// Deadlines 按方法类别的调用超时，包含重试在内
+type Upstream_Deadlines struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 查询类方法（Get、List、Search等），默认5秒
+	Read *durationpb.Duration `protobuf:"bytes,1,opt,name=read,proto3" json:"read,omitempty"`
+	// 写入类方法，默认10秒
+	Write *durationpb.Duration `protobuf:"bytes,2,opt,name=write,proto3" json:"write,omitempty"`
+	// 长耗时方法，如模型调用、文档处理，默认60秒
+	Long          *durationpb.Duration `protobuf:"bytes,3,opt,name=long,proto3" json:"long,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Upstream_Deadlines) Reset() {
+	*x = Upstream_Deadlines{}
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Upstream_Deadlines) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Upstream_Deadlines) ProtoMessage() {}
+
+func (x *Upstream_Deadlines) ProtoReflect() protoreflect.Message {
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Upstream_Deadlines.ProtoReflect.Descriptor instead.
+func (*Upstream_Deadlines) Descriptor() ([]byte, []int) {
+	return file_app_gateway_internal_conf_gateway_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *Upstream_Deadlines) GetRead() *durationpb.Duration {
+	if x != nil {
+		return x.Read
+	}
+	return nil
+}
+
+func (x *Upstream_Deadlines) GetWrite() *durationpb.Duration {
+	if x != nil {
+		return x.Write
+	}
+	return nil
+}
+
+func (x *Upstream_Deadlines) GetLong() *durationpb.Duration {
+	if x != nil {
+		return x.Long
+	}
+	return nil
+}
+
+// Retry 查询类方法在后端不可用时的重试，按指数退避并带随机抖动
+type Upstream_Retry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 最大尝试次数（含首次），默认3，为1时不重试
+	MaxAttempts    int32                `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	InitialBackoff *durationpb.Duration `protobuf:"bytes,2,opt,name=initial_backoff,json=initialBackoff,proto3" json:"initial_backoff,omitempty"`
+	MaxBackoff     *durationpb.Duration `protobuf:"bytes,3,opt,name=max_backoff,json=maxBackoff,proto3" json:"max_backoff,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Upstream_Retry) Reset() {
+	*x = Upstream_Retry{}
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Upstream_Retry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Upstream_Retry) ProtoMessage() {}
+
+func (x *Upstream_Retry) ProtoReflect() protoreflect.Message {
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Upstream_Retry.ProtoReflect.Descriptor instead.
+func (*Upstream_Retry) Descriptor() ([]byte, []int) {
+	return file_app_gateway_internal_conf_gateway_proto_rawDescGZIP(), []int{7, 1}
+}
+
+func (x *Upstream_Retry) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *Upstream_Retry) GetInitialBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.InitialBackoff
+	}
+	return nil
+}
+
+func (x *Upstream_Retry) GetMaxBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.MaxBackoff
+	}
+	return nil
+}
+
+// Breaker 按方法的SRE自适应熔断
+type Upstream_Breaker struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Disabled bool                   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// 成功率阈值，默认0.6
+	Success float64 `protobuf:"fixed64,2,opt,name=success,proto3" json:"success,omitempty"`
+	// 触发熔断的最小请求数，默认100
+	Request int64 `protobuf:"varint,3,opt,name=request,proto3" json:"request,omitempty"`
+	// 统计窗口，默认3秒
+	Window        *durationpb.Duration `protobuf:"bytes,4,opt,name=window,proto3" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Upstream_Breaker) Reset() {
+	*x = Upstream_Breaker{}
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Upstream_Breaker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Upstream_Breaker) ProtoMessage() {}
+
+func (x *Upstream_Breaker) ProtoReflect() protoreflect.Message {
+	mi := &file_app_gateway_internal_conf_gateway_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Upstream_Breaker.ProtoReflect.Descriptor instead.
+func (*Upstream_Breaker) Descriptor() ([]byte, []int) {
+	return file_app_gateway_internal_conf_gateway_proto_rawDescGZIP(), []int{7, 2}
+}
+
+func (x *Upstream_Breaker) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Upstream_Breaker) GetSuccess() float64 {
+	if x != nil {
+		return x.Success
+	}
+	return 0
+}
+
+func (x *Upstream_Breaker) GetRequest() int64 {
+	if x != nil {
+		return x.Request
+	}
+	return 0
+}
+
+func (x *Upstream_Breaker) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+var File_app_gateway_internal_conf_gateway_proto protoreflect.FileDescriptor
+
+const file_app_gateway_internal_conf_gateway_proto_rawDesc = "" +
+	"\n" +
+	"'app/gateway/internal/conf/gateway.proto\x12\x16universal.gateway.conf\x1a\x1egoogle/protobuf/duration.proto\"\x9a\x03\n" +
+	"\tBootstrap\x126\n" +
+	"\x06server\x18\x01 \x01(\v2\x1e.universal.gateway.conf.ServerR\x06server\x120\n" +
+	"\x04data\x18\x02 \x01(\v2\x1c.universal.gateway.conf.DataR\x04data\x12<\n" +
+	"\bregistry\x18\x03 \x01(\v2 .universal.gateway.conf.RegistryR\bregistry\x123\n" +
+	"\x05trace\x18\x04 \x01(\v2\x1d.universal.gateway.conf.TraceR\x05trace\x12@\n" +
+	"\n" +
+	"rate_limit\x18\x05 \x01(\v2!.universal.gateway.conf.RateLimitR\trateLimit\x12<\n" +
+	"\bupstream\x18\x06 \x01(\v2 .universal.gateway.conf.UpstreamR\bupstream\x120\n" +
+	"\x04auth\x18\a \x01(\v2\x1c.universal.gateway.conf.AuthR\x04auth\"\xa9\x03\n" +
+	"\x06Server\x127\n" +
+	"\x04http\x18\x01 \x01(\v2#.universal.gateway.conf.Server.HTTPR\x04http\x127\n" +
+	"\x04grpc\x18\x02 \x01(\v2#.universal.gateway.conf.Server.GRPCR\x04grpc\x12:\n" +
+	"\x05admin\x18\x03 \x01(\v2$.universal.gateway.conf.Server.AdminR\x05admin\x1ai\n" +
+	"\x04HTTP\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1ai\n" +
+	"\x04GRPC\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1a\x1b\n" +
+	"\x05Admin\x12\x12\n" +
+	"\x04addr\x18\x01 \x01(\tR\x04addr\"\xf5\x02\n" +
+	"\x04Data\x12A\n" +
+	"\bdatabase\x18\x01 \x01(\v2%.universal.gateway.conf.Data.DatabaseR\bdatabase\x128\n" +
+	"\x05redis\x18\x02 \x01(\v2\".universal.gateway.conf.Data.RedisR\x05redis\x1a:\n" +
+	"\bDatabase\x12\x16\n" +
+	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x1a\xb3\x01\n" +
+	"\x05Redis\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04addr\x18\x02 \x01(\tR\x04addr\x12<\n" +
+	"\fread_timeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
+	"\rwrite_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\"\xf4\x04\n" +
+	"\bRegistry\x12?\n" +
+	"\x06consul\x18\x01 \x01(\v2'.universal.gateway.conf.Registry.ConsulR\x06consul\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x129\n" +
+	"\x04etcd\x18\x03 \x01(\v2%.universal.gateway.conf.Registry.EtcdR\x04etcd\x12@\n" +
+	"\x06static\x18\x04 \x03(\v2(.universal.gateway.conf.Registry.ServiceR\x06static\x1a\xbb\x01\n" +
+	"\x06Consul\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06scheme\x18\x02 \x01(\tR\x06scheme\x120\n" +
+	"\x14disable_health_check\x18\x03 \x01(\bR\x12disableHealthCheck\x12M\n" +
+	"\x15health_check_interval\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x13healthCheckInterval\x1a\x9a\x01\n" +
+	"\x04Etcd\x12\x1c\n" +
+	"\tendpoints\x18\x01 \x03(\tR\tendpoints\x12<\n" +
+	"\fdial_timeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\vdialTimeout\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\x1a;\n" +
+	"\aService\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tendpoints\x18\x02 \x03(\tR\tendpoints\"F\n" +
+	"\x05Trace\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12!\n" +
+	"\fsample_ratio\x18\x02 \x01(\x01R\vsampleRatio\"\xfb\b\n" +
+	"\tRateLimit\x12\x1a\n" +
+	"\bdisabled\x18\x01 \x01(\bR\bdisabled\x12?\n" +
+	"\x06per_ip\x18\x02 \x01(\v2(.universal.gateway.conf.RateLimit.BucketR\x05perIp\x12C\n" +
+	"\bper_user\x18\x03 \x01(\v2(.universal.gateway.conf.RateLimit.BucketR\aperUser\x12A\n" +
+	"\aper_key\x18\x04 \x01(\v2(.universal.gateway.conf.RateLimit.BucketR\x06perKey\x12?\n" +
+	"\x06routes\x18\x05 \x03(\v2'.universal.gateway.conf.RateLimit.RouteR\x06routes\x12$\n" +
+	"\x0emax_body_bytes\x18\x06 \x01(\x03R\fmaxBodyBytes\x12'\n" +
+	"\x0ftrusted_proxies\x18\a \x03(\tR\x0etrustedProxies\x12#\n" +
+	"\vuser_header\x18\b \x01(\tB\x02\x18\x01R\n" +
+	"userHeader\x1a2\n" +
+	"\x06Bucket\x12\x12\n" +
+	"\x04rate\x18\x01 \x01(\x01R\x04rate\x12\x14\n" +
+	"\x05burst\x18\x02 \x01(\x03R\x05burst\x1a\x97\x02\n" +
+	"\n" +
+	"BruteForce\x12!\n" +
+	"\fmax_failures\x18\x01 \x01(\x05R\vmaxFailures\x121\n" +
+	"\x06window\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x06window\x123\n" +
+	"\alockout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\alockout\x12#\n" +
+	"\rfailure_codes\x18\x04 \x03(\x05R\ffailureCodes\x12'\n" +
+	"\x0faccount_segment\x18\x05 \x01(\x05R\x0eaccountSegment\x120\n" +
+	"\x14max_account_failures\x18\x06 \x01(\x05R\x12maxAccountFailures\x1a\x85\x03\n" +
+	"\x05Route\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12?\n" +
+	"\x06per_ip\x18\x04 \x01(\v2(.universal.gateway.conf.RateLimit.BucketR\x05perIp\x12C\n" +
+	"\bper_user\x18\x05 \x01(\v2(.universal.gateway.conf.RateLimit.BucketR\aperUser\x12A\n" +
+	"\aper_key\x18\x06 \x01(\v2(.universal.gateway.conf.RateLimit.BucketR\x06perKey\x12$\n" +
+	"\x0emax_body_bytes\x18\a \x01(\x03R\fmaxBodyBytes\x12M\n" +
+	"\vbrute_force\x18\b \x01(\v2,.universal.gateway.conf.RateLimit.BruteForceR\n" +
+	"bruteForce\"T\n" +
+	"\x04Auth\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x124\n" +
+	"\bmax_skew\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\amaxSkew\"\xee\x05\n" +
+	"\bUpstream\x12\x1a\n" +
+	"\bbalancer\x18\x01 \x01(\tR\bbalancer\x12H\n" +
+	"\tdeadlines\x18\x02 \x01(\v2*.universal.gateway.conf.Upstream.DeadlinesR\tdeadlines\x12<\n" +
+	"\x05retry\x18\x03 \x01(\v2&.universal.gateway.conf.Upstream.RetryR\x05retry\x12B\n" +
+	"\abreaker\x18\x04 \x01(\v2(.universal.gateway.conf.Upstream.BreakerR\abreaker\x12!\n" +
+	"\flong_methods\x18\x05 \x03(\tR\vlongMethods\x1a\x9a\x01\n" +
+	"\tDeadlines\x12-\n" +
+	"\x04read\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x04read\x12/\n" +
+	"\x05write\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x05write\x12-\n" +
+	"\x04long\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x04long\x1a\xaa\x01\n" +
+	"\x05Retry\x12!\n" +
+	"\fmax_attempts\x18\x01 \x01(\x05R\vmaxAttempts\x12B\n" +
+	"\x0finitial_backoff\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0einitialBackoff\x12:\n" +
+	"\vmax_backoff\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"maxBackoff\x1a\x8c\x01\n" +
+	"\aBreaker\x12\x1a\n" +
+	"\bdisabled\x18\x01 \x01(\bR\bdisabled\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\x01R\asuccess\x12\x18\n" +
+	"\arequest\x18\x03 \x01(\x03R\arequest\x121\n" +
+	"\x06window\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x06windowB*Z(universal/app/gateway/internal/conf;confb\x06proto3"
+
+var (
+	file_app_gateway_internal_conf_gateway_proto_rawDescOnce sync.Once
+	file_app_gateway_internal_conf_gateway_proto_rawDescData []byte
+)
+
+func file_app_gateway_internal_conf_gateway_proto_rawDescGZIP() []byte {
+	file_app_gateway_internal_conf_gateway_proto_rawDescOnce.Do(func() {
+		file_app_gateway_internal_conf_gateway_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_app_gateway_internal_conf_gateway_proto_rawDesc), len(file_app_gateway_internal_conf_gateway_proto_rawDesc)))
+	})
+	return file_app_gateway_internal_conf_gateway_proto_rawDescData
+}
+
+var file_app_gateway_internal_conf_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_app_gateway_internal_conf_gateway_proto_goTypes = []any{
+	(*Bootstrap)(nil),            // 0: universal.gateway.conf.Bootstrap
+	(*Server)(nil),               // 1: universal.gateway.conf.Server
+	(*Data)(nil),                 // 2: universal.gateway.conf.Data
+	(*Registry)(nil),             // 3: universal.gateway.conf.Registry
+	(*Trace)(nil),                // 4: universal.gateway.conf.Trace
+	(*RateLimit)(nil),            // 5: universal.gateway.conf.RateLimit
+	(*Auth)(nil),                 // 6: universal.gateway.conf.Auth
+	(*Upstream)(nil),             // 7: universal.gateway.conf.Upstream
+	(*Server_HTTP)(nil),          // 8: universal.gateway.conf.Server.HTTP
+	(*Server_GRPC)(nil),          // 9: universal.gateway.conf.Server.GRPC
+	(*Server_Admin)(nil),         // 10: universal.gateway.conf.Server.Admin
+	(*Data_Database)(nil),        // 11: universal.gateway.conf.Data.Database
+	(*Data_Redis)(nil),           // 12: universal.gateway.conf.Data.Redis
+	(*Registry_Consul)(nil),      // 13: universal.gateway.conf.Registry.Consul
+	(*Registry_Etcd)(nil),        // 14: universal.gateway.conf.Registry.Etcd
+	(*Registry_Service)(nil),     // 15: universal.gateway.conf.Registry.Service
+	(*RateLimit_Bucket)(nil),     // 16: universal.gateway.conf.RateLimit.Bucket
+	(*RateLimit_BruteForce)(nil), // 17: universal.gateway.conf.RateLimit.BruteForce
+	(*RateLimit_Route)(nil),      // 18: universal.gateway.conf.RateLimit.Route
+	(*Upstream_Deadlines)(nil),   // 19: universal.gateway.conf.Upstream.Deadlines
+	(*Upstream_Retry)(nil),       // 20: universal.gateway.conf.Upstream.Retry
+	(*Upstream_Breaker)(nil),     // 21: universal.gateway.conf.Upstream.Breaker
+	(*durationpb.Duration)(nil),  // 22: google.protobuf.Duration
+}
+var file_app_gateway_internal_conf_gateway_proto_depIdxs = []int32{
+	1,  // 0: universal.gateway.conf.Bootstrap.server:type_name -> universal.gateway.conf.Server
+	2,  // 1: universal.gateway.conf.Bootstrap.data:type_name -> universal.gateway.conf.Data
+	3,  // 2: universal.gateway.conf.Bootstrap.registry:type_name -> universal.gateway.conf.Registry
+	4,  // 3: universal.gateway.conf.Bootstrap.trace:type_name -> universal.gateway.conf.Trace
+	5,  // 4: universal.gateway.conf.Bootstrap.rate_limit:type_name -> universal.gateway.conf.RateLimit
+	7,  // 5: universal.gateway.conf.Bootstrap.upstream:type_name -> universal.gateway.conf.Upstream
+	6,  // 6: universal.gateway.conf.Bootstrap.auth:type_name -> universal.gateway.conf.Auth
+	8,  // 7: universal.gateway.conf.Server.http:type_name -> universal.gateway.conf.Server.HTTP
+	9,  // 8: universal.gateway.conf.Server.grpc:type_name -> universal.gateway.conf.Server.GRPC
+	10, // 9: universal.gateway.conf.Server.admin:type_name -> universal.gateway.conf.Server.Admin
+	11, // 10: universal.gateway.conf.Data.database:type_name -> universal.gateway.conf.Data.Database
+	12, // 11: universal.gateway.conf.Data.redis:type_name -> universal.gateway.conf.Data.Redis
+	13, // 12: universal.gateway.conf.Registry.consul:type_name -> universal.gateway.conf.Registry.Consul
+	14, // 13: universal.gateway.conf.Registry.etcd:type_name -> universal.gateway.conf.Registry.Etcd
+	15, // 14: universal.gateway.conf.Registry.static:type_name -> universal.gateway.conf.Registry.Service
+	16, // 15: universal.gateway.conf.RateLimit.per_ip:type_name -> universal.gateway.conf.RateLimit.Bucket
+	16, // 16: universal.gateway.conf.RateLimit.per_user:type_name -> universal.gateway.conf.RateLimit.Bucket
+	16, // 17: universal.gateway.conf.RateLimit.per_key:type_name -> universal.gateway.conf.RateLimit.Bucket
+	18, // 18: universal.gateway.conf.RateLimit.routes:type_name -> universal.gateway.conf.RateLimit.Route
+	22, // 19: universal.gateway.conf.Auth.max_skew:type_name -> google.protobuf.Duration
+	19, // 20: universal.gateway.conf.Upstream.deadlines:type_name -> universal.gateway.conf.Upstream.Deadlines
+	20, // 21: universal.gateway.conf.Upstream.retry:type_name -> universal.gateway.conf.Upstream.Retry
+	21, // 22: universal.gateway.conf.Upstream.breaker:type_name -> universal.gateway.conf.Upstream.Breaker
+	22, // 23: universal.gateway.conf.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	22, // 24: universal.gateway.conf.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	22, // 25: universal.gateway.conf.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	22, // 26: universal.gateway.conf.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	22, // 27: universal.gateway.conf.Registry.Consul.health_check_interval:type_name -> google.protobuf.Duration
+	22, // 28: universal.gateway.conf.Registry.Etcd.dial_timeout:type_name -> google.protobuf.Duration
+	22, // 29: universal.gateway.conf.RateLimit.BruteForce.window:type_name -> google.protobuf.Duration
+	22, // 30: universal.gateway.conf.RateLimit.BruteForce.lockout:type_name -> google.protobuf.Duration
+	16, // 31: universal.gateway.conf.RateLimit.Route.per_ip:type_name -> universal.gateway.conf.RateLimit.Bucket
+	16, // 32: universal.gateway.conf.RateLimit.Route.per_user:type_name -> universal.gateway.conf.RateLimit.Bucket
+	16, // 33: universal.gateway.conf.RateLimit.Route.per_key:type_name -> universal.gateway.conf.RateLimit.Bucket
+	17, // 34: universal.gateway.conf.RateLimit.Route.brute_force:type_name -> universal.gateway.conf.RateLimit.BruteForce
+	22, // 35: universal.gateway.conf.Upstream.Deadlines.read:type_name -> google.protobuf.Duration
+	22, // 36: universal.gateway.conf.Upstream.Deadlines.write:type_name -> google.protobuf.Duration
+	22, // 37: universal.gateway.conf.Upstream.Deadlines.long:type_name -> google.protobuf.Duration
+	22, // 38: universal.gateway.conf.Upstream.Retry.initial_backoff:type_name -> google.protobuf.Duration
+	22, // 39: universal.gateway.conf.Upstream.Retry.max_backoff:type_name -> google.protobuf.Duration
+	22, // 40: universal.gateway.conf.Upstream.Breaker.window:type_name -> google.protobuf.Duration
+	41, // [41:41] is the sub-list for method output_type
+	41, // [41:41] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
+}
+
+func init() { file_app_gateway_internal_conf_gateway_proto_init() }
+func file_app_gateway_internal_conf_gateway_proto_init() {
+	if File_app_gateway_internal_conf_gateway_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_app_gateway_internal_conf_gateway_proto_rawDesc), len(file_app_gateway_internal_conf_gateway_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   22,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_app_gateway_internal_conf_gateway_proto_goTypes,
+		DependencyIndexes: file_app_gateway_internal_conf_gateway_proto_depIdxs,
+		MessageInfos:      file_app_gateway_internal_conf_gateway_proto_msgTypes,
+	}.Build()
+	File_app_gateway_internal_conf_gateway_proto = out.File
+	file_app_gateway_internal_conf_gateway_proto_goTypes = nil
+	file_app_gateway_internal_conf_gateway_proto_depIdxs = nil
+}
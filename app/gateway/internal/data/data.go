@@ -1,27 +1,26 @@
 package data
 
 import (
-	"context"
 	aiv1 "universal/api/ai/v1"
 	userv1 "universal/api/user/v1"
 	"universal/app/gateway/internal/conf"
+	"universal/pkg/discovery"
 
-	"github.com/go-kratos/kratos/contrib/registry/consul/v2"
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/registry"
-	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/go-redis/redis/v8"
 	"github.com/google/wire"
-	consulAPI "github.com/hashicorp/consul/api"
 )
 
 // ProviderSet is data providers.
 var ProviderSet = wire.NewSet(
-	NewData, NewGreeterRepo, NewUserRepo, NewGatewayRepo,
+	NewData, NewGreeterRepo, NewUserRepo, NewGatewayRepo, NewEdgeLimitRepo,
+	NewRegistry,
 	NewDiscovery,
 	NewRegistrar,
+	NewClientPool,
 	NewUserServiceClient,
+	NewWorkspaceServiceClient,
 	NewAiServiceClient,
 	NewConversationServiceClient,
 	NewModelServiceClient,
@@ -31,20 +30,24 @@ var ProviderSet = wire.NewSet(
 
 // Data .
 type Data struct {
-	rdb *redis.Client
-	uc  userv1.UserClient
-	aic aiv1.AiClient
-	cc  aiv1.ConversationClient
-	mc  aiv1.ModelClient
-	kc  aiv1.KnowledgeClient
-	tc  aiv1.ToolClient
+	rdb       *redis.Client
+	discovery registry.Discovery
+	uc        userv1.UserClient
+	wc        userv1.WorkspaceClient
+	aic       aiv1.AiClient
+	cc        aiv1.ConversationClient
+	mc        aiv1.ModelClient
+	kc        aiv1.KnowledgeClient
+	tc        aiv1.ToolClient
 }
 
 // NewData .
 func NewData(
 	c *conf.Data,
 	logger log.Logger,
+	discovery registry.Discovery,
 	uc userv1.UserClient,
+	wc userv1.WorkspaceClient,
 	aic aiv1.AiClient,
 	cc aiv1.ConversationClient,
 	mc aiv1.ModelClient,
@@ -66,13 +69,15 @@ func NewData(
 		helper.Info("closing the data resources")
 	}
 	d := &Data{
-		rdb: rdb,
-		uc:  uc,
-		aic: aic,
-		cc:  cc,
-		mc:  mc,
-		kc:  kc,
-		tc:  tc,
+		rdb:       rdb,
+		discovery: discovery,
+		uc:        uc,
+		wc:        wc,
+		aic:       aic,
+		cc:        cc,
+		mc:        mc,
+		kc:        kc,
+		tc:        tc,
 	}
 
 	//cleanup := func() {
@@ -86,6 +91,10 @@ func (d *Data) UserClient() userv1.UserClient {
 	return d.uc
 }
 
+func (d *Data) WorkspaceClient() userv1.WorkspaceClient {
+	return d.wc
+}
+
 func (d *Data) AiClient() aiv1.AiClient {
 	return d.aic
 }
@@ -106,122 +115,68 @@ func (d *Data) ToolClient() aiv1.ToolClient {
 	return d.tc
 }
 
-func NewDiscovery(conf *conf.Registry) registry.Discovery {
-	c := consulAPI.DefaultConfig()
-	c.Address = conf.Consul.Address
-	c.Scheme = conf.Consul.Scheme
-	cli, err := consulAPI.NewClient(c)
-	if err != nil {
-		panic(err)
+// NewRegistry 按配置创建服务注册与发现
+func NewRegistry(c *conf.Registry) (discovery.Registry, error) {
+	o := discovery.Options{
+		Type: c.GetType(),
+		Consul: discovery.ConsulOptions{
+			Address:             c.GetConsul().GetAddress(),
+			Scheme:              c.GetConsul().GetScheme(),
+			DisableHealthCheck:  c.GetConsul().GetDisableHealthCheck(),
+			HealthCheckInterval: c.GetConsul().GetHealthCheckInterval().AsDuration(),
+		},
+		Etcd: discovery.EtcdOptions{
+			Endpoints:   c.GetEtcd().GetEndpoints(),
+			DialTimeout: c.GetEtcd().GetDialTimeout().AsDuration(),
+			Username:    c.GetEtcd().GetUsername(),
+			Password:    c.GetEtcd().GetPassword(),
+		},
+		Static: make(map[string][]string),
 	}
-	r := consul.New(cli, consul.WithHealthCheck(false))
+	for _, s := range c.GetStatic() {
+		o.Static[s.Name] = append(o.Static[s.Name], s.Endpoints...)
+	}
+	return discovery.New(o)
+}
+
+func NewDiscovery(r discovery.Registry) registry.Discovery {
 	return r
 }
 
-func NewRegistrar(conf *conf.Registry) registry.Registrar {
-	c := consulAPI.DefaultConfig()
-	c.Address = conf.Consul.Address
-	c.Scheme = conf.Consul.Scheme
-	cli, err := consulAPI.NewClient(c)
-	if err != nil {
-		panic(err)
-	}
-	r := consul.New(cli, consul.WithHealthCheck(false))
+func NewRegistrar(r discovery.Registry) registry.Registrar {
 	return r
 }
 
-func NewUserServiceClient(r registry.Discovery) userv1.UserClient {
-	conn, err := grpc.DialInsecure(
-		context.Background(),
-		grpc.WithEndpoint("discovery:///universal.user.service"),
-		grpc.WithDiscovery(r),
-		grpc.WithMiddleware(
-			recovery.Recovery(),
-		),
-	)
-	if err != nil {
-		panic(err)
-	}
-	c := userv1.NewUserClient(conn)
-	return c
+func NewUserServiceClient(p *ClientPool) userv1.UserClient {
+	return userv1.NewUserClient(p.Conn(userServiceName))
+}
+
+// 工作空间服务客户端
+func NewWorkspaceServiceClient(p *ClientPool) userv1.WorkspaceClient {
+	return userv1.NewWorkspaceClient(p.Conn(userServiceName))
 }
 
 // AI服务客户端
-func NewAiServiceClient(r registry.Discovery) aiv1.AiClient {
-	conn, err := grpc.DialInsecure(
-		context.Background(),
-		grpc.WithEndpoint("discovery:///universal.ai.service"),
-		grpc.WithDiscovery(r),
-		grpc.WithMiddleware(
-			recovery.Recovery(),
-		),
-	)
-	if err != nil {
-		panic(err)
-	}
-	return aiv1.NewAiClient(conn)
+func NewAiServiceClient(p *ClientPool) aiv1.AiClient {
+	return aiv1.NewAiClient(p.Conn(aiServiceName))
 }
 
 // 对话服务客户端
-func NewConversationServiceClient(r registry.Discovery) aiv1.ConversationClient {
-	conn, err := grpc.DialInsecure(
-		context.Background(),
-		grpc.WithEndpoint("discovery:///universal.ai.service"),
-		grpc.WithDiscovery(r),
-		grpc.WithMiddleware(
-			recovery.Recovery(),
-		),
-	)
-	if err != nil {
-		panic(err)
-	}
-	return aiv1.NewConversationClient(conn)
+func NewConversationServiceClient(p *ClientPool) aiv1.ConversationClient {
+	return aiv1.NewConversationClient(p.Conn(aiServiceName))
 }
 
 // 模型服务客户端
-func NewModelServiceClient(r registry.Discovery) aiv1.ModelClient {
-	conn, err := grpc.DialInsecure(
-		context.Background(),
-		grpc.WithEndpoint("discovery:///universal.ai.service"),
-		grpc.WithDiscovery(r),
-		grpc.WithMiddleware(
-			recovery.Recovery(),
-		),
-	)
-	if err != nil {
-		panic(err)
-	}
-	return aiv1.NewModelClient(conn)
+func NewModelServiceClient(p *ClientPool) aiv1.ModelClient {
+	return aiv1.NewModelClient(p.Conn(aiServiceName))
 }
 
 // 知识库服务客户端
-func NewKnowledgeServiceClient(r registry.Discovery) aiv1.KnowledgeClient {
-	conn, err := grpc.DialInsecure(
-		context.Background(),
-		grpc.WithEndpoint("discovery:///universal.ai.service"),
-		grpc.WithDiscovery(r),
-		grpc.WithMiddleware(
-			recovery.Recovery(),
-		),
-	)
-	if err != nil {
-		panic(err)
-	}
-	return aiv1.NewKnowledgeClient(conn)
+func NewKnowledgeServiceClient(p *ClientPool) aiv1.KnowledgeClient {
+	return aiv1.NewKnowledgeClient(p.Conn(aiServiceName))
 }
 
 // 工具服务客户端
-func NewToolServiceClient(r registry.Discovery) aiv1.ToolClient {
-	conn, err := grpc.DialInsecure(
-		context.Background(),
-		grpc.WithEndpoint("discovery:///universal.ai.service"),
-		grpc.WithDiscovery(r),
-		grpc.WithMiddleware(
-			recovery.Recovery(),
-		),
-	)
-	if err != nil {
-		panic(err)
-	}
-	return aiv1.NewToolClient(conn)
+func NewToolServiceClient(p *ClientPool) aiv1.ToolClient {
+	return aiv1.NewToolClient(p.Conn(aiServiceName))
 }
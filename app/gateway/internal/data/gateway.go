@@ -2,18 +2,52 @@ package data
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 	pb "universal/api/gateway/v1"
 	"universal/app/gateway/internal/biz"
+	"universal/pkg/discovery"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/registry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
 )
 
 var gatewayStartTime = time.Now()
 
+const (
+	// healthCheckTimeout 单个实例健康检查的超时时间
+	healthCheckTimeout = 2 * time.Second
+	// healthCacheTTL 健康检查结果的缓存时间，避免频繁探测压垮后端
+	healthCacheTTL = 5 * time.Second
+)
+
+// backendService 需要检查的后端服务
+type backendService struct {
+	name    string
+	service string
+}
+
+var backendServices = []backendService{
+	{name: "user-service", service: userServiceName},
+	{name: "ai-service", service: aiServiceName},
+}
+
 type gatewayRepo struct {
 	data *Data
 	log  *log.Helper
+
+	mu        sync.Mutex
+	statuses  []*pb.ServiceStatus
+	checkedAt time.Time
 }
 
 // NewGatewayRepo 创建网关仓储
@@ -24,18 +58,27 @@ func NewGatewayRepo(data *Data, logger log.Logger) biz.GatewayRepo {
 	}
 }
 
-// GetServiceStatuses 获取后端服务状态
+// GetServiceStatuses 获取后端服务状态，通过 grpc.health.v1 检查注册中心中的每个实例
 func (r *gatewayRepo) GetServiceStatuses(ctx context.Context) ([]*pb.ServiceStatus, error) {
-	services := make([]*pb.ServiceStatus, 0)
-
-	// 检查用户服务状态
-	userStatus := r.checkUserServiceHealth(ctx)
-	services = append(services, userStatus)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.statuses != nil && time.Since(r.checkedAt) < healthCacheTTL {
+		return r.statuses, nil
+	}
 
-	// 检查AI服务状态
-	aiStatus := r.checkAiServiceHealth(ctx)
-	services = append(services, aiStatus)
+	services := make([]*pb.ServiceStatus, len(backendServices))
+	var wg sync.WaitGroup
+	for i, svc := range backendServices {
+		wg.Add(1)
+		go func(i int, svc backendService) {
+			defer wg.Done()
+			services[i] = r.checkService(ctx, svc)
+		}(i, svc)
+	}
+	wg.Wait()
 
+	r.statuses = services
+	r.checkedAt = time.Now()
 	return services, nil
 }
 
@@ -44,74 +87,155 @@ func (r *gatewayRepo) GetStartTime(ctx context.Context) time.Time {
 	return gatewayStartTime
 }
 
-// 检查用户服务健康状态
-func (r *gatewayRepo) checkUserServiceHealth(ctx context.Context) *pb.ServiceStatus {
+// checkService 检查服务的全部实例，全部健康为 healthy，全部不可用为 unhealthy，其余为 degraded
+func (r *gatewayRepo) checkService(ctx context.Context, svc backendService) *pb.ServiceStatus {
 	start := time.Now()
 	status := &pb.ServiceStatus{
-		Name:     "user-service",
-		Endpoint: "universal.user.service",
+		Name:     svc.name,
+		Endpoint: svc.service,
 	}
-
-	// 这里应该调用用户服务的健康检查接口，暂时模拟
-	// 在实际实现中，可以调用用户服务的健康检查端点
 	defer func() {
 		status.ResponseTimeMs = int32(time.Since(start).Milliseconds())
 	}()
 
-	// 尝试连接用户服务
-	if r.data.uc != nil {
-		status.Status = "healthy"
-		status.Message = "User service is responding"
-	} else {
+	instances, err := r.data.discovery.GetService(ctx, svc.service)
+	if err != nil {
+		r.log.WithContext(ctx).Warnf("failed to discover %s: %v", svc.service, err)
 		status.Status = "unhealthy"
-		status.Message = "User service client not available"
+		status.Message = fmt.Sprintf("Service discovery failed: %v", err)
+		return status
+	}
+	if len(instances) == 0 {
+		status.Status = "unhealthy"
+		status.Message = "No registered instances"
+		return status
 	}
 
+	dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if discovery.InProcess(r.data.discovery) {
+		dialOpts = append(dialOpts, discovery.DialOption())
+	}
+	status.Instances = make([]*pb.InstanceStatus, len(instances))
+	var wg sync.WaitGroup
+	for i, ins := range instances {
+		wg.Add(1)
+		go func(i int, ins *registry.ServiceInstance) {
+			defer wg.Done()
+			status.Instances[i] = checkInstance(ctx, ins, dialOpts...)
+		}(i, ins)
+	}
+	wg.Wait()
+
+	healthy, unhealthy := 0, 0
+	for _, ins := range status.Instances {
+		switch ins.Status {
+		case "healthy":
+			healthy++
+		case "unhealthy":
+			unhealthy++
+		}
+	}
+	switch {
+	case healthy == len(instances):
+		status.Status = "healthy"
+		status.Message = fmt.Sprintf("All %d instances are healthy", len(instances))
+	case unhealthy == len(instances):
+		status.Status = "unhealthy"
+		status.Message = fmt.Sprintf("All %d instances are unhealthy", len(instances))
+	default:
+		status.Status = "degraded"
+		status.Message = fmt.Sprintf("%d of %d instances are healthy", healthy, len(instances))
+	}
 	return status
 }
 
-// 检查AI服务健康状态
-func (r *gatewayRepo) checkAiServiceHealth(ctx context.Context) *pb.ServiceStatus {
+// checkInstance 查询实例的整体与各依赖健康状态，非关键依赖异常时实例为 degraded
+func checkInstance(ctx context.Context, ins *registry.ServiceInstance, opts ...grpc.DialOption) *pb.InstanceStatus {
 	start := time.Now()
-	status := &pb.ServiceStatus{
-		Name:     "ai-service",
-		Endpoint: "universal.ai.service",
+	status := &pb.InstanceStatus{
+		Id:     ins.ID,
+		Status: "unhealthy",
 	}
-
 	defer func() {
 		status.ResponseTimeMs = int32(time.Since(start).Milliseconds())
 	}()
 
-	// 检查AI服务各个客户端的可用性
-	healthyCount := 0
-	totalClients := 5
+	status.Endpoint = grpcEndpoint(ins.Endpoints)
+	if status.Endpoint == "" {
+		status.Message = "No gRPC endpoint registered"
+		return status
+	}
 
-	if r.data.aic != nil {
-		healthyCount++
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+	// 直接拨注册的地址，不经过 DNS 解析，进程内地址也由拨号器处理
+	conn, err := grpc.NewClient("passthrough:///"+status.Endpoint, opts...)
+	if err != nil {
+		status.Message = err.Error()
+		return status
 	}
-	if r.data.cc != nil {
-		healthyCount++
+	defer conn.Close()
+
+	statuses, err := listHealth(ctx, grpc_health_v1.NewHealthClient(conn))
+	if err != nil {
+		status.Message = fmt.Sprintf("Health check failed: %v", err)
+		return status
 	}
-	if r.data.mc != nil {
-		healthyCount++
+
+	var failing []string
+	status.Dependencies = make(map[string]string, len(statuses))
+	for name, s := range statuses {
+		if name == "" {
+			continue
+		}
+		status.Dependencies[name] = strings.ToLower(s.String())
+		if s != grpc_health_v1.HealthCheckResponse_SERVING {
+			failing = append(failing, name)
+		}
 	}
-	if r.data.kc != nil {
-		healthyCount++
+	sort.Strings(failing)
+
+	switch {
+	case statuses[""] != grpc_health_v1.HealthCheckResponse_SERVING:
+		status.Message = "Instance is not serving"
+	case len(failing) > 0:
+		status.Status = "degraded"
+	default:
+		status.Status = "healthy"
 	}
-	if r.data.tc != nil {
-		healthyCount++
+	if len(failing) > 0 {
+		status.Message = "Failing dependencies: " + strings.Join(failing, ", ")
 	}
+	return status
+}
 
-	if healthyCount == totalClients {
-		status.Status = "healthy"
-		status.Message = "AI service is fully available"
-	} else if healthyCount > 0 {
-		status.Status = "degraded"
-		status.Message = "AI service is partially available"
-	} else {
-		status.Status = "unhealthy"
-		status.Message = "AI service is not available"
+// listHealth 通过 List 获取全部健康状态，实例不支持 List 时退回只查询整体状态
+func listHealth(ctx context.Context, client grpc_health_v1.HealthClient) (map[string]grpc_health_v1.HealthCheckResponse_ServingStatus, error) {
+	reply, err := client.List(ctx, &grpc_health_v1.HealthListRequest{})
+	if status.Code(err) == codes.Unimplemented {
+		resp, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+		if err != nil {
+			return nil, err
+		}
+		return map[string]grpc_health_v1.HealthCheckResponse_ServingStatus{"": resp.Status}, nil
+	}
+	if err != nil {
+		return nil, err
 	}
+	statuses := make(map[string]grpc_health_v1.HealthCheckResponse_ServingStatus, len(reply.Statuses))
+	for name, resp := range reply.Statuses {
+		statuses[name] = resp.Status
+	}
+	return statuses, nil
+}
 
-	return status
+// grpcEndpoint 从注册的端点中取 gRPC 地址
+func grpcEndpoint(endpoints []string) string {
+	for _, e := range endpoints {
+		u, err := url.Parse(e)
+		if err == nil && u.Scheme == "grpc" {
+			return u.Host
+		}
+	}
+	return ""
 }
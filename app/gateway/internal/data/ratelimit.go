@@ -0,0 +1,150 @@
+package data
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	"universal/app/gateway/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+)
+
+// edgeLimitPrefix 入口限流的Redis键前缀
+const edgeLimitPrefix = "gateway:ratelimit:"
+
+// takeScript 按经过的时间补充各令牌桶后，全部有令牌时各扣减一个。
+// ARGV[1] 为当前毫秒时间戳，之后每个桶依次为速率与容量，返回是否放行及各桶剩余令牌数。
+var takeScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local tokens = {}
+local stamps = {}
+local allowed = 1
+for i = 1, #KEYS do
+  local rate = tonumber(ARGV[i * 2])
+  local burst = tonumber(ARGV[i * 2 + 1])
+  local state = redis.call('HMGET', KEYS[i], 't', 'ts')
+  local t = tonumber(state[1])
+  local ts = tonumber(state[2])
+  if t == nil or ts == nil then
+    t = burst
+    ts = now
+  end
+  if now > ts then
+    t = math.min(burst, t + (now - ts) * rate / 1000)
+    ts = now
+  end
+  tokens[i] = t
+  stamps[i] = ts
+  if t < 1 then
+    allowed = 0
+  end
+end
+local result = {allowed}
+for i = 1, #KEYS do
+  local rate = tonumber(ARGV[i * 2])
+  local burst = tonumber(ARGV[i * 2 + 1])
+  local t = tokens[i]
+  if allowed == 1 then
+    t = t - 1
+  end
+  redis.call('HSET', KEYS[i], 't', tostring(t), 'ts', tostring(stamps[i]))
+  redis.call('PEXPIRE', KEYS[i], math.ceil(burst * 1000 / rate) + 1000)
+  result[i + 1] = tostring(t)
+end
+return result
+`)
+
+// failureScript 累加失败次数，达到上限时写入锁定键并清除计数
+var failureScript = redis.NewScript(`
+local n = redis.call('INCR', KEYS[1])
+if n == 1 then
+  redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+if n >= tonumber(ARGV[1]) then
+  redis.call('SET', KEYS[2], '1', 'PX', ARGV[3])
+  redis.call('DEL', KEYS[1])
+  return 1
+end
+return 0
+`)
+
+type edgeLimitRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewEdgeLimitRepo 创建入口限流仓储
+func NewEdgeLimitRepo(data *Data, logger log.Logger) biz.EdgeLimitRepo {
+	return &edgeLimitRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *edgeLimitRepo) Take(ctx context.Context, buckets []*biz.TokenBucket, now time.Time) (*biz.BucketState, error) {
+	keys := make([]string, len(buckets))
+	args := make([]interface{}, 0, 1+2*len(buckets))
+	args = append(args, now.UnixMilli())
+	for i, b := range buckets {
+		keys[i] = edgeLimitPrefix + "bucket:" + b.Key
+		args = append(args, b.Rate, b.Burst)
+	}
+	values, err := takeScript.Run(ctx, r.data.rdb, keys, args...).Slice()
+	if err != nil {
+		return nil, err
+	}
+
+	state := &biz.BucketState{Allowed: values[0].(int64) == 1}
+	var tightest *biz.TokenBucket
+	tightestTokens := math.Inf(1)
+	for i, b := range buckets {
+		tokens, err := strconv.ParseFloat(values[i+1].(string), 64)
+		if err != nil {
+			return nil, err
+		}
+		if tokens < 1 {
+			// 令牌恢复到1个所需的时间，多个桶不足时取最长的
+			if wait := seconds((1 - tokens) / b.Rate); wait > state.RetryAfter {
+				state.RetryAfter = wait
+			}
+		}
+		if tokens < tightestTokens {
+			tightest, tightestTokens = b, tokens
+		}
+	}
+	state.Limit = tightest.Burst
+	state.Remaining = int64(math.Max(0, math.Floor(tightestTokens)))
+	state.Reset = seconds((float64(tightest.Burst) - tightestTokens) / tightest.Rate)
+	return state, nil
+}
+
+func (r *edgeLimitRepo) LockedFor(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := r.data.rdb.PTTL(ctx, edgeLimitPrefix+"lock:"+key).Result()
+	if err != nil {
+		return 0, err
+	}
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+func (r *edgeLimitRepo) RecordFailure(ctx context.Context, key string, maxFailures int64, window, lockout time.Duration) (bool, error) {
+	keys := []string{edgeLimitPrefix + "fail:" + key, edgeLimitPrefix + "lock:" + key}
+	locked, err := failureScript.Run(ctx, r.data.rdb, keys, maxFailures, window.Milliseconds(), lockout.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+	return locked == 1, nil
+}
+
+func (r *edgeLimitRepo) ResetFailures(ctx context.Context, key string) error {
+	return r.data.rdb.Del(ctx, edgeLimitPrefix+"fail:"+key).Err()
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(math.Ceil(s * float64(time.Second)))
+}
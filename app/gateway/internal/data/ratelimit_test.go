@@ -0,0 +1,167 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"universal/app/gateway/internal/biz"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+)
+
+func newTestEdgeLimit(t *testing.T) (*edgeLimitRepo, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	return NewEdgeLimitRepo(&Data{rdb: rdb}, log.DefaultLogger).(*edgeLimitRepo), mr
+}
+
+func mustTake(t *testing.T, repo *edgeLimitRepo, buckets []*biz.TokenBucket, now time.Time) *biz.BucketState {
+	t.Helper()
+	state, err := repo.Take(context.Background(), buckets, now)
+	if err != nil {
+		t.Fatalf("Take: %v", err)
+	}
+	return state
+}
+
+func TestEdgeLimitTakeBurstAndRefill(t *testing.T) {
+	repo, _ := newTestEdgeLimit(t)
+	bucket := &biz.TokenBucket{Key: "default:ip:1.2.3.4", Rate: 1, Burst: 2}
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 2; i++ {
+		state := mustTake(t, repo, []*biz.TokenBucket{bucket}, now)
+		if !state.Allowed {
+			t.Fatalf("request %d rejected within burst", i+1)
+		}
+		if want := int64(1 - i); state.Remaining != want {
+			t.Fatalf("request %d: remaining = %d, want %d", i+1, state.Remaining, want)
+		}
+	}
+
+	state := mustTake(t, repo, []*biz.TokenBucket{bucket}, now)
+	if state.Allowed {
+		t.Fatal("request beyond burst allowed")
+	}
+	if state.RetryAfter <= 0 || state.RetryAfter > time.Second {
+		t.Fatalf("retry after = %s, want (0, 1s]", state.RetryAfter)
+	}
+	if state.Limit != 2 {
+		t.Fatalf("limit = %d, want 2", state.Limit)
+	}
+
+	state = mustTake(t, repo, []*biz.TokenBucket{bucket}, now.Add(time.Second))
+	if !state.Allowed {
+		t.Fatal("request rejected after one token was refilled")
+	}
+}
+
+func TestEdgeLimitTakeAllOrNothing(t *testing.T) {
+	repo, _ := newTestEdgeLimit(t)
+	ip := &biz.TokenBucket{Key: "default:ip:1.2.3.4", Rate: 1, Burst: 5}
+	user := &biz.TokenBucket{Key: "default:user:7", Rate: 1, Burst: 1}
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	if state := mustTake(t, repo, []*biz.TokenBucket{ip, user}, now); !state.Allowed {
+		t.Fatal("first request rejected")
+	}
+	state := mustTake(t, repo, []*biz.TokenBucket{ip, user}, now)
+	if state.Allowed {
+		t.Fatal("request allowed with an empty user bucket")
+	}
+	if state.Remaining != 0 {
+		t.Fatalf("remaining = %d, want 0 from the empty user bucket", state.Remaining)
+	}
+
+	// 被拒绝的请求不扣减其他桶的令牌
+	state = mustTake(t, repo, []*biz.TokenBucket{ip}, now)
+	if !state.Allowed || state.Remaining != 3 {
+		t.Fatalf("ip bucket: allowed = %v remaining = %d, want true 3", state.Allowed, state.Remaining)
+	}
+}
+
+func TestEdgeLimitFailureLockout(t *testing.T) {
+	repo, mr := newTestEdgeLimit(t)
+	ctx := context.Background()
+	key := "change-password:7:1.2.3.4"
+
+	for i := 1; i <= 3; i++ {
+		locked, err := repo.RecordFailure(ctx, key, 3, time.Minute, 5*time.Minute)
+		if err != nil {
+			t.Fatalf("RecordFailure: %v", err)
+		}
+		if locked != (i == 3) {
+			t.Fatalf("failure %d: locked = %v", i, locked)
+		}
+	}
+	ttl, err := repo.LockedFor(ctx, key)
+	if err != nil {
+		t.Fatalf("LockedFor: %v", err)
+	}
+	if ttl <= 4*time.Minute || ttl > 5*time.Minute {
+		t.Fatalf("lockout ttl = %s, want about 5m", ttl)
+	}
+
+	// 其他账户不受影响
+	if ttl, _ := repo.LockedFor(ctx, "change-password:8:1.2.3.4"); ttl != 0 {
+		t.Fatalf("other account locked for %s", ttl)
+	}
+
+	mr.FastForward(5 * time.Minute)
+	if ttl, _ := repo.LockedFor(ctx, key); ttl != 0 {
+		t.Fatalf("still locked for %s after lockout expired", ttl)
+	}
+}
+
+func TestEdgeLimitFailureWindowExpires(t *testing.T) {
+	repo, mr := newTestEdgeLimit(t)
+	ctx := context.Background()
+	key := "change-password:7:1.2.3.4"
+
+	for i := 0; i < 2; i++ {
+		if _, err := repo.RecordFailure(ctx, key, 3, time.Minute, time.Minute); err != nil {
+			t.Fatalf("RecordFailure: %v", err)
+		}
+	}
+	mr.FastForward(time.Minute)
+	locked, err := repo.RecordFailure(ctx, key, 3, time.Minute, time.Minute)
+	if err != nil {
+		t.Fatalf("RecordFailure: %v", err)
+	}
+	if locked {
+		t.Fatal("locked by failures from an expired window")
+	}
+}
+
+func TestEdgeLimitObserveResetsOnlyOnSuccess(t *testing.T) {
+	repo, _ := newTestEdgeLimit(t)
+	uc := biz.NewEdgeLimitUsecase(repo, log.DefaultLogger)
+	ctx := context.Background()
+	key := "change-password:7:1.2.3.4"
+	observe := func(outcome biz.AuthOutcome) {
+		uc.Observe(ctx, key, outcome, 3, time.Minute, time.Minute)
+	}
+
+	observe(biz.AuthFailed)
+	observe(biz.AuthFailed)
+	// 参数错误等与认证无关的响应不能清零计数
+	observe(biz.AuthOther)
+	observe(biz.AuthFailed)
+	if uc.Locked(ctx, key) == 0 {
+		t.Fatal("not locked after max failures interleaved with unrelated responses")
+	}
+
+	other := "change-password:8:1.2.3.4"
+	uc.Observe(ctx, other, biz.AuthFailed, 3, time.Minute, time.Minute)
+	uc.Observe(ctx, other, biz.AuthFailed, 3, time.Minute, time.Minute)
+	uc.Observe(ctx, other, biz.AuthSucceeded, 3, time.Minute, time.Minute)
+	uc.Observe(ctx, other, biz.AuthFailed, 3, time.Minute, time.Minute)
+	if ttl := uc.Locked(ctx, other); ttl != 0 {
+		t.Fatalf("locked for %s although a successful auth reset the failures", ttl)
+	}
+}
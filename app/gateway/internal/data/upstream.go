@@ -0,0 +1,255 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"universal/app/gateway/internal/conf"
+	"universal/pkg/discovery"
+	"universal/pkg/identity"
+	"universal/pkg/telemetry"
+	"universal/pkg/workspace"
+
+	"github.com/go-kratos/aegis/circuitbreaker"
+	"github.com/go-kratos/aegis/circuitbreaker/sre"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	breaker "github.com/go-kratos/kratos/v2/middleware/circuitbreaker"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/registry"
+	"github.com/go-kratos/kratos/v2/selector"
+	"github.com/go-kratos/kratos/v2/selector/p2c"
+	"github.com/go-kratos/kratos/v2/selector/random"
+	"github.com/go-kratos/kratos/v2/selector/wrr"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	ggrpc "google.golang.org/grpc"
+)
+
+// 后端服务在注册中心的名称
+const (
+	userServiceName = "universal.user.service"
+	aiServiceName   = "universal.ai.service"
+)
+
+const (
+	defaultReadDeadline   = 5 * time.Second
+	defaultWriteDeadline  = 10 * time.Second
+	defaultLongDeadline   = 60 * time.Second
+	defaultMaxAttempts    = 3
+	defaultInitialBackoff = 100 * time.Millisecond
+	defaultMaxBackoff     = time.Second
+)
+
+// methodClass 方法类别，决定调用超时与是否重试
+type methodClass int
+
+const (
+	classWrite methodClass = iota
+	classRead
+	classLong
+)
+
+// readPrefixes 查询类方法名前缀，这些方法没有副作用，可以安全重试
+var readPrefixes = []string{"Get", "List", "Search", "Check", "Validate", "Export", "HealthCheck"}
+
+// defaultLongMethods 调用模型、处理文档等长耗时方法
+var defaultLongMethods = []string{
+	"SendMessage", "RegenerateMessage", "SummarizeConversation", "ImportConversation",
+	"UploadDocument", "ProcessDocument", "ReindexKnowledgeBase", "AnalyzeKnowledgeBase",
+	"CallTool", "BatchCallTools", "TestProvider", "TestMcpServer", "SyncProviderModels",
+}
+
+// upstreamPolicy 后端调用策略
+type upstreamPolicy struct {
+	deadlines      map[methodClass]time.Duration
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	longMethods    map[string]bool
+	breaker        *conf.Upstream_Breaker
+}
+
+func newUpstreamPolicy(c *conf.Upstream) *upstreamPolicy {
+	p := &upstreamPolicy{
+		deadlines: map[methodClass]time.Duration{
+			classRead:  durationOr(c.GetDeadlines().GetRead().AsDuration(), defaultReadDeadline),
+			classWrite: durationOr(c.GetDeadlines().GetWrite().AsDuration(), defaultWriteDeadline),
+			classLong:  durationOr(c.GetDeadlines().GetLong().AsDuration(), defaultLongDeadline),
+		},
+		maxAttempts:    defaultMaxAttempts,
+		initialBackoff: durationOr(c.GetRetry().GetInitialBackoff().AsDuration(), defaultInitialBackoff),
+		maxBackoff:     durationOr(c.GetRetry().GetMaxBackoff().AsDuration(), defaultMaxBackoff),
+		longMethods:    make(map[string]bool),
+		breaker:        c.GetBreaker(),
+	}
+	if n := c.GetRetry().GetMaxAttempts(); n > 0 {
+		p.maxAttempts = int(n)
+	}
+	for _, m := range append(defaultLongMethods, c.GetLongMethods()...) {
+		p.longMethods[m] = true
+	}
+	return p
+}
+
+func durationOr(d, def time.Duration) time.Duration {
+	if d > 0 {
+		return d
+	}
+	return def
+}
+
+// classify 按方法名归类，operation 形如 /api.universal.v1.User/GetUser
+func (p *upstreamPolicy) classify(operation string) methodClass {
+	method := operation[strings.LastIndex(operation, "/")+1:]
+	if p.longMethods[method] {
+		return classLong
+	}
+	if strings.HasSuffix(method, "Search") {
+		return classRead
+	}
+	for _, prefix := range readPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return classRead
+		}
+	}
+	return classWrite
+}
+
+func operation(ctx context.Context) string {
+	if tr, ok := transport.FromClientContext(ctx); ok {
+		return tr.Operation()
+	}
+	return ""
+}
+
+// deadline 按方法类别设置调用超时，上游已设置更早的截止时间时以上游为准
+func (p *upstreamPolicy) deadline() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			ctx, cancel := context.WithTimeout(ctx, p.deadlines[p.classify(operation(ctx))])
+			defer cancel()
+			return handler(ctx, req)
+		}
+	}
+}
+
+// retry 查询类方法在后端不可用时按指数退避重试，熔断拒绝的请求不重试
+func (p *upstreamPolicy) retry() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if p.maxAttempts <= 1 || p.classify(operation(ctx)) != classRead {
+				return handler(ctx, req)
+			}
+			backoff := p.initialBackoff
+			for attempt := 1; ; attempt++ {
+				reply, err := handler(ctx, req)
+				if err == nil || attempt >= p.maxAttempts || !retryable(err) {
+					return reply, err
+				}
+				// 在 [backoff/2, backoff) 内随机等待，避免多个请求同时重试
+				wait := backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+				select {
+				case <-ctx.Done():
+					return reply, err
+				case <-time.After(wait):
+				}
+				backoff = min(backoff*2, p.maxBackoff)
+			}
+		}
+	}
+}
+
+func retryable(err error) bool {
+	se := errors.FromError(err)
+	return se.Code == 503 && se.Reason != breaker.ErrNotAllowed.Reason
+}
+
+// circuitBreaker 按方法统计成功率的自适应熔断
+func (p *upstreamPolicy) circuitBreaker() middleware.Middleware {
+	var opts []sre.Option
+	if c := p.breaker; c != nil {
+		if c.Success > 0 {
+			opts = append(opts, sre.WithSuccess(c.Success))
+		}
+		if c.Request > 0 {
+			opts = append(opts, sre.WithRequest(c.Request))
+		}
+		if c.Window.AsDuration() > 0 {
+			opts = append(opts, sre.WithWindow(c.Window.AsDuration()))
+		}
+	}
+	return breaker.Client(breaker.WithCircuitBreaker(func() circuitbreaker.CircuitBreaker {
+		return sre.NewBreaker(opts...)
+	}))
+}
+
+// ClientPool 网关到各后端服务的gRPC连接，同一服务的所有客户端共用一个连接
+type ClientPool struct {
+	conns map[string]*ggrpc.ClientConn
+}
+
+// NewClientPool 为每个后端服务建立一个连接，注册中心或后端暂不可用时不阻塞启动
+func NewClientPool(c *conf.Upstream, r registry.Discovery, logger log.Logger) (*ClientPool, func(), error) {
+	helper := log.NewHelper(logger)
+	switch c.GetBalancer() {
+	case "", "wrr":
+		selector.SetGlobalSelector(wrr.NewBuilder())
+	case "p2c":
+		selector.SetGlobalSelector(p2c.NewBuilder())
+	case "random":
+		selector.SetGlobalSelector(random.NewBuilder())
+	default:
+		return nil, nil, fmt.Errorf("unknown upstream balancer %q", c.GetBalancer())
+	}
+
+	policy := newUpstreamPolicy(c)
+	lazy := discovery.Lazy(r, logger)
+	pool := &ClientPool{conns: make(map[string]*ggrpc.ClientConn)}
+	cleanup := func() {
+		for name, conn := range pool.conns {
+			if err := conn.Close(); err != nil {
+				helper.Errorf("failed to close %s connection: %v", name, err)
+			}
+		}
+	}
+	for _, name := range []string{userServiceName, aiServiceName} {
+		mws := []middleware.Middleware{
+			recovery.Recovery(),
+			telemetry.Client(),
+			workspace.Client(),
+			identity.Client(),
+			policy.deadline(),
+			policy.retry(),
+		}
+		if !c.GetBreaker().GetDisabled() {
+			mws = append(mws, policy.circuitBreaker())
+		}
+		opts := []grpc.ClientOption{
+			grpc.WithEndpoint("discovery:///" + name),
+			grpc.WithDiscovery(lazy),
+			// 超时由 deadline 中间件按方法类别设置
+			grpc.WithTimeout(0),
+			grpc.WithMiddleware(mws...),
+		}
+		if discovery.InProcess(r) {
+			opts = append(opts, grpc.WithOptions(discovery.DialOption()))
+		}
+		conn, err := grpc.DialInsecure(context.Background(), opts...)
+		if err != nil {
+			cleanup()
+			return nil, nil, fmt.Errorf("failed to dial %s: %w", name, err)
+		}
+		pool.conns[name] = conn
+	}
+	return pool, cleanup, nil
+}
+
+// Conn 返回后端服务的连接
+func (p *ClientPool) Conn(service string) *ggrpc.ClientConn {
+	return p.conns[service]
+}
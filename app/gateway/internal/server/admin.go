@@ -0,0 +1,41 @@
+package server
+
+import (
+	"context"
+
+	"universal/app/gateway/internal/conf"
+	"universal/pkg/telemetry"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// AdminServer 管理端口，只提供指标接口，与对外的API端口分开监听。
+// 不实现 Endpointer，管理端口不注册到注册中心。
+type AdminServer struct {
+	srv *http.Server
+}
+
+// NewAdminServer 创建管理端口，未配置地址时不监听
+func NewAdminServer(c *conf.Server) *AdminServer {
+	addr := c.GetAdmin().GetAddr()
+	if addr == "" {
+		return &AdminServer{}
+	}
+	srv := http.NewServer(http.Address(addr))
+	srv.Handle(telemetry.MetricsPath, telemetry.MetricsHandler())
+	return &AdminServer{srv: srv}
+}
+
+func (s *AdminServer) Start(ctx context.Context) error {
+	if s.srv == nil {
+		return nil
+	}
+	return s.srv.Start(ctx)
+}
+
+func (s *AdminServer) Stop(ctx context.Context) error {
+	if s.srv == nil {
+		return nil
+	}
+	return s.srv.Stop(ctx)
+}
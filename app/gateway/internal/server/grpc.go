@@ -5,6 +5,9 @@ import (
 	v1 "universal/api/helloworld/v1"
 	"universal/app/gateway/internal/conf"
 	"universal/app/gateway/internal/service"
+	"universal/pkg/identity"
+	"universal/pkg/telemetry"
+	"universal/pkg/workspace"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
@@ -16,15 +19,20 @@ func NewGRPCServer(c *conf.Server,
 	greeter *service.GreeterService,
 	gatewayService *service.GatewayService,
 	userService *service.UserService,
+	workspaceService *service.WorkspaceService,
 	aiService *service.AiService,
 	conversationService *service.ConversationService,
 	knowledgeService *service.KnowledgeService,
 	toolService *service.ToolService,
+	verifier *identity.Verifier,
 	logger log.Logger,
 ) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
+			telemetry.Server(logger),
+			workspace.Server(),
+			verifier.Middleware(),
 		),
 	}
 	if c.Grpc.Network != "" {
@@ -40,6 +48,7 @@ func NewGRPCServer(c *conf.Server,
 	v1.RegisterGreeterServer(srv, greeter)
 	gatewayv1.RegisterGatewayServer(srv, gatewayService)
 	gatewayv1.RegisterUserServer(srv, userService)
+	gatewayv1.RegisterWorkspaceServer(srv, workspaceService)
 
 	// 注册Gateway AI服务 - 提供gRPC接口
 	gatewayv1.RegisterAiServer(srv, aiService)
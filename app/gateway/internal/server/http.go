@@ -3,8 +3,12 @@ package server
 import (
 	gatewayv1 "universal/api/gateway/v1"
 	v1 "universal/api/helloworld/v1"
+	"universal/app/gateway/internal/biz"
 	"universal/app/gateway/internal/conf"
 	"universal/app/gateway/internal/service"
+	"universal/pkg/identity"
+	"universal/pkg/telemetry"
+	"universal/pkg/workspace"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
@@ -16,15 +20,23 @@ func NewHTTPServer(c *conf.Server,
 	greeter *service.GreeterService,
 	gatewayService *service.GatewayService,
 	userService *service.UserService,
+	workspaceService *service.WorkspaceService,
 	aiService *service.AiService,
 	conversationService *service.ConversationService,
 	knowledgeService *service.KnowledgeService,
 	toolService *service.ToolService,
+	rateLimit *RateLimitPolicy,
+	edgeLimit *biz.EdgeLimitUsecase,
+	verifier *identity.Verifier,
 	logger log.Logger,
 ) *http.Server {
 	var opts = []http.ServerOption{
+		// 先校验调用方身份，限流按验证后的用户计数
+		http.Filter(verifier.Filter(), NewRateLimitFilter(rateLimit, edgeLimit)),
 		http.Middleware(
 			recovery.Recovery(),
+			telemetry.Server(logger),
+			workspace.Server(),
 		),
 	}
 	if c.Http.Network != "" {
@@ -40,6 +52,7 @@ func NewHTTPServer(c *conf.Server,
 	v1.RegisterGreeterHTTPServer(srv, greeter)
 	gatewayv1.RegisterGatewayHTTPServer(srv, gatewayService)
 	gatewayv1.RegisterUserHTTPServer(srv, userService)
+	gatewayv1.RegisterWorkspaceHTTPServer(srv, workspaceService)
 
 	// 注册Gateway AI服务的HTTP接口 - 这是主要的HTTP API入口
 	gatewayv1.RegisterAiHTTPServer(srv, aiService)
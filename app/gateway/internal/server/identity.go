@@ -0,0 +1,25 @@
+package server
+
+import (
+	"os"
+
+	"universal/app/gateway/internal/conf"
+	"universal/pkg/identity"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// authSecretEnv 覆盖配置中签名密钥的环境变量
+const authSecretEnv = "GATEWAY_AUTH_SECRET"
+
+// NewIdentityVerifier 创建调用方身份校验器，未配置密钥时所有请求视为匿名
+func NewIdentityVerifier(c *conf.Auth, logger log.Logger) *identity.Verifier {
+	secret := os.Getenv(authSecretEnv)
+	if secret == "" {
+		secret = c.GetSecret()
+	}
+	if secret == "" {
+		log.NewHelper(logger).Warnf("no auth secret configured, set auth.secret or %s; all requests are treated as anonymous", authSecretEnv)
+	}
+	return identity.NewVerifier([]byte(secret), c.GetMaxSkew().AsDuration())
+}
@@ -0,0 +1,440 @@
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net"
+	nethttp "net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"universal/app/gateway/internal/biz"
+	"universal/app/gateway/internal/conf"
+	"universal/pkg/identity"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+const (
+	defaultMaxBodyBytes = 1 << 20
+	// apiKeyHeader 除 Authorization: Bearer 外识别API密钥的请求头
+	apiKeyHeader = "X-Api-Key"
+	// globalRouteName 未匹配路由共用的预算名称
+	globalRouteName = "default"
+
+	defaultBruteForceWindow  = 15 * time.Minute
+	defaultBruteForceLockout = 15 * time.Minute
+	// defaultAccountFailureFactor 未配置账户级失败上限时取每IP上限的倍数
+	defaultAccountFailureFactor = 4
+)
+
+var defaultFailureCodes = []int32{nethttp.StatusUnauthorized, nethttp.StatusForbidden}
+
+// RateLimitPolicy 可热加载的入口限流配置
+type RateLimitPolicy struct {
+	rules atomic.Pointer[rateLimitRules]
+}
+
+type rateLimitRules struct {
+	disabled bool
+	global   *rateLimitRoute
+	routes   []*rateLimitRoute
+	trusted  []*net.IPNet
+}
+
+type rateLimitRoute struct {
+	name       string
+	method     string
+	path       string
+	perIP      *bucketRule
+	perUser    *bucketRule
+	perKey     *bucketRule
+	maxBody    int64
+	bruteForce *bruteForceRule
+}
+
+// bucketRule 令牌桶规则，route 为令牌桶所属的预算名称
+type bucketRule struct {
+	route string
+	rate  float64
+	burst int64
+}
+
+type bruteForceRule struct {
+	maxFailures        int64
+	maxAccountFailures int64
+	window             time.Duration
+	lockout            time.Duration
+	failureCodes       map[int]bool
+	// accountSegment 目标账户在路径中的段下标，-1 表示取验证后的身份
+	accountSegment int
+}
+
+// NewRateLimitPolicy 创建入口限流配置
+func NewRateLimitPolicy(c *conf.RateLimit) (*RateLimitPolicy, error) {
+	p := &RateLimitPolicy{}
+	if err := p.Update(c); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Update 校验并替换限流配置，校验失败时保留原配置
+func (p *RateLimitPolicy) Update(c *conf.RateLimit) error {
+	rules, err := compileRateLimit(c)
+	if err != nil {
+		return err
+	}
+	p.rules.Store(rules)
+	return nil
+}
+
+func compileRateLimit(c *conf.RateLimit) (*rateLimitRules, error) {
+	if c == nil {
+		return &rateLimitRules{disabled: true}, nil
+	}
+	rules := &rateLimitRules{
+		disabled: c.Disabled,
+		global: &rateLimitRoute{
+			name:    globalRouteName,
+			perIP:   newBucketRule(globalRouteName, c.PerIp),
+			perUser: newBucketRule(globalRouteName, c.PerUser),
+			perKey:  newBucketRule(globalRouteName, c.PerKey),
+			maxBody: c.MaxBodyBytes,
+		},
+	}
+	if rules.global.maxBody <= 0 {
+		rules.global.maxBody = defaultMaxBodyBytes
+	}
+
+	for _, cidr := range c.TrustedProxies {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", cidr, err)
+		}
+		rules.trusted = append(rules.trusted, network)
+	}
+
+	for _, r := range c.Routes {
+		if r.Name == "" || r.Name == globalRouteName {
+			return nil, fmt.Errorf("rate limit route %q: name is required and must not be %q", r.Path, globalRouteName)
+		}
+		if _, err := path.Match(r.Path, "/"); err != nil {
+			return nil, fmt.Errorf("rate limit route %s: invalid path %q: %w", r.Name, r.Path, err)
+		}
+		route := &rateLimitRoute{
+			name:    r.Name,
+			method:  strings.ToUpper(r.Method),
+			path:    r.Path,
+			perIP:   newBucketRule(r.Name, r.PerIp),
+			perUser: newBucketRule(r.Name, r.PerUser),
+			perKey:  newBucketRule(r.Name, r.PerKey),
+			maxBody: r.MaxBodyBytes,
+		}
+		// 路由未配置的维度共用全局预算
+		if route.perIP == nil {
+			route.perIP = rules.global.perIP
+		}
+		if route.perUser == nil {
+			route.perUser = rules.global.perUser
+		}
+		if route.perKey == nil {
+			route.perKey = rules.global.perKey
+		}
+		if route.maxBody <= 0 {
+			route.maxBody = rules.global.maxBody
+		}
+		if bf := r.BruteForce; bf != nil && bf.MaxFailures > 0 {
+			rule, err := newBruteForceRule(bf, r.Path)
+			if err != nil {
+				return nil, fmt.Errorf("rate limit route %s: %w", r.Name, err)
+			}
+			route.bruteForce = rule
+		}
+		rules.routes = append(rules.routes, route)
+	}
+	return rules, nil
+}
+
+func newBucketRule(route string, b *conf.RateLimit_Bucket) *bucketRule {
+	if b == nil || b.Rate <= 0 {
+		return nil
+	}
+	burst := b.Burst
+	if burst < 1 {
+		burst = int64(math.Max(1, math.Ceil(b.Rate)))
+	}
+	return &bucketRule{route: route, rate: b.Rate, burst: burst}
+}
+
+func newBruteForceRule(c *conf.RateLimit_BruteForce, pattern string) (*bruteForceRule, error) {
+	rule := &bruteForceRule{
+		maxFailures:        int64(c.MaxFailures),
+		maxAccountFailures: int64(c.MaxAccountFailures),
+		window:             defaultBruteForceWindow,
+		lockout:            defaultBruteForceLockout,
+		failureCodes:       make(map[int]bool),
+		accountSegment:     -1,
+	}
+	if rule.maxAccountFailures <= 0 {
+		rule.maxAccountFailures = rule.maxFailures * defaultAccountFailureFactor
+	}
+	if c.AccountSegment > 0 {
+		wildcards := 0
+		for i, seg := range strings.Split(pattern, "/") {
+			if seg == "*" {
+				wildcards++
+				if wildcards == int(c.AccountSegment) {
+					rule.accountSegment = i
+					break
+				}
+			}
+		}
+		if rule.accountSegment < 0 {
+			return nil, fmt.Errorf("brute force account_segment %d exceeds the wildcard segments of %q", c.AccountSegment, pattern)
+		}
+	}
+	if c.Window != nil && c.Window.AsDuration() > 0 {
+		rule.window = c.Window.AsDuration()
+	}
+	if c.Lockout != nil && c.Lockout.AsDuration() > 0 {
+		rule.lockout = c.Lockout.AsDuration()
+	}
+	codes := c.FailureCodes
+	if len(codes) == 0 {
+		codes = defaultFailureCodes
+	}
+	for _, code := range codes {
+		rule.failureCodes[int(code)] = true
+	}
+	return rule, nil
+}
+
+// match 返回请求匹配的路由预算
+func (r *rateLimitRules) match(req *nethttp.Request) *rateLimitRoute {
+	for _, route := range r.routes {
+		if route.method != "" && route.method != req.Method {
+			continue
+		}
+		if ok, _ := path.Match(route.path, req.URL.Path); ok {
+			return route
+		}
+	}
+	return r.global
+}
+
+// NewRateLimitFilter 入口限流过滤器：请求体上限、暴力破解锁定与按IP、用户、API密钥的令牌桶
+func NewRateLimitFilter(policy *RateLimitPolicy, uc *biz.EdgeLimitUsecase) http.FilterFunc {
+	return func(next nethttp.Handler) nethttp.Handler {
+		return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, req *nethttp.Request) {
+			rules := policy.rules.Load()
+			if rules == nil || rules.disabled {
+				next.ServeHTTP(w, req)
+				return
+			}
+			route := rules.match(req)
+			ctx := req.Context()
+
+			if req.ContentLength > route.maxBody {
+				http.DefaultErrorEncoder(w, req, errors.New(nethttp.StatusRequestEntityTooLarge, "REQUEST_TOO_LARGE",
+					fmt.Sprintf("request body exceeds %d bytes", route.maxBody)))
+				return
+			}
+			if req.Body != nil {
+				req.Body = nethttp.MaxBytesReader(w, req.Body, route.maxBody)
+			}
+
+			ip := clientIP(req, rules.trusted)
+			// 用户只取身份校验过滤器验证后的身份，客户端自带的请求头不可信
+			userID := identity.FromContext(ctx)
+			var ipKey, accountKey string
+			if route.bruteForce != nil {
+				ipKey, accountKey = route.bruteForce.keys(route.name, req, userID, ip)
+				ttl := uc.Locked(ctx, ipKey)
+				if accountKey != "" {
+					ttl = max(ttl, uc.Locked(ctx, accountKey))
+				}
+				if ttl > 0 {
+					setRetryAfter(w, ttl)
+					http.DefaultErrorEncoder(w, req, errors.New(nethttp.StatusTooManyRequests, "TOO_MANY_FAILURES",
+						"too many failed attempts, try again later"))
+					return
+				}
+			}
+
+			var buckets []*biz.TokenBucket
+			if b := route.perIP; b != nil {
+				buckets = append(buckets, b.bucket("ip", ip))
+			}
+			if b := route.perUser; b != nil {
+				// 匿名请求按IP使用用户预算
+				if userID > 0 {
+					buckets = append(buckets, b.bucket("user", strconv.FormatInt(userID, 10)))
+				} else {
+					buckets = append(buckets, b.bucket("user", "ip:"+ip))
+				}
+			}
+			if key := apiKey(req); key != "" && route.perKey != nil {
+				buckets = append(buckets, route.perKey.bucket("key", hashKey(key)))
+			}
+			if state := uc.Allow(ctx, buckets); state != nil {
+				h := w.Header()
+				h.Set("RateLimit-Limit", strconv.FormatInt(state.Limit, 10))
+				h.Set("RateLimit-Remaining", strconv.FormatInt(state.Remaining, 10))
+				h.Set("RateLimit-Reset", strconv.FormatInt(ceilSeconds(state.Reset), 10))
+				if !state.Allowed {
+					setRetryAfter(w, state.RetryAfter)
+					http.DefaultErrorEncoder(w, req, errors.New(nethttp.StatusTooManyRequests, "RATE_LIMITED",
+						"too many requests"))
+					return
+				}
+			}
+
+			if route.bruteForce == nil {
+				next.ServeHTTP(w, req)
+				return
+			}
+			sw := &statusWriter{ResponseWriter: w, status: nethttp.StatusOK}
+			next.ServeHTTP(sw, req)
+			rule := route.bruteForce
+			outcome := rule.outcome(sw.status)
+			uc.Observe(ctx, ipKey, outcome, rule.maxFailures, rule.window, rule.lockout)
+			if accountKey != "" {
+				uc.Observe(ctx, accountKey, outcome, rule.maxAccountFailures, rule.window, rule.lockout)
+			}
+		})
+	}
+}
+
+// keys 返回暴力破解计数键：目标账户在该IP的计数与该账户不区分IP的计数，
+// 攻击者无法通过其他账户的成功请求清零计数，也无法靠轮换IP绕过账户级锁定；无法确定账户时只按IP计数
+func (r *bruteForceRule) keys(route string, req *nethttp.Request, userID int64, ip string) (ipKey, accountKey string) {
+	var account string
+	if r.accountSegment >= 0 {
+		// 路由匹配保证路径段数与模式一致
+		account = strings.Split(req.URL.Path, "/")[r.accountSegment]
+		// 数字ID规范化，避免 007 与 7 指向同一账户却分开计数
+		if id, err := strconv.ParseInt(account, 10, 64); err == nil {
+			account = strconv.FormatInt(id, 10)
+		}
+	} else if userID > 0 {
+		account = strconv.FormatInt(userID, 10)
+	}
+	if account == "" {
+		return route + ":ip:" + ip, ""
+	}
+	return route + ":account-ip:" + account + ":" + ip, route + ":account:" + account
+}
+
+// outcome 失败状态码计为认证失败，2xx 计为认证成功
+func (r *bruteForceRule) outcome(status int) biz.AuthOutcome {
+	switch {
+	case r.failureCodes[status]:
+		return biz.AuthFailed
+	case status >= nethttp.StatusOK && status < nethttp.StatusMultipleChoices:
+		return biz.AuthSucceeded
+	default:
+		return biz.AuthOther
+	}
+}
+
+func (b *bucketRule) bucket(dimension, id string) *biz.TokenBucket {
+	return &biz.TokenBucket{
+		Key:   b.route + ":" + dimension + ":" + id,
+		Rate:  b.rate,
+		Burst: b.burst,
+	}
+}
+
+// clientIP 直连地址属于可信代理时，取 X-Forwarded-For 中从右往左第一个不可信的地址
+func clientIP(req *nethttp.Request, trusted []*net.IPNet) string {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		host = req.RemoteAddr
+	}
+	if !isTrusted(host, trusted) {
+		return host
+	}
+	hops := strings.Split(req.Header.Get("X-Forwarded-For"), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop == "" {
+			continue
+		}
+		if !isTrusted(hop, trusted) {
+			return hop
+		}
+		host = hop
+	}
+	return host
+}
+
+func isTrusted(addr string, trusted []*net.IPNet) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, network := range trusted {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// apiKey 从 Authorization: Bearer 或 X-Api-Key 读取API密钥
+func apiKey(req *nethttp.Request) string {
+	if key := req.Header.Get(apiKeyHeader); key != "" {
+		return key
+	}
+	auth := req.Header.Get("Authorization")
+	if len(auth) > 7 && strings.EqualFold(auth[:7], "Bearer ") {
+		return strings.TrimSpace(auth[7:])
+	}
+	return ""
+}
+
+// hashKey 限流键中只保存密钥摘要
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:16])
+}
+
+func ceilSeconds(d time.Duration) int64 {
+	return int64(math.Ceil(d.Seconds()))
+}
+
+func setRetryAfter(w nethttp.ResponseWriter, d time.Duration) {
+	seconds := ceilSeconds(d)
+	if seconds < 1 {
+		seconds = 1
+	}
+	w.Header().Set("Retry-After", strconv.FormatInt(seconds, 10))
+}
+
+// statusWriter 记录响应状态码，保留流式响应所需的 Flush
+type statusWriter struct {
+	nethttp.ResponseWriter
+	status int
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusWriter) Flush() {
+	if f, ok := w.ResponseWriter.(nethttp.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *statusWriter) Unwrap() nethttp.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -6,6 +6,7 @@ import (
 	gatewayv1 "universal/api/gateway/v1"
 	"universal/app/gateway/internal/biz"
 	"universal/app/gateway/internal/data"
+	"universal/pkg/identity"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -181,3 +182,40 @@ func (s *ConversationService) ImportConversation(ctx context.Context, req *aiv1.
 	s.log.WithContext(ctx).Infof("ImportConversation called for user: %d", req.UserId)
 	return &aiv1.ImportConversationReply{}, nil
 }
+
+// ShareConversation 共享对话
+func (s *ConversationService) ShareConversation(ctx context.Context, req *aiv1.ShareConversationRequest) (*aiv1.ShareConversationReply, error) {
+	s.log.WithContext(ctx).Infof("ShareConversation called for conversation: %d", req.ConversationId)
+	return s.data.ConversationClient().ShareConversation(ctx, req)
+}
+
+// RevokeConversationShare 撤销对话共享
+func (s *ConversationService) RevokeConversationShare(ctx context.Context, req *aiv1.RevokeConversationShareRequest) (*aiv1.RevokeConversationShareReply, error) {
+	s.log.WithContext(ctx).Infof("RevokeConversationShare called for conversation: %d", req.ConversationId)
+	return s.data.ConversationClient().RevokeConversationShare(ctx, req)
+}
+
+// ListConversationShares 获取对话共享列表
+func (s *ConversationService) ListConversationShares(ctx context.Context, req *aiv1.ListConversationSharesRequest) (*aiv1.ListConversationSharesReply, error) {
+	s.log.WithContext(ctx).Infof("ListConversationShares called for conversation: %d", req.ConversationId)
+	return s.data.ConversationClient().ListConversationShares(ctx, req)
+}
+
+// PublishConversationSnapshot 发布对话公开快照
+func (s *ConversationService) PublishConversationSnapshot(ctx context.Context, req *aiv1.PublishConversationSnapshotRequest) (*aiv1.PublishConversationSnapshotReply, error) {
+	s.log.WithContext(ctx).Infof("PublishConversationSnapshot called for conversation: %d", req.ConversationId)
+	return s.data.ConversationClient().PublishConversationSnapshot(ctx, req)
+}
+
+// RevokeConversationSnapshot 撤销对话公开快照
+func (s *ConversationService) RevokeConversationSnapshot(ctx context.Context, req *aiv1.RevokeConversationSnapshotRequest) (*aiv1.RevokeConversationSnapshotReply, error) {
+	s.log.WithContext(ctx).Infof("RevokeConversationSnapshot called by user: %d", identity.FromContext(ctx))
+	return s.data.ConversationClient().RevokeConversationSnapshot(ctx, req)
+}
+
+// GetConversationSnapshot 匿名获取对话公开快照
+// 公开访问路径，不做用户校验，令牌本身即访问凭证，日志中不记录令牌
+func (s *ConversationService) GetConversationSnapshot(ctx context.Context, req *aiv1.GetConversationSnapshotRequest) (*aiv1.GetConversationSnapshotReply, error) {
+	s.log.WithContext(ctx).Info("GetConversationSnapshot called anonymously")
+	return s.data.ConversationClient().GetConversationSnapshot(ctx, req)
+}
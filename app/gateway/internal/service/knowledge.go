@@ -6,18 +6,30 @@ import (
 
 	aiv1 "universal/api/ai/v1"
 	pb "universal/api/gateway/v1"
+	"universal/app/gateway/internal/data"
+
+	"github.com/go-kratos/kratos/v2/log"
 )
 
+// KnowledgeService 知识库服务代理
 type KnowledgeService struct {
 	pb.UnimplementedKnowledgeServer
+
+	data *data.Data
+	log  *log.Helper
 }
 
-func NewKnowledgeService() *KnowledgeService {
-	return &KnowledgeService{}
+// NewKnowledgeService 创建知识库服务
+func NewKnowledgeService(data *data.Data, logger log.Logger) *KnowledgeService {
+	return &KnowledgeService{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
 }
 
 func (s *KnowledgeService) CreateKnowledgeBase(ctx context.Context, req *aiv1.CreateKnowledgeBaseRequest) (*aiv1.CreateKnowledgeBaseReply, error) {
-	return &aiv1.CreateKnowledgeBaseReply{}, nil
+	s.log.WithContext(ctx).Infof("CreateKnowledgeBase called for user: %d", req.UserId)
+	return s.data.KnowledgeClient().CreateKnowledgeBase(ctx, req)
 }
 func (s *KnowledgeService) UpdateKnowledgeBase(ctx context.Context, req *aiv1.UpdateKnowledgeBaseRequest) (*aiv1.UpdateKnowledgeBaseReply, error) {
 	return &aiv1.UpdateKnowledgeBaseReply{}, nil
@@ -26,7 +38,8 @@ func (s *KnowledgeService) DeleteKnowledgeBase(ctx context.Context, req *aiv1.De
 	return &aiv1.DeleteKnowledgeBaseReply{}, nil
 }
 func (s *KnowledgeService) ListKnowledgeBases(ctx context.Context, req *aiv1.ListKnowledgeBasesRequest) (*aiv1.ListKnowledgeBasesReply, error) {
-	return &aiv1.ListKnowledgeBasesReply{}, nil
+	s.log.WithContext(ctx).Infof("ListKnowledgeBases called for user: %d", req.UserId)
+	return s.data.KnowledgeClient().ListKnowledgeBases(ctx, req)
 }
 func (s *KnowledgeService) GetKnowledgeBase(ctx context.Context, req *aiv1.GetKnowledgeBaseRequest) (*aiv1.GetKnowledgeBaseReply, error) {
 	return &aiv1.GetKnowledgeBaseReply{}, nil
@@ -94,3 +107,21 @@ func (s *KnowledgeService) GetKnowledgeBaseStats(ctx context.Context, req *aiv1.
 func (s *KnowledgeService) AnalyzeKnowledgeBase(ctx context.Context, req *aiv1.AnalyzeKnowledgeBaseRequest) (*aiv1.AnalyzeKnowledgeBaseReply, error) {
 	return &aiv1.AnalyzeKnowledgeBaseReply{}, nil
 }
+
+// ShareKnowledgeBase 共享知识库
+func (s *KnowledgeService) ShareKnowledgeBase(ctx context.Context, req *aiv1.ShareKnowledgeBaseRequest) (*aiv1.ShareKnowledgeBaseReply, error) {
+	s.log.WithContext(ctx).Infof("ShareKnowledgeBase called for knowledge base: %d", req.KnowledgeBaseId)
+	return s.data.KnowledgeClient().ShareKnowledgeBase(ctx, req)
+}
+
+// RevokeKnowledgeBaseShare 撤销知识库共享
+func (s *KnowledgeService) RevokeKnowledgeBaseShare(ctx context.Context, req *aiv1.RevokeKnowledgeBaseShareRequest) (*aiv1.RevokeKnowledgeBaseShareReply, error) {
+	s.log.WithContext(ctx).Infof("RevokeKnowledgeBaseShare called for knowledge base: %d", req.KnowledgeBaseId)
+	return s.data.KnowledgeClient().RevokeKnowledgeBaseShare(ctx, req)
+}
+
+// ListKnowledgeBaseShares 获取知识库共享列表
+func (s *KnowledgeService) ListKnowledgeBaseShares(ctx context.Context, req *aiv1.ListKnowledgeBaseSharesRequest) (*aiv1.ListKnowledgeBaseSharesReply, error) {
+	s.log.WithContext(ctx).Infof("ListKnowledgeBaseShares called for knowledge base: %d", req.KnowledgeBaseId)
+	return s.data.KnowledgeClient().ListKnowledgeBaseShares(ctx, req)
+}
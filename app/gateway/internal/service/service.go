@@ -7,6 +7,7 @@ var ProviderSet = wire.NewSet(
 	NewGreeterService,
 	NewGatewayService,
 	NewUserService,
+	NewWorkspaceService,
 	NewAiService,
 	NewConversationService,
 	NewKnowledgeService,
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+
+	pb "universal/api/gateway/v1"
+	userv1 "universal/api/user/v1"
+	"universal/app/gateway/internal/data"
+	"universal/pkg/identity"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// WorkspaceService 工作空间服务代理
+type WorkspaceService struct {
+	pb.UnimplementedWorkspaceServer
+
+	data *data.Data
+	log  *log.Helper
+}
+
+// NewWorkspaceService 创建工作空间服务
+func NewWorkspaceService(data *data.Data, logger log.Logger) *WorkspaceService {
+	return &WorkspaceService{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (s *WorkspaceService) CreateWorkspace(ctx context.Context, req *userv1.CreateWorkspaceRequest) (*userv1.CreateWorkspaceReply, error) {
+	s.log.WithContext(ctx).Infof("CreateWorkspace called by user: %d", identity.FromContext(ctx))
+	return s.data.WorkspaceClient().CreateWorkspace(ctx, req)
+}
+func (s *WorkspaceService) UpdateWorkspace(ctx context.Context, req *userv1.UpdateWorkspaceRequest) (*userv1.UpdateWorkspaceReply, error) {
+	s.log.WithContext(ctx).Infof("UpdateWorkspace called for workspace: %d by user: %d", req.Id, identity.FromContext(ctx))
+	return s.data.WorkspaceClient().UpdateWorkspace(ctx, req)
+}
+func (s *WorkspaceService) DeleteWorkspace(ctx context.Context, req *userv1.DeleteWorkspaceRequest) (*userv1.OperationReply, error) {
+	s.log.WithContext(ctx).Infof("DeleteWorkspace called for workspace: %d by user: %d", req.Id, identity.FromContext(ctx))
+	return s.data.WorkspaceClient().DeleteWorkspace(ctx, req)
+}
+func (s *WorkspaceService) GetWorkspace(ctx context.Context, req *userv1.GetWorkspaceRequest) (*userv1.GetWorkspaceReply, error) {
+	return s.data.WorkspaceClient().GetWorkspace(ctx, req)
+}
+func (s *WorkspaceService) ListUserWorkspaces(ctx context.Context, req *userv1.ListUserWorkspacesRequest) (*userv1.ListUserWorkspacesReply, error) {
+	return s.data.WorkspaceClient().ListUserWorkspaces(ctx, req)
+}
+func (s *WorkspaceService) AddWorkspaceMember(ctx context.Context, req *userv1.AddWorkspaceMemberRequest) (*userv1.WorkspaceMemberReply, error) {
+	s.log.WithContext(ctx).Infof("AddWorkspaceMember called for workspace: %d user: %d", req.WorkspaceId, req.UserId)
+	return s.data.WorkspaceClient().AddWorkspaceMember(ctx, req)
+}
+func (s *WorkspaceService) UpdateWorkspaceMember(ctx context.Context, req *userv1.UpdateWorkspaceMemberRequest) (*userv1.WorkspaceMemberReply, error) {
+	s.log.WithContext(ctx).Infof("UpdateWorkspaceMember called for workspace: %d user: %d", req.WorkspaceId, req.UserId)
+	return s.data.WorkspaceClient().UpdateWorkspaceMember(ctx, req)
+}
+func (s *WorkspaceService) RemoveWorkspaceMember(ctx context.Context, req *userv1.RemoveWorkspaceMemberRequest) (*userv1.OperationReply, error) {
+	s.log.WithContext(ctx).Infof("RemoveWorkspaceMember called for workspace: %d user: %d", req.WorkspaceId, req.UserId)
+	return s.data.WorkspaceClient().RemoveWorkspaceMember(ctx, req)
+}
+func (s *WorkspaceService) ListWorkspaceMembers(ctx context.Context, req *userv1.ListWorkspaceMembersRequest) (*userv1.ListWorkspaceMembersReply, error) {
+	return s.data.WorkspaceClient().ListWorkspaceMembers(ctx, req)
+}
@@ -0,0 +1,56 @@
+// Package bootstrap 组装用户服务，独立部署与单进程模式共用。
+package bootstrap
+
+import (
+	"context"
+	"io"
+
+	"universal/app/user/internal/conf"
+	"universal/app/user/internal/data"
+	"universal/pkg/healthcheck"
+
+	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/registry"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// Name 用户服务在注册中心的名称
+const Name = "universal.user.service"
+
+// Config 用户服务配置
+type Config = conf.Bootstrap
+
+// Info 服务实例信息
+type Info struct {
+	ID      string
+	Name    string
+	Version string
+}
+
+// NewApp 创建用户服务
+func NewApp(bc *Config, info Info, logger log.Logger) (*kratos.App, func(), error) {
+	return wireApp(bc.Server, bc.Data, bc.Registry, info, logger)
+}
+
+// Migrate 执行数据库迁移子命令：up、down [n]、status
+func Migrate(ctx context.Context, bc *Config, args []string, w io.Writer, logger log.Logger) error {
+	return data.Migrate(ctx, bc.Data, args, w, logger)
+}
+
+func newApp(info Info, logger log.Logger, gs *grpc.Server, hs *http.Server, hc *healthcheck.Checker, r registry.Registrar) *kratos.App {
+	return kratos.New(
+		kratos.ID(info.ID),
+		kratos.Name(info.Name),
+		kratos.Version(info.Version),
+		kratos.Metadata(map[string]string{}),
+		kratos.Logger(logger),
+		kratos.Server(
+			gs,
+			hs,
+			hc,
+		),
+		kratos.Registrar(r),
+	)
+}
@@ -4,7 +4,7 @@
 //go:build !wireinject
 // +build !wireinject
 
-package main
+package bootstrap
 
 import (
 	"github.com/go-kratos/kratos/v2"
@@ -16,14 +16,10 @@ import (
 	"universal/app/user/internal/service"
 )
 
-import (
-	_ "go.uber.org/automaxprocs"
-)
-
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, registry *conf.Registry, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, registry *conf.Registry, info Info, logger log.Logger) (*kratos.App, func(), error) {
 	dataData, cleanup, err := data.NewData(confData, logger)
 	if err != nil {
 		return nil, nil, err
@@ -31,10 +27,19 @@ func wireApp(confServer *conf.Server, confData *conf.Data, registry *conf.Regist
 	userRepo := data.NewUserRepo(dataData, logger)
 	userUsecase := biz.NewUserUsecase(userRepo, logger)
 	userService := service.NewUserService(userUsecase)
-	grpcServer := server.NewGRPCServer(confServer, userService, logger)
+	workspaceRepo := data.NewWorkspaceRepo(dataData, logger)
+	workspaceUsecase := biz.NewWorkspaceUsecase(workspaceRepo, logger)
+	workspaceService := service.NewWorkspaceService(workspaceUsecase)
+	checker := data.NewDependencyChecker(dataData, logger)
+	grpcServer := server.NewGRPCServer(confServer, userService, workspaceService, checker, logger)
 	httpServer := server.NewHTTPServer(confServer, logger)
-	registrar := server.NewRegistrar(registry)
-	app := newApp(logger, grpcServer, httpServer, registrar)
+	discoveryRegistry, err := data.NewRegistry(registry)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	registrar := server.NewRegistrar(discoveryRegistry)
+	app := newApp(info, logger, grpcServer, httpServer, checker, registrar)
 	return app, func() {
 		cleanup()
 	}, nil
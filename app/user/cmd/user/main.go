@@ -1,20 +1,19 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"fmt"
 	"os"
+	"universal/app/user/bootstrap"
 	"universal/pkg/idgen"
+	"universal/pkg/requestid"
+	"universal/pkg/telemetry"
 
-	"universal/app/user/internal/conf"
-
-	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
 	"github.com/go-kratos/kratos/v2/config/file"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
-	"github.com/go-kratos/kratos/v2/registry"
-	"github.com/go-kratos/kratos/v2/transport/grpc"
-	"github.com/go-kratos/kratos/v2/transport/http"
 
 	_ "go.uber.org/automaxprocs"
 )
@@ -22,7 +21,7 @@ import (
 // go build -ldflags "-X main.Version=x.y.z"
 var (
 	// Name is the name of the compiled software.
-	Name = "universal.user.service"
+	Name = bootstrap.Name
 	// Version is the version of the compiled software.
 	Version = "v1.0.0"
 	// flagconf is the config flag.
@@ -33,21 +32,10 @@ var (
 
 func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
-}
-
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, r registry.Registrar) *kratos.App {
-	return kratos.New(
-		kratos.ID(id),
-		kratos.Name(Name),
-		kratos.Version(Version),
-		kratos.Metadata(map[string]string{}),
-		kratos.Logger(logger),
-		kratos.Server(
-			gs,
-			hs,
-		),
-		kratos.Registrar(r),
-	)
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [-conf path] [migrate up | down [n] | status]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
 }
 
 func main() {
@@ -60,6 +48,7 @@ func main() {
 		"service.version", Version,
 		"trace.id", tracing.TraceID(),
 		"span.id", tracing.SpanID(),
+		"request.id", requestid.Valuer(),
 	)
 	c := config.New(
 		config.WithSource(
@@ -72,12 +61,32 @@ func main() {
 		panic(err)
 	}
 
-	var bc conf.Bootstrap
+	var bc bootstrap.Config
 	if err := c.Scan(&bc); err != nil {
 		panic(err)
 	}
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Registry, logger)
+	// migrate 子命令：执行数据库迁移后退出
+	if flag.Arg(0) == "migrate" {
+		if err := bootstrap.Migrate(context.Background(), &bc, flag.Args()[1:], os.Stdout, logger); err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	shutdown, err := telemetry.Setup(context.Background(), telemetry.Options{
+		Name:        Name,
+		Version:     Version,
+		ID:          id,
+		Endpoint:    bc.Trace.GetEndpoint(),
+		SampleRatio: bc.Trace.GetSampleRatio(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	defer shutdown()
+
+	app, cleanup, err := bootstrap.NewApp(&bc, bootstrap.Info{ID: id, Name: Name, Version: Version}, logger)
 	if err != nil {
 		panic(err)
 	}
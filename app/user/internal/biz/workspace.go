@@ -0,0 +1,385 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"universal/pkg/identity"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 工作空间角色，数值越大权限越高
+const (
+	WorkspaceRoleMember int32 = 1
+	WorkspaceRoleAdmin  int32 = 2
+	WorkspaceRoleOwner  int32 = 3
+)
+
+var (
+	ErrWorkspaceNotFound  = errors.New("工作空间不存在")
+	ErrWorkspaceForbidden = errors.New("无权操作该工作空间")
+	ErrWorkspaceSlugTaken = errors.New("工作空间标识已存在")
+	ErrMemberExists       = errors.New("成员已存在")
+	ErrMemberNotFound     = errors.New("成员不存在")
+	ErrInvalidMemberRole  = errors.New("无效的成员角色")
+	ErrInvalidUpdateField = errors.New("无效的更新字段")
+)
+
+// 工作空间可更新的字段，与 UpdateWorkspaceRequest 的字段名一致
+const (
+	WorkspaceFieldName                    = "name"
+	WorkspaceFieldDescription             = "description"
+	WorkspaceFieldDefaultChatModelID      = "default_chat_model_id"
+	WorkspaceFieldDefaultEmbeddingModelID = "default_embedding_model_id"
+	WorkspaceFieldDailyTokenLimit         = "daily_token_limit"
+	WorkspaceFieldMonthlyTokenLimit       = "monthly_token_limit"
+	WorkspaceFieldDailyRequestLimit       = "daily_request_limit"
+	WorkspaceFieldMonthlyRequestLimit     = "monthly_request_limit"
+)
+
+// Workspace 工作空间业务模型
+type Workspace struct {
+	ID                      int64     `json:"id"`
+	Name                    string    `json:"name"`
+	Slug                    string    `json:"slug"`
+	Description             string    `json:"description"`
+	OwnerID                 int64     `json:"owner_id"`
+	DefaultChatModelID      int64     `json:"default_chat_model_id"`
+	DefaultEmbeddingModelID int64     `json:"default_embedding_model_id"`
+	DailyTokenLimit         int64     `json:"daily_token_limit"`
+	MonthlyTokenLimit       int64     `json:"monthly_token_limit"`
+	DailyRequestLimit       int64     `json:"daily_request_limit"`
+	MonthlyRequestLimit     int64     `json:"monthly_request_limit"`
+	Status                  int32     `json:"status"`
+	MemberCount             int64     `json:"member_count"`
+	Role                    int32     `json:"role"` // 查询者在该工作空间中的角色
+	CreatedAt               time.Time `json:"created_at"`
+	UpdatedAt               time.Time `json:"updated_at"`
+}
+
+// WorkspaceMember 工作空间成员业务模型
+type WorkspaceMember struct {
+	WorkspaceID int64     `json:"workspace_id"`
+	UserID      int64     `json:"user_id"`
+	Role        int32     `json:"role"`
+	InvitedBy   int64     `json:"invited_by"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// WorkspaceRepo is a Workspace repo.
+type WorkspaceRepo interface {
+	// 工作空间
+	Create(context.Context, *Workspace) (*Workspace, error)                       // 创建工作空间，并将所有者加入成员
+	Update(context.Context, *Workspace) (*Workspace, error)                       // 更新工作空间
+	Delete(context.Context, int64) error                                          // 删除工作空间及其成员关系
+	GetByID(context.Context, int64) (*Workspace, error)                           // 根据ID获取，不存在时返回nil
+	ExistsBySlug(context.Context, string) (bool, error)                           // 检查标识是否存在
+	ListByUser(context.Context, int64, int32, int32) ([]*Workspace, int64, error) // 分页查询用户加入的工作空间，填充角色
+
+	// 成员
+	GetMember(context.Context, int64, int64) (*WorkspaceMember, error)                   // 获取成员，不存在时返回nil
+	CreateMember(context.Context, *WorkspaceMember) (*WorkspaceMember, error)            // 添加成员
+	UpdateMemberRole(context.Context, int64, int64, int32) error                         // 修改成员角色
+	DeleteMember(context.Context, int64, int64) error                                    // 移除成员
+	ListMembers(context.Context, int64, int32, int32) ([]*WorkspaceMember, int64, error) // 分页查询成员
+	CountMembers(context.Context, int64) (int64, error)                                  // 统计成员数
+}
+
+// WorkspaceUsecase is a Workspace usecase.
+type WorkspaceUsecase struct {
+	repo WorkspaceRepo
+	log  *log.Helper
+}
+
+// NewWorkspaceUsecase new a Workspace usecase.
+func NewWorkspaceUsecase(repo WorkspaceRepo, logger log.Logger) *WorkspaceUsecase {
+	return &WorkspaceUsecase{repo: repo, log: log.NewHelper(logger)}
+}
+
+// CreateWorkspace 创建工作空间，网关验证的调用方成为所有者
+func (uc *WorkspaceUsecase) CreateWorkspace(ctx context.Context, ws *Workspace) (*Workspace, error) {
+	operatorID, err := operator(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := uc.repo.ExistsBySlug(ctx, ws.Slug)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrWorkspaceSlugTaken
+	}
+
+	ws.OwnerID = operatorID
+	ws.Status = 1
+
+	uc.log.WithContext(ctx).Infof("CreateWorkspace: %v by %v", ws.Slug, operatorID)
+	created, err := uc.repo.Create(ctx, ws)
+	if err != nil {
+		return nil, err
+	}
+	created.Role = WorkspaceRoleOwner
+	created.MemberCount = 1
+	return created, nil
+}
+
+// UpdateWorkspace 更新工作空间配置，需要管理员权限。
+// fields 列出要更新的字段，列出的字段按 ws 中的值写入，可用于将默认模型或配额改回0（不指定或不限）；
+// fields 为空时只更新 ws 中非零的字段，其余保持不变
+func (uc *WorkspaceUsecase) UpdateWorkspace(ctx context.Context, ws *Workspace, fields []string) (*Workspace, error) {
+	operatorID := identity.FromContext(ctx)
+	existing, role, err := uc.authorize(ctx, ws.ID, operatorID, WorkspaceRoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := map[string]struct {
+		set   bool
+		apply func()
+	}{
+		WorkspaceFieldName:                    {ws.Name != "", func() { existing.Name = ws.Name }},
+		WorkspaceFieldDescription:             {ws.Description != "", func() { existing.Description = ws.Description }},
+		WorkspaceFieldDefaultChatModelID:      {ws.DefaultChatModelID != 0, func() { existing.DefaultChatModelID = ws.DefaultChatModelID }},
+		WorkspaceFieldDefaultEmbeddingModelID: {ws.DefaultEmbeddingModelID != 0, func() { existing.DefaultEmbeddingModelID = ws.DefaultEmbeddingModelID }},
+		WorkspaceFieldDailyTokenLimit:         {ws.DailyTokenLimit != 0, func() { existing.DailyTokenLimit = ws.DailyTokenLimit }},
+		WorkspaceFieldMonthlyTokenLimit:       {ws.MonthlyTokenLimit != 0, func() { existing.MonthlyTokenLimit = ws.MonthlyTokenLimit }},
+		WorkspaceFieldDailyRequestLimit:       {ws.DailyRequestLimit != 0, func() { existing.DailyRequestLimit = ws.DailyRequestLimit }},
+		WorkspaceFieldMonthlyRequestLimit:     {ws.MonthlyRequestLimit != 0, func() { existing.MonthlyRequestLimit = ws.MonthlyRequestLimit }},
+	}
+	if len(fields) == 0 {
+		for _, u := range updates {
+			if u.set {
+				u.apply()
+			}
+		}
+	}
+	for _, field := range fields {
+		u, ok := updates[field]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidUpdateField, field)
+		}
+		u.apply()
+	}
+
+	uc.log.WithContext(ctx).Infof("UpdateWorkspace: %v by %v", ws.ID, operatorID)
+	updated, err := uc.repo.Update(ctx, existing)
+	if err != nil {
+		return nil, err
+	}
+	return uc.withMembership(ctx, updated, role)
+}
+
+// DeleteWorkspace 删除工作空间，仅所有者可操作
+func (uc *WorkspaceUsecase) DeleteWorkspace(ctx context.Context, workspaceID int64) error {
+	operatorID := identity.FromContext(ctx)
+	if _, _, err := uc.authorize(ctx, workspaceID, operatorID, WorkspaceRoleOwner); err != nil {
+		return err
+	}
+	uc.log.WithContext(ctx).Infof("DeleteWorkspace: %v by %v", workspaceID, operatorID)
+	return uc.repo.Delete(ctx, workspaceID)
+}
+
+// GetWorkspace 获取工作空间详情，需要是成员
+func (uc *WorkspaceUsecase) GetWorkspace(ctx context.Context, workspaceID int64) (*Workspace, error) {
+	ws, role, err := uc.authorize(ctx, workspaceID, identity.FromContext(ctx), WorkspaceRoleMember)
+	if err != nil {
+		return nil, err
+	}
+	return uc.withMembership(ctx, ws, role)
+}
+
+// ListUserWorkspaces 分页查询网关验证的调用方加入的工作空间，userID 非零时须为调用方本人
+func (uc *WorkspaceUsecase) ListUserWorkspaces(ctx context.Context, userID int64, page, pageSize int32) ([]*Workspace, int64, error) {
+	caller, err := operator(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if userID != 0 && userID != caller {
+		return nil, 0, ErrWorkspaceForbidden
+	}
+	page, pageSize = normalizePage(page, pageSize)
+	return uc.repo.ListByUser(ctx, caller, page, pageSize)
+}
+
+// AddMember 添加成员，管理员可添加普通成员，仅所有者可添加管理员
+func (uc *WorkspaceUsecase) AddMember(ctx context.Context, member *WorkspaceMember) (*WorkspaceMember, error) {
+	operatorID := identity.FromContext(ctx)
+	if member.Role != WorkspaceRoleMember && member.Role != WorkspaceRoleAdmin {
+		return nil, ErrInvalidMemberRole
+	}
+
+	_, operatorRole, err := uc.authorize(ctx, member.WorkspaceID, operatorID, WorkspaceRoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if member.Role == WorkspaceRoleAdmin && operatorRole < WorkspaceRoleOwner {
+		return nil, ErrWorkspaceForbidden
+	}
+
+	existing, err := uc.repo.GetMember(ctx, member.WorkspaceID, member.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, ErrMemberExists
+	}
+
+	member.InvitedBy = operatorID
+	uc.log.WithContext(ctx).Infof("AddMember: workspace %v user %v role %v", member.WorkspaceID, member.UserID, member.Role)
+	return uc.repo.CreateMember(ctx, member)
+}
+
+// UpdateMemberRole 修改成员角色，仅所有者可调整管理员，所有者角色不可修改
+func (uc *WorkspaceUsecase) UpdateMemberRole(ctx context.Context, workspaceID, userID int64, role int32) (*WorkspaceMember, error) {
+	if role != WorkspaceRoleMember && role != WorkspaceRoleAdmin {
+		return nil, ErrInvalidMemberRole
+	}
+
+	_, operatorRole, err := uc.authorize(ctx, workspaceID, identity.FromContext(ctx), WorkspaceRoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := uc.repo.GetMember(ctx, workspaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, ErrMemberNotFound
+	}
+	if member.Role == WorkspaceRoleOwner {
+		return nil, ErrWorkspaceForbidden
+	}
+	if (member.Role == WorkspaceRoleAdmin || role == WorkspaceRoleAdmin) && operatorRole < WorkspaceRoleOwner {
+		return nil, ErrWorkspaceForbidden
+	}
+
+	if err := uc.repo.UpdateMemberRole(ctx, workspaceID, userID, role); err != nil {
+		return nil, err
+	}
+	member.Role = role
+	member.UpdatedAt = time.Now()
+	return member, nil
+}
+
+// RemoveMember 移除成员，成员可自行退出，所有者不可被移除
+func (uc *WorkspaceUsecase) RemoveMember(ctx context.Context, workspaceID, userID int64) error {
+	operatorID, err := operator(ctx)
+	if err != nil {
+		return err
+	}
+
+	member, err := uc.repo.GetMember(ctx, workspaceID, userID)
+	if err != nil {
+		return err
+	}
+	if member == nil {
+		return ErrMemberNotFound
+	}
+	if member.Role == WorkspaceRoleOwner {
+		return ErrWorkspaceForbidden
+	}
+
+	if operatorID != userID {
+		_, operatorRole, err := uc.authorize(ctx, workspaceID, operatorID, WorkspaceRoleAdmin)
+		if err != nil {
+			return err
+		}
+		if member.Role == WorkspaceRoleAdmin && operatorRole < WorkspaceRoleOwner {
+			return ErrWorkspaceForbidden
+		}
+	}
+
+	uc.log.WithContext(ctx).Infof("RemoveMember: workspace %v user %v by %v", workspaceID, userID, operatorID)
+	return uc.repo.DeleteMember(ctx, workspaceID, userID)
+}
+
+// ListMembers 分页查询成员，需要是成员
+func (uc *WorkspaceUsecase) ListMembers(ctx context.Context, workspaceID int64, page, pageSize int32) ([]*WorkspaceMember, int64, error) {
+	if _, _, err := uc.authorize(ctx, workspaceID, identity.FromContext(ctx), WorkspaceRoleMember); err != nil {
+		return nil, 0, err
+	}
+	page, pageSize = normalizePage(page, pageSize)
+	return uc.repo.ListMembers(ctx, workspaceID, page, pageSize)
+}
+
+// GetMembership 查询用户在工作空间中的成员身份，工作空间被禁用时视为非成员
+func (uc *WorkspaceUsecase) GetMembership(ctx context.Context, workspaceID, userID int64) (*Workspace, bool, error) {
+	ws, err := uc.repo.GetByID(ctx, workspaceID)
+	if err != nil {
+		return nil, false, err
+	}
+	if ws == nil || ws.Status != 1 {
+		return nil, false, nil
+	}
+
+	member, err := uc.repo.GetMember(ctx, workspaceID, userID)
+	if err != nil {
+		return nil, false, err
+	}
+	if member == nil {
+		return nil, false, nil
+	}
+
+	ws.Role = member.Role
+	return ws, true, nil
+}
+
+// operator 返回网关验证的调用方ID，未认证时拒绝
+func operator(ctx context.Context) (int64, error) {
+	operatorID := identity.FromContext(ctx)
+	if operatorID <= 0 {
+		return 0, ErrWorkspaceForbidden
+	}
+	return operatorID, nil
+}
+
+// authorize 校验操作者在工作空间中至少拥有指定角色，未认证的操作者没有任何角色
+func (uc *WorkspaceUsecase) authorize(ctx context.Context, workspaceID, operatorID int64, minRole int32) (*Workspace, int32, error) {
+	if operatorID <= 0 {
+		return nil, 0, ErrWorkspaceForbidden
+	}
+	ws, err := uc.repo.GetByID(ctx, workspaceID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if ws == nil {
+		return nil, 0, ErrWorkspaceNotFound
+	}
+
+	member, err := uc.repo.GetMember(ctx, workspaceID, operatorID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if member == nil || member.Role < minRole {
+		return nil, 0, ErrWorkspaceForbidden
+	}
+	return ws, member.Role, nil
+}
+
+// withMembership 填充调用者角色与成员数
+func (uc *WorkspaceUsecase) withMembership(ctx context.Context, ws *Workspace, role int32) (*Workspace, error) {
+	count, err := uc.repo.CountMembers(ctx, ws.ID)
+	if err != nil {
+		return nil, err
+	}
+	ws.Role = role
+	ws.MemberCount = count
+	return ws, nil
+}
+
+// normalizePage 规范分页参数
+func normalizePage(page, pageSize int32) (int32, int32) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+	return page, pageSize
+}
@@ -1,45 +1,121 @@
 package data
 
 import (
+	"context"
+
 	"universal/app/user/internal/conf"
-	"universal/app/user/internal/data/model"
+	"universal/pkg/database"
+	"universal/pkg/discovery"
+	"universal/pkg/healthcheck"
+	"universal/pkg/idgen"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
 	"github.com/google/wire"
-	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	gormlogger "gorm.io/gorm/logger"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewUserRepo)
+var ProviderSet = wire.NewSet(NewData, NewUserRepo, NewWorkspaceRepo, NewDependencyChecker, NewRegistry)
 
 // Data .
 type Data struct {
-	db *gorm.DB
+	db  *gorm.DB
+	rdb *redis.Client
 }
 
 // NewData .
 func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
 	helper := log.NewHelper(logger)
 	// 初始化数据库连接
-	db, err := gorm.Open(mysql.Open(c.Database.Source), &gorm.Config{
-		Logger: gormlogger.Default.LogMode(gormlogger.Info),
-	})
+	db, err := openDB(c)
 	if err != nil {
 		helper.Fatalf("failed to connect database: %v", err)
 		return nil, nil, err
 	}
 	// 数据库迁移
-	if err = db.AutoMigrate(&model.User{}); err != nil {
+	if err = migrateDB(context.Background(), db, c, logger); err != nil {
 		helper.Fatalf("failed to migrate database: %v", err)
 		return nil, nil, err
 	}
+	// 初始化Redis连接，用于租用雪花ID节点号
+	rdb := redis.NewClient(&redis.Options{
+		Network:      c.Redis.Network,
+		Addr:         c.Redis.Addr,
+		ReadTimeout:  c.Redis.ReadTimeout.AsDuration(),
+		WriteTimeout: c.Redis.WriteTimeout.AsDuration(),
+	})
+	// 雪花ID生成器，节点号通过Redis租约保证各副本不同
+	ids, releaseNode, err := idgen.NewGenerator(context.Background(), rdb, "user:idgen:node", logger)
+	if err != nil {
+		helper.Fatalf("failed to init id generator: %v", err)
+		return nil, nil, err
+	}
+	if err = db.Use(ids); err != nil {
+		helper.Fatalf("failed to register id generator: %v", err)
+		return nil, nil, err
+	}
 	cleanup := func() {
 		helper.Info("closing the data resources")
+		releaseNode()
+		if err := rdb.Close(); err != nil {
+			helper.Error(err)
+		}
 	}
 	d := &Data{
-		db: db,
+		db:  db,
+		rdb: rdb,
 	}
 	return d, cleanup, nil
 }
+
+func openDB(c *conf.Data) (*gorm.DB, error) {
+	return database.Open(c.Database.Driver, c.Database.Source, &gorm.Config{
+		Logger: gormlogger.Default.LogMode(gormlogger.Warn),
+	})
+}
+
+// NewDependencyChecker 创建用户服务的依赖健康检查
+func NewDependencyChecker(d *Data, logger log.Logger) *healthcheck.Checker {
+	return healthcheck.NewChecker(logger, []healthcheck.Check{
+		{Name: "db", Critical: true, Probe: d.pingDB},
+		{Name: "redis", Critical: true, Probe: d.pingRedis},
+	})
+}
+
+func (d *Data) pingDB(ctx context.Context) error {
+	sqlDB, err := d.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
+
+func (d *Data) pingRedis(ctx context.Context) error {
+	return d.rdb.Ping(ctx).Err()
+}
+
+// NewRegistry 按配置创建服务注册与发现
+func NewRegistry(c *conf.Registry) (discovery.Registry, error) {
+	o := discovery.Options{
+		Type: c.GetType(),
+		Consul: discovery.ConsulOptions{
+			Address:             c.GetConsul().GetAddress(),
+			Scheme:              c.GetConsul().GetScheme(),
+			DisableHealthCheck:  c.GetConsul().GetDisableHealthCheck(),
+			HealthCheckInterval: c.GetConsul().GetHealthCheckInterval().AsDuration(),
+		},
+		Etcd: discovery.EtcdOptions{
+			Endpoints:   c.GetEtcd().GetEndpoints(),
+			DialTimeout: c.GetEtcd().GetDialTimeout().AsDuration(),
+			Username:    c.GetEtcd().GetUsername(),
+			Password:    c.GetEtcd().GetPassword(),
+		},
+		Static: make(map[string][]string),
+	}
+	for _, s := range c.GetStatic() {
+		o.Static[s.Name] = append(o.Static[s.Name], s.Endpoints...)
+	}
+	return discovery.New(o)
+}
@@ -0,0 +1,59 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"universal/app/user/internal/conf"
+	"universal/pkg/migrate"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// migrations 用户服务的数据库迁移，按版本号顺序执行，已发布的迁移不可修改
+var migrations = []migrate.Migration{
+	{
+		// 基线：此前由启动时 AutoMigrate 建立的表结构，已有数据库执行时只补齐缺失的表、列与索引。
+		// 使用冻结的快照而不是当前模型，之后新增的列由各自的迁移添加
+		Version: 2026101901,
+		Name:    "baseline",
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&baselineUser{}, &baselineWorkspace{}, &baselineWorkspaceMember{})
+		},
+	},
+}
+
+// migrateDB 启动时迁移或检查数据库版本，数据库版本高于当前二进制时拒绝启动
+func migrateDB(ctx context.Context, db *gorm.DB, c *conf.Data, logger log.Logger) error {
+	m, err := migrate.New(db, migrations, logger)
+	if err != nil {
+		return err
+	}
+	if c.Database.AutoMigrate {
+		return m.Up(ctx)
+	}
+	err = m.Check(ctx)
+	if errors.Is(err, migrate.ErrPending) {
+		return fmt.Errorf("%w, run the migrate command first", err)
+	}
+	return err
+}
+
+// Migrate 执行 migrate 子命令
+func Migrate(ctx context.Context, c *conf.Data, args []string, w io.Writer, logger log.Logger) error {
+	db, err := openDB(c)
+	if err != nil {
+		return err
+	}
+	if sqlDB, err := db.DB(); err == nil {
+		defer sqlDB.Close()
+	}
+	m, err := migrate.New(db, migrations, logger)
+	if err != nil {
+		return err
+	}
+	return m.Run(ctx, args, w)
+}
@@ -0,0 +1,66 @@
+package data
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 已发布迁移建表时的表结构快照。模型之后新增的列与索引由后续迁移添加，快照冻结后不再修改，
+// 以保证同一迁移在任何时候执行都得到相同的结构
+
+// 2026101901 baseline
+type baselineUser struct {
+	ID        int64          `gorm:"primaryKey"`
+	CreatedAt time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+	Username  string         `gorm:"uniqueIndex;not null;size:50"`
+	Email     string         `gorm:"uniqueIndex;not null;size:100"`
+	Phone     string         `gorm:"size:20"`
+	Password  string         `gorm:"not null;size:255"`
+	Nickname  string         `gorm:"size:50"`
+	Avatar    string         `gorm:"size:255"`
+	Status    int32          `gorm:"default:1;comment:状态 1:正常 0:禁用"`
+}
+
+func (baselineUser) TableName() string {
+	return "users"
+}
+
+type baselineWorkspace struct {
+	ID                      int64          `gorm:"primaryKey"`
+	CreatedAt               time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt               time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt               gorm.DeletedAt `gorm:"index"`
+	Name                    string         `gorm:"not null;size:100"`
+	Slug                    string         `gorm:"uniqueIndex;not null;size:50"`
+	Description             string         `gorm:"size:500"`
+	OwnerID                 int64          `gorm:"not null;index"`
+	DefaultChatModelID      int64          `gorm:"default:0"`
+	DefaultEmbeddingModelID int64          `gorm:"default:0"`
+	DailyTokenLimit         int64          `gorm:"default:0;comment:成员共享的每日token配额 0:不限"`
+	MonthlyTokenLimit       int64          `gorm:"default:0;comment:成员共享的每月token配额 0:不限"`
+	DailyRequestLimit       int64          `gorm:"default:0"`
+	MonthlyRequestLimit     int64          `gorm:"default:0"`
+	Status                  int32          `gorm:"default:1;comment:状态 1:正常 0:禁用"`
+}
+
+func (baselineWorkspace) TableName() string {
+	return "workspaces"
+}
+
+type baselineWorkspaceMember struct {
+	ID          int64          `gorm:"primaryKey"`
+	CreatedAt   time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt   gorm.DeletedAt `gorm:"index"`
+	WorkspaceID int64          `gorm:"not null;uniqueIndex:idx_workspace_member"`
+	UserID      int64          `gorm:"not null;uniqueIndex:idx_workspace_member;index"`
+	Role        int32          `gorm:"not null;default:1;comment:角色 1:成员 2:管理员 3:所有者"`
+	InvitedBy   int64          `gorm:"default:0"`
+}
+
+func (baselineWorkspaceMember) TableName() string {
+	return "workspace_members"
+}
@@ -0,0 +1,35 @@
+package model
+
+// Workspace 工作空间数据库模型
+type Workspace struct {
+	BaseModel
+	Name                    string `gorm:"not null;size:100" json:"name"`
+	Slug                    string `gorm:"uniqueIndex;not null;size:50" json:"slug"`
+	Description             string `gorm:"size:500" json:"description"`
+	OwnerID                 int64  `gorm:"not null;index" json:"owner_id"`
+	DefaultChatModelID      int64  `gorm:"default:0" json:"default_chat_model_id"`
+	DefaultEmbeddingModelID int64  `gorm:"default:0" json:"default_embedding_model_id"`
+	DailyTokenLimit         int64  `gorm:"default:0;comment:成员共享的每日token配额 0:不限" json:"daily_token_limit"`
+	MonthlyTokenLimit       int64  `gorm:"default:0;comment:成员共享的每月token配额 0:不限" json:"monthly_token_limit"`
+	DailyRequestLimit       int64  `gorm:"default:0" json:"daily_request_limit"`
+	MonthlyRequestLimit     int64  `gorm:"default:0" json:"monthly_request_limit"`
+	Status                  int32  `gorm:"default:1;comment:状态 1:正常 0:禁用" json:"status"`
+}
+
+// WorkspaceMember 工作空间成员关系
+type WorkspaceMember struct {
+	BaseModel
+	WorkspaceID int64 `gorm:"not null;uniqueIndex:idx_workspace_member" json:"workspace_id"`
+	UserID      int64 `gorm:"not null;uniqueIndex:idx_workspace_member;index" json:"user_id"`
+	Role        int32 `gorm:"not null;default:1;comment:角色 1:成员 2:管理员 3:所有者" json:"role"`
+	InvitedBy   int64 `gorm:"default:0" json:"invited_by"`
+}
+
+// TableName 自定义表名
+func (Workspace) TableName() string {
+	return "workspaces"
+}
+
+func (WorkspaceMember) TableName() string {
+	return "workspace_members"
+}
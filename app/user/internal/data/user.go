@@ -4,6 +4,7 @@ import (
 	"context"
 	"universal/app/user/internal/biz"
 	"universal/app/user/internal/data/model"
+	"universal/pkg/database"
 
 	"golang.org/x/crypto/bcrypt"
 
@@ -80,7 +81,7 @@ func (r *userRepo) List(ctx context.Context, req *biz.ListUserRequest) (*biz.Lis
 
 	// 关键词搜索
 	if req.Keyword != "" {
-		query = query.Where("username LIKE ? OR email LIKE ? OR nickname LIKE ?",
+		query = query.Where(database.Like(r.data.db, "username", "email", "nickname"),
 			"%"+req.Keyword+"%", "%"+req.Keyword+"%", "%"+req.Keyword+"%")
 	}
 
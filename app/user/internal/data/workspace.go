@@ -0,0 +1,244 @@
+package data
+
+import (
+	"context"
+	"universal/app/user/internal/biz"
+	"universal/app/user/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type workspaceRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewWorkspaceRepo .
+func NewWorkspaceRepo(data *Data, logger log.Logger) biz.WorkspaceRepo {
+	return &workspaceRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *workspaceRepo) Create(ctx context.Context, ws *biz.Workspace) (*biz.Workspace, error) {
+	dbWorkspace := &model.Workspace{
+		Name:                    ws.Name,
+		Slug:                    ws.Slug,
+		Description:             ws.Description,
+		OwnerID:                 ws.OwnerID,
+		DefaultChatModelID:      ws.DefaultChatModelID,
+		DefaultEmbeddingModelID: ws.DefaultEmbeddingModelID,
+		DailyTokenLimit:         ws.DailyTokenLimit,
+		MonthlyTokenLimit:       ws.MonthlyTokenLimit,
+		DailyRequestLimit:       ws.DailyRequestLimit,
+		MonthlyRequestLimit:     ws.MonthlyRequestLimit,
+		Status:                  ws.Status,
+	}
+
+	// 工作空间与所有者成员关系在同一事务中创建
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(dbWorkspace).Error; err != nil {
+			return err
+		}
+		return tx.Create(&model.WorkspaceMember{
+			WorkspaceID: dbWorkspace.ID,
+			UserID:      dbWorkspace.OwnerID,
+			Role:        biz.WorkspaceRoleOwner,
+			InvitedBy:   dbWorkspace.OwnerID,
+		}).Error
+	})
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("创建工作空间失败: %v", err)
+		return nil, err
+	}
+
+	return convertWorkspace(dbWorkspace), nil
+}
+
+func (r *workspaceRepo) Update(ctx context.Context, ws *biz.Workspace) (*biz.Workspace, error) {
+	updates := map[string]interface{}{
+		"name":                       ws.Name,
+		"description":                ws.Description,
+		"default_chat_model_id":      ws.DefaultChatModelID,
+		"default_embedding_model_id": ws.DefaultEmbeddingModelID,
+		"daily_token_limit":          ws.DailyTokenLimit,
+		"monthly_token_limit":        ws.MonthlyTokenLimit,
+		"daily_request_limit":        ws.DailyRequestLimit,
+		"monthly_request_limit":      ws.MonthlyRequestLimit,
+	}
+	if err := r.data.db.WithContext(ctx).Model(&model.Workspace{}).Where("id = ?", ws.ID).Updates(updates).Error; err != nil {
+		r.log.WithContext(ctx).Errorf("更新工作空间失败: %v", err)
+		return nil, err
+	}
+	return r.GetByID(ctx, ws.ID)
+}
+
+func (r *workspaceRepo) Delete(ctx context.Context, id int64) error {
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("workspace_id = ?", id).Delete(&model.WorkspaceMember{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&model.Workspace{}, id).Error
+	})
+}
+
+func (r *workspaceRepo) GetByID(ctx context.Context, id int64) (*biz.Workspace, error) {
+	var dbWorkspace model.Workspace
+	if err := r.data.db.WithContext(ctx).First(&dbWorkspace, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return convertWorkspace(&dbWorkspace), nil
+}
+
+func (r *workspaceRepo) ExistsBySlug(ctx context.Context, slug string) (bool, error) {
+	var count int64
+	if err := r.data.db.WithContext(ctx).Model(&model.Workspace{}).Where("slug = ?", slug).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *workspaceRepo) ListByUser(ctx context.Context, userID int64, page, pageSize int32) ([]*biz.Workspace, int64, error) {
+	var total int64
+	query := r.data.db.WithContext(ctx).Model(&model.Workspace{}).
+		Joins("JOIN workspace_members ON workspace_members.workspace_id = workspaces.id AND workspace_members.deleted_at IS NULL").
+		Where("workspace_members.user_id = ?", userID)
+
+	if err := query.Count(&total).Error; err != nil {
+		r.log.WithContext(ctx).Errorf("查询工作空间总数失败: %v", err)
+		return nil, 0, err
+	}
+
+	var rows []struct {
+		model.Workspace
+		MemberRole int32
+	}
+	offset := (page - 1) * pageSize
+	err := query.Select("workspaces.*, workspace_members.role AS member_role").
+		Order("workspaces.created_at ASC").
+		Limit(int(pageSize)).Offset(int(offset)).
+		Scan(&rows).Error
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("查询工作空间列表失败: %v", err)
+		return nil, 0, err
+	}
+
+	workspaces := make([]*biz.Workspace, len(rows))
+	for i := range rows {
+		workspaces[i] = convertWorkspace(&rows[i].Workspace)
+		workspaces[i].Role = rows[i].MemberRole
+	}
+	return workspaces, total, nil
+}
+
+func (r *workspaceRepo) GetMember(ctx context.Context, workspaceID, userID int64) (*biz.WorkspaceMember, error) {
+	var dbMember model.WorkspaceMember
+	err := r.data.db.WithContext(ctx).
+		Where("workspace_id = ? AND user_id = ?", workspaceID, userID).
+		First(&dbMember).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return convertWorkspaceMember(&dbMember), nil
+}
+
+func (r *workspaceRepo) CreateMember(ctx context.Context, m *biz.WorkspaceMember) (*biz.WorkspaceMember, error) {
+	dbMember := &model.WorkspaceMember{
+		WorkspaceID: m.WorkspaceID,
+		UserID:      m.UserID,
+		Role:        m.Role,
+		InvitedBy:   m.InvitedBy,
+	}
+	// 成员被移除后软删除记录仍占用唯一索引，重新加入时先清理
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().
+			Where("workspace_id = ? AND user_id = ? AND deleted_at IS NOT NULL", m.WorkspaceID, m.UserID).
+			Delete(&model.WorkspaceMember{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(dbMember).Error
+	})
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("添加工作空间成员失败: %v", err)
+		return nil, err
+	}
+	return convertWorkspaceMember(dbMember), nil
+}
+
+func (r *workspaceRepo) UpdateMemberRole(ctx context.Context, workspaceID, userID int64, role int32) error {
+	return r.data.db.WithContext(ctx).Model(&model.WorkspaceMember{}).
+		Where("workspace_id = ? AND user_id = ?", workspaceID, userID).
+		Update("role", role).Error
+}
+
+func (r *workspaceRepo) DeleteMember(ctx context.Context, workspaceID, userID int64) error {
+	return r.data.db.WithContext(ctx).
+		Where("workspace_id = ? AND user_id = ?", workspaceID, userID).
+		Delete(&model.WorkspaceMember{}).Error
+}
+
+func (r *workspaceRepo) ListMembers(ctx context.Context, workspaceID int64, page, pageSize int32) ([]*biz.WorkspaceMember, int64, error) {
+	var total int64
+	query := r.data.db.WithContext(ctx).Model(&model.WorkspaceMember{}).Where("workspace_id = ?", workspaceID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var dbMembers []*model.WorkspaceMember
+	offset := (page - 1) * pageSize
+	if err := query.Order("role DESC, created_at ASC").Limit(int(pageSize)).Offset(int(offset)).Find(&dbMembers).Error; err != nil {
+		return nil, 0, err
+	}
+
+	members := make([]*biz.WorkspaceMember, len(dbMembers))
+	for i, dbMember := range dbMembers {
+		members[i] = convertWorkspaceMember(dbMember)
+	}
+	return members, total, nil
+}
+
+func (r *workspaceRepo) CountMembers(ctx context.Context, workspaceID int64) (int64, error) {
+	var count int64
+	err := r.data.db.WithContext(ctx).Model(&model.WorkspaceMember{}).Where("workspace_id = ?", workspaceID).Count(&count).Error
+	return count, err
+}
+
+// convertWorkspace 转换为业务模型
+func convertWorkspace(w *model.Workspace) *biz.Workspace {
+	return &biz.Workspace{
+		ID:                      w.ID,
+		Name:                    w.Name,
+		Slug:                    w.Slug,
+		Description:             w.Description,
+		OwnerID:                 w.OwnerID,
+		DefaultChatModelID:      w.DefaultChatModelID,
+		DefaultEmbeddingModelID: w.DefaultEmbeddingModelID,
+		DailyTokenLimit:         w.DailyTokenLimit,
+		MonthlyTokenLimit:       w.MonthlyTokenLimit,
+		DailyRequestLimit:       w.DailyRequestLimit,
+		MonthlyRequestLimit:     w.MonthlyRequestLimit,
+		Status:                  w.Status,
+		CreatedAt:               w.CreatedAt,
+		UpdatedAt:               w.UpdatedAt,
+	}
+}
+
+// convertWorkspaceMember 转换为业务模型
+func convertWorkspaceMember(m *model.WorkspaceMember) *biz.WorkspaceMember {
+	return &biz.WorkspaceMember{
+		WorkspaceID: m.WorkspaceID,
+		UserID:      m.UserID,
+		Role:        m.Role,
+		InvitedBy:   m.InvitedBy,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
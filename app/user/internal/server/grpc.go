@@ -4,6 +4,10 @@ import (
 	v1 "universal/api/user/v1"
 	"universal/app/user/internal/conf"
 	"universal/app/user/internal/service"
+	"universal/pkg/discovery"
+	"universal/pkg/healthcheck"
+	"universal/pkg/identity"
+	"universal/pkg/telemetry"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
@@ -11,13 +15,21 @@ import (
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, user *service.UserService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, user *service.UserService, workspace *service.WorkspaceService, checker *healthcheck.Checker, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
+		// 健康状态由依赖检查决定，替代默认只反映进程存活的健康服务
+		grpc.CustomHealth(),
 		grpc.Middleware(
 			recovery.Recovery(),
+			telemetry.Server(logger),
+			identity.Server(),
 		),
 	}
-	if c.Grpc.Network != "" {
+	if c.Grpc.Network == discovery.NetworkInProc {
+		// 单进程模式：不监听端口，以 addr 为名称注册进程内连接
+		lis, endpoint := discovery.Listen(c.Grpc.Addr)
+		opts = append(opts, grpc.Listener(lis), grpc.Endpoint(endpoint))
+	} else if c.Grpc.Network != "" {
 		opts = append(opts, grpc.Network(c.Grpc.Network))
 	}
 	if c.Grpc.Addr != "" {
@@ -28,5 +40,7 @@ func NewGRPCServer(c *conf.Server, user *service.UserService, logger log.Logger)
 	}
 	srv := grpc.NewServer(opts...)
 	v1.RegisterUserServer(srv, user)
+	v1.RegisterWorkspaceServer(srv, workspace)
+	checker.Register(srv)
 	return srv
 }
@@ -2,6 +2,7 @@ package server
 
 import (
 	"universal/app/user/internal/conf"
+	"universal/pkg/telemetry"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
@@ -13,6 +14,7 @@ func NewHTTPServer(c *conf.Server, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
+			telemetry.Server(logger),
 		),
 	}
 	if c.Http.Network != "" {
@@ -25,5 +27,6 @@ func NewHTTPServer(c *conf.Server, logger log.Logger) *http.Server {
 		opts = append(opts, http.Timeout(c.Http.Timeout.AsDuration()))
 	}
 	srv := http.NewServer(opts...)
+	srv.Handle(telemetry.MetricsPath, telemetry.MetricsHandler())
 	return srv
 }
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"universal/app/user/internal/biz"
+
+	pb "universal/api/user/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type WorkspaceService struct {
+	pb.UnimplementedWorkspaceServer
+	uc *biz.WorkspaceUsecase
+}
+
+func NewWorkspaceService(uc *biz.WorkspaceUsecase) *WorkspaceService {
+	return &WorkspaceService{uc: uc}
+}
+
+func (s *WorkspaceService) CreateWorkspace(ctx context.Context, req *pb.CreateWorkspaceRequest) (*pb.CreateWorkspaceReply, error) {
+	ws, err := s.uc.CreateWorkspace(ctx, &biz.Workspace{
+		Name:                    req.Name,
+		Slug:                    req.Slug,
+		Description:             req.Description,
+		DefaultChatModelID:      req.DefaultChatModelId,
+		DefaultEmbeddingModelID: req.DefaultEmbeddingModelId,
+		DailyTokenLimit:         req.DailyTokenLimit,
+		MonthlyTokenLimit:       req.MonthlyTokenLimit,
+		DailyRequestLimit:       req.DailyRequestLimit,
+		MonthlyRequestLimit:     req.MonthlyRequestLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateWorkspaceReply{Workspace: toWorkspaceInfo(ws)}, nil
+}
+
+func (s *WorkspaceService) UpdateWorkspace(ctx context.Context, req *pb.UpdateWorkspaceRequest) (*pb.UpdateWorkspaceReply, error) {
+	ws, err := s.uc.UpdateWorkspace(ctx, &biz.Workspace{
+		ID:                      req.Id,
+		Name:                    req.Name,
+		Description:             req.Description,
+		DefaultChatModelID:      req.DefaultChatModelId,
+		DefaultEmbeddingModelID: req.DefaultEmbeddingModelId,
+		DailyTokenLimit:         req.DailyTokenLimit,
+		MonthlyTokenLimit:       req.MonthlyTokenLimit,
+		DailyRequestLimit:       req.DailyRequestLimit,
+		MonthlyRequestLimit:     req.MonthlyRequestLimit,
+	}, req.GetUpdateMask().GetPaths())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateWorkspaceReply{Workspace: toWorkspaceInfo(ws)}, nil
+}
+
+func (s *WorkspaceService) DeleteWorkspace(ctx context.Context, req *pb.DeleteWorkspaceRequest) (*pb.OperationReply, error) {
+	if err := s.uc.DeleteWorkspace(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &pb.OperationReply{Success: true, Message: "工作空间已删除", AffectedCount: 1}, nil
+}
+
+func (s *WorkspaceService) GetWorkspace(ctx context.Context, req *pb.GetWorkspaceRequest) (*pb.GetWorkspaceReply, error) {
+	ws, err := s.uc.GetWorkspace(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetWorkspaceReply{Workspace: toWorkspaceInfo(ws)}, nil
+}
+
+func (s *WorkspaceService) ListUserWorkspaces(ctx context.Context, req *pb.ListUserWorkspacesRequest) (*pb.ListUserWorkspacesReply, error) {
+	workspaces, total, err := s.uc.ListUserWorkspaces(ctx, req.UserId, req.Page, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	pbWorkspaces := make([]*pb.WorkspaceInfo, len(workspaces))
+	for i, ws := range workspaces {
+		pbWorkspaces[i] = toWorkspaceInfo(ws)
+	}
+	return &pb.ListUserWorkspacesReply{
+		Workspaces: pbWorkspaces,
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+	}, nil
+}
+
+func (s *WorkspaceService) AddWorkspaceMember(ctx context.Context, req *pb.AddWorkspaceMemberRequest) (*pb.WorkspaceMemberReply, error) {
+	member, err := s.uc.AddMember(ctx, &biz.WorkspaceMember{
+		WorkspaceID: req.WorkspaceId,
+		UserID:      req.UserId,
+		Role:        int32(req.Role),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.WorkspaceMemberReply{Member: toWorkspaceMember(member)}, nil
+}
+
+func (s *WorkspaceService) UpdateWorkspaceMember(ctx context.Context, req *pb.UpdateWorkspaceMemberRequest) (*pb.WorkspaceMemberReply, error) {
+	member, err := s.uc.UpdateMemberRole(ctx, req.WorkspaceId, req.UserId, int32(req.Role))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.WorkspaceMemberReply{Member: toWorkspaceMember(member)}, nil
+}
+
+func (s *WorkspaceService) RemoveWorkspaceMember(ctx context.Context, req *pb.RemoveWorkspaceMemberRequest) (*pb.OperationReply, error) {
+	if err := s.uc.RemoveMember(ctx, req.WorkspaceId, req.UserId); err != nil {
+		return nil, err
+	}
+	return &pb.OperationReply{Success: true, Message: "成员已移除", AffectedCount: 1}, nil
+}
+
+func (s *WorkspaceService) ListWorkspaceMembers(ctx context.Context, req *pb.ListWorkspaceMembersRequest) (*pb.ListWorkspaceMembersReply, error) {
+	members, total, err := s.uc.ListMembers(ctx, req.WorkspaceId, req.Page, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	pbMembers := make([]*pb.WorkspaceMember, len(members))
+	for i, member := range members {
+		pbMembers[i] = toWorkspaceMember(member)
+	}
+	return &pb.ListWorkspaceMembersReply{
+		Members:  pbMembers,
+		Total:    total,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}, nil
+}
+
+func (s *WorkspaceService) GetWorkspaceMembership(ctx context.Context, req *pb.GetWorkspaceMembershipRequest) (*pb.GetWorkspaceMembershipReply, error) {
+	ws, isMember, err := s.uc.GetMembership(ctx, req.WorkspaceId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if !isMember {
+		return &pb.GetWorkspaceMembershipReply{IsMember: false}, nil
+	}
+	return &pb.GetWorkspaceMembershipReply{IsMember: true, Workspace: toWorkspaceInfo(ws)}, nil
+}
+
+// toWorkspaceInfo 将业务层Workspace转换为protobuf消息
+func toWorkspaceInfo(ws *biz.Workspace) *pb.WorkspaceInfo {
+	return &pb.WorkspaceInfo{
+		Id:                      ws.ID,
+		Name:                    ws.Name,
+		Slug:                    ws.Slug,
+		Description:             ws.Description,
+		OwnerId:                 ws.OwnerID,
+		DefaultChatModelId:      ws.DefaultChatModelID,
+		DefaultEmbeddingModelId: ws.DefaultEmbeddingModelID,
+		DailyTokenLimit:         ws.DailyTokenLimit,
+		MonthlyTokenLimit:       ws.MonthlyTokenLimit,
+		DailyRequestLimit:       ws.DailyRequestLimit,
+		MonthlyRequestLimit:     ws.MonthlyRequestLimit,
+		Status:                  ws.Status,
+		MemberCount:             ws.MemberCount,
+		Role:                    pb.WorkspaceRole(ws.Role),
+		CreatedAt:               timestamppb.New(ws.CreatedAt),
+		UpdatedAt:               timestamppb.New(ws.UpdatedAt),
+	}
+}
+
+// toWorkspaceMember 将业务层成员转换为protobuf消息
+func toWorkspaceMember(m *biz.WorkspaceMember) *pb.WorkspaceMember {
+	return &pb.WorkspaceMember{
+		WorkspaceId: m.WorkspaceID,
+		UserId:      m.UserID,
+		Role:        pb.WorkspaceRole(m.Role),
+		InvitedBy:   m.InvitedBy,
+		CreatedAt:   timestamppb.New(m.CreatedAt),
+		UpdatedAt:   timestamppb.New(m.UpdatedAt),
+	}
+}
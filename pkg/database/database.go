@@ -0,0 +1,64 @@
+// Package database 按配置的驱动打开 gorm 数据库连接，并提供各方言 SQL 写法的差异封装。
+package database
+
+import (
+	"fmt"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// 支持的数据库驱动，与 gorm Dialector.Name() 一致
+const (
+	DriverMySQL    = "mysql"
+	DriverPostgres = "postgres"
+	// DriverSQLite 嵌入式 SQLite，纯Go实现无需CGO，用于本地开发与单进程模式
+	DriverSQLite = "sqlite"
+)
+
+// postgresDomains 模型中使用的 MySQL 专有列类型，在 PostgreSQL 中以同名 domain 提供，模型定义无需区分方言
+var postgresDomains = []struct{ name, base string }{
+	{"longtext", "text"},
+	{"tinyint", "smallint"},
+}
+
+// Open 按驱动打开数据库，driver 为空时使用 MySQL
+func Open(driver, source string, config *gorm.Config) (*gorm.DB, error) {
+	var dialector gorm.Dialector
+	switch driver {
+	case "", DriverMySQL:
+		dialector = mysql.Open(source)
+	case DriverPostgres, "postgresql":
+		dialector = postgres.Open(source)
+	case DriverSQLite:
+		dialector = sqlite.Open(source)
+	default:
+		return nil, fmt.Errorf("unsupported database driver %q", driver)
+	}
+	db, err := gorm.Open(dialector, config)
+	if err != nil {
+		return nil, err
+	}
+	if Dialect(db) == DriverPostgres {
+		if err := createPostgresDomains(db); err != nil {
+			return nil, err
+		}
+	}
+	return db, nil
+}
+
+func createPostgresDomains(db *gorm.DB) error {
+	for _, d := range postgresDomains {
+		// CREATE DOMAIN 不支持 IF NOT EXISTS，已存在时忽略 duplicate_object
+		err := db.Exec(fmt.Sprintf(
+			"DO $$ BEGIN CREATE DOMAIN %s AS %s; EXCEPTION WHEN duplicate_object THEN NULL; END $$",
+			d.name, d.base,
+		)).Error
+		if err != nil {
+			return fmt.Errorf("failed to create domain %s: %w", d.name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// IsDuplicateKey 判断错误是否为唯一约束冲突，各方言的错误码由驱动转换
+func IsDuplicateKey(db *gorm.DB, err error) bool {
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return true
+	}
+	if t, ok := db.Dialector.(gorm.ErrorTranslator); ok {
+		return errors.Is(t.Translate(err), gorm.ErrDuplicatedKey)
+	}
+	return false
+}
+
+// Dialect 返回连接使用的方言，取值为 DriverMySQL、DriverPostgres 或 DriverSQLite
+func Dialect(db *gorm.DB) string {
+	return db.Dialector.Name()
+}
+
+// Like 返回多列模糊匹配条件，各列以 OR 连接，每列一个占位符。
+// MySQL 默认排序规则与 SQLite 的 LIKE 均不区分大小写，PostgreSQL 使用 ILIKE 保持一致
+func Like(db *gorm.DB, columns ...string) string {
+	op := "LIKE"
+	if Dialect(db) == DriverPostgres {
+		op = "ILIKE"
+	}
+	conds := make([]string, len(columns))
+	for i, column := range columns {
+		conds[i] = fmt.Sprintf("%s %s ?", column, op)
+	}
+	return strings.Join(conds, " OR ")
+}
+
+// JSONText 返回 JSON 列的文本形式，用于对整个 JSON 值做模糊匹配
+func JSONText(db *gorm.DB, column string) string {
+	switch Dialect(db) {
+	case DriverPostgres:
+		return fmt.Sprintf("CAST(%s AS TEXT)", column)
+	case DriverSQLite:
+		// SQLite 中 JSON 以文本存储
+		return column
+	default:
+		return fmt.Sprintf("JSON_EXTRACT(%s, '$')", column)
+	}
+}
+
+// JSONArrayContains 返回 JSON 字符串数组包含某个元素的条件，占位符为元素原值
+func JSONArrayContains(db *gorm.DB, column string) string {
+	switch Dialect(db) {
+	case DriverPostgres:
+		return fmt.Sprintf("CAST(%s AS JSONB) @> jsonb_build_array(CAST(? AS TEXT))", column)
+	case DriverSQLite:
+		return fmt.Sprintf("EXISTS (SELECT 1 FROM json_each(%s) WHERE json_each.value = ?)", column)
+	default:
+		return fmt.Sprintf("JSON_CONTAINS(%s, JSON_QUOTE(?))", column)
+	}
+}
+
+// JSONEqual 返回 JSON 列与给定 JSON 文本语义相等的条件，占位符为 JSON 文本
+func JSONEqual(db *gorm.DB, column string) string {
+	switch Dialect(db) {
+	case DriverPostgres:
+		// json 类型没有相等运算符，转为 jsonb 比较
+		return fmt.Sprintf("CAST(%s AS JSONB) = CAST(? AS JSONB)", column)
+	case DriverSQLite:
+		return fmt.Sprintf("json(%s) = json(?)", column)
+	default:
+		return fmt.Sprintf("%s = CAST(? AS JSON)", column)
+	}
+}
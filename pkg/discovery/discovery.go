@@ -0,0 +1,87 @@
+// Package discovery 按配置创建服务注册与发现：Consul、etcd、静态地址列表或进程内注册表。
+//
+// 本地开发可以不依赖 Consul：多个进程时使用 static 列出各服务地址，
+// 所有服务运行在同一进程时使用 memory。
+package discovery
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/contrib/registry/consul/v2"
+	"github.com/go-kratos/kratos/v2/registry"
+	consulAPI "github.com/hashicorp/consul/api"
+)
+
+// 注册中心类型
+const (
+	TypeConsul = "consul"
+	TypeEtcd   = "etcd"
+	TypeStatic = "static"
+	TypeMemory = "memory"
+)
+
+// Registry 同时提供服务注册与发现
+type Registry interface {
+	registry.Registrar
+	registry.Discovery
+}
+
+// Options 注册中心配置
+type Options struct {
+	// Type 注册中心类型，默认 consul
+	Type   string
+	Consul ConsulOptions
+	Etcd   EtcdOptions
+	// Static 服务名到地址列表，如 universal.user.service: [grpc://127.0.0.1:9001]
+	Static map[string][]string
+}
+
+// ConsulOptions Consul 配置
+type ConsulOptions struct {
+	Address string
+	Scheme  string
+	// DisableHealthCheck 关闭健康检查，开启时 Consul 检查实例端口与心跳，发现只返回健康的实例
+	DisableHealthCheck bool
+	// HealthCheckInterval 健康检查间隔，默认10秒
+	HealthCheckInterval time.Duration
+}
+
+// EtcdOptions etcd 配置
+type EtcdOptions struct {
+	Endpoints   []string
+	DialTimeout time.Duration
+	Username    string
+	Password    string
+}
+
+// New 创建注册中心
+func New(o Options) (Registry, error) {
+	switch o.Type {
+	case "", TypeConsul:
+		return newConsul(o.Consul)
+	case TypeEtcd:
+		return newEtcd(o.Etcd)
+	case TypeStatic:
+		return newStatic(o.Static), nil
+	case TypeMemory:
+		return defaultMemory, nil
+	default:
+		return nil, fmt.Errorf("unknown registry type %q", o.Type)
+	}
+}
+
+func newConsul(o ConsulOptions) (Registry, error) {
+	c := consulAPI.DefaultConfig()
+	c.Address = o.Address
+	c.Scheme = o.Scheme
+	cli, err := consulAPI.NewClient(c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create consul client: %w", err)
+	}
+	opts := []consul.Option{consul.WithHealthCheck(!o.DisableHealthCheck)}
+	if o.HealthCheckInterval > 0 {
+		opts = append(opts, consul.WithHealthCheckInterval(int(o.HealthCheckInterval.Seconds())))
+	}
+	return consul.New(cli, opts...), nil
+}
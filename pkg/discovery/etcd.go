@@ -0,0 +1,200 @@
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/registry"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	// etcdNamespace 服务实例的键前缀，键为 /microservices/<服务名>/<实例ID>
+	etcdNamespace          = "/microservices"
+	etcdLeaseTTL           = 15
+	defaultEtcdDialTimeout = 5 * time.Second
+	// etcdRetryInterval 租约丢失后重新注册的间隔
+	etcdRetryInterval = 3 * time.Second
+)
+
+// etcdRegistry 基于租约的 etcd 注册中心，进程退出或失联后实例随租约过期自动摘除
+type etcdRegistry struct {
+	client *clientv3.Client
+
+	mu      sync.Mutex
+	cancels map[string]context.CancelFunc
+}
+
+func newEtcd(o EtcdOptions) (Registry, error) {
+	timeout := o.DialTimeout
+	if timeout <= 0 {
+		timeout = defaultEtcdDialTimeout
+	}
+	// 客户端建连不阻塞，etcd 暂不可用时不影响启动
+	client, err := clientv3.New(clientv3.Config{
+		Endpoints:   o.Endpoints,
+		DialTimeout: timeout,
+		Username:    o.Username,
+		Password:    o.Password,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create etcd client: %w", err)
+	}
+	return &etcdRegistry{
+		client:  client,
+		cancels: make(map[string]context.CancelFunc),
+	}, nil
+}
+
+func etcdKey(name, id string) string {
+	return fmt.Sprintf("%s/%s/%s", etcdNamespace, name, id)
+}
+
+func etcdPrefix(name string) string {
+	return fmt.Sprintf("%s/%s/", etcdNamespace, name)
+}
+
+// Register 写入实例并保持租约，租约丢失时在后台重新注册
+func (r *etcdRegistry) Register(ctx context.Context, ins *registry.ServiceInstance) error {
+	value, err := json.Marshal(ins)
+	if err != nil {
+		return err
+	}
+	key := etcdKey(ins.Name, ins.ID)
+	leaseID, err := r.put(ctx, key, string(value))
+	if err != nil {
+		return err
+	}
+
+	kctx, cancel := context.WithCancel(context.Background())
+	r.mu.Lock()
+	if prev := r.cancels[key]; prev != nil {
+		prev()
+	}
+	r.cancels[key] = cancel
+	r.mu.Unlock()
+	go r.keepAlive(kctx, key, string(value), leaseID)
+	return nil
+}
+
+func (r *etcdRegistry) put(ctx context.Context, key, value string) (clientv3.LeaseID, error) {
+	lease, err := r.client.Grant(ctx, etcdLeaseTTL)
+	if err != nil {
+		return 0, err
+	}
+	if _, err = r.client.Put(ctx, key, value, clientv3.WithLease(lease.ID)); err != nil {
+		return 0, err
+	}
+	return lease.ID, nil
+}
+
+func (r *etcdRegistry) keepAlive(ctx context.Context, key, value string, leaseID clientv3.LeaseID) {
+	for {
+		ch, err := r.client.KeepAlive(ctx, leaseID)
+		if err == nil {
+			for range ch {
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		log.Warnf("[registry] etcd lease of %s lost, registering again", key)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(etcdRetryInterval):
+			}
+			if leaseID, err = r.put(ctx, key, value); err == nil {
+				break
+			}
+			log.Warnf("[registry] failed to register %s: %v", key, err)
+		}
+	}
+}
+
+func (r *etcdRegistry) Deregister(ctx context.Context, ins *registry.ServiceInstance) error {
+	key := etcdKey(ins.Name, ins.ID)
+	r.mu.Lock()
+	if cancel := r.cancels[key]; cancel != nil {
+		cancel()
+		delete(r.cancels, key)
+	}
+	r.mu.Unlock()
+	_, err := r.client.Delete(ctx, key)
+	return err
+}
+
+func (r *etcdRegistry) GetService(ctx context.Context, name string) ([]*registry.ServiceInstance, error) {
+	ins, err := r.list(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(ins) == 0 {
+		return nil, fmt.Errorf("service %s not found in registry", name)
+	}
+	return ins, nil
+}
+
+func (r *etcdRegistry) list(ctx context.Context, name string) ([]*registry.ServiceInstance, error) {
+	resp, err := r.client.Get(ctx, etcdPrefix(name), clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	ins := make([]*registry.ServiceInstance, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var s registry.ServiceInstance
+		if err := json.Unmarshal(kv.Value, &s); err != nil {
+			continue
+		}
+		ins = append(ins, &s)
+	}
+	return ins, nil
+}
+
+func (r *etcdRegistry) Watch(ctx context.Context, name string) (registry.Watcher, error) {
+	w := &etcdWatcher{registry: r, name: name, first: true}
+	w.ctx, w.cancel = context.WithCancel(ctx)
+	w.events = r.client.Watch(clientv3.WithRequireLeader(w.ctx), etcdPrefix(name), clientv3.WithPrefix())
+	return w, nil
+}
+
+// etcdWatcher 首次返回当前实例，之后每次前缀下有变更时重新读取全部实例
+type etcdWatcher struct {
+	registry *etcdRegistry
+	name     string
+	first    bool
+	events   clientv3.WatchChan
+	ctx      context.Context
+	cancel   context.CancelFunc
+}
+
+func (w *etcdWatcher) Next() ([]*registry.ServiceInstance, error) {
+	if w.first {
+		ins, err := w.registry.list(w.ctx, w.name)
+		if err != nil {
+			return nil, err
+		}
+		w.first = false
+		return ins, nil
+	}
+	select {
+	case <-w.ctx.Done():
+		return nil, w.ctx.Err()
+	case resp, ok := <-w.events:
+		if !ok || resp.Err() != nil {
+			// 监听中断时重新建立，期间的变更由重新读取全部实例覆盖
+			w.events = w.registry.client.Watch(clientv3.WithRequireLeader(w.ctx), etcdPrefix(w.name), clientv3.WithPrefix())
+		}
+	}
+	return w.registry.list(w.ctx, w.name)
+}
+
+func (w *etcdWatcher) Stop() error {
+	w.cancel()
+	return nil
+}
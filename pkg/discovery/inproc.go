@@ -0,0 +1,56 @@
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/registry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// NetworkInProc 进程内网络，gRPC 服务端的 network 配置为 inproc 时不监听端口，
+// 同一进程内的客户端通过内存连接访问，与 memory 注册中心配合使用
+const NetworkInProc = "inproc"
+
+// inprocBufferSize 进程内连接的缓冲区大小
+const inprocBufferSize = 1 << 20
+
+var inproc = struct {
+	sync.RWMutex
+	listeners map[string]*bufconn.Listener
+}{listeners: make(map[string]*bufconn.Listener)}
+
+// Listen 创建进程内监听，返回注册到注册中心的端点 grpc://<name>.inproc
+func Listen(name string) (net.Listener, *url.URL) {
+	host := name + "." + NetworkInProc
+	lis := bufconn.Listen(inprocBufferSize)
+	inproc.Lock()
+	inproc.listeners[host] = lis
+	inproc.Unlock()
+	return lis, &url.URL{Scheme: "grpc", Host: host}
+}
+
+func dialInProc(ctx context.Context, addr string) (net.Conn, error) {
+	inproc.RLock()
+	lis := inproc.listeners[addr]
+	inproc.RUnlock()
+	if lis == nil {
+		return nil, fmt.Errorf("no in-process listener for %s", addr)
+	}
+	return lis.DialContext(ctx)
+}
+
+// InProcess 是否为进程内注册中心，是时客户端须使用 DialOption 拨号
+func InProcess(d registry.Discovery) bool {
+	_, ok := d.(*memoryRegistry)
+	return ok
+}
+
+// DialOption 通过进程内网络拨号
+func DialOption() grpc.DialOption {
+	return grpc.WithContextDialer(dialInProc)
+}
@@ -0,0 +1,91 @@
+package discovery
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/registry"
+)
+
+// 注册中心不可用时重新订阅服务的退避区间
+const (
+	watchInitialBackoff = time.Second
+	watchMaxBackoff     = 30 * time.Second
+)
+
+// Lazy 包装服务发现，注册中心或服务暂不可用时客户端拨号不失败，在后台重试订阅
+func Lazy(d registry.Discovery, logger log.Logger) registry.Discovery {
+	return &lazyDiscovery{Discovery: d, log: log.NewHelper(logger)}
+}
+
+// lazyDiscovery 注册中心暂不可用时延后订阅服务，避免拨号失败。
+// 先用 GetService 确认可以查询到服务再订阅，Consul 首次订阅失败后不会再重新拉取服务列表。
+type lazyDiscovery struct {
+	registry.Discovery
+	log *log.Helper
+}
+
+func (d *lazyDiscovery) Watch(ctx context.Context, name string) (registry.Watcher, error) {
+	if _, err := d.GetService(ctx, name); err == nil {
+		return d.Discovery.Watch(ctx, name)
+	}
+	w := &lazyWatcher{ready: make(chan struct{})}
+	w.ctx, w.cancel = context.WithCancel(ctx)
+	go w.subscribe(d, name)
+	return w, nil
+}
+
+// lazyWatcher 在后台按退避重试订阅，成功后转发给实际的 Watcher
+type lazyWatcher struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	ready  chan struct{}
+
+	mu sync.Mutex
+	w  registry.Watcher
+}
+
+func (w *lazyWatcher) subscribe(d *lazyDiscovery, name string) {
+	backoff := watchInitialBackoff
+	for {
+		_, err := d.GetService(w.ctx, name)
+		if err == nil {
+			var watcher registry.Watcher
+			if watcher, err = d.Discovery.Watch(w.ctx, name); err == nil {
+				w.mu.Lock()
+				w.w = watcher
+				w.mu.Unlock()
+				close(w.ready)
+				return
+			}
+		}
+		d.log.Warnf("service %s not available in registry, retrying in %s: %v", name, backoff, err)
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, watchMaxBackoff)
+	}
+}
+
+func (w *lazyWatcher) Next() ([]*registry.ServiceInstance, error) {
+	select {
+	case <-w.ctx.Done():
+		return nil, w.ctx.Err()
+	case <-w.ready:
+	}
+	return w.w.Next()
+}
+
+func (w *lazyWatcher) Stop() error {
+	w.cancel()
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.w != nil {
+		return w.w.Stop()
+	}
+	return nil
+}
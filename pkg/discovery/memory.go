@@ -0,0 +1,119 @@
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/registry"
+)
+
+// defaultMemory 进程内注册表，同一进程内的服务共用
+var defaultMemory = newMemory()
+
+// memoryRegistry 进程内注册表
+type memoryRegistry struct {
+	mu       sync.RWMutex
+	services map[string]map[string]*registry.ServiceInstance
+	watchers map[string]map[*memoryWatcher]struct{}
+}
+
+func newMemory() *memoryRegistry {
+	return &memoryRegistry{
+		services: make(map[string]map[string]*registry.ServiceInstance),
+		watchers: make(map[string]map[*memoryWatcher]struct{}),
+	}
+}
+
+func (r *memoryRegistry) Register(ctx context.Context, ins *registry.ServiceInstance) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.services[ins.Name] == nil {
+		r.services[ins.Name] = make(map[string]*registry.ServiceInstance)
+	}
+	r.services[ins.Name][ins.ID] = ins
+	r.notifyLocked(ins.Name)
+	return nil
+}
+
+func (r *memoryRegistry) Deregister(ctx context.Context, ins *registry.ServiceInstance) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.services[ins.Name], ins.ID)
+	r.notifyLocked(ins.Name)
+	return nil
+}
+
+func (r *memoryRegistry) GetService(ctx context.Context, name string) ([]*registry.ServiceInstance, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ins := r.listLocked(name)
+	if len(ins) == 0 {
+		return nil, fmt.Errorf("service %s not found in registry", name)
+	}
+	return ins, nil
+}
+
+func (r *memoryRegistry) Watch(ctx context.Context, name string) (registry.Watcher, error) {
+	w := &memoryWatcher{
+		registry: r,
+		name:     name,
+		event:    make(chan struct{}, 1),
+	}
+	w.ctx, w.cancel = context.WithCancel(ctx)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.watchers[name] == nil {
+		r.watchers[name] = make(map[*memoryWatcher]struct{})
+	}
+	r.watchers[name][w] = struct{}{}
+	if len(r.services[name]) > 0 {
+		w.event <- struct{}{}
+	}
+	return w, nil
+}
+
+func (r *memoryRegistry) listLocked(name string) []*registry.ServiceInstance {
+	ins := make([]*registry.ServiceInstance, 0, len(r.services[name]))
+	for _, s := range r.services[name] {
+		ins = append(ins, s)
+	}
+	return ins
+}
+
+func (r *memoryRegistry) notifyLocked(name string) {
+	for w := range r.watchers[name] {
+		select {
+		case w.event <- struct{}{}:
+		default:
+		}
+	}
+}
+
+type memoryWatcher struct {
+	registry *memoryRegistry
+	name     string
+	event    chan struct{}
+	ctx      context.Context
+	cancel   context.CancelFunc
+}
+
+func (w *memoryWatcher) Next() ([]*registry.ServiceInstance, error) {
+	select {
+	case <-w.ctx.Done():
+		return nil, w.ctx.Err()
+	case <-w.event:
+	}
+	w.registry.mu.RLock()
+	defer w.registry.mu.RUnlock()
+	return w.registry.listLocked(w.name), nil
+}
+
+func (w *memoryWatcher) Stop() error {
+	w.cancel()
+	w.registry.mu.Lock()
+	defer w.registry.mu.Unlock()
+	delete(w.registry.watchers[w.name], w)
+	return nil
+}
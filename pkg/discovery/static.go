@@ -0,0 +1,71 @@
+package discovery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/registry"
+)
+
+// staticRegistry 使用配置中固定的服务地址，注册为空操作
+type staticRegistry struct {
+	services map[string][]*registry.ServiceInstance
+}
+
+func newStatic(services map[string][]string) *staticRegistry {
+	r := &staticRegistry{services: make(map[string][]*registry.ServiceInstance)}
+	for name, endpoints := range services {
+		for i, endpoint := range endpoints {
+			r.services[name] = append(r.services[name], &registry.ServiceInstance{
+				ID:        fmt.Sprintf("%s-%d", name, i),
+				Name:      name,
+				Endpoints: []string{endpoint},
+			})
+		}
+	}
+	return r
+}
+
+func (r *staticRegistry) Register(ctx context.Context, ins *registry.ServiceInstance) error {
+	return nil
+}
+
+func (r *staticRegistry) Deregister(ctx context.Context, ins *registry.ServiceInstance) error {
+	return nil
+}
+
+func (r *staticRegistry) GetService(ctx context.Context, name string) ([]*registry.ServiceInstance, error) {
+	ins := r.services[name]
+	if len(ins) == 0 {
+		return nil, fmt.Errorf("service %s not configured in static registry", name)
+	}
+	return ins, nil
+}
+
+func (r *staticRegistry) Watch(ctx context.Context, name string) (registry.Watcher, error) {
+	w := &staticWatcher{instances: r.services[name]}
+	w.ctx, w.cancel = context.WithCancel(ctx)
+	return w, nil
+}
+
+// staticWatcher 首次返回配置的地址，之后阻塞直到停止
+type staticWatcher struct {
+	instances []*registry.ServiceInstance
+	sent      bool
+	ctx       context.Context
+	cancel    context.CancelFunc
+}
+
+func (w *staticWatcher) Next() ([]*registry.ServiceInstance, error) {
+	if !w.sent && len(w.instances) > 0 {
+		w.sent = true
+		return w.instances, nil
+	}
+	<-w.ctx.Done()
+	return nil, w.ctx.Err()
+}
+
+func (w *staticWatcher) Stop() error {
+	w.cancel()
+	return nil
+}
@@ -0,0 +1,179 @@
+// Package envelope 实现信封加密：每个值使用随机生成的数据密钥加密，数据密钥再由KMS中的主密钥加密后随密文保存。
+// 密文记录了主密钥版本，轮换主密钥后旧数据仍可解密，可在线逐行重新加密。
+package envelope
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// prefix 密文前缀，格式为 enc:v1:<主密钥版本>:<加密的数据密钥>:<密文>
+const prefix = "enc:v1:"
+
+// dataKeySize 数据密钥长度，AES-256
+const dataKeySize = 32
+
+// ErrMalformed 密文格式错误
+var ErrMalformed = errors.New("envelope: malformed ciphertext")
+
+// KMS 主密钥管理服务，负责加解密数据密钥
+type KMS interface {
+	// PrimaryKeyID 当前用于加密的主密钥版本
+	PrimaryKeyID() string
+	// WrapKey 使用指定版本的主密钥加密数据密钥
+	WrapKey(ctx context.Context, keyID string, dataKey []byte) ([]byte, error)
+	// UnwrapKey 使用指定版本的主密钥解密数据密钥
+	UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error)
+}
+
+// Cipher 信封加密器
+type Cipher struct {
+	kms KMS
+}
+
+// NewCipher 创建信封加密器
+func NewCipher(kms KMS) *Cipher {
+	return &Cipher{kms: kms}
+}
+
+// Encrypt 使用当前主密钥版本加密，空字符串不加密
+func (c *Cipher) Encrypt(ctx context.Context, plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", err
+	}
+	keyID := c.kms.PrimaryKeyID()
+	wrapped, err := c.kms.WrapKey(ctx, keyID, dataKey)
+	if err != nil {
+		return "", fmt.Errorf("envelope: failed to wrap data key: %w", err)
+	}
+	sealed, err := seal(dataKey, []byte(plaintext))
+	if err != nil {
+		return "", err
+	}
+	return prefix + keyID + ":" + base64.RawStdEncoding.EncodeToString(wrapped) + ":" + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt 解密密文，未加密的旧数据原样返回
+func (c *Cipher) Decrypt(ctx context.Context, value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	keyID, wrapped, sealed, err := parse(value)
+	if err != nil {
+		return "", err
+	}
+	dataKey, err := c.kms.UnwrapKey(ctx, keyID, wrapped)
+	if err != nil {
+		return "", fmt.Errorf("envelope: failed to unwrap data key with %s: %w", keyID, err)
+	}
+	plaintext, err := open(dataKey, sealed)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// NeedsRotation 值未加密或不是由当前主密钥版本加密时返回true
+func (c *Cipher) NeedsRotation(value string) bool {
+	if value == "" {
+		return false
+	}
+	return KeyID(value) != c.kms.PrimaryKeyID()
+}
+
+// IsEncrypted 判断值是否为信封密文
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
+
+// KeyID 密文使用的主密钥版本，未加密时返回空
+func KeyID(value string) string {
+	if !IsEncrypted(value) {
+		return ""
+	}
+	keyID, _, _ := strings.Cut(strings.TrimPrefix(value, prefix), ":")
+	return keyID
+}
+
+func parse(value string) (string, []byte, []byte, error) {
+	parts := strings.Split(strings.TrimPrefix(value, prefix), ":")
+	if len(parts) != 3 || parts[0] == "" {
+		return "", nil, nil, ErrMalformed
+	}
+	wrapped, err := base64.RawStdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", nil, nil, ErrMalformed
+	}
+	sealed, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", nil, nil, ErrMalformed
+	}
+	return parts[0], wrapped, sealed, nil
+}
+
+// seal AES-GCM加密，随机nonce置于密文之前
+func seal(key, plaintext []byte) ([]byte, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func open(key, sealed []byte) ([]byte, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return nil, ErrMalformed
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("envelope: decryption failed: %w", err)
+	}
+	return plaintext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// redactedPrefix 脱敏值前缀
+const redactedPrefix = "****"
+
+// Redact 脱敏显示密钥，仅保留末尾4个字符
+func Redact(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	runes := []rune(secret)
+	if len(runes) <= 8 {
+		return redactedPrefix
+	}
+	return redactedPrefix + string(runes[len(runes)-4:])
+}
+
+// IsRedacted 判断值是否为 Redact 的输出
+func IsRedacted(value string) bool {
+	return strings.HasPrefix(value, redactedPrefix)
+}
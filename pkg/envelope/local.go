@@ -0,0 +1,135 @@
+package envelope
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// LocalKMS 进程内保存主密钥的KMS实现
+type LocalKMS struct {
+	keys    map[string][]byte
+	primary string
+}
+
+var _ KMS = (*LocalKMS)(nil)
+
+// NewLocalKMS 由主密钥版本与密钥创建本地KMS，密钥须为32字节
+func NewLocalKMS(keys map[string][]byte, primary string) (*LocalKMS, error) {
+	if _, ok := keys[primary]; !ok {
+		return nil, fmt.Errorf("envelope: primary key %q not found", primary)
+	}
+	for id, key := range keys {
+		if id == "" || strings.Contains(id, ":") {
+			return nil, fmt.Errorf("envelope: invalid key id %q", id)
+		}
+		if len(key) != dataKeySize {
+			return nil, fmt.Errorf("envelope: key %s must be %d bytes", id, dataKeySize)
+		}
+		if weakKey(key) {
+			return nil, fmt.Errorf("envelope: key %s is a well-known or weak key, generate a random one", id)
+		}
+	}
+	return &LocalKMS{keys: keys, primary: primary}, nil
+}
+
+// LoadLocalKMS 从密钥文件加载本地KMS，每行格式为 <版本>=<base64编码的密钥>，#开头为注释。
+// primary 为空时使用文件中最后一个版本。
+func LoadLocalKMS(path, primary string) (*LocalKMS, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	keys := make(map[string][]byte)
+	last := ""
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		id, encoded, ok := strings.Cut(text, "=")
+		if !ok {
+			return nil, fmt.Errorf("envelope: %s:%d: expected <id>=<key>", path, line)
+		}
+		key, err := DecodeKey(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("envelope: %s:%d: %w", path, line, err)
+		}
+		id = strings.TrimSpace(id)
+		keys[id] = key
+		last = id
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if primary == "" {
+		primary = last
+	}
+	return NewLocalKMS(keys, primary)
+}
+
+// knownKeys 曾公开在仓库配置中的主密钥，不得用于加密
+var knownKeys = []string{
+	"6+MrB+5KRCREx9mkulICVJzSiFIAZp+kR8cHUmntlkQ=",
+}
+
+// weakKey 判断密钥是否为公开过的密钥，或全部字节相同（如全零）
+func weakKey(key []byte) bool {
+	encoded := base64.StdEncoding.EncodeToString(key)
+	for _, known := range knownKeys {
+		if encoded == known {
+			return true
+		}
+	}
+	for _, b := range key[1:] {
+		if b != key[0] {
+			return false
+		}
+	}
+	return true
+}
+
+// GenerateKey 生成随机主密钥，返回base64编码
+func GenerateKey() (string, error) {
+	key := make([]byte, dataKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// DecodeKey 解码base64编码的主密钥
+func DecodeKey(encoded string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64 key: %w", err)
+	}
+	return key, nil
+}
+
+func (k *LocalKMS) PrimaryKeyID() string {
+	return k.primary
+}
+
+func (k *LocalKMS) WrapKey(ctx context.Context, keyID string, dataKey []byte) ([]byte, error) {
+	key, ok := k.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("envelope: unknown key %s", keyID)
+	}
+	return seal(key, dataKey)
+}
+
+func (k *LocalKMS) UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	key, ok := k.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("envelope: unknown key %s", keyID)
+	}
+	return open(key, wrapped)
+}
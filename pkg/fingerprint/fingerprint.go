@@ -0,0 +1,163 @@
+// Package fingerprint 文本指纹，用于近似重复检测。
+//
+// 文本先分词并组成连续词组（shingle），SimHash 将词组集合压缩为 64 位指纹，
+// 汉明距离越小越相似；MinHash 签名用于估计两段文本词组集合的 Jaccard 相似度，
+// 配合 LSH 分桶可以在大量文本中只比较可能相似的候选对。
+package fingerprint
+
+import (
+	"hash/fnv"
+	"math"
+	"math/bits"
+	"strings"
+
+	"universal/pkg/textseg"
+)
+
+const (
+	// ShingleSize 组成一个特征的连续词数
+	ShingleSize = 3
+	// SignatureSize MinHash 签名长度
+	SignatureSize = 64
+	// Bands LSH 分桶数，SignatureSize 需能被其整除，
+	// 16 个桶每桶 4 行时 Jaccard 约 0.5 以上的文本大概率进入同一个桶
+	Bands = 16
+)
+
+// Signature MinHash 签名
+type Signature []uint32
+
+// Fingerprint 文本指纹
+type Fingerprint struct {
+	SimHash uint64
+	MinHash Signature
+}
+
+// Of 计算文本指纹，空文本返回零值
+func Of(text string) Fingerprint {
+	features := Features(text)
+	if len(features) == 0 {
+		return Fingerprint{}
+	}
+	return Fingerprint{SimHash: SimHash(features), MinHash: MinHash(features)}
+}
+
+// Features 分词后按 ShingleSize 组成词组，词数不足时整段作为一个特征
+func Features(text string) []string {
+	tokens := textseg.Default().Cut(text)
+	if len(tokens) == 0 {
+		return nil
+	}
+	if len(tokens) <= ShingleSize {
+		return []string{strings.Join(tokens, " ")}
+	}
+	features := make([]string, 0, len(tokens)-ShingleSize+1)
+	for i := 0; i+ShingleSize <= len(tokens); i++ {
+		features = append(features, strings.Join(tokens[i:i+ShingleSize], " "))
+	}
+	return features
+}
+
+// SimHash 计算特征集合的 64 位 SimHash，重复出现的特征权重累加
+func SimHash(features []string) uint64 {
+	var weights [64]int
+	for _, f := range features {
+		h := hash64(f)
+		for i := 0; i < 64; i++ {
+			if h&(1<<uint(i)) != 0 {
+				weights[i]++
+			} else {
+				weights[i]--
+			}
+		}
+	}
+	var sim uint64
+	for i, w := range weights {
+		if w > 0 {
+			sim |= 1 << uint(i)
+		}
+	}
+	return sim
+}
+
+// Hamming 两个 SimHash 的汉明距离
+func Hamming(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+// MinHash 计算特征集合的 MinHash 签名，第 i 个哈希函数由特征哈希与第 i 个种子混合得到
+func MinHash(features []string) Signature {
+	sig := make(Signature, SignatureSize)
+	for i := range sig {
+		sig[i] = math.MaxUint32
+	}
+	for _, f := range features {
+		h := hash64(f)
+		for i := range sig {
+			if v := uint32(mix(h^seeds[i]) >> 32); v < sig[i] {
+				sig[i] = v
+			}
+		}
+	}
+	return sig
+}
+
+// Jaccard 由签名估计的 Jaccard 相似度，签名长度不一致时返回 0
+func (s Signature) Jaccard(o Signature) float64 {
+	if len(s) == 0 || len(s) != len(o) {
+		return 0
+	}
+	same := 0
+	for i := range s {
+		if s[i] == o[i] {
+			same++
+		}
+	}
+	return float64(same) / float64(len(s))
+}
+
+// BandKeys 签名的 LSH 分桶键，键中包含桶序号，不同桶的键不会相同
+func (s Signature) BandKeys() []uint64 {
+	if len(s) != SignatureSize {
+		return nil
+	}
+	rows := SignatureSize / Bands
+	keys := make([]uint64, Bands)
+	for b := 0; b < Bands; b++ {
+		h := fnv.New64a()
+		buf := []byte{byte(b)}
+		for _, v := range s[b*rows : (b+1)*rows] {
+			buf = append(buf, byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+		}
+		h.Write(buf)
+		keys[b] = h.Sum64()
+	}
+	return keys
+}
+
+// seeds MinHash 各哈希函数的种子，由固定序列生成，保证不同进程计算结果一致
+var seeds = func() [SignatureSize]uint64 {
+	var s [SignatureSize]uint64
+	x := uint64(0x9e3779b97f4a7c15)
+	for i := range s {
+		x = mix(x + uint64(i))
+		s[i] = x
+	}
+	return s
+}()
+
+func hash64(s string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(s))
+	return mix(h.Sum64())
+}
+
+// mix splitmix64 的混合函数，改善 FNV 低位分布
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
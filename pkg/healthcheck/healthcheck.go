@@ -0,0 +1,145 @@
+// Package healthcheck 基于标准 grpc.health.v1 协议暴露服务及其依赖的健康状态。
+//
+// Checker 周期性执行各依赖的检查并更新健康服务：整体状态使用空服务名，
+// 各依赖以检查名称作为服务名，可通过 Check 单独查询或通过 List 一次取回。
+// 关键依赖失败时整体状态为 NOT_SERVING，非关键依赖失败只影响其自身状态。
+package healthcheck
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	defaultInterval = 10 * time.Second
+	defaultTimeout  = 3 * time.Second
+)
+
+// Check 一项依赖检查
+type Check struct {
+	Name string
+	// Critical 为 true 时检查失败会使整体状态变为 NOT_SERVING
+	Critical bool
+	Probe    func(ctx context.Context) error
+}
+
+// Option 检查器选项
+type Option func(*Checker)
+
+// WithInterval 检查间隔，默认10秒
+func WithInterval(d time.Duration) Option {
+	return func(c *Checker) {
+		if d > 0 {
+			c.interval = d
+		}
+	}
+}
+
+// WithTimeout 单项检查的超时时间，默认3秒
+func WithTimeout(d time.Duration) Option {
+	return func(c *Checker) {
+		if d > 0 {
+			c.timeout = d
+		}
+	}
+}
+
+// Checker 依赖健康检查器，作为 transport.Server 随应用启停
+type Checker struct {
+	health   *health.Server
+	checks   []Check
+	interval time.Duration
+	timeout  time.Duration
+	stop     chan struct{}
+	once     sync.Once
+	log      *log.Helper
+}
+
+// NewChecker 创建健康检查器，首轮检查完成前整体状态为 NOT_SERVING
+func NewChecker(logger log.Logger, checks []Check, opts ...Option) *Checker {
+	c := &Checker{
+		health:   health.NewServer(),
+		checks:   checks,
+		interval: defaultInterval,
+		timeout:  defaultTimeout,
+		stop:     make(chan struct{}),
+		log:      log.NewHelper(logger),
+	}
+	for _, o := range opts {
+		o(c)
+	}
+	c.health.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	for _, check := range checks {
+		c.health.SetServingStatus(check.Name, grpc_health_v1.HealthCheckResponse_UNKNOWN)
+	}
+	return c
+}
+
+// Register 在 gRPC 服务上注册健康服务，服务须以 grpc.CustomHealth() 创建
+func (c *Checker) Register(srv *grpc.Server) {
+	grpc_health_v1.RegisterHealthServer(srv, c.health)
+}
+
+// Start 立即执行一轮检查，之后按间隔执行直到停止
+func (c *Checker) Start(ctx context.Context) error {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.run(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-c.stop:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop 将全部状态置为 NOT_SERVING，负载均衡器在进程退出前即可摘除实例
+func (c *Checker) Stop(ctx context.Context) error {
+	c.once.Do(func() {
+		close(c.stop)
+		c.health.Shutdown()
+	})
+	return nil
+}
+
+// run 并发执行全部检查，关机后不再更新状态
+func (c *Checker) run(ctx context.Context) {
+	failed := make([]bool, len(c.checks))
+	var wg sync.WaitGroup
+	for i, check := range c.checks {
+		wg.Add(1)
+		go func(i int, check Check) {
+			defer wg.Done()
+			pctx, cancel := context.WithTimeout(ctx, c.timeout)
+			defer cancel()
+			if err := check.Probe(pctx); err != nil {
+				c.log.Warnf("[Health] %s check failed: %v", check.Name, err)
+				failed[i] = true
+			}
+		}(i, check)
+	}
+	wg.Wait()
+
+	overall := grpc_health_v1.HealthCheckResponse_SERVING
+	for i, check := range c.checks {
+		status := grpc_health_v1.HealthCheckResponse_SERVING
+		if failed[i] {
+			status = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+			if check.Critical {
+				overall = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+			}
+		}
+		c.health.SetServingStatus(check.Name, status)
+	}
+	c.health.SetServingStatus("", overall)
+}
@@ -0,0 +1,193 @@
+// Package identity 在服务间传递经网关验证的调用方用户ID与用户等级。
+//
+// 前置认证代理完成登录校验后写入 X-User-Id、X-User-Level 与 X-User-Signature 请求头，
+// 签名格式为 "<unix时间戳>.<hex(HMAC-SHA256(secret, 用户ID + "." + 用户等级 + "." + 时间戳))>"。
+// 网关用共享密钥校验签名后经 gRPC 元数据转发给下游服务，下游服务通过 FromContext 获取。
+// 客户端直接携带的元数据键在网关不被信任；下游服务只应暴露在内网。
+package identity
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+const (
+	// HeaderKey 认证代理写入用户ID的HTTP请求头
+	HeaderKey = "X-User-Id"
+	// LevelHeaderKey 认证代理写入用户等级的HTTP请求头，决定适用的限流规则，可为空
+	LevelHeaderKey = "X-User-Level"
+	// SignatureHeaderKey 认证代理写入签名的HTTP请求头
+	SignatureHeaderKey = "X-User-Signature"
+	// MetadataKey 服务间转发用户ID使用的元数据键
+	MetadataKey = "x-md-global-user-id"
+	// LevelMetadataKey 服务间转发用户等级使用的元数据键
+	LevelMetadataKey = "x-md-global-user-level"
+
+	// DefaultMaxSkew 签名时间戳与网关时钟的默认最大偏差
+	DefaultMaxSkew = 5 * time.Minute
+)
+
+// ErrInvalidSignature 签名缺失、格式错误、过期或不匹配
+var ErrInvalidSignature = errors.New("identity: invalid signature")
+
+type (
+	userKey  struct{}
+	levelKey struct{}
+)
+
+// NewContext 返回携带调用方用户ID的上下文
+func NewContext(ctx context.Context, userID int64) context.Context {
+	return context.WithValue(ctx, userKey{}, userID)
+}
+
+// FromContext 获取调用方用户ID，未认证时返回0
+func FromContext(ctx context.Context) int64 {
+	id, _ := ctx.Value(userKey{}).(int64)
+	return id
+}
+
+// WithLevel 返回携带调用方用户等级的上下文
+func WithLevel(ctx context.Context, level string) context.Context {
+	return context.WithValue(ctx, levelKey{}, level)
+}
+
+// LevelFromContext 获取调用方用户等级，未认证或认证代理未提供时返回空字符串
+func LevelFromContext(ctx context.Context) string {
+	level, _ := ctx.Value(levelKey{}).(string)
+	return level
+}
+
+// Sign 计算用户ID与等级在指定时间的签名，供认证代理与测试使用
+func Sign(secret []byte, userID int64, level string, at time.Time) string {
+	ts := strconv.FormatInt(at.Unix(), 10)
+	return ts + "." + mac(secret, strconv.FormatInt(userID, 10), level, ts)
+}
+
+func mac(secret []byte, userID, level, ts string) string {
+	h := hmac.New(sha256.New, secret)
+	h.Write([]byte(userID + "." + level + "." + ts))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Verifier 网关侧的签名校验器，密钥为空时不信任任何身份，所有请求视为匿名
+type Verifier struct {
+	secret  []byte
+	maxSkew time.Duration
+	now     func() time.Time
+}
+
+// NewVerifier 创建签名校验器，maxSkew 为0时使用 DefaultMaxSkew
+func NewVerifier(secret []byte, maxSkew time.Duration) *Verifier {
+	if maxSkew <= 0 {
+		maxSkew = DefaultMaxSkew
+	}
+	return &Verifier{secret: secret, maxSkew: maxSkew, now: time.Now}
+}
+
+// Enabled 是否配置了签名密钥
+func (v *Verifier) Enabled() bool {
+	return v != nil && len(v.secret) > 0
+}
+
+// Verify 校验用户ID、等级与签名，返回经验证的用户ID
+func (v *Verifier) Verify(userID, level, signature string) (int64, error) {
+	if !v.Enabled() || userID == "" || signature == "" {
+		return 0, ErrInvalidSignature
+	}
+	id, err := strconv.ParseInt(userID, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, ErrInvalidSignature
+	}
+	ts, sum, ok := strings.Cut(signature, ".")
+	if !ok {
+		return 0, ErrInvalidSignature
+	}
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidSignature
+	}
+	if skew := v.now().Sub(time.Unix(unix, 0)); skew > v.maxSkew || skew < -v.maxSkew {
+		return 0, ErrInvalidSignature
+	}
+	if !hmac.Equal([]byte(sum), []byte(mac(v.secret, userID, level, ts))) {
+		return 0, ErrInvalidSignature
+	}
+	return id, nil
+}
+
+// verifyHeader 从请求头校验身份，校验通过时返回携带用户ID与等级的上下文
+func (v *Verifier) verifyHeader(ctx context.Context, get func(string) string) (context.Context, bool) {
+	level := get(LevelHeaderKey)
+	id, err := v.Verify(get(HeaderKey), level, get(SignatureHeaderKey))
+	if err != nil {
+		return ctx, false
+	}
+	return WithLevel(NewContext(ctx, id), level), true
+}
+
+// Filter 网关HTTP过滤器，在限流等过滤器之前校验身份并写入请求上下文
+func (v *Verifier) Filter() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if ctx, ok := v.verifyHeader(r.Context(), r.Header.Get); ok {
+				r = r.WithContext(ctx)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Middleware 网关服务端中间件，用于未经过 Filter 的 gRPC 入口
+func (v *Verifier) Middleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if FromContext(ctx) == 0 {
+				if tr, ok := transport.FromServerContext(ctx); ok {
+					ctx, _ = v.verifyHeader(ctx, tr.RequestHeader().Get)
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// Server 下游服务端中间件，从网关转发的元数据解析调用方用户ID与等级
+func Server() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				if id, err := strconv.ParseInt(tr.RequestHeader().Get(MetadataKey), 10, 64); err == nil && id > 0 {
+					ctx = WithLevel(NewContext(ctx, id), tr.RequestHeader().Get(LevelMetadataKey))
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// Client 客户端中间件，将调用方用户ID与等级写入下游请求元数据
+func Client() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if id := FromContext(ctx); id > 0 {
+				if tr, ok := transport.FromClientContext(ctx); ok {
+					tr.RequestHeader().Set(MetadataKey, strconv.FormatInt(id, 10))
+					if level := LevelFromContext(ctx); level != "" {
+						tr.RequestHeader().Set(LevelMetadataKey, level)
+					}
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
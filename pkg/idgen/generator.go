@@ -0,0 +1,232 @@
+package idgen
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	mrand "math/rand"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+)
+
+const (
+	// leaseTTL 节点号租约有效期，副本异常退出后超过该时间节点号可被其他副本使用
+	leaseTTL = 30 * time.Second
+	// renewInterval 续约间隔
+	renewInterval = leaseTTL / 3
+)
+
+// renewScript 仅在租约仍由自己持有时续约
+var renewScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// releaseScript 仅在租约仍由自己持有时释放
+var releaseScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// ErrNoFreeNode 所有节点号都已被占用
+var ErrNoFreeNode = errors.New("idgen: no free snowflake node")
+
+// ErrLeaseExpired 超过租约有效期未能续约，节点号可能已被其他副本占用，此时拒绝生成ID
+var ErrLeaseExpired = errors.New("idgen: snowflake node lease expired")
+
+// Generator 进程内共享的雪花ID生成器，节点号通过 Redis 租约在多个副本间保证唯一。
+// Generator 同时实现 gorm.Plugin，为整数主键为零的新记录分配ID。
+type Generator struct {
+	rdb    redis.UniversalClient
+	prefix string
+	owner  string
+	log    *log.Helper
+
+	mu   sync.RWMutex
+	node *Node
+	id   int64
+	// renewedAt 最近一次成功租用或续约的请求发出时间，租约至少有效到 renewedAt+leaseTTL
+	renewedAt time.Time
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewGenerator 租用一个节点号并创建生成器，prefix 为租约键前缀，如 ai:idgen:node。
+// 返回的函数停止续约并释放节点号。
+func NewGenerator(ctx context.Context, rdb redis.UniversalClient, prefix string, logger log.Logger) (*Generator, func(), error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, nil, err
+	}
+	g := &Generator{
+		rdb:    rdb,
+		prefix: prefix,
+		owner:  hex.EncodeToString(b),
+		log:    log.NewHelper(log.With(logger, "module", "idgen")),
+		done:   make(chan struct{}),
+	}
+	if err := g.acquire(ctx); err != nil {
+		return nil, nil, err
+	}
+	var renewCtx context.Context
+	renewCtx, g.cancel = context.WithCancel(context.Background())
+	go g.renew(renewCtx)
+	return g, g.stop, nil
+}
+
+// Generate 生成一个ID，租约已过期时返回 ErrLeaseExpired
+func (g *Generator) Generate() (int64, error) {
+	g.mu.RLock()
+	node, renewedAt := g.node, g.renewedAt
+	g.mu.RUnlock()
+	if time.Since(renewedAt) >= leaseTTL {
+		return 0, ErrLeaseExpired
+	}
+	return node.Generate().Int64(), nil
+}
+
+// GenerateString 生成带前缀的字符串ID，用于字符串主键
+func (g *Generator) GenerateString(prefix string) (string, error) {
+	id, err := g.Generate()
+	if err != nil {
+		return "", err
+	}
+	return prefix + ParseInt64(id).String(), nil
+}
+
+// NodeID 当前租用的节点号
+func (g *Generator) NodeID() int64 {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.id
+}
+
+func (g *Generator) key(id int64) string {
+	return fmt.Sprintf("%s:%d", g.prefix, id)
+}
+
+// acquire 从随机位置开始依次尝试租用空闲节点号，减少多个副本同时启动时的冲突
+func (g *Generator) acquire(ctx context.Context) error {
+	nodeMax := int64(-1 ^ (-1 << NodeBits))
+	start := mrand.Int63n(nodeMax + 1)
+	for i := int64(0); i <= nodeMax; i++ {
+		id := (start + i) % (nodeMax + 1)
+		sent := time.Now()
+		ok, err := g.rdb.SetNX(ctx, g.key(id), g.owner, leaseTTL).Result()
+		if err != nil {
+			return fmt.Errorf("idgen: failed to lease node: %w", err)
+		}
+		if !ok {
+			continue
+		}
+		node, err := NewNode(id)
+		if err != nil {
+			return err
+		}
+		g.mu.Lock()
+		g.node, g.id, g.renewedAt = node, id, sent
+		g.mu.Unlock()
+		g.log.Infof("leased snowflake node %d", id)
+		return nil
+	}
+	return ErrNoFreeNode
+}
+
+// renew 定期续约，租约丢失（如 Redis 长时间不可用后被其他副本占用）时改租新的节点号。
+// 续约持续失败期间 Generate 在租约到期后返回错误，避免与接手该节点号的副本生成重复ID
+func (g *Generator) renew(ctx context.Context) {
+	defer close(g.done)
+	ticker := time.NewTicker(renewInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		id := g.NodeID()
+		sent := time.Now()
+		n, err := renewScript.Run(ctx, g.rdb, []string{g.key(id)}, g.owner, leaseTTL.Milliseconds()).Int()
+		if err != nil {
+			g.log.Errorf("failed to renew snowflake node %d: %v", id, err)
+			continue
+		}
+		if n == 1 {
+			g.mu.Lock()
+			g.renewedAt = sent
+			g.mu.Unlock()
+			continue
+		}
+		g.log.Warnf("lost lease of snowflake node %d, leasing a new one", id)
+		if err := g.acquire(ctx); err != nil {
+			g.log.Errorf("failed to lease a new snowflake node: %v", err)
+		}
+	}
+}
+
+func (g *Generator) stop() {
+	g.cancel()
+	<-g.done
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := releaseScript.Run(ctx, g.rdb, []string{g.key(g.NodeID())}, g.owner).Err(); err != nil {
+		g.log.Errorf("failed to release snowflake node %d: %v", g.NodeID(), err)
+	}
+}
+
+// Name 实现 gorm.Plugin
+func (g *Generator) Name() string {
+	return "idgen"
+}
+
+// Initialize 实现 gorm.Plugin，在插入前为整数主键为零的记录分配ID，
+// 已有的自增主键记录不受影响；租约过期时插入以 ErrLeaseExpired 失败
+func (g *Generator) Initialize(db *gorm.DB) error {
+	return db.Callback().Create().Before("gorm:create").Register("idgen:assign_id", g.assignID)
+}
+
+func (g *Generator) assignID(db *gorm.DB) {
+	if db.Statement.Schema == nil {
+		return
+	}
+	field := db.Statement.Schema.PrioritizedPrimaryField
+	if field == nil || field.FieldType.Kind() != reflect.Int64 {
+		return
+	}
+	ctx := db.Statement.Context
+	assign := func(rv reflect.Value) {
+		if rv.Kind() == reflect.Ptr && rv.IsNil() {
+			return
+		}
+		if _, zero := field.ValueOf(ctx, rv); zero {
+			id, err := g.Generate()
+			if err != nil {
+				db.AddError(err)
+				return
+			}
+			if err := field.Set(ctx, rv, id); err != nil {
+				db.AddError(err)
+			}
+		}
+	}
+	switch rv := db.Statement.ReflectValue; rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			assign(rv.Index(i))
+		}
+	case reflect.Struct:
+		assign(rv)
+	}
+}
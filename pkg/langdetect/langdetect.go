@@ -0,0 +1,289 @@
+// Package langdetect 离线语言识别。
+//
+// 先按文字统计主导书写系统：汉字与假名区分中文和日文，谚文为韩文，
+// 阿拉伯、希伯来、希腊、泰文与天城文各自对应一种语言；拉丁与西里尔字母
+// 再用内置语料训练的字符 1-3 元组朴素贝叶斯模型区分具体语言。
+// 返回 ISO 639-1 语言代码；文本过短或模型把握不足时返回空字符串，由调用方使用默认语言。
+package langdetect
+
+import (
+	"embed"
+	"math"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+//go:embed corpus/*.txt
+var corpus embed.FS
+
+const (
+	// maxNgram 模型使用的最长字符元组
+	maxNgram = 3
+	// maxLetters 参与 n 元组打分的最大字母数，长文本只取前面部分
+	maxLetters = 2000
+	// kanaRatio 假名占汉字与假名总数的比例不低于该值时判定为日文
+	kanaRatio = 0.1
+	// minScriptLetters 由书写系统直接确定语言时至少需要的字符数
+	minScriptLetters = 2
+	// minClassifyLetters 用语言模型区分拉丁与西里尔字母语言时至少需要的字母数，
+	// 更短的文本（单词、缩写、产品名）常被误判
+	minClassifyLetters = 12
+	// minConfidence 语言模型给出的后验概率低于该值时视为无法识别
+	minConfidence = 0.95
+)
+
+type script int
+
+const (
+	scriptNone script = iota
+	scriptCJK
+	scriptHangul
+	scriptLatin
+	scriptCyrillic
+	scriptArabic
+	scriptHebrew
+	scriptGreek
+	scriptThai
+	scriptDevanagari
+)
+
+// scriptLanguages 只有一种候选语言的书写系统
+var scriptLanguages = map[script]string{
+	scriptHangul:     "ko",
+	scriptArabic:     "ar",
+	scriptHebrew:     "he",
+	scriptGreek:      "el",
+	scriptThai:       "th",
+	scriptDevanagari: "hi",
+}
+
+// profile 一种语言的 n 元组对数概率
+type profile struct {
+	lang    string
+	logProb map[string]float64
+	unseen  float64
+}
+
+var (
+	profilesOnce sync.Once
+	profiles     map[script][]*profile
+)
+
+// Detect 识别文本的主要语言，无法可靠识别（如只有数字与标点、文本过短或模型把握不足）时返回空字符串
+func Detect(text string) string {
+	counts := make(map[script]int)
+	var kana int
+	for _, r := range text {
+		s := scriptOf(r)
+		if s == scriptNone {
+			continue
+		}
+		counts[s]++
+		if unicode.In(r, unicode.Hiragana, unicode.Katakana) {
+			kana++
+		}
+	}
+
+	best, bestCount := scriptNone, 0
+	for s, n := range counts {
+		if n > bestCount || (n == bestCount && s < best) {
+			best, bestCount = s, n
+		}
+	}
+
+	if best == scriptNone || bestCount < minScriptLetters {
+		return ""
+	}
+	switch best {
+	case scriptCJK:
+		if float64(kana) >= kanaRatio*float64(bestCount) {
+			return "ja"
+		}
+		return "zh"
+	case scriptLatin, scriptCyrillic:
+		if bestCount < minClassifyLetters {
+			return ""
+		}
+		return classify(best, text)
+	default:
+		return scriptLanguages[best]
+	}
+}
+
+// Languages 可识别的语言代码
+func Languages() []string {
+	langs := []string{"zh", "ja"}
+	for _, lang := range scriptLanguages {
+		langs = append(langs, lang)
+	}
+	for _, ps := range loadProfiles() {
+		for _, p := range ps {
+			langs = append(langs, p.lang)
+		}
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+// classify 用指定书写系统的语言模型为文本打分，只统计该书写系统的字母，
+// 得分最高的语言后验概率不足 minConfidence 时返回空字符串
+func classify(s script, text string) string {
+	candidates := loadProfiles()[s]
+	if len(candidates) == 0 {
+		return ""
+	}
+	grams := ngrams(text, s)
+	if len(grams) == 0 {
+		return ""
+	}
+
+	best, bestScore := "", math.Inf(-1)
+	scores := make([]float64, len(candidates))
+	for i, p := range candidates {
+		var score float64
+		for _, g := range grams {
+			if lp, ok := p.logProb[g]; ok {
+				score += lp
+			} else {
+				score += p.unseen
+			}
+		}
+		scores[i] = score
+		if score > bestScore {
+			best, bestScore = p.lang, score
+		}
+	}
+	// 各语言先验相同，后验概率为 1/Σexp(score-bestScore)
+	var sum float64
+	for _, score := range scores {
+		sum += math.Exp(score - bestScore)
+	}
+	if 1/sum < minConfidence {
+		return ""
+	}
+	return best
+}
+
+// ngrams 按单词切分后在词首尾补空格，生成 1 到 maxNgram 元组
+func ngrams(text string, s script) []string {
+	var grams []string
+	var word []rune
+	letters := 0
+	flush := func() {
+		if len(word) == 0 {
+			return
+		}
+		padded := append(append([]rune{' '}, word...), ' ')
+		for n := 1; n <= maxNgram; n++ {
+			for i := 0; i+n <= len(padded); i++ {
+				if n == 1 && padded[i] == ' ' {
+					continue
+				}
+				grams = append(grams, string(padded[i:i+n]))
+			}
+		}
+		word = word[:0]
+	}
+	for _, r := range text {
+		if letters >= maxLetters {
+			break
+		}
+		if scriptOf(r) == s {
+			word = append(word, unicode.ToLower(r))
+			letters++
+			continue
+		}
+		flush()
+	}
+	flush()
+	return grams
+}
+
+// loadProfiles 首次使用时从内置语料训练各语言模型，文件名为语言代码
+func loadProfiles() map[script][]*profile {
+	profilesOnce.Do(func() {
+		profiles = make(map[script][]*profile)
+		entries, err := corpus.ReadDir("corpus")
+		if err != nil {
+			return
+		}
+		for _, entry := range entries {
+			data, err := corpus.ReadFile(path.Join("corpus", entry.Name()))
+			if err != nil {
+				continue
+			}
+			text := string(data)
+			lang := strings.TrimSuffix(entry.Name(), path.Ext(entry.Name()))
+			s := dominantScript(text)
+			profiles[s] = append(profiles[s], train(lang, ngrams(text, s)))
+		}
+		for _, ps := range profiles {
+			sort.Slice(ps, func(i, j int) bool { return ps[i].lang < ps[j].lang })
+		}
+	})
+	return profiles
+}
+
+// train 统计 n 元组频次，按加一平滑计算对数概率
+func train(lang string, grams []string) *profile {
+	counts := make(map[string]int)
+	for _, g := range grams {
+		counts[g]++
+	}
+	total := float64(len(grams) + len(counts) + 1)
+	p := &profile{
+		lang:    lang,
+		logProb: make(map[string]float64, len(counts)),
+		unseen:  math.Log(1 / total),
+	}
+	for g, n := range counts {
+		p.logProb[g] = math.Log(float64(n+1) / total)
+	}
+	return p
+}
+
+func dominantScript(text string) script {
+	counts := make(map[script]int)
+	for _, r := range text {
+		counts[scriptOf(r)]++
+	}
+	best, bestCount := scriptNone, 0
+	for s, n := range counts {
+		if s != scriptNone && n > bestCount {
+			best, bestCount = s, n
+		}
+	}
+	return best
+}
+
+func scriptOf(r rune) script {
+	switch {
+	case r < 0x80:
+		if 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' {
+			return scriptLatin
+		}
+		return scriptNone
+	case unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana):
+		return scriptCJK
+	case unicode.Is(unicode.Hangul, r):
+		return scriptHangul
+	case unicode.Is(unicode.Latin, r):
+		return scriptLatin
+	case unicode.Is(unicode.Cyrillic, r):
+		return scriptCyrillic
+	case unicode.Is(unicode.Arabic, r):
+		return scriptArabic
+	case unicode.Is(unicode.Hebrew, r):
+		return scriptHebrew
+	case unicode.Is(unicode.Greek, r):
+		return scriptGreek
+	case unicode.Is(unicode.Thai, r):
+		return scriptThai
+	case unicode.Is(unicode.Devanagari, r):
+		return scriptDevanagari
+	}
+	return scriptNone
+}
@@ -0,0 +1,356 @@
+// Package migrate 版本化数据库迁移。迁移以代码形式编译进二进制，按版本号顺序执行，
+// 已执行的版本记录在 schema_migrations 表中，多个副本同时迁移时通过锁表保证只有一个副本执行。
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+const (
+	// lockTTL 锁的有效期，持有者异常退出后超过该时间其他副本可以接管
+	lockTTL = 5 * time.Minute
+	// lockRetryInterval 等待其他副本释放锁的轮询间隔
+	lockRetryInterval = time.Second
+	// lockID 锁表中唯一的一行
+	lockID = 1
+)
+
+var (
+	// ErrSchemaTooNew 数据库中存在当前二进制不认识的更新版本，通常是回滚了二进制但没有回滚数据库
+	ErrSchemaTooNew = errors.New("database schema is newer than this binary")
+	// ErrPending 存在尚未执行的迁移
+	ErrPending = errors.New("database schema has pending migrations")
+)
+
+// Migration 一次数据库迁移，发布后不可修改，需要调整时新增迁移
+type Migration struct {
+	// Version 版本号，严格递增，建议使用日期加序号，如 2026101901
+	Version int64
+	Name    string
+	Up      func(tx *gorm.DB) error
+	// Down 回滚迁移，为空表示不可回滚
+	Down func(tx *gorm.DB) error
+}
+
+// Status 迁移状态
+type Status struct {
+	Version   int64
+	Name      string
+	AppliedAt *time.Time
+}
+
+// schemaMigration 已执行的迁移
+type schemaMigration struct {
+	Version   int64     `gorm:"primaryKey;autoIncrement:false"`
+	Name      string    `gorm:"type:varchar(255);not null"`
+	AppliedAt time.Time `gorm:"not null"`
+}
+
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// schemaMigrationLock 迁移锁，插入成功即持有锁
+type schemaMigrationLock struct {
+	ID       int64     `gorm:"primaryKey;autoIncrement:false"`
+	Owner    string    `gorm:"type:varchar(255);not null"`
+	LockedAt time.Time `gorm:"not null"`
+}
+
+func (schemaMigrationLock) TableName() string {
+	return "schema_migration_lock"
+}
+
+// Migrator 执行迁移
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+	owner      string
+	log        *log.Helper
+}
+
+// New 创建迁移器，迁移按版本号排序，版本号重复时返回错误
+func New(db *gorm.DB, migrations []Migration, logger log.Logger) (*Migrator, error) {
+	sorted := append([]Migration(nil), migrations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Version < sorted[j].Version })
+	for i, m := range sorted {
+		if m.Version <= 0 || m.Up == nil {
+			return nil, fmt.Errorf("invalid migration %d %s", m.Version, m.Name)
+		}
+		if i > 0 && sorted[i-1].Version == m.Version {
+			return nil, fmt.Errorf("duplicate migration version %d", m.Version)
+		}
+	}
+	hostname, _ := os.Hostname()
+	return &Migrator{
+		db:         db,
+		migrations: sorted,
+		owner:      fmt.Sprintf("%s-%d-%d", hostname, os.Getpid(), time.Now().UnixNano()),
+		log:        log.NewHelper(log.With(logger, "module", "migrate")),
+	}, nil
+}
+
+// Latest 当前二进制已知的最新版本
+func (m *Migrator) Latest() int64 {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Check 启动时检查数据库版本，存在未知的更新版本时返回 ErrSchemaTooNew，存在未执行的迁移时返回 ErrPending
+func (m *Migrator) Check(ctx context.Context) error {
+	if err := m.ensureTables(ctx); err != nil {
+		return err
+	}
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return err
+	}
+	if err := m.checkUnknown(applied); err != nil {
+		return err
+	}
+	if pending := m.pending(applied); len(pending) > 0 {
+		return fmt.Errorf("%w: %d migrations up to version %d not applied", ErrPending, len(pending), m.Latest())
+	}
+	return nil
+}
+
+// Up 执行所有未执行的迁移
+func (m *Migrator) Up(ctx context.Context) error {
+	return m.withLock(ctx, func() error {
+		applied, err := m.applied(ctx)
+		if err != nil {
+			return err
+		}
+		if err := m.checkUnknown(applied); err != nil {
+			return err
+		}
+		for _, mg := range m.pending(applied) {
+			m.log.WithContext(ctx).Infof("applying migration %d %s", mg.Version, mg.Name)
+			// MySQL 的 DDL 会隐式提交事务，迁移失败时需要人工确认已执行的部分
+			err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+				if err := mg.Up(tx); err != nil {
+					return err
+				}
+				return tx.Create(&schemaMigration{Version: mg.Version, Name: mg.Name, AppliedAt: time.Now()}).Error
+			})
+			if err != nil {
+				return fmt.Errorf("migration %d %s failed: %w", mg.Version, mg.Name, err)
+			}
+		}
+		return nil
+	})
+}
+
+// Down 按版本从新到旧回滚最近执行的 steps 个迁移
+func (m *Migrator) Down(ctx context.Context, steps int) error {
+	return m.withLock(ctx, func() error {
+		applied, err := m.applied(ctx)
+		if err != nil {
+			return err
+		}
+		if err := m.checkUnknown(applied); err != nil {
+			return err
+		}
+		for i := len(m.migrations) - 1; i >= 0 && steps > 0; i-- {
+			mg := m.migrations[i]
+			if _, ok := applied[mg.Version]; !ok {
+				continue
+			}
+			if mg.Down == nil {
+				return fmt.Errorf("migration %d %s is irreversible", mg.Version, mg.Name)
+			}
+			m.log.WithContext(ctx).Infof("reverting migration %d %s", mg.Version, mg.Name)
+			err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+				if err := mg.Down(tx); err != nil {
+					return err
+				}
+				return tx.Delete(&schemaMigration{}, "version = ?", mg.Version).Error
+			})
+			if err != nil {
+				return fmt.Errorf("revert migration %d %s failed: %w", mg.Version, mg.Name, err)
+			}
+			steps--
+		}
+		return nil
+	})
+}
+
+// Status 返回所有已知迁移的执行状态
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	if err := m.ensureTables(ctx); err != nil {
+		return nil, err
+	}
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, mg := range m.migrations {
+		s := Status{Version: mg.Version, Name: mg.Name}
+		if row, ok := applied[mg.Version]; ok {
+			s.AppliedAt = &row.AppliedAt
+		}
+		statuses = append(statuses, s)
+	}
+	return statuses, nil
+}
+
+// Run 执行 migrate 子命令：up、down [n]、status
+func (m *Migrator) Run(ctx context.Context, args []string, w io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: migrate up | down [n] | status")
+	}
+	switch args[0] {
+	case "up":
+		return m.Up(ctx)
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			n, err := strconv.Atoi(args[1])
+			if err != nil || n <= 0 {
+				return fmt.Errorf("invalid number of steps %q", args[1])
+			}
+			steps = n
+		}
+		return m.Down(ctx, steps)
+	case "status":
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, s := range statuses {
+			applied := "pending"
+			if s.AppliedAt != nil {
+				applied = s.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\n", s.Version, s.Name, applied)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown migrate command %q", args[0])
+	}
+}
+
+// ensureTables 创建迁移记录表与锁表，多个副本并发创建时以表已存在为准
+func (m *Migrator) ensureTables(ctx context.Context) error {
+	db := m.db.WithContext(ctx)
+	for _, table := range []interface{}{&schemaMigration{}, &schemaMigrationLock{}} {
+		if db.Migrator().HasTable(table) {
+			continue
+		}
+		if err := db.Migrator().CreateTable(table); err != nil && !db.Migrator().HasTable(table) {
+			return fmt.Errorf("failed to create migration table: %w", err)
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) applied(ctx context.Context) (map[int64]schemaMigration, error) {
+	var rows []schemaMigration
+	if err := m.db.WithContext(ctx).Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to read applied migrations: %w", err)
+	}
+	applied := make(map[int64]schemaMigration, len(rows))
+	for _, row := range rows {
+		applied[row.Version] = row
+	}
+	return applied, nil
+}
+
+// checkUnknown 已执行但当前二进制不认识的版本高于已知最新版本时拒绝继续
+func (m *Migrator) checkUnknown(applied map[int64]schemaMigration) error {
+	for version, row := range applied {
+		if version > m.Latest() {
+			return fmt.Errorf("%w: version %d %s is applied but the latest known migration is %d",
+				ErrSchemaTooNew, version, row.Name, m.Latest())
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) pending(applied map[int64]schemaMigration) []Migration {
+	var pending []Migration
+	for _, mg := range m.migrations {
+		if _, ok := applied[mg.Version]; !ok {
+			pending = append(pending, mg)
+		}
+	}
+	return pending
+}
+
+// withLock 持有迁移锁执行 fn，锁被其他副本持有时等待其释放或过期
+func (m *Migrator) withLock(ctx context.Context, fn func() error) error {
+	if err := m.ensureTables(ctx); err != nil {
+		return err
+	}
+	if err := m.lock(ctx); err != nil {
+		return err
+	}
+
+	// 迁移耗时较长时定期续期，避免被其他副本判定为过期
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(lockTTL / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := m.db.Model(&schemaMigrationLock{}).
+					Where("id = ? AND owner = ?", lockID, m.owner).
+					Update("locked_at", time.Now()).Error; err != nil {
+					m.log.Errorf("failed to refresh migration lock: %v", err)
+				}
+			}
+		}
+	}()
+	defer func() {
+		close(done)
+		if err := m.db.Where("id = ? AND owner = ?", lockID, m.owner).Delete(&schemaMigrationLock{}).Error; err != nil {
+			m.log.Errorf("failed to release migration lock: %v", err)
+		}
+	}()
+	return fn()
+}
+
+func (m *Migrator) lock(ctx context.Context) error {
+	db := m.db.WithContext(ctx)
+	// 锁被占用时插入必然冲突，不记录错误日志
+	quiet := db.Session(&gorm.Session{Logger: db.Logger.LogMode(gormlogger.Silent)})
+	for waited := false; ; waited = true {
+		now := time.Now()
+		if err := quiet.Create(&schemaMigrationLock{ID: lockID, Owner: m.owner, LockedAt: now}).Error; err == nil {
+			return nil
+		}
+		// 接管已过期的锁
+		res := db.Model(&schemaMigrationLock{}).
+			Where("id = ? AND locked_at < ?", lockID, now.Add(-lockTTL)).
+			Updates(map[string]interface{}{"owner": m.owner, "locked_at": now})
+		if res.Error == nil && res.RowsAffected == 1 {
+			m.log.WithContext(ctx).Warn("took over expired migration lock")
+			return nil
+		}
+		if !waited {
+			m.log.WithContext(ctx).Info("waiting for another instance to finish migrating")
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("failed to acquire migration lock: %w", ctx.Err())
+		case <-time.After(lockRetryInterval):
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// Package requestid 为每个请求分配请求ID并在服务间传递。
+//
+// 网关优先使用客户端传入的 X-Request-Id 请求头，未传入时生成新ID，并在响应头中返回；
+// 经 gRPC 元数据转发给下游服务，日志通过 Valuer 输出同一请求ID。
+package requestid
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/google/uuid"
+)
+
+const (
+	// HeaderKey 请求与响应中携带请求ID的HTTP头
+	HeaderKey = "X-Request-Id"
+	// MetadataKey 服务间转发使用的元数据键
+	MetadataKey = "x-md-global-request-id"
+	// maxLength 客户端传入的请求ID最大长度，超出时重新生成
+	maxLength = 128
+)
+
+type requestIDKey struct{}
+
+// NewContext 返回携带请求ID的上下文
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// FromContext 获取当前请求ID，不存在时返回空
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// Valuer 日志字段，输出当前请求ID
+func Valuer() log.Valuer {
+	return func(ctx context.Context) interface{} {
+		return FromContext(ctx)
+	}
+}
+
+// Server 服务端中间件，从请求头或元数据读取请求ID，不存在时生成，并写入响应头
+func Server() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				id := tr.RequestHeader().Get(HeaderKey)
+				if id == "" {
+					id = tr.RequestHeader().Get(MetadataKey)
+				}
+				if id == "" || len(id) > maxLength {
+					id = uuid.NewString()
+				}
+				tr.ReplyHeader().Set(HeaderKey, id)
+				ctx = NewContext(ctx, id)
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// Client 客户端中间件，将请求ID写入下游请求元数据
+func Client() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if id := FromContext(ctx); id != "" {
+				if tr, ok := transport.FromClientContext(ctx); ok {
+					tr.RequestHeader().Set(MetadataKey, id)
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
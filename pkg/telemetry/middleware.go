@@ -0,0 +1,143 @@
+package telemetry
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"universal/pkg/requestid"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/metrics"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// 请求指标名称，导出到 Prometheus 时分别带 _total 与 _seconds 后缀
+const (
+	serverRequestsName = "server_requests"
+	serverSecondsName  = "server_request_duration"
+	clientRequestsName = "client_requests"
+	clientSecondsName  = "client_request_duration"
+)
+
+// latencyBuckets 请求耗时直方图的分桶，单位秒
+var latencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+type instruments struct {
+	serverRequests metric.Int64Counter
+	serverSeconds  metric.Float64Histogram
+	clientRequests metric.Int64Counter
+	clientSeconds  metric.Float64Histogram
+}
+
+var (
+	instrumentsOnce sync.Once
+	shared          *instruments
+)
+
+// sharedInstruments 同一进程的HTTP与gRPC服务共用一组指标，创建失败时对应指标不记录
+func sharedInstruments() *instruments {
+	instrumentsOnce.Do(func() {
+		meter := otel.Meter("universal/pkg/telemetry")
+		shared = &instruments{}
+		shared.serverRequests, _ = meter.Int64Counter(serverRequestsName,
+			metric.WithDescription("The total number of processed requests"))
+		shared.serverSeconds, _ = meter.Float64Histogram(serverSecondsName,
+			metric.WithDescription("Server request latency"),
+			metric.WithUnit("s"),
+			metric.WithExplicitBucketBoundaries(latencyBuckets...))
+		shared.clientRequests, _ = meter.Int64Counter(clientRequestsName,
+			metric.WithDescription("The total number of outgoing requests"))
+		shared.clientSeconds, _ = meter.Float64Histogram(clientSecondsName,
+			metric.WithDescription("Client request latency"),
+			metric.WithUnit("s"),
+			metric.WithExplicitBucketBoundaries(latencyBuckets...))
+	})
+	return shared
+}
+
+// Server 服务端公共中间件：请求ID、链路追踪、请求指标与访问日志
+func Server(logger log.Logger) middleware.Middleware {
+	ins := sharedInstruments()
+	var opts []metrics.Option
+	if ins.serverRequests != nil {
+		opts = append(opts, metrics.WithRequests(ins.serverRequests))
+	}
+	if ins.serverSeconds != nil {
+		opts = append(opts, metrics.WithSeconds(ins.serverSeconds))
+	}
+	return middleware.Chain(
+		requestid.Server(),
+		tracing.Server(),
+		metrics.Server(opts...),
+		accessLog(logger),
+	)
+}
+
+// Client 服务间调用的客户端中间件：请求ID与追踪上下文传播、请求指标
+func Client() middleware.Middleware {
+	ins := sharedInstruments()
+	var opts []metrics.Option
+	if ins.clientRequests != nil {
+		opts = append(opts, metrics.WithRequests(ins.clientRequests))
+	}
+	if ins.clientSeconds != nil {
+		opts = append(opts, metrics.WithSeconds(ins.clientSeconds))
+	}
+	return middleware.Chain(
+		requestid.Client(),
+		tracing.Client(),
+		metrics.Client(opts...),
+	)
+}
+
+// accessLog 按路由输出访问日志，不记录请求参数以免泄露密钥等敏感字段
+func accessLog(logger log.Logger) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+
+			start := time.Now()
+			reply, err := handler(ctx, req)
+
+			keyvals := []interface{}{
+				"kind", "server",
+				"component", tr.Kind().String(),
+				"operation", tr.Operation(),
+			}
+			if ht, ok := tr.(http.Transporter); ok {
+				keyvals = append(keyvals,
+					"method", ht.Request().Method,
+					"path", ht.Request().URL.Path,
+				)
+			}
+
+			level := log.LevelInfo
+			code, reason := int32(200), ""
+			if err != nil {
+				se := errors.FromError(err)
+				code, reason = se.Code, se.Reason
+				level = log.LevelWarn
+				if code >= 500 {
+					level = log.LevelError
+				}
+			}
+			keyvals = append(keyvals,
+				"code", code,
+				"reason", reason,
+				"latency", time.Since(start).Seconds(),
+			)
+			_ = log.WithContext(ctx, logger).Log(level, keyvals...)
+			return reply, err
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// Package telemetry 提供各服务统一的可观测性配置：OpenTelemetry 链路追踪、Prometheus 指标与访问日志。
+//
+// 进程启动时调用 Setup 注册全局 TracerProvider 与 MeterProvider，
+// HTTP/gRPC 服务端使用 Server 中间件，服务间调用的客户端使用 Client 中间件，
+// 指标通过 HTTP 服务的 /metrics 暴露。
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+)
+
+// MetricsPath 指标暴露路径
+const MetricsPath = "/metrics"
+
+// shutdownTimeout 退出时上报剩余追踪数据的超时时间
+const shutdownTimeout = 5 * time.Second
+
+// Options 可观测性配置
+type Options struct {
+	Name    string
+	Version string
+	ID      string
+	// Endpoint OTLP/HTTP 追踪接收地址，如 http://localhost:4318/v1/traces，为空时只生成追踪ID不上报
+	Endpoint string
+	// SampleRatio 根请求的采样比例，上游已采样的请求始终采样，默认1
+	SampleRatio float64
+}
+
+// Setup 注册全局 TracerProvider、MeterProvider 与追踪上下文传播方式，返回的函数在退出时调用
+func Setup(ctx context.Context, opts Options) (func(), error) {
+	res := resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(opts.Name),
+		semconv.ServiceVersion(opts.Version),
+		semconv.ServiceInstanceID(opts.ID),
+	)
+
+	ratio := opts.SampleRatio
+	if ratio <= 0 {
+		ratio = 1
+	}
+	traceOpts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+	}
+	if opts.Endpoint != "" {
+		exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(opts.Endpoint))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create trace exporter: %w", err)
+		}
+		traceOpts = append(traceOpts, sdktrace.WithBatcher(exporter))
+	}
+	tp := sdktrace.NewTracerProvider(traceOpts...)
+
+	// 指标注册到 Prometheus 默认注册表，与Go运行时指标一同暴露
+	exporter, err := prometheus.New()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create metrics exporter: %w", err)
+	}
+	mp := sdkmetric.NewMeterProvider(
+		sdkmetric.WithResource(res),
+		sdkmetric.WithReader(exporter),
+	)
+
+	otel.SetTracerProvider(tp)
+	otel.SetMeterProvider(mp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		_ = tp.Shutdown(ctx)
+		_ = mp.Shutdown(ctx)
+	}, nil
+}
+
+// MetricsHandler Prometheus 指标接口
+func MetricsHandler() http.Handler {
+	return promhttp.Handler()
+}
@@ -0,0 +1,113 @@
+package textseg
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// koreanSuffixes 韩文词尾常见的助词与语尾，按长度从长到短匹配
+var koreanSuffixes = []string{
+	"에서는", "에게서", "으로는", "으로서", "으로써", "습니다", "습니까", "합니다", "합니까", "입니다", "입니까",
+	"에서", "에게", "으로", "까지", "부터", "처럼", "보다", "하는", "하고", "했다", "한다", "하다",
+	"은", "는", "이", "가", "을", "를", "의", "에", "로", "와", "과", "도", "만", "께",
+}
+
+// KeywordsFor 按语言切分文本并去除该语言的停用词，lang 为 ISO 639-1 代码。
+// 中文、英文与未知语言同 Keywords；日文按汉字与片假名连续段切分，平假名多为虚词与词尾，直接丢弃；
+// 韩文按单词切分后去除词尾助词；其他语言按单词切分。
+func (s *Segmenter) KeywordsFor(lang, text string) []string {
+	switch lang {
+	case "", "zh", "en":
+		return s.Keywords(text)
+	case "ja":
+		return japaneseKeywords(text)
+	}
+
+	tokens := s.Cut(text)
+	keywords := tokens[:0]
+	for _, token := range tokens {
+		if lang == "ko" {
+			token = trimKoreanSuffix(token)
+		}
+		if IsKeyword(token) && !IsStopWordFor(lang, token) {
+			keywords = append(keywords, token)
+		}
+	}
+	return keywords
+}
+
+// TopKeywordsFor 返回去重后的关键词，按出现次数降序，次数相同时按首次出现顺序，n 不大于 0 时不限数量
+func (s *Segmenter) TopKeywordsFor(lang, text string, n int) []string {
+	counts := make(map[string]int)
+	var keywords []string
+	for _, keyword := range s.KeywordsFor(lang, text) {
+		if counts[keyword] == 0 {
+			keywords = append(keywords, keyword)
+		}
+		counts[keyword]++
+	}
+	sort.SliceStable(keywords, func(i, j int) bool {
+		return counts[keywords[i]] > counts[keywords[j]]
+	})
+	if n > 0 && len(keywords) > n {
+		keywords = keywords[:n]
+	}
+	return keywords
+}
+
+// japaneseKeywords 日文按书写系统切分：连续汉字过长时按双字切分，片假名连续段整体作为一个词
+func japaneseKeywords(text string) []string {
+	var keywords []string
+	var run []rune
+	var kind int // 0:none, 1:han, 2:katakana, 3:latin
+	flush := func() {
+		switch {
+		case len(run) == 0:
+		case kind == 1:
+			if len(run) > 1 {
+				keywords = append(keywords, mergeUnknown(run)...)
+			}
+		case kind == 2:
+			if len(run) > 1 {
+				keywords = append(keywords, string(run))
+			}
+		case kind == 3:
+			if token := strings.ToLower(string(run)); IsKeyword(token) {
+				keywords = append(keywords, token)
+			}
+		}
+		run, kind = run[:0], 0
+	}
+
+	for _, r := range text {
+		k := 0
+		switch {
+		case unicode.Is(unicode.Han, r):
+			k = 1
+		case unicode.Is(unicode.Katakana, r) || r == 'ー':
+			k = 2
+		case unicode.IsLetter(r) && !unicode.Is(unicode.Hiragana, r) || unicode.IsDigit(r):
+			k = 3
+		}
+		if k != kind {
+			flush()
+		}
+		if k != 0 {
+			run = append(run, r)
+			kind = k
+		}
+	}
+	flush()
+	return keywords
+}
+
+// trimKoreanSuffix 去除韩文词尾助词，去除后不足两个字时保留原词
+func trimKoreanSuffix(token string) string {
+	for _, suffix := range koreanSuffixes {
+		if stem := strings.TrimSuffix(token, suffix); stem != token && len([]rune(stem)) >= 2 {
+			return stem
+		}
+	}
+	return token
+}
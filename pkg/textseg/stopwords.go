@@ -0,0 +1,93 @@
+package textseg
+
+import "strings"
+
+// stopWords 中英文停用词，对所有语言生效
+var stopWords = map[string]struct{}{}
+
+func init() {
+	for _, w := range strings.Fields(`
+		a an the and or but if then else of to in on at by for with from into onto about as is are was were be been being
+		do does did done have has had having can could will would shall should may might must not no yes
+		i me my mine we us our you your he him his she her it its they them their this that these those there here
+		what which who whom whose when where why how all any both each few more most other some such only own same so
+		than too very just also again further once out up down over under off above below between through during before after
+		please help thanks thank hello hi ok okay want need like get got make made use using used let lets know think
+		我们 你们 他们 她们 它们 自己 什么 怎么 怎样 如何 为什么 为何 哪些 哪个 哪里 这个 那个 这些 那些 这样 那样 这里 那里
+		一个 一些 一下 一种 一样 一直 可以 可能 应该 需要 能够 能否 能不能 是否 是不是 有没有 没有 已经 现在 时候 然后 之后 之前
+		因为 所以 但是 而且 并且 或者 还是 如果 虽然 就是 不是 只是 还有 以及 关于 对于 通过 进行 其中 其他 其它 比较 非常 特别
+		请问 帮我 帮忙 麻烦 谢谢 你好 您好 知道 觉得 认为 告诉 给我 一起 东西 事情 问题 情况 方面 时间 内容 具体 详细 简单
+	`) {
+		stopWords[w] = struct{}{}
+	}
+}
+
+// langStopWords 其他语言的停用词，按 ISO 639-1 语言代码索引
+var langStopWords = map[string]map[string]struct{}{}
+
+func init() {
+	for lang, words := range map[string]string{
+		"fr": `le la les un une des du de d l au aux et ou mais donc car ni que qui quoi dont où ce cet cette ces
+			je tu il elle on nous vous ils elles me te se moi toi lui leur leurs mon ma mes ton ta tes son sa ses notre nos votre vos
+			est sont était être avoir ai as avons avez ont a été fait faire peut plus pas ne non oui en dans par pour sur sous avec sans
+			entre vers chez après avant depuis pendant comme aussi très tout tous toute toutes même autre autres bien alors ainsi si quand comment pourquoi`,
+		"de": `der die das den dem des ein eine einer eines einem einen und oder aber denn sondern dass ob wenn weil als wie
+			ich du er sie es wir ihr mich dich sich uns euch mein meine dein deine sein seine ihre unser unsere euer
+			ist sind war waren bin bist sein haben hat habe hatte wird werden wurde kann können muss müssen soll sollen
+			nicht kein keine ja nein auch noch nur schon sehr mehr in im an am auf aus bei mit nach von vom zu zum zur für über unter
+			durch gegen ohne um vor hinter zwischen dieser diese dieses jeder jede alle man was wer wo warum dann so hier dort`,
+		"es": `el la los las un una unos unas lo al del de y e o u pero sino que quien cual cuales donde cuando como porque
+			yo tú él ella nosotros vosotros ellos ellas me te se nos os le les mi mis tu tus su sus nuestro nuestra
+			es son era ser estar está están fue ha han hay haber tiene tienen puede pueden no sí ya muy más menos también
+			en con sin por para sobre entre hasta desde hacia este esta estos estas ese esa esos esas todo todos toda todas otro otra`,
+		"it": `il lo la i gli le un uno una del dello della dei degli delle di da al allo alla ai agli alle e ed o ma che chi cui
+			io tu lui lei noi voi loro mi ti si ci vi mio mia miei mie tuo tua suo sua nostro nostra
+			è sono era essere ha hanno ho avere fa può non sì già molto più meno anche in con su per tra fra
+			questo questa questi queste quello quella tutto tutti tutta tutte altro altra come dove quando perché se`,
+		"pt": `o a os as um uma uns umas do da dos das de em no na nos nas ao aos à às e ou mas que quem qual onde quando como porque
+			eu tu ele ela nós vós eles elas me te se lhe lhes meu minha meus minhas seu sua seus suas nosso nossa
+			é são era ser estar está estão foi tem têm ter há pode podem não sim já muito mais menos também
+			com sem por para pelo pela pelos pelas sobre entre até desde este esta estes estas esse essa esses essas isso isto todo toda todos todas outro outra`,
+		"nl": `de het een en of maar dat die dit deze wie wat waar wanneer hoe waarom als omdat
+			ik jij je hij zij ze wij we jullie u mij me hem haar ons hun mijn jouw zijn haar onze
+			is zijn was waren ben bent heeft hebben had wordt worden werd kan kunnen moet moeten zal zullen
+			niet geen ja nee ook nog al wel zeer meer in op aan bij met naar van voor over onder door tegen zonder tot uit om er hier daar`,
+		"sv": `en ett den det de dem och eller men att som vem vad var när hur varför om eftersom
+			jag du han hon vi ni mig dig sig oss er min mitt mina din ditt dina hans hennes vår vårt våra deras
+			är var vara har hade ha blir blev kan kunde måste ska skulle inte ingen inga ja nej också även bara redan mycket mer
+			i på av till från med för över under genom mot utan vid efter före hos här där denna detta dessa alla`,
+		"pl": `i a o u w we z ze na do od po za przez dla bez przy pod nad przed między oraz lub albo ale że czy jak gdy kiedy gdzie dlaczego bo
+			ja ty on ona ono my wy oni one mnie ciebie go jej nas was ich mój moja moje twój twoja jego nasz nasza wasz
+			jest są był była było być ma mają miał może mogą nie tak też także już bardzo więcej ten ta to te tego tej tym wszystko wszyscy się`,
+		"ru": `и в во не что он она оно они я ты мы вы на с со как а то все всё так его её их но да к ко у же вы за бы по только
+			мне меня тебя нас вас им ему ей из от до о об при для без под над через между или ли если когда где почему потому
+			это этот эта эти тот та те быть был была было были есть будет может можно нет уже ещё очень более мой моя наш ваш свой`,
+		"uk": `і й та в у на з із зі до від по за для без під над через між або чи але що як коли де чому тому якщо
+			я ти він вона воно ми ви вони мене тебе нас вас їх його її мій моя наш ваш свій це цей ця ці той та ті
+			бути був була було були є буде може можна не ні так також вже ще дуже більше все всі`,
+		"ko": `그리고 그러나 하지만 그래서 또는 및 등 이 그 저 것 수 때 더 또 잘 좀 안 못 우리 저희 나 너 당신
+			이것 그것 저것 여기 거기 저기 어떻게 무엇 왜 어디 언제 누구 있다 없다 하다 되다 있습니다 없습니다 합니다 됩니다`,
+	} {
+		set := make(map[string]struct{})
+		for _, w := range strings.Fields(words) {
+			set[w] = struct{}{}
+		}
+		langStopWords[lang] = set
+	}
+}
+
+// IsStopWord 判断是否为停用词
+func IsStopWord(word string) bool {
+	_, ok := stopWords[strings.ToLower(word)]
+	return ok
+}
+
+// IsStopWordFor 判断是否为指定语言或中英文的停用词
+func IsStopWordFor(lang, word string) bool {
+	word = strings.ToLower(word)
+	if _, ok := langStopWords[lang][word]; ok {
+		return true
+	}
+	_, ok := stopWords[word]
+	return ok
+}
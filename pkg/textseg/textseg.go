@@ -0,0 +1,258 @@
+// Package textseg 离线分词。
+//
+// 中文按内置词典做最大概率切分，词典未收录的连续单字合并为新词；
+// 拉丁字母与数字按单词切分并转为小写。Keywords 在分词基础上去除停用词，
+// 用于关键词统计与主题模型；KeywordsFor 按文本语言选择切分方式与停用词。
+package textseg
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+//go:embed dict.txt
+var builtinDict string
+
+// maxMergeRunes 未登录词合并的最大长度，更长的单字串按双字切分
+const maxMergeRunes = 4
+
+// functionChars 虚词与代词，不参与未登录词合并
+var functionChars = map[rune]struct{}{}
+
+func init() {
+	for _, r := range "的了是在我你他她它们这那有和与就都也还要会能吗呢吧啊么个一不没很把被给对从到让为及或但而着过地得之其些" {
+		functionChars[r] = struct{}{}
+	}
+}
+
+// Segmenter 基于词频词典的分词器，词典加载完成后可并发使用
+type Segmenter struct {
+	mu      sync.RWMutex
+	freq    map[string]float64
+	total   float64
+	maxLen  int
+	logProb map[string]float64
+	minLog  float64
+}
+
+var (
+	defaultOnce sync.Once
+	defaultSeg  *Segmenter
+)
+
+// Default 返回加载内置词典的共享分词器
+func Default() *Segmenter {
+	defaultOnce.Do(func() {
+		defaultSeg = New()
+	})
+	return defaultSeg
+}
+
+// New 创建加载内置词典的分词器
+func New() *Segmenter {
+	s := &Segmenter{freq: make(map[string]float64)}
+	if err := s.LoadDictionary(strings.NewReader(builtinDict)); err != nil {
+		panic(fmt.Sprintf("textseg: invalid builtin dictionary: %v", err))
+	}
+	return s
+}
+
+// LoadDictionary 加载词典，每行为"词 词频"，词频可省略，#开头为注释
+func (s *Segmenter) LoadDictionary(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		freq := 1000
+		if len(fields) > 1 {
+			n, err := strconv.Atoi(fields[1])
+			if err != nil || n <= 0 {
+				return fmt.Errorf("line %d: invalid frequency %q", line, fields[1])
+			}
+			freq = n
+		}
+		s.AddWord(fields[0], freq)
+	}
+	return scanner.Err()
+}
+
+// AddWord 添加或覆盖词典词条
+func (s *Segmenter) AddWord(word string, freq int) {
+	if word == "" || freq <= 0 {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.total += float64(freq) - s.freq[word]
+	s.freq[word] = float64(freq)
+	if n := len([]rune(word)); n > s.maxLen {
+		s.maxLen = n
+	}
+	s.logProb = nil
+}
+
+// Cut 切分文本，保留停用词，不含标点与空白
+func (s *Segmenter) Cut(text string) []string {
+	logProb, minLog, maxLen := s.snapshot()
+
+	var tokens []string
+	var word, han []rune
+	flush := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, strings.ToLower(string(word)))
+			word = word[:0]
+		}
+		if len(han) > 0 {
+			tokens = append(tokens, cutHan(han, logProb, minLog, maxLen)...)
+			han = han[:0]
+		}
+	}
+
+	for _, r := range text {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			if len(word) > 0 {
+				flush()
+			}
+			han = append(han, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || (r == '_' && len(word) > 0):
+			if len(han) > 0 {
+				flush()
+			}
+			word = append(word, r)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
+
+// Keywords 切分文本并去除停用词、单字与纯数字
+func (s *Segmenter) Keywords(text string) []string {
+	tokens := s.Cut(text)
+	keywords := tokens[:0]
+	for _, token := range tokens {
+		if IsKeyword(token) {
+			keywords = append(keywords, token)
+		}
+	}
+	return keywords
+}
+
+// IsKeyword 判断词是否可作为关键词
+func IsKeyword(token string) bool {
+	runes := []rune(token)
+	if len(runes) < 2 || IsStopWord(token) {
+		return false
+	}
+	if !unicode.In(runes[0], unicode.Han, unicode.Hangul) && len(runes) < 3 {
+		return false
+	}
+	for _, r := range runes {
+		if !unicode.IsDigit(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// snapshot 返回对数概率表，词典变更后重新计算
+func (s *Segmenter) snapshot() (map[string]float64, float64, int) {
+	s.mu.RLock()
+	if s.logProb != nil {
+		defer s.mu.RUnlock()
+		return s.logProb, s.minLog, s.maxLen
+	}
+	s.mu.RUnlock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.logProb == nil {
+		logTotal := math.Log(s.total)
+		logProb := make(map[string]float64, len(s.freq))
+		for word, freq := range s.freq {
+			logProb[word] = math.Log(freq) - logTotal
+		}
+		s.logProb = logProb
+		s.minLog = -logTotal
+	}
+	return s.logProb, s.minLog, s.maxLen
+}
+
+// cutHan 对连续汉字做最大概率切分，词典外的单字按概率下限计分
+func cutHan(han []rune, logProb map[string]float64, minLog float64, maxLen int) []string {
+	n := len(han)
+	score := make([]float64, n+1)
+	next := make([]int, n+1)
+	for i := n - 1; i >= 0; i-- {
+		score[i] = math.Inf(-1)
+		for j := i + 1; j <= n && j-i <= maxLen; j++ {
+			p, ok := logProb[string(han[i:j])]
+			if !ok {
+				if j != i+1 {
+					continue
+				}
+				p = minLog
+			}
+			if p+score[j] > score[i] {
+				score[i] = p + score[j]
+				next[i] = j
+			}
+		}
+	}
+
+	var tokens []string
+	var single []rune
+	flushSingle := func() {
+		tokens = append(tokens, mergeUnknown(single)...)
+		single = single[:0]
+	}
+	for i := 0; i < n; i = next[i] {
+		if next[i] == i+1 {
+			if _, known := logProb[string(han[i])]; !known {
+				if _, fn := functionChars[han[i]]; !fn {
+					single = append(single, han[i])
+					continue
+				}
+			}
+		}
+		flushSingle()
+		tokens = append(tokens, string(han[i:next[i]]))
+	}
+	flushSingle()
+	return tokens
+}
+
+// mergeUnknown 合并连续的未登录单字，过长时按双字切分
+func mergeUnknown(runes []rune) []string {
+	switch {
+	case len(runes) == 0:
+		return nil
+	case len(runes) == 1:
+		return []string{string(runes)}
+	case len(runes) <= maxMergeRunes:
+		return []string{string(runes)}
+	}
+	tokens := make([]string, 0, len(runes)/2+1)
+	for i := 0; i < len(runes); i += 2 {
+		end := i + 2
+		if end > len(runes) {
+			end = len(runes)
+		}
+		tokens = append(tokens, string(runes[i:end]))
+	}
+	return tokens
+}
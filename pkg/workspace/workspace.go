@@ -0,0 +1,65 @@
+// Package workspace 在服务间传递当前激活的工作空间。
+//
+// 网关从 HTTP 请求头 X-Workspace-Id 读取工作空间ID，经 gRPC 元数据转发给下游服务，
+// 下游服务通过 FromContext 获取。未携带时表示个人空间。
+package workspace
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+const (
+	// HeaderKey 客户端指定工作空间使用的HTTP请求头
+	HeaderKey = "X-Workspace-Id"
+	// MetadataKey 服务间转发使用的元数据键
+	MetadataKey = "x-md-global-workspace-id"
+)
+
+type workspaceKey struct{}
+
+// NewContext 返回携带工作空间ID的上下文
+func NewContext(ctx context.Context, workspaceID int64) context.Context {
+	return context.WithValue(ctx, workspaceKey{}, workspaceID)
+}
+
+// FromContext 获取当前工作空间ID，个人空间返回0
+func FromContext(ctx context.Context) int64 {
+	id, _ := ctx.Value(workspaceKey{}).(int64)
+	return id
+}
+
+// Server 服务端中间件，从请求头或元数据解析工作空间ID
+func Server() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				value := tr.RequestHeader().Get(HeaderKey)
+				if value == "" {
+					value = tr.RequestHeader().Get(MetadataKey)
+				}
+				if id, err := strconv.ParseInt(value, 10, 64); err == nil && id > 0 {
+					ctx = NewContext(ctx, id)
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// Client 客户端中间件，将工作空间ID写入下游请求元数据
+func Client() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if id := FromContext(ctx); id > 0 {
+				if tr, ok := transport.FromClientContext(ctx); ok {
+					tr.RequestHeader().Set(MetadataKey, strconv.FormatInt(id, 10))
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}